@@ -2,407 +2,1117 @@ package api
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/gorilla/mux"
-	"io"
+	"github.com/graph-gophers/graphql-go"
 	"log"
 	"net/http"
-	"strconv"
+	"time"
 )
 
 type APIServer struct {
 	listenAddr string
 	store      Storage
+	blobs      BlobStore
+	sealer     *Sealer
+	auctions   *auctionHub
+	graphql    *graphql.Schema
 }
 
-func NewAPIServer(listenAddr string, store Storage) *APIServer {
-	return &APIServer{
+var _ ServerInterface = (*APIServer)(nil)
+
+func NewAPIServer(listenAddr string, store Storage, blobs BlobStore, sealer *Sealer) *APIServer {
+	v := &APIServer{
 		listenAddr: listenAddr,
 		store:      store,
+		blobs:      blobs,
+		sealer:     sealer,
+		auctions:   newAuctionHub(),
 	}
+	v.graphql = newGraphQLSchema(v)
+	return v
 }
 
-func (v *APIServer) Run() {
+// Handler builds the router: the routes of задание/openapi.yml come from the
+// generated ServerInterface wrapper, behind spec-driven request validation.
+func (v *APIServer) Handler() (http.Handler, error) {
 	router := mux.NewRouter()
+	router.Use(withRequestID)
 
-	router.HandleFunc("/api/ping", makeHTTPHandleFunc(v.pingServer))
-
-	router.HandleFunc("/api/tenders", makeHTTPHandleFunc(v.getAllTenders))
-	router.HandleFunc("/api/tenders/new", makeHTTPHandleFunc(v.createNewTender))
-	router.HandleFunc("/api/tenders/my", makeHTTPHandleFunc(v.handleUserTenders))
-	router.HandleFunc("/api/tenders/{id}/edit", makeHTTPHandleFunc(v.updateTenderById))
-	router.HandleFunc("/api/tenders/{tender_id}/rollback/{version}", makeHTTPHandleFunc(v.handleTenderRollback))
+	validator, err := newRequestValidator()
+	if err != nil {
+		return nil, err
+	}
+	router.Use(validator)
+
+	router.NotFoundHandler = makeHTTPHandleFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return httpError(http.StatusNotFound, "route %s not found", r.URL.Path)
+	})
+	router.MethodNotAllowedHandler = makeHTTPHandleFunc(func(w http.ResponseWriter, r *http.Request) error {
+		return httpError(http.StatusMethodNotAllowed, "method %s not allowed", r.Method)
+	})
+
+	HandlerWithOptions(v, GorillaServerOptions{
+		BaseURL:    "/api",
+		BaseRouter: router,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			handleError(w, httpError(http.StatusBadRequest, "%v", err))
+		},
+	})
+
+	return router, nil
+}
 
-	router.HandleFunc("/api/bids/new", makeHTTPHandleFunc(v.createNewBid))
-	router.HandleFunc("/api/bids/my", makeHTTPHandleFunc(v.handleUserBids))
-	router.HandleFunc("/api/bids/{tender_id}/list", makeHTTPHandleFunc(v.handleTenderBids))
-	router.HandleFunc("/api/bids/{id}/edit", makeHTTPHandleFunc(v.updateBidById))
-	router.HandleFunc("/api/bids/{bid_id}/rollback/{version}", makeHTTPHandleFunc(v.handleBidRollback))
-	router.HandleFunc("/api/bids/{bid_id}/newreview", makeHTTPHandleFunc(v.createNewReviewOnBid))
-	router.HandleFunc("/api/bids/{tender_id}/reviews", makeHTTPHandleFunc(v.handleReviewBids))
+func (v *APIServer) Run() {
+	router, err := v.Handler()
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	log.Println("JSON API RUNNING ON PORT", v.listenAddr)
-	http.ListenAndServe(v.listenAddr, router)
+	log.Fatal(http.ListenAndServe(v.listenAddr, router))
+}
+
+func (a *APIServer) CheckServer(w http.ResponseWriter, r *http.Request) {
+	handleError(w, a.pingServer(w, r))
+}
+
+func (a *APIServer) GetTenders(w http.ResponseWriter, r *http.Request, params GetTendersParams) {
+	handleError(w, a.getAllTenders(w, r, params))
+}
+
+func (a *APIServer) CreateTender(w http.ResponseWriter, r *http.Request) {
+	handleError(w, a.createNewTender(w, r))
+}
+
+func (a *APIServer) GetUserTenders(w http.ResponseWriter, r *http.Request, params GetUserTendersParams) {
+	handleError(w, a.handleUserTenders(w, r, params))
+}
+
+func (a *APIServer) GetTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderStatusParams) {
+	handleError(w, a.getTenderStatus(w, r, tenderId, params))
+}
+
+func (a *APIServer) UpdateTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UpdateTenderStatusParams) {
+	handleError(w, a.updateTenderStatus(w, r, tenderId, params))
+}
+
+func (a *APIServer) EditTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params EditTenderParams) {
+	handleError(w, a.updateTenderById(w, r, tenderId, params))
+}
+
+func (a *APIServer) RollbackTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, version int32, params RollbackTenderParams) {
+	handleError(w, a.handleTenderRollback(w, r, tenderId, version, params))
+}
+
+func (a *APIServer) CreateBid(w http.ResponseWriter, r *http.Request) {
+	handleError(w, a.createNewBid(w, r))
+}
+
+func (a *APIServer) GetUserBids(w http.ResponseWriter, r *http.Request, params GetUserBidsParams) {
+	handleError(w, a.handleUserBids(w, r, params))
+}
+
+func (a *APIServer) GetBidsForTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidsForTenderParams) {
+	handleError(w, a.handleTenderBids(w, r, tenderId, params))
+}
+
+func (a *APIServer) GetBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidStatusParams) {
+	handleError(w, a.getBidStatus(w, r, bidId, params))
+}
+
+func (a *APIServer) UpdateBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params UpdateBidStatusParams) {
+	handleError(w, a.updateBidStatus(w, r, bidId, params))
+}
+
+func (a *APIServer) EditBid(w http.ResponseWriter, r *http.Request, bidId BidId, params EditBidParams) {
+	handleError(w, a.updateBidById(w, r, bidId, params))
+}
+
+func (a *APIServer) SubmitBidDecision(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidDecisionParams) {
+	handleError(w, a.submitBidDecision(w, r, bidId, params))
+}
+
+func (a *APIServer) SubmitBidFeedback(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidFeedbackParams) {
+	handleError(w, a.createNewReviewOnBid(w, r, bidId, params))
+}
+
+func (a *APIServer) RollbackBid(w http.ResponseWriter, r *http.Request, bidId BidId, version int32, params RollbackBidParams) {
+	handleError(w, a.handleBidRollback(w, r, bidId, version, params))
+}
+
+func (a *APIServer) GetBidReviews(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidReviewsParams) {
+	handleError(w, a.handleReviewBids(w, r, tenderId, params))
+}
+
+func (a *APIServer) PlaceAuctionBid(w http.ResponseWriter, r *http.Request, bidId BidId, params PlaceAuctionBidParams) {
+	handleError(w, a.placeAuctionBid(w, r, bidId, params))
+}
+
+func (a *APIServer) GetAuctionLeaderboard(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetAuctionLeaderboardParams) {
+	handleError(w, a.streamAuctionLeaderboard(w, r, tenderId, params))
+}
+
+func (a *APIServer) SubmitBidScores(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidScoresParams) {
+	handleError(w, a.submitBidScores(w, r, bidId, params))
+}
+
+func (a *APIServer) GetBidScores(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidScoresParams) {
+	handleError(w, a.getBidScores(w, r, bidId, params))
+}
+
+func (a *APIServer) GetTenderRanking(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderRankingParams) {
+	handleError(w, a.getTenderRanking(w, r, tenderId, params))
+}
+
+func (a *APIServer) CancelTenderLot(w http.ResponseWriter, r *http.Request, tenderId TenderId, lotId LotId, params CancelTenderLotParams) {
+	handleError(w, a.cancelTenderLot(w, r, tenderId, lotId, params))
+}
+
+func (a *APIServer) CreateTenderInvitation(w http.ResponseWriter, r *http.Request, tenderId TenderId, params CreateTenderInvitationParams) {
+	handleError(w, a.createTenderInvitation(w, r, tenderId, params))
+}
+
+func (a *APIServer) GetTenderInvitations(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderInvitationsParams) {
+	handleError(w, a.getTenderInvitations(w, r, tenderId, params))
+}
+
+func (a *APIServer) GetUserInvitations(w http.ResponseWriter, r *http.Request, params GetUserInvitationsParams) {
+	handleError(w, a.getUserInvitations(w, r, params))
+}
+
+func (a *APIServer) RespondTenderInvitation(w http.ResponseWriter, r *http.Request, invitationId InvitationId, params RespondTenderInvitationParams) {
+	handleError(w, a.respondTenderInvitation(w, r, invitationId, params))
+}
+
+func (a *APIServer) AskTenderQuestion(w http.ResponseWriter, r *http.Request, tenderId TenderId, params AskTenderQuestionParams) {
+	handleError(w, a.askTenderQuestion(w, r, tenderId, params))
+}
+
+func (a *APIServer) GetTenderQuestions(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderQuestionsParams) {
+	handleError(w, a.getTenderQuestions(w, r, tenderId, params))
+}
+
+func (a *APIServer) AnswerTenderQuestion(w http.ResponseWriter, r *http.Request, questionId QuestionId, params AnswerTenderQuestionParams) {
+	handleError(w, a.answerTenderQuestion(w, r, questionId, params))
+}
+
+func (a *APIServer) GetTenderHistory(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderHistoryParams) {
+	handleError(w, a.getTenderHistory(w, r, tenderId, params))
+}
+
+func (a *APIServer) UploadTenderAttachment(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UploadTenderAttachmentParams) {
+	handleError(w, a.uploadTenderAttachment(w, r, tenderId, params))
+}
+
+func (a *APIServer) GetTenderAttachments(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderAttachmentsParams) {
+	handleError(w, a.getTenderAttachments(w, r, tenderId, params))
+}
+
+func (a *APIServer) UploadBidAttachment(w http.ResponseWriter, r *http.Request, bidId BidId, params UploadBidAttachmentParams) {
+	handleError(w, a.uploadBidAttachment(w, r, bidId, params))
+}
+
+func (a *APIServer) GetBidAttachments(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidAttachmentsParams) {
+	handleError(w, a.getBidAttachments(w, r, bidId, params))
+}
+
+func (a *APIServer) DownloadAttachment(w http.ResponseWriter, r *http.Request, attachmentId AttachmentId, params DownloadAttachmentParams) {
+	handleError(w, a.downloadAttachment(w, r, attachmentId, params))
+}
+
+func (a *APIServer) SearchTenders(w http.ResponseWriter, r *http.Request, params SearchTendersParams) {
+	handleError(w, a.searchTenders(w, r, params))
+}
+
+func (a *APIServer) SearchBids(w http.ResponseWriter, r *http.Request, params SearchBidsParams) {
+	handleError(w, a.searchBids(w, r, params))
+}
+
+func (a *APIServer) CreateWebhook(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params CreateWebhookParams) {
+	handleError(w, a.createWebhook(w, r, organizationId, params))
+}
+
+func (a *APIServer) GetOrganizationWebhooks(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetOrganizationWebhooksParams) {
+	handleError(w, a.getOrganizationWebhooks(w, r, organizationId, params))
+}
+
+func (a *APIServer) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params DeleteWebhookParams) {
+	handleError(w, a.deleteWebhook(w, r, webhookId, params))
+}
+
+func (a *APIServer) PingWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params PingWebhookParams) {
+	handleError(w, a.pingWebhook(w, r, webhookId, params))
+}
+
+func (a *APIServer) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params GetWebhookDeliveriesParams) {
+	handleError(w, a.getWebhookDeliveries(w, r, webhookId, params))
+}
+
+func (a *APIServer) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, deliveryId WebhookDeliveryId, params RedeliverWebhookDeliveryParams) {
+	handleError(w, a.redeliverWebhookDelivery(w, r, deliveryId, params))
+}
+
+func (a *APIServer) StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) {
+	handleError(w, a.streamEvents(w, r, params))
+}
+
+func (a *APIServer) GetNotificationPreferences(w http.ResponseWriter, r *http.Request, params GetNotificationPreferencesParams) {
+	handleError(w, a.getNotificationPreferences(w, r, params))
+}
+
+func (a *APIServer) SetNotificationPreferences(w http.ResponseWriter, r *http.Request, params SetNotificationPreferencesParams) {
+	handleError(w, a.setNotificationPreferences(w, r, params))
+}
+
+func (a *APIServer) GetAuditLog(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetAuditLogParams) {
+	handleError(w, a.getAuditLog(w, r, organizationId, params))
+}
+
+func (a *APIServer) VerifyAuditLog(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params VerifyAuditLogParams) {
+	handleError(w, a.verifyAuditLog(w, r, organizationId, params))
+}
+
+func (a *APIServer) ExportTenders(w http.ResponseWriter, r *http.Request, params ExportTendersParams) {
+	handleError(w, a.exportTenders(w, r, params))
+}
+
+func (a *APIServer) ExportBidsForTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params ExportBidsForTenderParams) {
+	handleError(w, a.exportBidsForTender(w, r, tenderId, params))
+}
+
+func (a *APIServer) GetTenderReport(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderReportParams) {
+	handleError(w, a.getTenderReport(w, r, tenderId, params))
+}
+
+func (a *APIServer) ImportTenders(w http.ResponseWriter, r *http.Request, params ImportTendersParams) {
+	handleError(w, a.importTenders(w, r, params))
+}
+
+func (a *APIServer) ImportBids(w http.ResponseWriter, r *http.Request, params ImportBidsParams) {
+	handleError(w, a.importBids(w, r, params))
+}
+
+func (a *APIServer) GetOrganizationReputation(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetOrganizationReputationParams) {
+	handleError(w, a.getOrganizationReputation(w, r, organizationId, params))
+}
+
+func (a *APIServer) QueryGraphQL(w http.ResponseWriter, r *http.Request) {
+	handleError(w, a.queryGraphQL(w, r))
 }
 
 func (a *APIServer) createNewBid(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "POST" {
-		var bid Bid
-		if err := json.NewDecoder(r.Body).Decode(&bid); err != nil {
-			fmt.Errorf("Invalid request payload", err)
-			return err
-		}
-		valid, err := a.store.isValidTenderCreator(bid.CreatorUsername, bid.OrganizationId)
+	var req CreateBidJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
 
-		if err != nil {
-			fmt.Errorf("Internal server error", err)
-			return err
-		}
-		if !valid {
-			return fmt.Errorf("Invalid creator username for the given organization", err)
-		}
+	bid, author, err := a.prepareBid(req)
+	if err != nil {
+		return err
+	}
 
-		createdTender, err := a.store.CreateBid(&bid)
-		if err != nil {
-			fmt.Errorf("Failed to create tender", err)
+	var createdBid *Bid
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if createdBid, err = tx.store.CreateBid(bid); err != nil {
 			return err
 		}
-
-		return WriteJSON(w, http.StatusOK, createdTender)
+		return tx.auditBid(r, author.Username, AuditActionCreateBid, createdBid, nil, createdBid)
+	})
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, createdBid)
 }
 
-func (a *APIServer) createNewTender(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "POST" {
-		var tender Tender
-		if err := json.NewDecoder(r.Body).Decode(&tender); err != nil {
-			fmt.Errorf("Invalid request payload", err)
-			return err
-		}
-		valid, err := a.store.isValidTenderCreator(tender.CreatorUsername, tender.OrganizationID)
+// prepareBid checks a new bid against the rules of bid creation and returns
+// it, ready to be stored, with its author.
+func (a *APIServer) prepareBid(req CreateBidJSONRequestBody) (*Bid, *User, error) {
+	author, err := a.store.GetUserById(req.AuthorId)
+	if err != nil {
+		return nil, nil, storageError(err)
+	}
 
+	if req.AuthorType == BidAuthorTypeOrganization {
+		org, err := a.store.GetUserOrganization(author.Id)
 		if err != nil {
-			fmt.Errorf("Internal server error", err)
-			return err
+			return nil, nil, err
+		}
+		if org == "" {
+			return nil, nil, httpError(http.StatusForbidden, "user %s is not responsible for any organization", author.Username)
+		}
+	}
+
+	tender, err := a.store.GetTenderById(req.TenderId)
+	if err != nil {
+		return nil, nil, storageError(err)
+	}
+	if tender.Status != TenderStatusPublished {
+		return nil, nil, httpError(http.StatusForbidden, "tender %s is not accepting bids", tender.Id)
+	}
+	if tender.SubmissionDeadline != nil && !time.Now().Before(*tender.SubmissionDeadline) {
+		return nil, nil, httpError(http.StatusForbidden, "submission deadline of tender %s has passed", tender.Id)
+	}
+	if tender.Auction != nil {
+		if !time.Now().Before(tender.Auction.EndAt) {
+			return nil, nil, httpError(http.StatusForbidden, "auction of tender %s is over", tender.Id)
 		}
-		if !valid {
-			return fmt.Errorf("Invalid creator username for the given organization", err)
+		if req.Price != nil {
+			return nil, nil, httpError(http.StatusBadRequest, "auction prices are placed with PUT /api/bids/{bidId}/price")
 		}
+	}
 
-		createdTender, err := a.store.CreateTender(&tender)
+	own, err := a.store.isValidTenderCreator(author.Username, tender.OrganizationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if own {
+		return nil, nil, httpError(http.StatusForbidden, "responsibles can't bid on their own tender")
+	}
+	if tender.Visibility == TenderVisibilityPrivate {
+		status, err := a.store.GetInvitationStatus(tender.Id, author.Username)
 		if err != nil {
-			fmt.Errorf("Failed to create tender", err)
-			return err
+			return nil, nil, err
+		}
+		if status != InvitationStatusAccepted {
+			return nil, nil, httpError(http.StatusForbidden, "tender %s takes bids by accepted invitation only", tender.Id)
 		}
+	}
 
-		return WriteJSON(w, http.StatusOK, createdTender)
+	if err := validateBidPrice(req.Price, req.Currency, tender); err != nil {
+		return nil, nil, err
+	}
+	if err := validateBidLots(req.LotIds, req.Currency, tender); err != nil {
+		return nil, nil, err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
-}
 
-func (a *APIServer) handleUserTenders(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "GET" {
-		username := r.URL.Query().Get("username")
-		if username == "" {
-			return WriteJSON(w, http.StatusBadRequest, "No `username` param")
-		}
+	bid := &Bid{
+		Name:         req.Name,
+		Description:  req.Description,
+		TenderId:     req.TenderId,
+		AuthorType:   req.AuthorType,
+		AuthorId:     req.AuthorId,
+		Price:        req.Price,
+		Currency:     req.Currency,
+		DeliveryDays: req.DeliveryDays,
+		LotIds:       req.LotIds,
+	}
+	return bid, author, nil
+}
 
-		user, err := a.store.GetUserByUsername(username)
+func (a *APIServer) createNewTender(w http.ResponseWriter, r *http.Request) error {
+	var req CreateTenderJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
 
-		if user == nil || user.Id == "" {
-			return WriteJSON(w, http.StatusNotFound, "User not found")
-		}
+	tender, err := a.prepareTender(req)
+	if err != nil {
+		return err
+	}
 
-		tenders, err := a.store.GetTendersByUsername(username)
-		if err != nil {
+	var createdTender *Tender
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if createdTender, err = tx.store.CreateTender(tender, req.CreatorUsername); err != nil {
 			return err
 		}
+		return tx.auditTender(r, req.CreatorUsername, AuditActionCreateTender, nil, createdTender)
+	})
+	if err != nil {
+		return err
+	}
 
-		if tenders == nil {
-			return WriteJSON(w, http.StatusOK, "No tenders yet")
-		}
+	return WriteJSON(w, http.StatusOK, createdTender)
+}
 
-		return WriteJSON(w, http.StatusOK, tenders)
+// prepareTender checks a new tender against the rules of tender creation and
+// returns it, ready to be stored.
+func (a *APIServer) prepareTender(req CreateTenderJSONRequestBody) (*Tender, error) {
+	if _, err := a.authenticate(req.CreatorUsername); err != nil {
+		return nil, err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
-}
 
-func (a *APIServer) handleUserBids(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "GET" {
-		username := r.URL.Query().Get("username")
-		if username == "" {
-			return WriteJSON(w, http.StatusBadRequest, "No `username` param")
-		}
+	valid, err := a.store.isValidTenderCreator(req.CreatorUsername, req.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, httpError(http.StatusForbidden, "Invalid creator username for the given organization")
+	}
 
-		user, err := a.store.GetUserByUsername(username)
+	if err := validateSchedule(req.SubmissionDeadline, req.PublishAt); err != nil {
+		return nil, err
+	}
+	if err := validateBudget(req.Budget); err != nil {
+		return nil, err
+	}
+	if err := validateCriteria(req.Criteria, req.Budget); err != nil {
+		return nil, err
+	}
 
-		if user == nil || user.Id == "" {
-			return WriteJSON(w, http.StatusNotFound, "User not found")
-		}
+	tender := &Tender{
+		Name:               req.Name,
+		Description:        req.Description,
+		ServiceType:        req.ServiceType,
+		OrganizationId:     req.OrganizationId,
+		SubmissionDeadline: req.SubmissionDeadline,
+		PublishAt:          req.PublishAt,
+		Budget:             req.Budget,
+		Sealed:             deref(req.Sealed),
+		Auction:            req.Auction,
+		Criteria:           req.Criteria,
+		Visibility:         TenderVisibilityPublic,
+	}
+	if req.Visibility != nil {
+		tender.Visibility = *req.Visibility
+	}
+	if err := validateAuction(tender.Auction, tender); err != nil {
+		return nil, err
+	}
+	if tender.Lots, err = newLots(req.Lots, tender); err != nil {
+		return nil, err
+	}
+	return tender, nil
+}
 
-		bids, err := a.store.GetBidsByUsername(username)
-		if err != nil {
-			return err
-		}
+func (a *APIServer) handleUserTenders(w http.ResponseWriter, r *http.Request, params GetUserTendersParams) error {
+	user, err := a.authenticate(deref(params.Username))
+	if err != nil {
+		return err
+	}
 
-		if bids == nil {
-			return WriteJSON(w, http.StatusOK, "No bids yet")
-		}
+	limit, offset := pagination(params.Limit, params.Offset)
+	filter := TenderFilter{
+		Currency:  deref(params.Currency),
+		MinBudget: deref(params.MinBudget),
+		MaxBudget: deref(params.MaxBudget),
+		SortBy:    deref(params.SortBy),
+		SortOrder: deref(params.SortOrder),
+	}
+	tenders, err := a.store.GetTendersByUsername(user.Username, filter, limit, offset)
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, tenders)
+}
 
-		return WriteJSON(w, http.StatusOK, bids)
+func (a *APIServer) handleUserBids(w http.ResponseWriter, r *http.Request, params GetUserBidsParams) error {
+	user, err := a.authenticate(deref(params.Username))
+	if err != nil {
+		return err
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	filter := BidFilter{
+		Currency:        deref(params.Currency),
+		MinPrice:        deref(params.MinPrice),
+		MaxPrice:        deref(params.MaxPrice),
+		MaxDeliveryDays: deref(params.MaxDeliveryDays),
+		SortBy:          deref(params.SortBy),
+		SortOrder:       deref(params.SortOrder),
+	}
+	bids, err := a.store.GetBidsByUsername(user.Username, filter, limit, offset)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, bids)
 }
 
-func (a *APIServer) handleTenderBids(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "GET" {
+func (a *APIServer) handleTenderBids(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidsForTenderParams) error {
+	if _, err := a.requireTenderBidsReader(params.Username, tenderId); err != nil {
+		return err
+	}
 
-		vars := mux.Vars(r)
-		tenderIDStr := vars["tender_id"]
-		if tenderIDStr == "" {
-			return WriteJSON(w, http.StatusBadRequest, "No `tender_id` param")
-		}
+	limit, offset := pagination(params.Limit, params.Offset)
+	filter := BidFilter{
+		Currency:        deref(params.Currency),
+		MinPrice:        deref(params.MinPrice),
+		MaxPrice:        deref(params.MaxPrice),
+		MaxDeliveryDays: deref(params.MaxDeliveryDays),
+		SortBy:          deref(params.SortBy),
+		SortOrder:       deref(params.SortOrder),
+	}
+	bids, err := a.store.GetBidsByTenderId(tenderId, filter, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+	if err := a.attachReputations(bids); err != nil {
+		return err
+	}
 
-		bids, err := a.store.GetBidsByTenderId(tenderIDStr)
-		if err != nil {
-			return err
-		}
+	return WriteJSON(w, http.StatusOK, bids)
+}
 
-		if bids == nil {
-			return WriteJSON(w, http.StatusOK, "No bids")
-		}
+// requireTenderBidsReader checks that username may list the bids on the
+// tender: it is responsible for the tender and the bids are not sealed.
+func (a *APIServer) requireTenderBidsReader(username, tenderId string) (*Tender, error) {
+	user, err := a.authenticate(username)
+	if err != nil {
+		return nil, err
+	}
 
-		return WriteJSON(w, http.StatusOK, bids)
+	tender, err := a.store.GetTenderById(tenderId)
+	if err != nil {
+		return nil, storageError(err)
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
 
+	if err := a.requireResponsible(user, tender.OrganizationId); err != nil {
+		return nil, err
+	}
+
+	hidden, err := a.bidsHidden(tender, time.Now())
+	if err != nil {
+		return nil, storageError(err)
+	}
+	if hidden {
+		return nil, httpError(http.StatusForbidden, "bids of sealed tender %s are not revealed yet", tender.Id)
+	}
+	return tender, nil
 }
 
-func (a *APIServer) getAllTenders(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "GET" {
-		service_type := r.URL.Query().Get("service_type")
-		tenders, err := a.store.GetAllTenders(service_type)
+func (a *APIServer) getAllTenders(w http.ResponseWriter, r *http.Request, params GetTendersParams) error {
+	var viewer string
+	if params.Username != nil {
+		user, err := a.authenticate(*params.Username)
 		if err != nil {
-
 			return err
 		}
-		return WriteJSON(w, http.StatusOK, tenders)
+		viewer = user.Username
+	}
+
+	filter := TenderFilter{
+		Viewer:       viewer,
+		ServiceTypes: deref(params.ServiceType),
+		Currency:     deref(params.Currency),
+		MinBudget:    deref(params.MinBudget),
+		MaxBudget:    deref(params.MaxBudget),
+		SortBy:       deref(params.SortBy),
+		SortOrder:    deref(params.SortOrder),
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	tenders, err := a.store.GetAllTenders(filter, limit, offset)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+	return WriteJSON(w, http.StatusOK, tenders)
 }
 
 func (a *APIServer) pingServer(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "GET" {
-		return WriteJSON(w, http.StatusOK, "ok")
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write([]byte("ok"))
+	return err
+}
 
+func (a *APIServer) getTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderStatusParams) error {
+	tender, err := a.requireVisibleTender(params.Username, tenderId)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, tender.Status)
 }
 
-func (a *APIServer) updateTenderById(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "PATCH" {
-		vars := mux.Vars(r)
-		tenderIdStr := vars["id"]
+func (a *APIServer) updateTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UpdateTenderStatusParams) error {
+	current, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+	if params.Status == TenderStatusClosed && hasOpenLots(current) {
+		return httpError(http.StatusBadRequest, "tender %s closes once every lot is awarded or canceled", tenderId)
+	}
 
-		var tenderUpdate TenderUpdate
-		body, err := io.ReadAll(r.Body)
-		if err != nil {
-			return fmt.Errorf("Failed to read request body", http.StatusBadRequest)
+	var tender *Tender
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if tender, err = tx.store.UpdateTenderStatus(tenderId, params.Status); err != nil {
+			return storageError(err)
 		}
 
-		var data map[string]interface{}
-		if err := json.Unmarshal(body, &data); err != nil {
-			return fmt.Errorf("Invalid request body", http.StatusBadRequest)
-		}
-		// Check for unexpected fields
-		for key := range data {
-			if key != "name" && key != "description" {
-				return fmt.Errorf("Unexpected field", http.StatusBadRequest)
+		// Closing a sealed tender reveals its bids right away.
+		if tender.Sealed && tender.Status == TenderStatusClosed {
+			if _, err := tx.bidsHidden(tender, time.Now()); err != nil {
+				return storageError(err)
+			}
+			if tender, err = tx.store.GetTenderById(tenderId); err != nil {
+				return storageError(err)
 			}
 		}
+		return tx.auditTender(r, params.Username, AuditActionUpdateTenderStatus, current, tender)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, tender)
+}
+
+func (a *APIServer) updateTenderById(w http.ResponseWriter, r *http.Request, tenderId TenderId, params EditTenderParams) error {
+	var tenderUpdate EditTenderJSONRequestBody
+	decoder := json.NewDecoder(r.Body)
+	// Check for unexpected fields
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&tenderUpdate); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request body: %v", err)
+	}
+
+	current, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
 
-		if err := json.Unmarshal(body, &tenderUpdate); err != nil {
-			return fmt.Errorf("Invalid request body", http.StatusBadRequest)
+	deadline, publishAt := current.SubmissionDeadline, current.PublishAt
+	if tenderUpdate.SubmissionDeadline != nil {
+		deadline = tenderUpdate.SubmissionDeadline
+	}
+	if tenderUpdate.PublishAt != nil {
+		publishAt = tenderUpdate.PublishAt
+	}
+	if tenderUpdate.SubmissionDeadline != nil || tenderUpdate.PublishAt != nil {
+		if err := validateSchedule(deadline, publishAt); err != nil {
+			return err
 		}
+	}
+	if err := validateBudget(tenderUpdate.Budget); err != nil {
+		return err
+	}
 
-		tender, err := a.store.UpdateTenderById(tenderIdStr, tenderUpdate.Name, tenderUpdate.Description)
-		if err != nil {
+	var tender *Tender
+	err = a.atomically(func(tx *APIServer) error {
+		if err := tx.requireCurrencyKept(current, tenderUpdate.Budget); err != nil {
 			return err
 		}
+		var err error
+		if tender, err = tx.store.UpdateTenderById(tenderId, tenderUpdate); err != nil {
+			return storageError(err)
+		}
+		return tx.auditTender(r, params.Username, AuditActionEditTender, current, tender)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, tender)
+}
 
-		return WriteJSON(w, http.StatusOK, tender)
+func (a *APIServer) getBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidStatusParams) error {
+	bid, err := a.requireBidManager(params.Username, bidId)
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, bid.Status)
 }
 
-func (a *APIServer) updateBidById(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "PATCH" {
-		vars := mux.Vars(r)
-		bidIdStr := vars["id"]
+func (a *APIServer) updateBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params UpdateBidStatusParams) error {
+	current, _, err := a.requireBidChangeable(params.Username, bidId)
+	if err != nil {
+		return err
+	}
 
-		var tenderUpdate TenderUpdate
-		body, err := io.ReadAll(r.Body)
-		if err != nil {
-			return fmt.Errorf("Failed to read request body", http.StatusBadRequest)
+	var bid *Bid
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if bid, err = tx.store.UpdateBidStatus(bidId, params.Status); err != nil {
+			return storageError(err)
 		}
+		return tx.auditBid(r, params.Username, AuditActionUpdateBidStatus, bid, current, bid)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, bid)
+}
+
+func (a *APIServer) updateBidById(w http.ResponseWriter, r *http.Request, bidId BidId, params EditBidParams) error {
+	var bidUpdate EditBidJSONRequestBody
+	decoder := json.NewDecoder(r.Body)
+	// Check for unexpected fields
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&bidUpdate); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request body: %v", err)
+	}
 
-		var data map[string]interface{}
-		if err := json.Unmarshal(body, &data); err != nil {
-			return fmt.Errorf("Invalid request body", http.StatusBadRequest)
+	current, tender, err := a.requireBidChangeable(params.Username, bidId)
+	if err != nil {
+		return err
+	}
+
+	if bidUpdate.Price != nil || bidUpdate.Currency != nil {
+		if tender.Auction != nil && bidUpdate.Price != nil {
+			return httpError(http.StatusBadRequest, "auction prices are placed with PUT /api/bids/{bidId}/price")
 		}
-		// Check for unexpected fields
-		for key := range data {
-			if key != "name" && key != "description" {
-				return fmt.Errorf("Unexpected field", http.StatusBadRequest)
-			}
+		price, currency := current.Price, current.Currency
+		if bidUpdate.Price != nil {
+			price = bidUpdate.Price
 		}
-
-		if err := json.Unmarshal(body, &tenderUpdate); err != nil {
-			return fmt.Errorf("Invalid request body", http.StatusBadRequest)
+		if bidUpdate.Currency != nil {
+			currency = bidUpdate.Currency
 		}
-
-		bid, err := a.store.UpdateBidById(bidIdStr, tenderUpdate.Name, tenderUpdate.Description)
-		if err != nil {
+		if err := validateBidPrice(price, currency, tender); err != nil {
 			return err
 		}
+	}
 
-		return WriteJSON(w, http.StatusOK, bid)
+	var bid *Bid
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if bid, err = tx.store.UpdateBidById(bidId, bidUpdate); err != nil {
+			return storageError(err)
+		}
+		return tx.auditBid(r, params.Username, AuditActionEditBid, bid, current, bid)
+	})
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, bid)
 }
 
-func (a *APIServer) createNewReviewOnBid(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "POST" {
-		vars := mux.Vars(r)
-		bidIDStr := vars["bid_id"]
-		if bidIDStr == "" {
-			return fmt.Errorf("Invalid params", http.StatusBadRequest)
-		}
+func (a *APIServer) submitBidDecision(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidDecisionParams) error {
+	bid, tender, err := a.requireBidReviewer(params.Username, bidId)
+	if err != nil {
+		return err
+	}
 
-		var review Review
-		if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
-			return fmt.Errorf("Invalid req body", http.StatusBadRequest)
-		}
+	if bid.Status != BidStatusPublished {
+		return httpError(http.StatusBadRequest, "bid %s is not published", bid.Id)
+	}
+	open, err := a.decisionsOpen(tender)
+	if err != nil {
+		return storageError(err)
+	}
+	if !open {
+		return httpError(http.StatusBadRequest, "tender %s is already closed", tender.Id)
+	}
+	lotId := deref(params.LotId)
+	if err := validateDecisionLot(bid, tender, lotId); err != nil {
+		return err
+	}
 
-		err := a.store.CreateReviewOnBid(&review, bidIDStr)
-		if err != nil {
-			return fmt.Errorf("Data error : %v", err)
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if bid, err = tx.store.SubmitBidDecision(bidId, params.Username, params.Decision, lotId); err != nil {
+			return storageError(err)
 		}
-
-		return WriteJSON(w, http.StatusOK, "ok")
+		decision := bidDecisionEvent{Bid: bid, Decision: params.Decision, LotId: params.LotId, Username: params.Username}
+		return tx.auditBid(r, params.Username, AuditActionSubmitBidDecision, bid, nil, decision)
+	})
+	if err != nil {
+		return err
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, bid)
 }
 
-func (a *APIServer) handleTenderRollback(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "PUT" {
-		vars := mux.Vars(r)
-		tenderIDStr := vars["tender_id"]
-		versionStr := vars["version"]
-		if tenderIDStr == "" || versionStr == "" {
-			return fmt.Errorf("Invalid params", http.StatusBadRequest)
+func (a *APIServer) createNewReviewOnBid(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidFeedbackParams) error {
+	bid, _, err := a.requireBidReviewer(params.Username, bidId)
+	if err != nil {
+		return err
+	}
+
+	err = a.atomically(func(tx *APIServer) error {
+		if err := tx.store.CreateReviewOnBid(bidId, params.Username, params.BidFeedback, params.Rating, params.OnTime); err != nil {
+			return storageError(err)
+		}
+		feedback := bidFeedbackEvent{
+			Bid: bid, Feedback: params.BidFeedback, Rating: params.Rating, OnTime: params.OnTime, Username: params.Username,
 		}
+		return tx.auditBid(r, params.Username, AuditActionSubmitBidFeedback, bid, nil, feedback)
+	})
+	if err != nil {
+		return err
+	}
 
-		version, err := strconv.Atoi(versionStr)
-		if err != nil {
-			return fmt.Errorf("Invalid version", http.StatusBadRequest)
+	return WriteJSON(w, http.StatusOK, bid)
+}
+
+func (a *APIServer) handleTenderRollback(w http.ResponseWriter, r *http.Request, tenderId TenderId, version int32, params RollbackTenderParams) error {
+	current, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+
+	// The budget of the version rolled back to has to suit the criteria and
+	// the bids made since, like an edit of the budget.
+	versions, err := a.store.GetTenderVersions(tenderId)
+	if err != nil {
+		return storageError(err)
+	}
+	var target *Tender
+	for _, v := range versions {
+		if v.Version == version {
+			target = v
 		}
+	}
 
-		tender, err := a.store.RollbackTender(tenderIDStr, version)
-		if err != nil {
-			if err.Error() == fmt.Sprintf("version %d does not exist for tender %d", version, tenderIDStr) {
-				return fmt.Errorf("Version does not exist", http.StatusBadRequest)
-			} else {
-				return fmt.Errorf("Error rolling back tender: %v", err)
+	var tender *Tender
+	err = a.atomically(func(tx *APIServer) error {
+		if target != nil {
+			if err := validateCriteria(current.Criteria, target.Budget); err != nil {
+				return err
 			}
+			if err := tx.requireCurrencyKept(current, target.Budget); err != nil {
+				return err
+			}
+		}
+		var err error
+		if tender, err = tx.store.RollbackTender(tenderId, version); err != nil {
+			return storageError(err)
+		}
+		return tx.auditTender(r, params.Username, AuditActionRollbackTender, current, tender)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, tender)
+}
+
+func (a *APIServer) handleReviewBids(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidReviewsParams) error {
+	if _, err := a.requireTenderResponsible(params.RequesterUsername, tenderId); err != nil {
+		return err
+	}
+
+	if _, err := a.store.GetUserByUsername(params.AuthorUsername); err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return httpError(http.StatusNotFound, "author %s not found", params.AuthorUsername)
 		}
+		return err
+	}
 
-		return WriteJSON(w, http.StatusOK, tender)
+	limit, offset := pagination(params.Limit, params.Offset)
+	reviews, err := a.store.GetReviewBids(tenderId, params.AuthorUsername, limit, offset)
+	if err != nil {
+		return storageError(err)
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return WriteJSON(w, http.StatusOK, reviews)
 }
 
-func (a *APIServer) handleReviewBids(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "GET" {
-		organizationId := r.URL.Query().Get("organizationId")
-		authorUsername := r.URL.Query().Get("authorUsername")
-		vars := mux.Vars(r)
-		tenderId := vars["tender_id"]
-		if organizationId == "" || authorUsername == "" || tenderId == "" {
-			return fmt.Errorf("Invalid params", http.StatusBadRequest)
+func (a *APIServer) handleBidRollback(w http.ResponseWriter, r *http.Request, bidId BidId, version int32, params RollbackBidParams) error {
+	current, _, err := a.requireBidChangeable(params.Username, bidId)
+	if err != nil {
+		return err
+	}
+
+	var bid *Bid
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if bid, err = tx.store.RollbackBid(bidId, version); err != nil {
+			return storageError(err)
 		}
+		return tx.auditBid(r, params.Username, AuditActionRollbackBid, bid, current, bid)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, bid)
+}
+
+// authenticate resolves the username every request acts on behalf of.
+func (a *APIServer) authenticate(username string) (*User, error) {
+	if username == "" {
+		return nil, httpError(http.StatusUnauthorized, "username is required")
+	}
+
+	user, err := a.store.GetUserByUsername(username)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	return user, nil
+}
+
+func (a *APIServer) requireResponsible(user *User, organizationId string) error {
+	ok, err := a.store.isValidTenderCreator(user.Username, organizationId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return httpError(http.StatusForbidden, "user %s is not responsible for organization %s", user.Username, organizationId)
+	}
+	return nil
+}
+
+// requireTenderResponsible checks that username is responsible for the
+// organization owning the tender.
+func (a *APIServer) requireTenderResponsible(username, tenderId string) (*Tender, error) {
+	user, err := a.authenticate(username)
+	if err != nil {
+		return nil, err
+	}
 
-		reviews, err := a.store.GetReviewBids(tenderId, organizationId, authorUsername)
+	tender, err := a.store.GetTenderById(tenderId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	if err := a.requireResponsible(user, tender.OrganizationId); err != nil {
+		return nil, err
+	}
+
+	return tender, nil
+}
+
+// requireVisibleTender loads the tender for username, nil for anonymous
+// requests, if the visibility rules let them see it.
+func (a *APIServer) requireVisibleTender(username *string, tenderId string) (*Tender, error) {
+	var user *User
+	if username != nil {
+		u, err := a.authenticate(*username)
 		if err != nil {
-			{
-				return fmt.Errorf("Error finding reviews: %v", err)
-			}
+			return nil, err
 		}
+		user = u
+	}
 
-		return WriteJSON(w, http.StatusOK, reviews)
+	tender, err := a.store.GetTenderById(tenderId)
+	if err != nil {
+		return nil, storageError(err)
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	if err := a.checkTenderVisible(user, tender); err != nil {
+		return nil, err
+	}
+	return tender, nil
 }
 
-func (a *APIServer) handleBidRollback(w http.ResponseWriter, r *http.Request) error {
-	if r.Method == "PUT" {
-		vars := mux.Vars(r)
-		bidIDStr := vars["bid_id"]
-		versionStr := vars["version"]
-		if bidIDStr == "" || versionStr == "" {
-			return fmt.Errorf("Invalid params", http.StatusBadRequest)
-		}
+// requireBidManager checks that username authored the bid or, for bids made
+// on behalf of an organization, is responsible for that organization.
+func (a *APIServer) requireBidManager(username, bidId string) (*Bid, error) {
+	user, err := a.authenticate(username)
+	if err != nil {
+		return nil, err
+	}
+
+	bid, err := a.store.GetBidById(bidId)
+	if err != nil {
+		return nil, storageError(err)
+	}
 
-		version, err := strconv.Atoi(versionStr)
+	if bid.AuthorId == user.Id {
+		return bid, nil
+	}
+
+	if bid.AuthorType == BidAuthorTypeOrganization {
+		authorOrg, err := a.store.GetUserOrganization(bid.AuthorId)
 		if err != nil {
-			return fmt.Errorf("Invalid version", http.StatusBadRequest)
+			return nil, err
 		}
-
-		tender, err := a.store.RollbackBid(bidIDStr, version)
+		userOrg, err := a.store.GetUserOrganization(user.Id)
 		if err != nil {
-			if err.Error() == fmt.Sprintf("version %d does not exist for tender %d", version, bidIDStr) {
-				return fmt.Errorf("Version does not exist", http.StatusBadRequest)
-			} else {
-				return fmt.Errorf("Error rolling back tender: %v", err)
-			}
+			return nil, err
+		}
+		if authorOrg != "" && authorOrg == userOrg {
+			return bid, nil
 		}
-
-		return WriteJSON(w, http.StatusOK, tender)
 	}
-	return fmt.Errorf("Method not allowed %s", r.Method)
+
+	return nil, httpError(http.StatusForbidden, "user %s can't manage bid %s", user.Username, bid.Id)
 }
 
-func (a *APIServer) handleGetAccount(w http.ResponseWriter, r *http.Request) error {
-	accounts, err := a.store.GetAccounts()
+// requireBidChangeable checks that username manages the bid and that its
+// tender still takes changes to it: neither the submission deadline has
+// passed nor, for sealed tenders, have the bids been revealed.
+func (a *APIServer) requireBidChangeable(username, bidId string) (*Bid, *Tender, error) {
+	bid, err := a.requireBidManager(username, bidId)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	tender, err := a.store.GetTenderById(bid.TenderId)
+	if err != nil {
+		return nil, nil, storageError(err)
+	}
+	if tender.SubmissionDeadline != nil && !time.Now().Before(*tender.SubmissionDeadline) {
+		return nil, nil, httpError(http.StatusBadRequest, "submission deadline of tender %s has passed", tender.Id)
+	}
+	if tender.RevealedAt != nil {
+		return nil, nil, httpError(http.StatusBadRequest, "bids of sealed tender %s are already revealed", tender.Id)
 	}
 
-	return WriteJSON(w, http.StatusOK, accounts)
+	return bid, tender, nil
 }
 
-func (a *APIServer) handleCreateAccount(w http.ResponseWriter, r *http.Request) error {
-	CreateAccountReq := new(CreateAccountRequest)
-	//CreateAccountRequest := CreateAccountRequest{}
-	if err := json.NewDecoder(r.Body).Decode(CreateAccountReq); err != nil {
-		return err
+// requireBidReviewer checks that username is responsible for the
+// organization owning the tender the bid was made on.
+func (a *APIServer) requireBidReviewer(username, bidId string) (*Bid, *Tender, error) {
+	user, err := a.authenticate(username)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	account := NewAccount(CreateAccountReq.name)
-	if err := a.store.CreateAccount(account); err != nil {
-		return err
+	bid, err := a.store.GetBidById(bidId)
+	if err != nil {
+		return nil, nil, storageError(err)
 	}
 
-	return WriteJSON(w, http.StatusOK, account)
+	tender, err := a.store.GetTenderById(bid.TenderId)
+	if err != nil {
+		return nil, nil, storageError(err)
+	}
+
+	if err := a.requireResponsible(user, tender.OrganizationId); err != nil {
+		return nil, nil, err
+	}
+
+	hidden, err := a.bidsHidden(tender, time.Now())
+	if err != nil {
+		return nil, nil, storageError(err)
+	}
+	if hidden {
+		return nil, nil, httpError(http.StatusForbidden, "bids of sealed tender %s are not revealed yet", tender.Id)
+	}
+
+	return bid, tender, nil
 }
 
-func (a *APIServer) handleDeleteAccount(w http.ResponseWriter, r *http.Request) error {
+const (
+	defaultPaginationLimit = 5
+)
+
+// validateSchedule checks the tender dates: the deadline must be in the
+// future and after the publication time, if both are set.
+func validateSchedule(deadline, publishAt *time.Time) error {
+	if deadline == nil {
+		return nil
+	}
+	if !deadline.After(time.Now()) {
+		return httpError(http.StatusBadRequest, "submissionDeadline must be in the future")
+	}
+	if publishAt != nil && !deadline.After(*publishAt) {
+		return httpError(http.StatusBadRequest, "submissionDeadline must be after publishAt")
+	}
 	return nil
 }
 
-func (a *APIServer) handleTransferFunds(w http.ResponseWriter, r *http.Request) error {
-	return nil
+func pagination(limit, offset *int32) (int32, int32) {
+	l, o := int32(defaultPaginationLimit), int32(0)
+	if limit != nil {
+		l = *limit
+	}
+	if offset != nil {
+		o = *offset
+	}
+	return l, o
+}
+
+func deref[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
 }
 
 func WriteJSON(w http.ResponseWriter, status int, v any) error {
@@ -415,15 +1125,57 @@ func WriteJSON(w http.ResponseWriter, status int, v any) error {
 
 type apiFunc func(http.ResponseWriter, *http.Request) error
 
-type ApiError struct {
-	Error string
+// HTTPError is an error carrying the status code it should be reported with.
+type HTTPError struct {
+	Status int
+	Reason string
+}
+
+func (e *HTTPError) Error() string {
+	return e.Reason
+}
+
+func httpError(status int, format string, args ...any) error {
+	return &HTTPError{Status: status, Reason: fmt.Sprintf(format, args...)}
+}
+
+// storageError maps the storage sentinel errors onto response codes.
+func storageError(err error) error {
+	switch {
+	case errors.Is(err, ErrUserNotFound):
+		return httpError(http.StatusUnauthorized, "%v", err)
+	case errors.Is(err, ErrTenderNotFound), errors.Is(err, ErrBidNotFound), errors.Is(err, ErrVersionNotFound),
+		errors.Is(err, ErrLotNotFound), errors.Is(err, ErrInvitationNotFound), errors.Is(err, ErrOrganizationNotFound),
+		errors.Is(err, ErrQuestionNotFound), errors.Is(err, ErrAttachmentNotFound),
+		errors.Is(err, ErrWebhookNotFound), errors.Is(err, ErrDeliveryNotFound):
+		return httpError(http.StatusNotFound, "%v", err)
+	case errors.Is(err, ErrDecisionExists), errors.Is(err, ErrSealingDisabled),
+		errors.Is(err, ErrNotAuction), errors.Is(err, ErrBidTooHigh), errors.Is(err, ErrLotSettled),
+		errors.Is(err, ErrInvitationExists), errors.Is(err, ErrQuestionAnswered), errors.Is(err, ErrDeliveryNotDead):
+		return httpError(http.StatusBadRequest, "%v", err)
+	case errors.Is(err, ErrAuctionClosed), errors.Is(err, ErrScoresLocked):
+		return httpError(http.StatusForbidden, "%v", err)
+	}
+	return err
+}
+
+func handleError(w http.ResponseWriter, err error) {
+	if err == nil {
+		return
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		WriteJSON(w, httpErr.Status, ErrorResponse{Reason: httpErr.Reason})
+		return
+	}
+
+	log.Printf("internal error: %v", err)
+	WriteJSON(w, http.StatusInternalServerError, ErrorResponse{Reason: "internal server error"})
 }
 
 func makeHTTPHandleFunc(f apiFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		if err := f(w, r); err != nil {
-			//handle the error
-			WriteJSON(w, http.StatusBadRequest, ApiError{err.Error()})
-		}
+		handleError(w, f(w, r))
 	}
 }
@@ -0,0 +1,249 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// maxAttachmentSize caps the size of an uploaded file.
+	maxAttachmentSize = 20 << 20
+	// maxUploadSize caps the size of an upload request: the file and the
+	// rest of the multipart body.
+	maxUploadSize = maxAttachmentSize + 1<<20
+)
+
+// attachmentTypes are the media types files may be uploaded as, each with
+// the type http.DetectContentType sniffs the contents of such a file as. To
+// the sniffer docx and xlsx documents are zip archives, and a dwg drawing
+// is nothing it can tell, so its contents go unchecked.
+var attachmentTypes = map[string]string{
+	"application/pdf": "application/pdf",
+	"image/png":       "image/png",
+	"image/jpeg":      "image/jpeg",
+	"image/vnd.dwg":   "",
+	"text/plain":      "text/plain",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": "application/zip",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":       "application/zip",
+}
+
+func (a *APIServer) uploadTenderAttachment(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UploadTenderAttachmentParams) error {
+	tender, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+
+	return a.storeAttachment(w, r, &Attachment{TenderId: &tenderId, UploaderUsername: params.Username},
+		tender, AuditActionUploadTenderAttachment, []string{tender.OrganizationId})
+}
+
+func (a *APIServer) uploadBidAttachment(w http.ResponseWriter, r *http.Request, bidId BidId, params UploadBidAttachmentParams) error {
+	bid, tender, err := a.requireBidChangeable(params.Username, bidId)
+	if err != nil {
+		return err
+	}
+	_, organizationIds, err := a.bidOrganizations(bid)
+	if err != nil {
+		return err
+	}
+
+	return a.storeAttachment(w, r, &Attachment{BidId: &bidId, UploaderUsername: params.Username},
+		tender, AuditActionUploadBidAttachment, organizationIds)
+}
+
+// storeAttachment reads the file part of a multipart upload, checks its
+// size and media type, which its contents must not contradict, and stores it in the blob store under the id of the
+// new attachment, recording the upload as action in the audit log. Files
+// attached to bids on a sealed tender are stored encrypted, see sealedBlob.
+func (a *APIServer) storeAttachment(w http.ResponseWriter, r *http.Request, attachment *Attachment,
+	tender *Tender, action AuditAction, organizationIds []string) error {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxAttachmentSize+1))
+	if err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	if len(data) > maxAttachmentSize {
+		return httpError(http.StatusBadRequest, "file %q is larger than %d bytes", header.Filename, maxAttachmentSize)
+	}
+
+	sniffed, _, _ := mime.ParseMediaType(http.DetectContentType(data))
+	contentType, _, err := mime.ParseMediaType(header.Header.Get("Content-Type"))
+	if err != nil || contentType == "application/octet-stream" {
+		contentType = sniffed
+	}
+	want, ok := attachmentTypes[contentType]
+	if !ok {
+		return httpError(http.StatusBadRequest, "files of type %s can't be attached", contentType)
+	}
+	if want != "" && sniffed != want {
+		return httpError(http.StatusBadRequest, "file %q is not of type %s: its contents are %s", header.Filename, contentType, sniffed)
+	}
+
+	sum := sha256.Sum256(data)
+	attachment.Id = uuid.NewString()
+	attachment.Name = header.Filename
+	attachment.ContentType = contentType
+	attachment.Size = int64(len(data))
+	attachment.Sha256 = hex.EncodeToString(sum[:])
+
+	blob := data
+	if sealedBlob(attachment, tender) {
+		if a.sealer == nil {
+			return storageError(ErrSealingDisabled)
+		}
+		if blob, err = a.sealer.SealBlob(attachment.Id, data); err != nil {
+			return err
+		}
+	}
+
+	if err := a.blobs.Put(r.Context(), attachment.Id, bytes.NewReader(blob), int64(len(blob)), contentType); err != nil {
+		return err
+	}
+	var created *Attachment
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if created, err = tx.store.CreateAttachment(attachment); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, created.UploaderUsername, action, AuditEntityTypeAttachment, created.Id,
+			organizationIds, nil, attachmentEventData(created, tender))
+	})
+	if err != nil {
+		// Nothing refers to the blob, so it goes too.
+		if err := a.blobs.Delete(context.WithoutCancel(r.Context()), attachment.Id); err != nil {
+			log.Printf("failed to delete blob of attachment %s: %v", attachment.Id, err)
+		}
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, created)
+}
+
+func (a *APIServer) getTenderAttachments(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderAttachmentsParams) error {
+	if _, err := a.requireVisibleTender(params.Username, tenderId); err != nil {
+		return err
+	}
+
+	attachments, err := a.store.GetTenderAttachments(tenderId, deref(params.Version))
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, attachments)
+}
+
+func (a *APIServer) getBidAttachments(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidAttachmentsParams) error {
+	if _, err := a.requireBidViewer(params.Username, bidId); err != nil {
+		return err
+	}
+
+	attachments, err := a.store.GetBidAttachments(bidId, deref(params.Version))
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, attachments)
+}
+
+func (a *APIServer) downloadAttachment(w http.ResponseWriter, r *http.Request, attachmentId AttachmentId, params DownloadAttachmentParams) error {
+	attachment, err := a.store.GetAttachmentById(attachmentId)
+	if err != nil {
+		return storageError(err)
+	}
+
+	var tender *Tender
+	if attachment.TenderId != nil {
+		if tender, err = a.requireVisibleTender(params.Username, *attachment.TenderId); err != nil {
+			return err
+		}
+	} else {
+		if params.Username == nil {
+			return httpError(http.StatusUnauthorized, "username is required for bid attachments")
+		}
+		bid, err := a.requireBidViewer(*params.Username, *attachment.BidId)
+		if err != nil {
+			return err
+		}
+		if tender, err = a.store.GetTenderById(bid.TenderId); err != nil {
+			return storageError(err)
+		}
+	}
+
+	blob, err := a.blobs.Get(r.Context(), attachment.Id)
+	if errors.Is(err, ErrBlobNotFound) {
+		return httpError(http.StatusNotFound, "contents of attachment %s are missing", attachment.Id)
+	}
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	var contents io.Reader = blob
+	if sealedBlob(attachment, tender) {
+		if contents, err = a.openBlob(attachment, blob); err != nil {
+			return err
+		}
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Name}))
+	w.Header().Set("X-Checksum-Sha256", attachment.Sha256)
+	w.WriteHeader(http.StatusOK)
+	// The headers are out, so a failure can only be logged.
+	if _, err := io.Copy(w, contents); err != nil {
+		log.Printf("failed to send attachment %s: %v", attachment.Id, err)
+	}
+	return nil
+}
+
+// sealedBlob reports whether the contents of the attachment are encrypted
+// with the sealing key: those of files attached to bids on a sealed tender
+// are, revealed or not, as whether a tender is sealed never changes.
+func sealedBlob(attachment *Attachment, tender *Tender) bool {
+	return attachment.BidId != nil && tender.Sealed
+}
+
+// openBlob decrypts the contents of an attachment stored by storeAttachment
+// with sealedBlob set.
+func (a *APIServer) openBlob(attachment *Attachment, blob io.Reader) (io.Reader, error) {
+	if a.sealer == nil {
+		return nil, storageError(ErrSealingDisabled)
+	}
+	payload, err := io.ReadAll(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read attachment %s: %w", attachment.Id, err)
+	}
+	data, err := a.sealer.OpenBlob(attachment.Id, payload)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// requireBidViewer checks that username may see the bid, either as its
+// manager or as its reviewer.
+func (a *APIServer) requireBidViewer(username, bidId string) (*Bid, error) {
+	bid, err := a.requireBidManager(username, bidId)
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && httpErr.Status == http.StatusForbidden {
+		bid, _, err = a.requireBidReviewer(username, bidId)
+	}
+	return bid, err
+}
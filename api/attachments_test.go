@@ -0,0 +1,67 @@
+package api
+
+import (
+	"bytes"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+// failingAttachments is a storage that can't record attachments.
+type failingAttachments struct {
+	Storage
+}
+
+func (s failingAttachments) Atomically(fn func(Storage) error) error {
+	return fn(s)
+}
+
+func (s failingAttachments) CreateAttachment(*Attachment) (*Attachment, error) {
+	return nil, errors.New("disk full")
+}
+
+func TestStoreAttachmentCleansUp(t *testing.T) {
+	store := NewMemoryStorage()
+	org := store.AddOrganization("Avito")
+	owner := store.AddEmployee("owner", "Owner", "Owner")
+	store.AddResponsible(org, owner.Id)
+	tender, err := store.CreateTender(&Tender{Name: "Ремонт дорог", OrganizationId: org}, owner.Username)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	blobs, err := NewLocalBlobStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := NewAPIServer("", failingAttachments{store}, blobs, nil).Handler()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, _ := mw.CreateFormFile("file", "смета.txt")
+	part.Write([]byte("Смета"))
+	mw.Close()
+	req := httptest.NewRequest("POST", "/api/tenders/"+tender.Id+"/attachments?username=owner", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("upload = %d %s", rec.Code, rec.Body)
+	}
+
+	// The upload wrote a blob before failing; none may be left behind.
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("orphaned blobs: %v", entries)
+	}
+}
@@ -0,0 +1,260 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// AuctionStanding is a published bid of an auction tender as the
+// leaderboard sees it.
+type AuctionStanding struct {
+	BidId    string
+	Username string
+	Price    *Money
+}
+
+// auctionBid applies the rules of a reverse auction to a price placed on
+// tender t, given the best price currently on it. It returns the end of the
+// auction, extended if the price came in the anti-sniping window.
+// Storages call it with the tender locked, so bids are judged one at a time.
+func auctionBid(t *Tender, price Money, best *Money, now time.Time) (time.Time, error) {
+	auction := t.Auction
+	if auction == nil {
+		return time.Time{}, ErrNotAuction
+	}
+	if t.Budget == nil {
+		// Prices are in the budget currency, so an edit dropping the budget
+		// stops the auction.
+		return time.Time{}, fmt.Errorf("%w: the tender has no budget", ErrNotAuction)
+	}
+	if t.Status != TenderStatusPublished || now.Before(auction.StartAt) || !now.Before(auction.EndAt) {
+		return time.Time{}, ErrAuctionClosed
+	}
+	if t.Budget.Max != nil && compareMoney(price, *t.Budget.Max) > 0 {
+		return time.Time{}, fmt.Errorf("%w: the budget is %s", ErrBidTooHigh, *t.Budget.Max)
+	}
+	if best != nil {
+		if limit := subMoney(*best, auction.MinDecrement); compareMoney(price, limit) > 0 {
+			return time.Time{}, fmt.Errorf("%w: the leading price is %s, bid %s or less", ErrBidTooHigh, *best, limit)
+		}
+	}
+
+	window := time.Duration(auction.ExtensionSeconds) * time.Second
+	if auction.EndAt.Sub(now) < window {
+		return now.Add(window), nil
+	}
+	return auction.EndAt, nil
+}
+
+// leaderboard ranks the priced standings, which storages return best first.
+func leaderboard(t *Tender, standings []AuctionStanding, username string) AuctionLeaderboard {
+	board := AuctionLeaderboard{TenderId: t.Id, EndAt: t.Auction.EndAt, Entries: []AuctionLeaderboardEntry{}}
+	for _, s := range standings {
+		if s.Price == nil {
+			continue
+		}
+		board.Entries = append(board.Entries, AuctionLeaderboardEntry{
+			Rank:  int32(len(board.Entries) + 1),
+			BidId: s.BidId,
+			Price: *s.Price,
+			Own:   s.Username == username,
+		})
+	}
+	return board
+}
+
+// auctionHub wakes up the leaderboard streams of a tender when a price is
+// placed on it. It only reaches the streams served by this process.
+type auctionHub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+}
+
+func newAuctionHub() *auctionHub {
+	return &auctionHub{subs: map[string]map[chan struct{}]struct{}{}}
+}
+
+func (h *auctionHub) subscribe(tenderId string) (<-chan struct{}, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// A pending wake-up is enough: the stream rereads the whole leaderboard.
+	ch := make(chan struct{}, 1)
+	if h.subs[tenderId] == nil {
+		h.subs[tenderId] = map[chan struct{}]struct{}{}
+	}
+	h.subs[tenderId][ch] = struct{}{}
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs[tenderId], ch)
+		if len(h.subs[tenderId]) == 0 {
+			delete(h.subs, tenderId)
+		}
+	}
+}
+
+func (h *auctionHub) notify(tenderId string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs[tenderId] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (a *APIServer) placeAuctionBid(w http.ResponseWriter, r *http.Request, bidId BidId, params PlaceAuctionBidParams) error {
+	current, tender, err := a.requireBidChangeable(params.Username, bidId)
+	if err != nil {
+		return err
+	}
+	bid := current
+
+	if tender.Auction == nil {
+		return httpError(http.StatusBadRequest, "tender %s is not an auction", tender.Id)
+	}
+	if bid.Status != BidStatusPublished {
+		return httpError(http.StatusBadRequest, "bid %s is not published", bid.Id)
+	}
+
+	price := params.Price
+	if err := normalizeMoney(&price); err != nil {
+		return err
+	}
+
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if bid, err = tx.store.PlaceAuctionBid(bidId, price, time.Now()); err != nil {
+			return storageError(err)
+		}
+		return tx.auditBid(r, params.Username, AuditActionPlaceAuctionBid, bid, current, bid)
+	})
+	if err != nil {
+		return err
+	}
+	a.auctions.notify(tender.Id)
+
+	return WriteJSON(w, http.StatusOK, bid)
+}
+
+// streamAuctionLeaderboard sends the leaderboard as server-sent events: on
+// connect, after every accepted price and once more with an end event when
+// the auction is over.
+func (a *APIServer) streamAuctionLeaderboard(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetAuctionLeaderboardParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+
+	tender, err := a.store.GetTenderById(tenderId)
+	if err != nil {
+		return storageError(err)
+	}
+	if tender.Auction == nil {
+		return httpError(http.StatusBadRequest, "tender %s is not an auction", tender.Id)
+	}
+
+	standings, err := a.store.GetAuctionStandings(tenderId)
+	if err != nil {
+		return storageError(err)
+	}
+	if err := a.requireAuctionParticipant(user, tender, standings); err != nil {
+		return err
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming is not supported")
+	}
+
+	updates, unsubscribe := a.auctions.subscribe(tenderId)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// Once the stream has started errors can only end it.
+	end := time.NewTimer(time.Until(tender.Auction.EndAt))
+	defer end.Stop()
+	for {
+		if err := writeEvent(w, "leaderboard", leaderboard(tender, standings, user.Username)); err != nil {
+			return nil
+		}
+		flusher.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return nil
+		case <-updates:
+		case <-end.C:
+		}
+
+		if tender, err = a.store.GetTenderById(tenderId); err != nil {
+			log.Printf("auction %s: %v", tenderId, err)
+			return nil
+		}
+		if standings, err = a.store.GetAuctionStandings(tenderId); err != nil {
+			log.Printf("auction %s: %v", tenderId, err)
+			return nil
+		}
+		if !time.Now().Before(tender.Auction.EndAt) {
+			writeEvent(w, "end", leaderboard(tender, standings, user.Username))
+			flusher.Flush()
+			return nil
+		}
+		end.Reset(time.Until(tender.Auction.EndAt))
+	}
+}
+
+// requireAuctionParticipant lets the responsibles of the tender and the
+// authors of its published bids watch the auction.
+func (a *APIServer) requireAuctionParticipant(user *User, tender *Tender, standings []AuctionStanding) error {
+	for _, s := range standings {
+		if s.Username == user.Username {
+			return nil
+		}
+	}
+	return a.requireResponsible(user, tender.OrganizationId)
+}
+
+func writeEvent(w http.ResponseWriter, event string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
+
+// validateAuction checks the auction parameters of a new tender.
+func validateAuction(auction *TenderAuction, tender *Tender) error {
+	if auction == nil {
+		return nil
+	}
+	if tender.Budget == nil {
+		return httpError(http.StatusBadRequest, "an auction requires a budget")
+	}
+	if tender.Sealed {
+		return httpError(http.StatusBadRequest, "an auction can't be sealed")
+	}
+	if !auction.EndAt.After(auction.StartAt) || !auction.EndAt.After(time.Now()) {
+		return httpError(http.StatusBadRequest, "auction endAt must be in the future and after startAt")
+	}
+	if err := normalizeMoney(&auction.MinDecrement); err != nil {
+		return err
+	}
+	if r, _ := parseMoney(auction.MinDecrement); r.Sign() <= 0 {
+		return httpError(http.StatusBadRequest, "auction minDecrement must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAuctionBid(t *testing.T) {
+	now := time.Now()
+	max := Money("1000.00")
+	tender := func(status TenderStatus, endAt time.Time) *Tender {
+		return &Tender{
+			Status: status,
+			Budget: &TenderBudget{Max: &max, Currency: "RUB"},
+			Auction: &TenderAuction{
+				StartAt:          now.Add(-time.Hour),
+				EndAt:            endAt,
+				MinDecrement:     "10.00",
+				ExtensionSeconds: 120,
+			},
+		}
+	}
+	best := Money("900.00")
+
+	tests := []struct {
+		name   string
+		tender *Tender
+		price  Money
+		best   *Money
+		err    error
+		endAt  time.Time
+	}{
+		{"first price", tender(TenderStatusPublished, now.Add(time.Hour)), "1000.00", nil, nil, now.Add(time.Hour)},
+		{"over budget", tender(TenderStatusPublished, now.Add(time.Hour)), "1000.01", nil, ErrBidTooHigh, time.Time{}},
+		{"within decrement", tender(TenderStatusPublished, now.Add(time.Hour)), "890.01", &best, ErrBidTooHigh, time.Time{}},
+		{"full decrement", tender(TenderStatusPublished, now.Add(time.Hour)), "890.00", &best, nil, now.Add(time.Hour)},
+		{"sniping", tender(TenderStatusPublished, now.Add(time.Minute)), "890.00", &best, nil, now.Add(2 * time.Minute)},
+		{"ended", tender(TenderStatusPublished, now), "500.00", &best, ErrAuctionClosed, time.Time{}},
+		{"closed", tender(TenderStatusClosed, now.Add(time.Hour)), "500.00", &best, ErrAuctionClosed, time.Time{}},
+		{"not an auction", &Tender{Status: TenderStatusPublished}, "500.00", nil, ErrNotAuction, time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endAt, err := auctionBid(tt.tender, tt.price, tt.best, now)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if !endAt.Equal(tt.endAt) {
+				t.Errorf("endAt = %v, want %v", endAt, tt.endAt)
+			}
+		})
+	}
+}
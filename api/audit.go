@@ -0,0 +1,287 @@
+package api
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"net"
+	"net/http"
+	"regexp"
+	"time"
+)
+
+const (
+	// auditGenesis is the prevHash of the first entry of the audit log.
+	auditGenesis = "0000000000000000000000000000000000000000000000000000000000000000"
+	// auditVerifyBatch is how many entries a verification reads at once.
+	auditVerifyBatch = 500
+)
+
+type requestIDKey struct{}
+
+// requestIDPattern is what a client-sent X-Request-ID has to look like to
+// be kept; anything else is replaced with a fresh id.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,100}$`)
+
+// withRequestID tags every request with an id, the X-Request-ID the client
+// sent or a new one, and returns it in the response headers.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-ID")
+		if !requestIDPattern.MatchString(id) {
+			id = uuid.NewString()
+		}
+		w.Header().Set("X-Request-ID", id)
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), requestIDKey{}, id)))
+	})
+}
+
+func requestID(r *http.Request) string {
+	id, _ := r.Context().Value(requestIDKey{}).(string)
+	return id
+}
+
+// clientIP is the address the request came from. Forwarding headers are
+// ignored, as anyone can set them.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// atomically runs fn with a copy of the server whose storage makes every
+// change in one transaction, so a mutation and its audit entry are
+// committed together or not at all.
+func (a *APIServer) atomically(fn func(tx *APIServer) error) error {
+	return a.store.Atomically(func(store Storage) error {
+		tx := *a
+		tx.store = store
+		return fn(&tx)
+	})
+}
+
+// audit appends a mutation made by actor to the audit log. It is called
+// within the transaction of the mutation, see atomically, so a failure to
+// record it undoes the change.
+func (a *APIServer) audit(r *http.Request, actor string, action AuditAction, entityType AuditEntityType, entityId string,
+	organizationIds []string, before, after any) error {
+	entry := &AuditEntry{
+		Actor:      actor,
+		Action:     action,
+		EntityType: entityType,
+		EntityId:   entityId,
+		RequestId:  requestID(r),
+		Ip:         clientIP(r),
+	}
+	return appendAudit(a.store, entry, organizationIds, before, after)
+}
+
+// appendAudit fills in the organizations and snapshots of the entry and
+// appends it to the audit log.
+func appendAudit(store Storage, entry *AuditEntry, organizationIds []string, before, after any) error {
+	entry.OrganizationIds = []OrganizationId{}
+	for _, id := range organizationIds {
+		if id != "" && !contains(entry.OrganizationIds, id) {
+			entry.OrganizationIds = append(entry.OrganizationIds, id)
+		}
+	}
+
+	var err error
+	if entry.Before, err = auditSnapshot(before); err != nil {
+		return err
+	}
+	if entry.After, err = auditSnapshot(after); err != nil {
+		return err
+	}
+
+	if _, err := store.AppendAuditLog(entry); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+// auditTender records a mutation of a tender.
+func (a *APIServer) auditTender(r *http.Request, actor string, action AuditAction, before, after *Tender) error {
+	tender := cmp.Or(after, before)
+	return a.audit(r, actor, action, AuditEntityTypeTender, tender.Id, []string{tender.OrganizationId}, before, after)
+}
+
+// auditBid records a mutation of a bid, or of what was said about it when
+// before and after aren't bids. It concerns the organization owning the
+// tender and the one the bid was made on behalf of. Bids on a sealed tender
+// are recorded without what the seal hides.
+func (a *APIServer) auditBid(r *http.Request, actor string, action AuditAction, bid *Bid, before, after any) error {
+	tender, organizationIds, err := a.bidOrganizations(bid)
+	if err != nil {
+		return err
+	}
+
+	hide := func(v any) any {
+		if b, ok := v.(*Bid); ok && b != nil {
+			return bidEventData(b, tender)
+		}
+		return v
+	}
+	return a.audit(r, actor, action, AuditEntityTypeBid, bid.Id, organizationIds, hide(before), hide(after))
+}
+
+// bidOrganizations returns the tender of a bid and the organizations the
+// bid concerns.
+func (a *APIServer) bidOrganizations(bid *Bid) (*Tender, []string, error) {
+	tender, err := a.store.GetTenderById(bid.TenderId)
+	if err != nil {
+		return nil, nil, storageError(err)
+	}
+	organizationIds := []string{tender.OrganizationId}
+	if bid.AuthorType == BidAuthorTypeOrganization {
+		org, err := a.store.GetUserOrganization(bid.AuthorId)
+		if err != nil {
+			return nil, nil, err
+		}
+		organizationIds = append(organizationIds, org)
+	}
+	return tender, organizationIds, nil
+}
+
+// auditSnapshot turns v into the object an audit entry records, nil for a
+// nil v.
+func auditSnapshot(v any) (*map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audit snapshot: %w", err)
+	}
+	var snapshot *map[string]any
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("audit snapshot is not an object: %w", err)
+	}
+	return snapshot, nil
+}
+
+// auditTime formats the time of an audit entry. Postgres keeps
+// microseconds, so the time hashed is the time read back.
+func auditTime(t time.Time) string {
+	return t.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// AuditHash returns the hash of an audit entry: the SHA-256 of its fields
+// but id and hash, in a fixed order. Snapshots are maps, which encoding/json
+// writes with sorted keys, so the hash doesn't depend on how they were
+// stored.
+func AuditHash(e *AuditEntry) (string, error) {
+	data, err := json.Marshal(struct {
+		PrevHash        string           `json:"prevHash"`
+		Actor           Username         `json:"actor"`
+		Action          AuditAction      `json:"action"`
+		EntityType      AuditEntityType  `json:"entityType"`
+		EntityId        string           `json:"entityId"`
+		OrganizationIds []OrganizationId `json:"organizationIds"`
+		Before          *map[string]any  `json:"before"`
+		After           *map[string]any  `json:"after"`
+		RequestId       string           `json:"requestId"`
+		Ip              string           `json:"ip"`
+		CreatedAt       string           `json:"createdAt"`
+	}{e.PrevHash, e.Actor, e.Action, e.EntityType, e.EntityId, e.OrganizationIds, e.Before, e.After, e.RequestId, e.Ip, e.CreatedAt})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// chainAuditEntry links e to the entry before it, stamping it with the
+// time it is appended at and its hash.
+func chainAuditEntry(e *AuditEntry, prevHash string, at time.Time) error {
+	e.PrevHash = prevHash
+	e.CreatedAt = auditTime(at)
+	if e.OrganizationIds == nil {
+		e.OrganizationIds = []OrganizationId{}
+	}
+	hash, err := AuditHash(e)
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+	return nil
+}
+
+// VerifyAuditLog walks the whole audit log checking that every entry links
+// to the one before it and hashes to its hash. It stops at the first entry
+// that doesn't.
+func VerifyAuditLog(store Storage) (*AuditVerification, error) {
+	result := &AuditVerification{Valid: true}
+	prevHash := auditGenesis
+	var after int64
+	for {
+		entries, err := store.GetAuditChain(after, auditVerifyBatch)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range entries {
+			hash, err := AuditHash(e)
+			if err != nil {
+				return nil, err
+			}
+			if e.PrevHash != prevHash || e.Hash != hash {
+				result.Valid = false
+				result.BrokenAt = &e.Id
+				return result, nil
+			}
+			result.Checked++
+			prevHash, after = e.Hash, e.Id
+		}
+
+		if len(entries) < auditVerifyBatch {
+			return result, nil
+		}
+	}
+}
+
+func (a *APIServer) getAuditLog(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetAuditLogParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+	if err := a.requireResponsible(user, organizationId); err != nil {
+		return err
+	}
+
+	filter := AuditFilter{
+		OrganizationId: organizationId,
+		EntityType:     deref(params.EntityType),
+		EntityId:       deref(params.EntityId),
+		Actor:          deref(params.Actor),
+		From:           params.From,
+		To:             params.To,
+	}
+	limit, offset := pagination(params.Limit, params.Offset)
+	entries, err := a.store.GetAuditLog(filter, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, entries)
+}
+
+func (a *APIServer) verifyAuditLog(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params VerifyAuditLogParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+	if err := a.requireResponsible(user, organizationId); err != nil {
+		return err
+	}
+
+	result, err := VerifyAuditLog(a.store)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, result)
+}
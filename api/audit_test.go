@@ -0,0 +1,46 @@
+package api
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVerifyAuditLog(t *testing.T) {
+	store := NewMemoryStorage()
+	for i, action := range []AuditAction{AuditActionCreateTender, AuditActionEditTender, AuditActionCreateBid, AuditActionEditBid} {
+		snapshot := map[string]any{"version": i + 1, "name": "Ремонт дорог"}
+		_, err := store.AppendAuditLog(&AuditEntry{Actor: "owner", Action: action, EntityType: AuditEntityTypeTender,
+			EntityId: "t1", OrganizationIds: []OrganizationId{"o1"}, After: &snapshot, RequestId: "r1", Ip: "127.0.0.1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	verify := func() *AuditVerification {
+		t.Helper()
+		result, err := VerifyAuditLog(store)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	if got := verify(); !got.Valid || got.Checked != 4 || got.BrokenAt != nil {
+		t.Fatalf("intact log: %+v", got)
+	}
+	if store.auditLog[0].PrevHash != auditGenesis || store.auditLog[1].PrevHash != store.auditLog[0].Hash {
+		t.Errorf("log is not chained: %+v", store.auditLog[:2])
+	}
+
+	// A rewritten snapshot no longer hashes to its hash.
+	(*store.auditLog[2].After)["name"] = "Ремонт мостов"
+	if got := verify(); got.Valid || got.Checked != 2 || *got.BrokenAt != 3 {
+		t.Errorf("rewritten entry: %+v", got)
+	}
+	(*store.auditLog[2].After)["name"] = "Ремонт дорог"
+
+	// An entry taken out breaks the link of the one after it.
+	store.auditLog = slices.Delete(store.auditLog, 1, 2)
+	if got := verify(); got.Valid || got.Checked != 1 || *got.BrokenAt != 3 {
+		t.Errorf("deleted entry: %+v", got)
+	}
+}
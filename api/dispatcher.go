@@ -0,0 +1,212 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// webhookMaxAttempts is how many times a delivery is tried before it is
+	// dead-lettered.
+	webhookMaxAttempts = 8
+	// webhookBackoff is the delay before the first retry; it doubles with
+	// every failed attempt, about an hour over all the attempts.
+	webhookBackoff = 30 * time.Second
+	// webhookTimeout bounds a single attempt.
+	webhookTimeout = 10 * time.Second
+	// webhookLease hides a claimed delivery from other replicas while it is
+	// being sent. It outlasts an attempt, so a delivery is only tried again
+	// when the replica sending it died.
+	webhookLease = time.Minute
+	// webhookBatch is how many deliveries a tick claims.
+	webhookBatch = 50
+)
+
+// WebhookDispatcher sends the queued webhook deliveries and retries the
+// failed ones with exponential backoff. Replicas claim deliveries from the
+// storage, so all of them can run a dispatcher.
+type WebhookDispatcher struct {
+	store    Storage
+	client   *http.Client
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewWebhookDispatcher returns a dispatcher ticking every interval.
+func NewWebhookDispatcher(store Storage, interval time.Duration) *WebhookDispatcher {
+	// Only public addresses are dialed, see publicAddress, and directly: a
+	// proxy would dial in the dispatcher's stead, past the check.
+	dialer := &net.Dialer{Timeout: webhookTimeout, Control: webhookDialControl}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &WebhookDispatcher{
+		store: store,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   webhookTimeout,
+			// A redirect is an answer other than 2xx, so it fails the attempt.
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// webhookDialControl refuses connections to addresses that aren't public.
+// It runs on the address being dialed, after resolution, so a host that
+// resolved to a public address when the webhook was created can't be
+// pointed at an internal one later.
+func webhookDialControl(network, address string, _ syscall.RawConn) error {
+	if privateWebhooksAllowed() {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !publicAddress(addr) {
+		return fmt.Errorf("%s is not a public address", addr)
+	}
+	return nil
+}
+
+// Run ticks until ctx is cancelled.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Tick(ctx); err != nil {
+			log.Printf("webhooks: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick makes an attempt at every delivery due, until none is left.
+func (d *WebhookDispatcher) Tick(ctx context.Context) error {
+	for {
+		now := d.now()
+		due, err := d.store.ClaimDueDeliveries(now, now.Add(webhookLease), webhookBatch)
+		if err != nil {
+			return fmt.Errorf("failed to claim deliveries: %w", err)
+		}
+
+		for _, dd := range due {
+			attempt := d.send(ctx, dd)
+			if err := d.store.RecordDeliveryAttempt(dd.Delivery.Id, attempt, d.now()); err != nil {
+				return fmt.Errorf("failed to record delivery %s: %w", dd.Delivery.Id, err)
+			}
+		}
+
+		if len(due) < webhookBatch {
+			return nil
+		}
+	}
+}
+
+// send makes an attempt at a delivery and schedules the next one if it
+// fails.
+func (d *WebhookDispatcher) send(ctx context.Context, dd DueDelivery) DeliveryAttempt {
+	var attempt DeliveryAttempt
+	err := func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, dd.URL, bytes.NewReader(dd.Body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "tender-webhooks/1")
+		req.Header.Set("X-Webhook-Event", string(dd.Delivery.Event))
+		req.Header.Set("X-Webhook-Delivery", dd.Delivery.Id)
+		req.Header.Set("X-Webhook-Signature", SignWebhook(dd.Secret, d.now(), dd.Body))
+
+		resp, err := d.client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+		attempt.ResponseStatus = int32(resp.StatusCode)
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("receiver answered %s", resp.Status)
+		}
+		return nil
+	}()
+	if err == nil {
+		attempt.Delivered = true
+		return attempt
+	}
+
+	attempt.Error = err.Error()
+	if attempts := dd.Delivery.Attempts + 1; attempts < webhookMaxAttempts {
+		next := d.now().Add(webhookBackoff << (attempts - 1))
+		attempt.NextAttemptAt = &next
+	}
+	return attempt
+}
+
+// SignWebhook returns the X-Webhook-Signature header of a body sent at t:
+// the HMAC-SHA256 of "<unix time>.<body>" keyed with the webhook secret.
+func SignWebhook(secret string, t time.Time, body []byte) string {
+	return fmt.Sprintf("t=%d,v1=%s", t.Unix(), webhookMAC(secret, t.Unix(), body))
+}
+
+func webhookMAC(secret string, unix int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", unix)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook checks the X-Webhook-Signature header of a delivery
+// received at now, rejecting signatures older than tolerance so that a
+// captured delivery can't be replayed later.
+func VerifyWebhook(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var unix int64 = -1
+	var signature string
+	for _, field := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(field, "=")
+		switch key {
+		case "t":
+			unix, _ = strconv.ParseInt(value, 10, 64)
+		case "v1":
+			signature = value
+		}
+	}
+	if unix < 0 || signature == "" {
+		return errors.New("malformed webhook signature")
+	}
+	if !hmac.Equal([]byte(signature), []byte(webhookMAC(secret, unix, body))) {
+		return errors.New("webhook signature mismatch")
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return errors.New("webhook signature expired")
+	}
+	return nil
+}
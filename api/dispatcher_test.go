@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWebhookRetries(t *testing.T) {
+	t.Setenv("WEBHOOK_ALLOW_PRIVATE", "true")
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	store := NewMemoryStorage()
+	org := store.AddOrganization("ERP")
+	webhook, err := store.CreateWebhook(&Webhook{OrganizationId: org, Url: srv.URL,
+		Events: []WebhookEvent{WebhookEventTenderClosed}}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.EnqueueWebhookEvent(1, WebhookEventTenderClosed, []string{org}, []byte(`{"event":"tender.closed"}`)); err != nil {
+		t.Fatal(err)
+	}
+
+	clock := time.Now()
+	d := NewWebhookDispatcher(store, time.Second)
+	d.now = func() time.Time { return clock }
+	tick := func() *WebhookDelivery {
+		t.Helper()
+		if err := d.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		deliveries, err := store.GetWebhookDeliveries(webhook.Id, "", 10, 0)
+		if err != nil || len(deliveries) != 1 {
+			t.Fatalf("deliveries = %v, %v", deliveries, err)
+		}
+		return deliveries[0]
+	}
+
+	delivery := tick()
+	if delivery.Attempts != 1 || *delivery.ResponseStatus != http.StatusServiceUnavailable ||
+		*delivery.NextAttemptAt != clock.Add(webhookBackoff).UTC().Format(time.RFC3339) {
+		t.Fatalf("after the first attempt: %+v", delivery)
+	}
+
+	// The backoff isn't over yet.
+	clock = clock.Add(webhookBackoff - time.Second)
+	if delivery = tick(); delivery.Attempts != 1 {
+		t.Fatalf("retried early: %+v", delivery)
+	}
+
+	for attempt := int32(2); attempt <= webhookMaxAttempts; attempt++ {
+		clock = clock.Add(webhookBackoff << (attempt - 1))
+		if delivery = tick(); delivery.Attempts != attempt {
+			t.Fatalf("attempt %d: %+v", attempt, delivery)
+		}
+	}
+	if delivery.Status != WebhookDeliveryStatusDead || delivery.NextAttemptAt != nil || calls.Load() != webhookMaxAttempts {
+		t.Fatalf("after the last attempt: %+v, %d calls", delivery, calls.Load())
+	}
+
+	delivery, err = store.RedeliverWebhookDelivery(delivery.Id)
+	if err != nil || delivery.Status != WebhookDeliveryStatusPending || delivery.Attempts != 0 {
+		t.Fatalf("redelivered: %+v, %v", delivery, err)
+	}
+	if _, err := store.RedeliverWebhookDelivery(delivery.Id); err != ErrDeliveryNotDead {
+		t.Fatalf("redelivered twice: %v", err)
+	}
+}
+
+func TestWebhookPrivateAddresses(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer srv.Close()
+
+	// The webhook points at a loopback address, as if its host had been
+	// resolved elsewhere since it was created.
+	store := NewMemoryStorage()
+	org := store.AddOrganization("ERP")
+	webhook, err := store.CreateWebhook(&Webhook{OrganizationId: org, Url: srv.URL,
+		Events: []WebhookEvent{WebhookEventTenderClosed}}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.EnqueueWebhookEvent(1, WebhookEventTenderClosed, []string{org}, []byte(`{"event":"tender.closed"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := NewWebhookDispatcher(store, time.Second).Tick(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err := store.GetWebhookDeliveries(webhook.Id, "", 10, 0)
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("deliveries = %v, %v", deliveries, err)
+	}
+	if d := deliveries[0]; calls.Load() != 0 || d.LastError == nil || !strings.Contains(*d.LastError, "is not a public address") {
+		t.Errorf("delivery = %+v, %d calls", d, calls.Load())
+	}
+
+	for addr, public := range map[string]bool{
+		"93.184.216.34":   true,
+		"2606:2800::1":    true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"10.1.2.3":        false,
+		"192.168.0.1":     false,
+		"169.254.169.254": false,
+		"100.100.100.200": false,
+		"fd00:ec2::254":   false,
+		"::ffff:10.0.0.1": false,
+		"0.0.0.0":         false,
+	} {
+		if got := publicAddress(netip.MustParseAddr(addr)); got != public {
+			t.Errorf("publicAddress(%s) = %v", addr, got)
+		}
+	}
+}
+
+func TestVerifyWebhook(t *testing.T) {
+	sent := time.Unix(1700000000, 0)
+	body := []byte(`{"event":"webhook.ping"}`)
+	header := SignWebhook("secret", sent, body)
+
+	if err := VerifyWebhook("secret", header, body, sent.Add(time.Minute), 5*time.Minute); err != nil {
+		t.Errorf("valid signature: %v", err)
+	}
+	for name, err := range map[string]error{
+		"tampered": VerifyWebhook("secret", header, []byte(`{"event":"tender.closed"}`), sent, 5*time.Minute),
+		"secret":   VerifyWebhook("other", header, body, sent, 5*time.Minute),
+		"replayed": VerifyWebhook("secret", header, body, sent.Add(time.Hour), 5*time.Minute),
+		"garbled":  VerifyWebhook("secret", "v1=abc", body, sent, 5*time.Minute),
+	} {
+		if err == nil {
+			t.Errorf("%s signature accepted", name)
+		}
+	}
+}
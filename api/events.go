@@ -0,0 +1,213 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+const (
+	// eventStreamPoll is how often an event stream looks for new events.
+	eventStreamPoll = time.Second
+	// eventStreamKeepalive is how long a stream may stay silent before a
+	// comment is sent to keep proxies from closing it.
+	eventStreamKeepalive = 15 * time.Second
+	// eventStreamBatch is how many logged events a poll reads at once.
+	eventStreamBatch = 100
+)
+
+// EventLogSink keeps the relayed events in the event log streamed to
+// clients. The relay is the only writer of the log, so it is numbered in
+// the order events are relayed and a client resuming after an event misses
+// none of the later ones.
+type EventLogSink struct {
+	store Storage
+}
+
+func NewEventLogSink(store Storage) *EventLogSink {
+	return &EventLogSink{store: store}
+}
+
+func (ls *EventLogSink) Publish(ctx context.Context, e OutboxEvent) error {
+	tender, _, err := eventSubject(ls.store, e)
+	if errors.Is(err, ErrTenderNotFound) || errors.Is(err, ErrBidNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return ls.store.AppendEventLog(LoggedEvent{Event: e, TenderId: tender.Id, OrganizationId: tender.OrganizationId})
+}
+
+// streamEvents sends the event log as server-sent events, from after
+// Last-Event-ID or from the time of connecting. Whether the user may see
+// an event is decided as it is sent, so a stream follows access as it
+// changes.
+func (a *APIServer) streamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams) error {
+	user, filter, after, err := a.openEventStream(params)
+	if err != nil {
+		return err
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return fmt.Errorf("streaming is not supported")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	lastWrite := time.Now()
+	send := func(e LoggedEvent) error {
+		if err := writeLoggedEvent(w, e); err != nil {
+			return err
+		}
+		lastWrite = time.Now()
+		return nil
+	}
+	idle := func() error {
+		if time.Since(lastWrite) >= eventStreamKeepalive {
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return err
+			}
+			lastWrite = time.Now()
+		}
+		flusher.Flush()
+		return nil
+	}
+	a.followEvents(r.Context(), user, filter, after, send, idle)
+	return nil
+}
+
+// openEventStream checks a request for the event stream and returns the
+// user, nil for anonymous clients, the events to send and the one they
+// follow.
+func (a *APIServer) openEventStream(params StreamEventsParams) (*User, EventLogFilter, int64, error) {
+	var user *User
+	if params.Username != nil {
+		u, err := a.authenticate(*params.Username)
+		if err != nil {
+			return nil, EventLogFilter{}, 0, err
+		}
+		user = u
+	}
+
+	filter := EventLogFilter{
+		TenderId:       deref(params.TenderId),
+		OrganizationId: deref(params.OrganizationId),
+		Types:          deref(params.Type),
+	}
+	if filter.TenderId != "" {
+		if _, err := a.requireVisibleTender(params.Username, filter.TenderId); err != nil {
+			return nil, EventLogFilter{}, 0, err
+		}
+	}
+
+	after := deref(params.LastEventID)
+	if params.LastEventID == nil {
+		head, err := a.store.GetEventLogHead()
+		if err != nil {
+			return nil, EventLogFilter{}, 0, storageError(err)
+		}
+		after = head
+	}
+	return user, filter, after, nil
+}
+
+// followEvents passes the events logged after seq after that user may see
+// to send, as they are logged, until ctx is done or send fails. idle, if
+// set, is called after every poll. Once a stream has started errors can
+// only end it, so they are logged.
+func (a *APIServer) followEvents(ctx context.Context, user *User, filter EventLogFilter, after int64,
+	send func(LoggedEvent) error, idle func() error) {
+	poll := time.NewTicker(eventStreamPoll)
+	defer poll.Stop()
+	for {
+		events, err := a.store.GetEventLog(after, filter, eventStreamBatch)
+		if err != nil {
+			log.Printf("event stream: %v", err)
+			return
+		}
+
+		for _, e := range events {
+			after = e.Seq
+			visible, err := a.eventVisible(user, e)
+			if err != nil {
+				log.Printf("event stream: %v", err)
+				return
+			}
+			if !visible {
+				continue
+			}
+			if err := send(e); err != nil {
+				return
+			}
+		}
+		if idle != nil && idle() != nil {
+			return
+		}
+
+		if len(events) == eventStreamBatch {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+		}
+	}
+}
+
+// eventVisible tells whether user, nil for anonymous clients, may now see
+// what the event is about: the tender, or the bid as its manager or as a
+// reviewer of it once published.
+func (a *APIServer) eventVisible(user *User, e LoggedEvent) (bool, error) {
+	if e.Event.AggregateType == EventAggregateTypeTender {
+		tender, err := a.store.GetTenderById(e.TenderId)
+		if err != nil {
+			return granted(storageError(err))
+		}
+		return granted(a.checkTenderVisible(user, tender))
+	}
+
+	if user == nil {
+		return false, nil
+	}
+	_, err := a.requireBidManager(user.Username, e.Event.AggregateId)
+	if ok, err := granted(err); ok || err != nil {
+		return ok, err
+	}
+	bid, _, err := a.requireBidReviewer(user.Username, e.Event.AggregateId)
+	if ok, err := granted(err); !ok || err != nil {
+		return false, err
+	}
+	return bid.Status == BidStatusPublished, nil
+}
+
+// granted reads the error of an access check: an HTTP error denies access,
+// any other error is a failure to decide.
+func granted(err error) (bool, error) {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func writeLoggedEvent(w io.Writer, e LoggedEvent) error {
+	payload, err := json.Marshal(e.Event)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.Seq, e.Event.Type, payload)
+	return err
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// exportBatch is how many rows an export reads from the storage at once.
+const exportBatch = 500
+
+type exportColumn struct {
+	name    string
+	numeric bool
+}
+
+var tenderExportColumns = []exportColumn{
+	{"id", false}, {"name", false}, {"description", false}, {"serviceType", false}, {"status", false},
+	{"visibility", false}, {"sealed", false}, {"organizationId", false}, {"version", true},
+	{"budgetMin", true}, {"budgetMax", true}, {"currency", false}, {"submissionDeadline", false},
+	{"createdAt", false}, {"approvals", true}, {"rejections", true}, {"reviews", true},
+}
+
+var bidExportColumns = []exportColumn{
+	{"id", false}, {"tenderId", false}, {"name", false}, {"description", false}, {"status", false},
+	{"authorType", false}, {"authorId", false}, {"version", true}, {"price", true}, {"currency", false},
+	{"deliveryDays", true}, {"lotIds", false}, {"createdAt", false},
+	{"approvals", true}, {"rejections", true}, {"reviews", true},
+}
+
+// exportWriter writes the rows of an export in one of its formats.
+type exportWriter interface {
+	Write(row []string) error
+	Flush() error
+	Close() error
+}
+
+// csvExport writes CSV with a byte order mark, which spreadsheets need to
+// read it as UTF-8.
+type csvExport struct {
+	buf *bufio.Writer
+	csv *csv.Writer
+}
+
+func newCSVExport(w io.Writer, columns []exportColumn) *csvExport {
+	c := &csvExport{buf: bufio.NewWriter(w)}
+	c.buf.WriteString("\ufeff")
+	c.csv = csv.NewWriter(c.buf)
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+	c.csv.Write(header)
+	return c
+}
+
+// Write quotes text cells a spreadsheet would take for a formula.
+func (c *csvExport) Write(row []string) error {
+	for i, v := range row {
+		if v != "" && strings.ContainsRune("=+-@\t\r", rune(v[0])) {
+			if _, err := strconv.ParseFloat(v, 64); err != nil {
+				row[i] = "'" + v
+			}
+		}
+	}
+	return c.csv.Write(row)
+}
+
+func (c *csvExport) Flush() error {
+	c.csv.Flush()
+	if err := c.csv.Error(); err != nil {
+		return err
+	}
+	return c.buf.Flush()
+}
+
+func (c *csvExport) Close() error {
+	return c.Flush()
+}
+
+// streamExport sends the rows next returns, a batch at a time, until it
+// returns less than a batch. The first batch is read before the status is
+// sent, so failing to read it is answered with an error. A failure past
+// that point aborts the connection, see abortExport.
+func streamExport(w http.ResponseWriter, format ExportFormat, name string, columns []exportColumn,
+	next func(offset int32) ([][]string, error)) error {
+	rows, err := next(0)
+	if err != nil {
+		return storageError(err)
+	}
+
+	ext := string(cmp.Or(format, ExportFormatCsv))
+	filename := fmt.Sprintf("%s-%s.%s", name, time.Now().UTC().Format("20060102-150405"), ext)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+
+	var out exportWriter
+	if ext == string(ExportFormatXlsx) {
+		w.Header().Set("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		w.WriteHeader(http.StatusOK)
+		x, err := newXLSXWriter(w, columns)
+		if err != nil {
+			abortExport(name, err)
+		}
+		out = x
+	} else {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		out = newCSVExport(w, columns)
+	}
+
+	flusher, _ := w.(http.Flusher)
+	for offset := int32(0); ; offset += exportBatch {
+		if offset > 0 {
+			if rows, err = next(offset); err != nil {
+				abortExport(name, err)
+			}
+		}
+		for _, row := range rows {
+			if err := out.Write(row); err != nil {
+				abortExport(name, err)
+			}
+		}
+		if len(rows) < exportBatch {
+			break
+		}
+		if err := out.Flush(); err != nil {
+			abortExport(name, err)
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+	if err := out.Close(); err != nil {
+		abortExport(name, err)
+	}
+	return nil
+}
+
+// abortExport gives up on an export whose status is already sent. The
+// connection is cut without ending the response, so the client sees a
+// broken transfer rather than a file that merely looks complete.
+func abortExport(name string, err error) {
+	log.Printf("failed to export %s: %v", name, err)
+	panic(http.ErrAbortHandler)
+}
+
+func (a *APIServer) exportTenders(w http.ResponseWriter, r *http.Request, params ExportTendersParams) error {
+	var viewer string
+	if params.Username != nil {
+		user, err := a.authenticate(*params.Username)
+		if err != nil {
+			return err
+		}
+		viewer = user.Username
+	}
+
+	filter := TenderFilter{
+		Viewer:       viewer,
+		ServiceTypes: deref(params.ServiceType),
+		Currency:     deref(params.Currency),
+		MinBudget:    deref(params.MinBudget),
+		MaxBudget:    deref(params.MaxBudget),
+		SortBy:       deref(params.SortBy),
+		SortOrder:    deref(params.SortOrder),
+	}
+
+	return streamExport(w, deref(params.Format), "tenders", tenderExportColumns, func(offset int32) ([][]string, error) {
+		tenders, err := a.store.GetAllTenders(filter, exportBatch, offset)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(tenders))
+		for i, t := range tenders {
+			ids[i] = t.Id
+		}
+		counts, err := a.store.CountTenderActivity(ids)
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([][]string, len(tenders))
+		for i, t := range tenders {
+			budget := deref(t.Budget)
+			var deadline string
+			if t.SubmissionDeadline != nil {
+				deadline = t.SubmissionDeadline.Format(time.RFC3339)
+			}
+			c := counts[t.Id]
+			rows[i] = []string{
+				t.Id, t.Name, t.Description, string(t.ServiceType), string(t.Status),
+				string(t.Visibility), strconv.FormatBool(t.Sealed), t.OrganizationId, strconv.Itoa(int(t.Version)),
+				deref(budget.Min), deref(budget.Max), string(budget.Currency), deadline,
+				t.CreatedAt, strconv.Itoa(c.Approvals), strconv.Itoa(c.Rejections), strconv.Itoa(c.Reviews),
+			}
+		}
+		return rows, nil
+	})
+}
+
+func (a *APIServer) exportBidsForTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params ExportBidsForTenderParams) error {
+	if _, err := a.requireTenderBidsReader(params.Username, tenderId); err != nil {
+		return err
+	}
+
+	filter := BidFilter{
+		Currency:        deref(params.Currency),
+		MinPrice:        deref(params.MinPrice),
+		MaxPrice:        deref(params.MaxPrice),
+		MaxDeliveryDays: deref(params.MaxDeliveryDays),
+		SortBy:          deref(params.SortBy),
+		SortOrder:       deref(params.SortOrder),
+	}
+
+	return streamExport(w, deref(params.Format), "bids", bidExportColumns, func(offset int32) ([][]string, error) {
+		bids, err := a.store.GetBidsByTenderId(tenderId, filter, exportBatch, offset)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(bids))
+		for i, b := range bids {
+			ids[i] = b.Id
+		}
+		counts, err := a.store.CountBidActivity(ids)
+		if err != nil {
+			return nil, err
+		}
+
+		rows := make([][]string, len(bids))
+		for i, b := range bids {
+			var deliveryDays string
+			if b.DeliveryDays != nil {
+				deliveryDays = strconv.Itoa(int(*b.DeliveryDays))
+			}
+			c := counts[b.Id]
+			rows[i] = []string{
+				b.Id, b.TenderId, b.Name, b.Description, string(b.Status),
+				string(b.AuthorType), b.AuthorId, strconv.Itoa(int(b.Version)), deref(b.Price), string(deref(b.Currency)),
+				deliveryDays, strings.Join(deref(b.LotIds), ","), b.CreatedAt,
+				strconv.Itoa(c.Approvals), strconv.Itoa(c.Rejections), strconv.Itoa(c.Reviews),
+			}
+		}
+		return rows, nil
+	})
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestStreamExportBatches(t *testing.T) {
+	const total = 2*exportBatch + 7
+	columns := []exportColumn{{"name", false}, {"n", true}}
+	var offsets []int32
+	next := func(offset int32) ([][]string, error) {
+		offsets = append(offsets, offset)
+		var rows [][]string
+		for i := offset; i < min(offset+exportBatch, total); i++ {
+			rows = append(rows, []string{"<строка & " + strconv.Itoa(int(i)) + ">", strconv.Itoa(int(i))})
+		}
+		return rows, nil
+	}
+
+	w := httptest.NewRecorder()
+	if err := streamExport(w, ExportFormatXlsx, "rows", columns, next); err != nil {
+		t.Fatal(err)
+	}
+	if len(offsets) != 3 || offsets[2] != 2*exportBatch {
+		t.Errorf("read at offsets %v", offsets)
+	}
+
+	body := w.Body.Bytes()
+	book, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, part := range book.File {
+		f, err := part.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var sheet struct {
+			Rows []struct {
+				Cells []struct {
+					Type  string `xml:"t,attr"`
+					Value string `xml:"v"`
+					Text  string `xml:"is>t"`
+				} `xml:"c"`
+			} `xml:"sheetData>row"`
+		}
+		if err := xml.NewDecoder(f).Decode(&sheet); err != nil {
+			t.Fatalf("%s: %v", part.Name, err)
+		}
+		f.Close()
+		if part.Name != "xl/worksheets/sheet1.xml" {
+			continue
+		}
+		if len(sheet.Rows) != total+1 {
+			t.Fatalf("sheet has %d rows", len(sheet.Rows))
+		}
+		last := sheet.Rows[total].Cells
+		if last[0].Text != "<строка & 1006>" || last[1].Type != "" || last[1].Value != "1006" {
+			t.Errorf("last row = %+v", last)
+		}
+	}
+}
+
+func TestStreamExportFailure(t *testing.T) {
+	columns := []exportColumn{{"n", true}}
+	failAt := func(at int32) func(int32) ([][]string, error) {
+		return func(offset int32) ([][]string, error) {
+			if offset == at {
+				return nil, errors.New("connection reset")
+			}
+			return make([][]string, exportBatch), nil
+		}
+	}
+
+	// Nothing is sent yet, so the handler answers with the error.
+	w := httptest.NewRecorder()
+	if err := streamExport(w, ExportFormatCsv, "rows", columns, failAt(0)); err == nil {
+		t.Fatal("failed first batch exported")
+	}
+	if w.Body.Len() != 0 || w.Header().Get("Content-Disposition") != "" {
+		t.Errorf("response written: %q", w.Body)
+	}
+
+	// Past the status the connection is aborted.
+	defer func() {
+		if got := recover(); got != http.ErrAbortHandler {
+			t.Errorf("recovered %v, want http.ErrAbortHandler", got)
+		}
+	}()
+	streamExport(httptest.NewRecorder(), ExportFormatCsv, "rows", columns, failAt(exportBatch))
+	t.Error("failed export completed")
+}
@@ -0,0 +1,715 @@
+package api
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+//go:embed schema.graphql
+var graphqlSDL string
+
+const (
+	// maxGraphQLComplexity is the cost above which a query is rejected, and
+	// defaultGraphQLListSize what a list field without a limit is taken to
+	// multiply the cost of its selections by.
+	maxGraphQLComplexity   = 1000
+	defaultGraphQLListSize = 10
+	maxGraphQLPageLimit    = 50
+)
+
+// graphqlCostSchema is the schema as gqlparser sees it, used to cost queries
+// before graphql-go, which has no public AST, executes them.
+var graphqlCostSchema = gqlparser.MustLoadSchema(&ast.Source{Name: "schema.graphql", Input: graphqlSDL})
+
+func newGraphQLSchema(a *APIServer) *graphql.Schema {
+	return graphql.MustParseSchema(graphqlSDL, &graphqlResolver{api: a},
+		graphql.UseStringDescriptions(),
+		graphql.MaxParallelism(maxGraphQLPageLimit))
+}
+
+func (a *APIServer) queryGraphQL(w http.ResponseWriter, r *http.Request) error {
+	var req QueryGraphQLJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	variables := deref(req.Variables)
+
+	if cost, ok := graphqlComplexity(req.Query, deref(req.OperationName), variables); ok && cost > maxGraphQLComplexity {
+		return WriteJSON(w, http.StatusOK, GraphqlResponse{Errors: &[]GraphqlError{{
+			Message:    fmt.Sprintf("query complexity %d exceeds %d", cost, maxGraphQLComplexity),
+			Extensions: &map[string]interface{}{"status": http.StatusBadRequest},
+		}}})
+	}
+
+	ctx := context.WithValue(r.Context(), graphqlRequestKey{}, newGraphQLRequest(a))
+	return WriteJSON(w, http.StatusOK, a.graphql.Exec(ctx, req.Query, deref(req.OperationName), variables))
+}
+
+// graphqlComplexity costs the operation of the query: each field costs 1 and
+// list fields multiply the cost of their selections by their limit. It
+// reports false for queries that don't validate, which are left to Exec to
+// reject.
+func graphqlComplexity(query, operationName string, variables map[string]interface{}) (int, bool) {
+	doc, errs := gqlparser.LoadQuery(graphqlCostSchema, query)
+	if errs != nil {
+		return 0, false
+	}
+
+	op := doc.Operations.ForName(operationName)
+	if operationName == "" && len(doc.Operations) == 1 {
+		op = doc.Operations[0]
+	}
+	if op == nil {
+		return 0, false
+	}
+	return selectionCost(op.SelectionSet, variables), true
+}
+
+func selectionCost(set ast.SelectionSet, variables map[string]interface{}) int {
+	cost := 0
+	for _, s := range set {
+		switch s := s.(type) {
+		case *ast.Field:
+			nested := selectionCost(s.SelectionSet, variables)
+			if s.Definition != nil && s.Definition.Type.Elem != nil {
+				nested *= listSize(s, variables)
+			}
+			cost += 1 + nested
+		case *ast.InlineFragment:
+			cost += selectionCost(s.SelectionSet, variables)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				cost += selectionCost(s.Definition.SelectionSet, variables)
+			}
+		}
+	}
+	return cost
+}
+
+// listSize is the limit of a list field, or defaultGraphQLListSize if it
+// has none.
+func listSize(field *ast.Field, variables map[string]interface{}) int {
+	arg := field.Arguments.ForName("limit")
+	if arg == nil {
+		return defaultGraphQLListSize
+	}
+	v, err := arg.Value.Value(variables)
+	if err != nil {
+		return defaultGraphQLListSize
+	}
+	switch v := v.(type) {
+	case int64:
+		return max(int(v), 0)
+	case float64:
+		return max(int(v), 0)
+	}
+	return defaultGraphQLListSize
+}
+
+type graphqlRequestKey struct{}
+
+// graphqlRequest is what the resolvers of a request share: loaders batching
+// the storage reads of nested fields, and the outcomes of policy checks, so
+// a list of bids doesn't check the same tender once per bid.
+type graphqlRequest struct {
+	api *APIServer
+
+	users          *dataloader.Loader[string, *User]
+	usersByName    *dataloader.Loader[string, *User]
+	organizationOf *dataloader.Loader[string, string]
+	organizations  *dataloader.Loader[string, *Organization]
+	tenders        *dataloader.Loader[string, *Tender]
+	versions       *dataloader.Loader[string, []*Tender]
+	bids           *dataloader.Loader[string, []*Bid]
+	decisions      *dataloader.Loader[string, []DecisionRecord]
+	reviews        *dataloader.Loader[string, []ReviewRecord]
+	authorReviews  *dataloader.Loader[string, []ReviewRecord]
+
+	responsible memo[bool]
+	visible     memo[struct{}]
+	hidden      memo[bool]
+}
+
+func newGraphQLRequest(a *APIServer) *graphqlRequest {
+	return &graphqlRequest{
+		api:            a,
+		users:          newLoader(a.store.GetUsersByIds, ErrUserNotFound),
+		usersByName:    newLoader(a.store.GetUsersByUsernames, ErrUserNotFound),
+		organizationOf: newLoader(a.store.GetUserOrganizations, nil),
+		organizations:  newLoader(a.store.GetOrganizationsByIds, ErrOrganizationNotFound),
+		tenders:        newLoader(a.store.GetTendersByIds, ErrTenderNotFound),
+		versions:       newLoader(a.store.GetTenderVersionsByIds, ErrTenderNotFound),
+		bids:           newLoader(a.store.GetBidsByTenderIds, nil),
+		decisions:      newLoader(a.store.GetDecisionsByBidIds, nil),
+		reviews:        newLoader(a.store.GetReviewsByBidIds, nil),
+		authorReviews:  newLoader(a.store.GetReviewsByAuthors, nil),
+	}
+}
+
+// newLoader batches the keys loaded together into one call of fetch. Keys
+// fetch leaves out fail with missing, or load the zero value if it is nil.
+func newLoader[V any](fetch func([]string) (map[string]V, error), missing error) *dataloader.Loader[string, V] {
+	return dataloader.NewBatchedLoader(func(ctx context.Context, keys []string) []*dataloader.Result[V] {
+		found, err := fetch(keys)
+		results := make([]*dataloader.Result[V], len(keys))
+		for i, key := range keys {
+			v, ok := found[key]
+			switch {
+			case err != nil:
+				results[i] = &dataloader.Result[V]{Error: err}
+			case !ok && missing != nil:
+				results[i] = &dataloader.Result[V]{Error: missing}
+			default:
+				results[i] = &dataloader.Result[V]{Data: v}
+			}
+		}
+		return results
+	})
+}
+
+// memo remembers the outcome of a check by key for the rest of a request.
+type memo[V any] struct {
+	m sync.Map
+}
+
+type memoEntry[V any] struct {
+	v   V
+	err error
+}
+
+func (m *memo[V]) get(key string, check func() (V, error)) (V, error) {
+	if e, ok := m.m.Load(key); ok {
+		return e.(memoEntry[V]).v, e.(memoEntry[V]).err
+	}
+	v, err := check()
+	m.m.Store(key, memoEntry[V]{v, err})
+	return v, err
+}
+
+// graphqlViewer is the user a root field acts for, nil for anonymous
+// requests, and applies the policies of the REST API on their behalf.
+type graphqlViewer struct {
+	req  *graphqlRequest
+	user *User
+}
+
+func (r *graphqlResolver) viewer(ctx context.Context, username *string) (*graphqlViewer, error) {
+	v := &graphqlViewer{req: ctx.Value(graphqlRequestKey{}).(*graphqlRequest)}
+	if username != nil {
+		user, err := r.api.authenticate(*username)
+		if err != nil {
+			return nil, err
+		}
+		v.user = user
+	}
+	return v, nil
+}
+
+func (v *graphqlViewer) requireUser() error {
+	if v.user == nil {
+		return httpError(http.StatusUnauthorized, "username is required")
+	}
+	return nil
+}
+
+func (v *graphqlViewer) isResponsible(organizationId string) (bool, error) {
+	return v.req.responsible.get(v.user.Username+" "+organizationId, func() (bool, error) {
+		return v.req.api.store.isValidTenderCreator(v.user.Username, organizationId)
+	})
+}
+
+// requireResponsible is APIServer.requireResponsible for the viewer.
+func (v *graphqlViewer) requireResponsible(organizationId string) error {
+	if err := v.requireUser(); err != nil {
+		return err
+	}
+	ok, err := v.isResponsible(organizationId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return httpError(http.StatusForbidden, "user %s is not responsible for organization %s", v.user.Username, organizationId)
+	}
+	return nil
+}
+
+// checkTenderVisible is APIServer.checkTenderVisible for the viewer.
+func (v *graphqlViewer) checkTenderVisible(tender *Tender) error {
+	var username string
+	if v.user != nil {
+		username = v.user.Username
+	}
+	_, err := v.req.visible.get(username+" "+tender.Id, func() (struct{}, error) {
+		return struct{}{}, v.req.api.checkTenderVisible(v.user, tender)
+	})
+	return err
+}
+
+// requireTenderViewer is APIServer.requireTenderViewer for the viewer.
+func (v *graphqlViewer) requireTenderViewer(tender *Tender) error {
+	if err := v.requireUser(); err != nil {
+		return err
+	}
+	own, err := v.isResponsible(tender.OrganizationId)
+	if err != nil || own {
+		return err
+	}
+	return v.checkTenderVisible(tender)
+}
+
+// requireBidsReader is APIServer.requireTenderBidsReader for the viewer.
+func (v *graphqlViewer) requireBidsReader(tender *Tender) error {
+	if err := v.requireResponsible(tender.OrganizationId); err != nil {
+		return err
+	}
+	hidden, err := v.req.hidden.get(tender.Id, func() (bool, error) {
+		return v.req.api.bidsHidden(tender, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+	if hidden {
+		return httpError(http.StatusForbidden, "bids of sealed tender %s are not revealed yet", tender.Id)
+	}
+	return nil
+}
+
+// graphqlError reports err as the REST API would: with the status it would
+// answer with, and internal errors logged and masked.
+func graphqlError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var httpErr *HTTPError
+	if errors.As(storageError(err), &httpErr) {
+		return &graphqlFieldError{httpErr}
+	}
+
+	log.Printf("internal error: %v", err)
+	return &graphqlFieldError{&HTTPError{Status: http.StatusInternalServerError, Reason: "internal server error"}}
+}
+
+// graphqlFieldError is an HTTPError as graphql-go reports it, the status in
+// the extensions of the error.
+type graphqlFieldError struct {
+	*HTTPError
+}
+
+func (e *graphqlFieldError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"status": e.Status}
+}
+
+// graphqlPage checks limit and offset as the REST API validates them and
+// applies the defaults.
+func graphqlPage(limit, offset *int32) (int32, int32, error) {
+	if limit != nil && (*limit < 0 || *limit > maxGraphQLPageLimit) {
+		return 0, 0, httpError(http.StatusBadRequest, "limit must be between 0 and %d", maxGraphQLPageLimit)
+	}
+	if offset != nil && *offset < 0 {
+		return 0, 0, httpError(http.StatusBadRequest, "offset must not be negative")
+	}
+	l, o := pagination(limit, offset)
+	return l, o, nil
+}
+
+func page[T any](items []T, limit, offset int32) []T {
+	items = items[min(int(offset), len(items)):]
+	return items[:min(int(limit), len(items))]
+}
+
+type pageArgs struct {
+	Limit  *int32
+	Offset *int32
+}
+
+// graphqlResolver resolves the root fields of the schema.
+type graphqlResolver struct {
+	api *APIServer
+}
+
+func (r *graphqlResolver) Tenders(ctx context.Context, args struct {
+	Username    *string
+	Limit       *int32
+	Offset      *int32
+	ServiceType *[]string
+}) ([]*tenderResolver, error) {
+	v, err := r.viewer(ctx, args.Username)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	limit, offset, err := graphqlPage(args.Limit, args.Offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+
+	filter := TenderFilter{}
+	if v.user != nil {
+		filter.Viewer = v.user.Username
+	}
+	for _, t := range deref(args.ServiceType) {
+		filter.ServiceTypes = append(filter.ServiceTypes, TenderServiceType(t))
+	}
+	tenders, err := r.api.store.GetAllTenders(filter, limit, offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return v.tenders(tenders), nil
+}
+
+func (r *graphqlResolver) MyTenders(ctx context.Context, args struct {
+	Username string
+	pageArgs
+}) ([]*tenderResolver, error) {
+	v, err := r.viewer(ctx, &args.Username)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	limit, offset, err := graphqlPage(args.Limit, args.Offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+
+	tenders, err := r.api.store.GetTendersByUsername(v.user.Username, TenderFilter{}, limit, offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return v.tenders(tenders), nil
+}
+
+func (r *graphqlResolver) Tender(ctx context.Context, args struct {
+	Id       graphql.ID
+	Username *string
+}) (*tenderResolver, error) {
+	v, err := r.viewer(ctx, args.Username)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	tender, err := r.api.store.GetTenderById(string(args.Id))
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	if err := v.checkTenderVisible(tender); err != nil {
+		return nil, graphqlError(err)
+	}
+	return &tenderResolver{v, tender}, nil
+}
+
+func (r *graphqlResolver) MyBids(ctx context.Context, args struct {
+	Username string
+	pageArgs
+}) ([]*bidResolver, error) {
+	v, err := r.viewer(ctx, &args.Username)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	limit, offset, err := graphqlPage(args.Limit, args.Offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+
+	bids, err := r.api.store.GetBidsByUsername(v.user.Username, BidFilter{}, limit, offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return v.bids(bids), nil
+}
+
+func (r *graphqlResolver) Bid(ctx context.Context, args struct {
+	Id       graphql.ID
+	Username string
+}) (*bidResolver, error) {
+	bid, err := r.api.requireBidManager(args.Username, string(args.Id))
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	v, err := r.viewer(ctx, &args.Username)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return &bidResolver{v, bid}, nil
+}
+
+func (v *graphqlViewer) tenders(tenders []*Tender) []*tenderResolver {
+	resolvers := make([]*tenderResolver, len(tenders))
+	for i, t := range tenders {
+		resolvers[i] = &tenderResolver{v, t}
+	}
+	return resolvers
+}
+
+func (v *graphqlViewer) bids(bids []*Bid) []*bidResolver {
+	resolvers := make([]*bidResolver, len(bids))
+	for i, b := range bids {
+		resolvers[i] = &bidResolver{v, b}
+	}
+	return resolvers
+}
+
+func (v *graphqlViewer) reviews(reviews []ReviewRecord) []*reviewResolver {
+	resolvers := make([]*reviewResolver, len(reviews))
+	for i, r := range reviews {
+		resolvers[i] = &reviewResolver{v, r}
+	}
+	return resolvers
+}
+
+func (v *graphqlViewer) employee(ctx context.Context, loader *dataloader.Loader[string, *User], key string) (*employeeResolver, error) {
+	user, err := loader.Load(ctx, key)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return &employeeResolver{v, user}, nil
+}
+
+// tenderResolver resolves a Tender, and a Version from a version of one.
+type tenderResolver struct {
+	v *graphqlViewer
+	t *Tender
+}
+
+func (r *tenderResolver) ID() graphql.ID      { return graphql.ID(r.t.Id) }
+func (r *tenderResolver) Name() string        { return r.t.Name }
+func (r *tenderResolver) Description() string { return r.t.Description }
+func (r *tenderResolver) ServiceType() string { return string(r.t.ServiceType) }
+func (r *tenderResolver) Status() string      { return string(r.t.Status) }
+func (r *tenderResolver) Visibility() string  { return string(r.t.Visibility) }
+func (r *tenderResolver) Sealed() bool        { return r.t.Sealed }
+func (r *tenderResolver) Version() int32      { return r.t.Version }
+func (r *tenderResolver) CreatedAt() string   { return r.t.CreatedAt }
+func (r *tenderResolver) PublishAt() *string  { return graphqlTime(r.t.PublishAt) }
+func (r *tenderResolver) Budget() *budgetResolver {
+	if r.t.Budget == nil {
+		return nil
+	}
+	return &budgetResolver{r.t.Budget}
+}
+
+func (r *tenderResolver) SubmissionDeadline() *string {
+	return graphqlTime(r.t.SubmissionDeadline)
+}
+
+func (r *tenderResolver) Organization(ctx context.Context) (*organizationResolver, error) {
+	org, err := r.v.req.organizations.Load(ctx, r.t.OrganizationId)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return &organizationResolver{org}, nil
+}
+
+func (r *tenderResolver) Bids(ctx context.Context, args pageArgs) (*[]*bidResolver, error) {
+	if err := r.v.requireBidsReader(r.t); err != nil {
+		return nil, graphqlError(err)
+	}
+	limit, offset, err := graphqlPage(args.Limit, args.Offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+
+	bids, err := r.v.req.bids.Load(ctx, r.t.Id)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	resolvers := r.v.bids(page(bids, limit, offset))
+	return &resolvers, nil
+}
+
+func (r *tenderResolver) Versions(ctx context.Context, args pageArgs) (*[]*tenderResolver, error) {
+	if err := r.v.requireTenderViewer(r.t); err != nil {
+		return nil, graphqlError(err)
+	}
+	limit, offset, err := graphqlPage(args.Limit, args.Offset)
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+
+	versions, err := r.v.req.versions.Load(ctx, r.t.Id)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	resolvers := r.v.tenders(page(versions, limit, offset))
+	return &resolvers, nil
+}
+
+func graphqlTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+type budgetResolver struct {
+	b *TenderBudget
+}
+
+func (r *budgetResolver) Currency() string { return r.b.Currency }
+func (r *budgetResolver) Min() *string     { return r.b.Min }
+func (r *budgetResolver) Max() *string     { return r.b.Max }
+
+type bidResolver struct {
+	v *graphqlViewer
+	b *Bid
+}
+
+func (r *bidResolver) ID() graphql.ID       { return graphql.ID(r.b.Id) }
+func (r *bidResolver) Name() string         { return r.b.Name }
+func (r *bidResolver) Description() string  { return r.b.Description }
+func (r *bidResolver) Status() string       { return string(r.b.Status) }
+func (r *bidResolver) AuthorType() string   { return string(r.b.AuthorType) }
+func (r *bidResolver) Version() int32       { return r.b.Version }
+func (r *bidResolver) CreatedAt() string    { return r.b.CreatedAt }
+func (r *bidResolver) Price() *string       { return r.b.Price }
+func (r *bidResolver) Currency() *string    { return r.b.Currency }
+func (r *bidResolver) DeliveryDays() *int32 { return r.b.DeliveryDays }
+
+func (r *bidResolver) Tender(ctx context.Context) (*tenderResolver, error) {
+	tender, err := r.v.req.tenders.Load(ctx, r.b.TenderId)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	if err := r.v.checkTenderVisible(tender); err != nil {
+		return nil, graphqlError(err)
+	}
+	return &tenderResolver{r.v, tender}, nil
+}
+
+func (r *bidResolver) Author(ctx context.Context) (*employeeResolver, error) {
+	return r.v.employee(ctx, r.v.req.users, r.b.AuthorId)
+}
+
+// reviewableTender loads the tender of the bid if the viewer may read what
+// responsibles did with its bids.
+func (r *bidResolver) reviewableTender(ctx context.Context) (*Tender, error) {
+	tender, err := r.v.req.tenders.Load(ctx, r.b.TenderId)()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.v.requireBidsReader(tender); err != nil {
+		return nil, err
+	}
+	return tender, nil
+}
+
+func (r *bidResolver) Reviews(ctx context.Context) (*[]*reviewResolver, error) {
+	if _, err := r.reviewableTender(ctx); err != nil {
+		return nil, graphqlError(err)
+	}
+	reviews, err := r.v.req.reviews.Load(ctx, r.b.Id)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	resolvers := r.v.reviews(reviews)
+	return &resolvers, nil
+}
+
+// AuthorReviews follows GET /bids/{tenderId}/reviews, which is open to the
+// responsibles of the tender whether or not its bids are revealed.
+func (r *bidResolver) AuthorReviews(ctx context.Context) (*[]*reviewResolver, error) {
+	tender, err := r.v.req.tenders.Load(ctx, r.b.TenderId)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	if err := r.v.requireResponsible(tender.OrganizationId); err != nil {
+		return nil, graphqlError(err)
+	}
+
+	author, err := r.v.req.users.Load(ctx, r.b.AuthorId)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	reviews, err := r.v.req.authorReviews.Load(ctx, author.Username)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	resolvers := r.v.reviews(reviews)
+	return &resolvers, nil
+}
+
+func (r *bidResolver) Decisions(ctx context.Context) (*[]*decisionResolver, error) {
+	if _, err := r.reviewableTender(ctx); err != nil {
+		return nil, graphqlError(err)
+	}
+	decisions, err := r.v.req.decisions.Load(ctx, r.b.Id)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+
+	resolvers := make([]*decisionResolver, len(decisions))
+	for i, d := range decisions {
+		resolvers[i] = &decisionResolver{r.v, d}
+	}
+	return &resolvers, nil
+}
+
+type reviewResolver struct {
+	v *graphqlViewer
+	r ReviewRecord
+}
+
+func (r *reviewResolver) ID() graphql.ID      { return graphql.ID(r.r.Id) }
+func (r *reviewResolver) Description() string { return r.r.Comment }
+func (r *reviewResolver) CreatedAt() string   { return *graphqlTime(&r.r.CreatedAt) }
+
+func (r *reviewResolver) Author(ctx context.Context) (*employeeResolver, error) {
+	return r.v.employee(ctx, r.v.req.usersByName, r.r.Username)
+}
+
+type decisionResolver struct {
+	v *graphqlViewer
+	d DecisionRecord
+}
+
+func (r *decisionResolver) Decision() string  { return string(r.d.Decision) }
+func (r *decisionResolver) CreatedAt() string { return *graphqlTime(&r.d.CreatedAt) }
+func (r *decisionResolver) LotId() *graphql.ID {
+	if r.d.LotId == "" {
+		return nil
+	}
+	id := graphql.ID(r.d.LotId)
+	return &id
+}
+
+func (r *decisionResolver) Employee(ctx context.Context) (*employeeResolver, error) {
+	return r.v.employee(ctx, r.v.req.usersByName, r.d.Username)
+}
+
+type employeeResolver struct {
+	v *graphqlViewer
+	u *User
+}
+
+func (r *employeeResolver) ID() graphql.ID    { return graphql.ID(r.u.Id) }
+func (r *employeeResolver) Username() string  { return r.u.Username }
+func (r *employeeResolver) FirstName() string { return r.u.FirstName }
+func (r *employeeResolver) LastName() string  { return r.u.LastName }
+
+func (r *employeeResolver) Organization(ctx context.Context) (*organizationResolver, error) {
+	orgId, err := r.v.req.organizationOf.Load(ctx, r.u.Id)()
+	if err != nil || orgId == "" {
+		return nil, graphqlError(err)
+	}
+	org, err := r.v.req.organizations.Load(ctx, orgId)()
+	if err != nil {
+		return nil, graphqlError(err)
+	}
+	return &organizationResolver{org}, nil
+}
+
+type organizationResolver struct {
+	o *Organization
+}
+
+func (r *organizationResolver) ID() graphql.ID { return graphql.ID(r.o.Id) }
+func (r *organizationResolver) Name() string   { return r.o.Name }
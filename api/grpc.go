@@ -0,0 +1,420 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"io"
+	"log"
+	"my_zad/api/tenderpb"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// grpcService serves the gRPC API. A unary call is served as the request
+// of its REST operation, run through the REST handler in process, so the
+// two transports share the validation, policy and audit of a request and
+// answer it the same.
+type grpcService struct {
+	tenderpb.UnimplementedTenderServiceServer
+
+	api     *APIServer
+	handler http.Handler
+	// validate checks a request against the spec the way handler does,
+	// and does nothing else, for the calls served without it.
+	validate http.Handler
+}
+
+// GRPCServer builds the gRPC server of tenderpb.TenderService.
+func (v *APIServer) GRPCServer() (*grpc.Server, error) {
+	handler, err := v.Handler()
+	if err != nil {
+		return nil, err
+	}
+	validator, err := newRequestValidator()
+	if err != nil {
+		return nil, err
+	}
+	validate := validator(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	server := grpc.NewServer()
+	tenderpb.RegisterTenderServiceServer(server, &grpcService{api: v, handler: handler, validate: validate})
+	return server, nil
+}
+
+func (v *APIServer) RunGRPC(listenAddr string) {
+	server, err := v.GRPCServer()
+	if err != nil {
+		log.Fatal(err)
+	}
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Println("GRPC API RUNNING ON PORT", listenAddr)
+	log.Fatal(server.Serve(listener))
+}
+
+func (s *grpcService) ListTenders(ctx context.Context, req *tenderpb.ListTendersRequest) (*tenderpb.ListTendersResponse, error) {
+	query := url.Values{}
+	setQuery(query, "limit", req.Limit)
+	setQuery(query, "offset", req.Offset)
+	setQuery(query, "username", req.Username)
+	for _, serviceType := range req.ServiceTypes {
+		query.Add("service_type", string(fromProto(serviceTypes, serviceType)))
+	}
+	setQuery(query, "currency", req.Currency)
+	setQuery(query, "minBudget", req.MinBudget)
+	setQuery(query, "maxBudget", req.MaxBudget)
+	setQuery(query, "sortBy", optionalFromProto(tenderSortBys, req.SortBy))
+	setQuery(query, "sortOrder", optionalFromProto(sortOrders, req.SortOrder))
+
+	var tenders []Tender
+	if err := s.call(ctx, "GET", "/tenders", query, nil, &tenders); err != nil {
+		return nil, err
+	}
+	return &tenderpb.ListTendersResponse{Tenders: tendersToProto(tenders)}, nil
+}
+
+func (s *grpcService) CreateTender(ctx context.Context, req *tenderpb.CreateTenderRequest) (*tenderpb.Tender, error) {
+	var tender Tender
+	if err := s.call(ctx, "POST", "/tenders/new", nil, createTenderFromProto(req), &tender); err != nil {
+		return nil, err
+	}
+	return tenderToProto(&tender), nil
+}
+
+func (s *grpcService) ListUserTenders(ctx context.Context, req *tenderpb.ListUserTendersRequest) (*tenderpb.ListTendersResponse, error) {
+	query := url.Values{"username": {req.Username}}
+	setQuery(query, "limit", req.Limit)
+	setQuery(query, "offset", req.Offset)
+	setQuery(query, "currency", req.Currency)
+	setQuery(query, "minBudget", req.MinBudget)
+	setQuery(query, "maxBudget", req.MaxBudget)
+	setQuery(query, "sortBy", optionalFromProto(tenderSortBys, req.SortBy))
+	setQuery(query, "sortOrder", optionalFromProto(sortOrders, req.SortOrder))
+
+	var tenders []Tender
+	if err := s.call(ctx, "GET", "/tenders/my", query, nil, &tenders); err != nil {
+		return nil, err
+	}
+	return &tenderpb.ListTendersResponse{Tenders: tendersToProto(tenders)}, nil
+}
+
+func (s *grpcService) GetTenderStatus(ctx context.Context, req *tenderpb.GetTenderStatusRequest) (*tenderpb.GetTenderStatusResponse, error) {
+	query := url.Values{}
+	setQuery(query, "username", req.Username)
+
+	var tenderStatus TenderStatus
+	if err := s.call(ctx, "GET", "/tenders/"+url.PathEscape(req.TenderId)+"/status", query, nil, &tenderStatus); err != nil {
+		return nil, err
+	}
+	return &tenderpb.GetTenderStatusResponse{Status: toProto[tenderpb.TenderStatus](tenderStatuses, tenderStatus)}, nil
+}
+
+func (s *grpcService) UpdateTenderStatus(ctx context.Context, req *tenderpb.UpdateTenderStatusRequest) (*tenderpb.Tender, error) {
+	query := url.Values{"status": {string(fromProto(tenderStatuses, req.Status))}, "username": {req.Username}}
+
+	var tender Tender
+	if err := s.call(ctx, "PUT", "/tenders/"+url.PathEscape(req.TenderId)+"/status", query, nil, &tender); err != nil {
+		return nil, err
+	}
+	return tenderToProto(&tender), nil
+}
+
+func (s *grpcService) EditTender(ctx context.Context, req *tenderpb.EditTenderRequest) (*tenderpb.Tender, error) {
+	query := url.Values{"username": {req.Username}}
+
+	var tender Tender
+	if err := s.call(ctx, "PATCH", "/tenders/"+url.PathEscape(req.TenderId)+"/edit", query, editTenderFromProto(req), &tender); err != nil {
+		return nil, err
+	}
+	return tenderToProto(&tender), nil
+}
+
+func (s *grpcService) RollbackTender(ctx context.Context, req *tenderpb.RollbackTenderRequest) (*tenderpb.Tender, error) {
+	query := url.Values{"username": {req.Username}}
+	path := "/tenders/" + url.PathEscape(req.TenderId) + "/rollback/" + strconv.Itoa(int(req.Version))
+
+	var tender Tender
+	if err := s.call(ctx, "PUT", path, query, nil, &tender); err != nil {
+		return nil, err
+	}
+	return tenderToProto(&tender), nil
+}
+
+func (s *grpcService) CreateBid(ctx context.Context, req *tenderpb.CreateBidRequest) (*tenderpb.Bid, error) {
+	var bid Bid
+	if err := s.call(ctx, "POST", "/bids/new", nil, createBidFromProto(req), &bid); err != nil {
+		return nil, err
+	}
+	return bidToProto(&bid), nil
+}
+
+func (s *grpcService) ListUserBids(ctx context.Context, req *tenderpb.ListUserBidsRequest) (*tenderpb.ListBidsResponse, error) {
+	query := url.Values{"username": {req.Username}}
+	setQuery(query, "limit", req.Limit)
+	setQuery(query, "offset", req.Offset)
+	setQuery(query, "currency", req.Currency)
+	setQuery(query, "minPrice", req.MinPrice)
+	setQuery(query, "maxPrice", req.MaxPrice)
+	setQuery(query, "maxDeliveryDays", req.MaxDeliveryDays)
+	setQuery(query, "sortBy", optionalFromProto(bidSortBys, req.SortBy))
+	setQuery(query, "sortOrder", optionalFromProto(sortOrders, req.SortOrder))
+
+	var bids []Bid
+	if err := s.call(ctx, "GET", "/bids/my", query, nil, &bids); err != nil {
+		return nil, err
+	}
+	return &tenderpb.ListBidsResponse{Bids: bidsToProto(bids)}, nil
+}
+
+func (s *grpcService) ListTenderBids(ctx context.Context, req *tenderpb.ListTenderBidsRequest) (*tenderpb.ListBidsResponse, error) {
+	query := url.Values{"username": {req.Username}}
+	setQuery(query, "limit", req.Limit)
+	setQuery(query, "offset", req.Offset)
+	setQuery(query, "currency", req.Currency)
+	setQuery(query, "minPrice", req.MinPrice)
+	setQuery(query, "maxPrice", req.MaxPrice)
+	setQuery(query, "maxDeliveryDays", req.MaxDeliveryDays)
+	setQuery(query, "sortBy", optionalFromProto(bidSortBys, req.SortBy))
+	setQuery(query, "sortOrder", optionalFromProto(sortOrders, req.SortOrder))
+
+	var bids []Bid
+	if err := s.call(ctx, "GET", "/bids/"+url.PathEscape(req.TenderId)+"/list", query, nil, &bids); err != nil {
+		return nil, err
+	}
+	return &tenderpb.ListBidsResponse{Bids: bidsToProto(bids)}, nil
+}
+
+func (s *grpcService) GetBidStatus(ctx context.Context, req *tenderpb.GetBidStatusRequest) (*tenderpb.GetBidStatusResponse, error) {
+	query := url.Values{"username": {req.Username}}
+
+	var bidStatus BidStatus
+	if err := s.call(ctx, "GET", "/bids/"+url.PathEscape(req.BidId)+"/status", query, nil, &bidStatus); err != nil {
+		return nil, err
+	}
+	return &tenderpb.GetBidStatusResponse{Status: toProto[tenderpb.BidStatus](bidStatuses, bidStatus)}, nil
+}
+
+func (s *grpcService) UpdateBidStatus(ctx context.Context, req *tenderpb.UpdateBidStatusRequest) (*tenderpb.Bid, error) {
+	query := url.Values{"status": {string(fromProto(bidStatuses, req.Status))}, "username": {req.Username}}
+
+	var bid Bid
+	if err := s.call(ctx, "PUT", "/bids/"+url.PathEscape(req.BidId)+"/status", query, nil, &bid); err != nil {
+		return nil, err
+	}
+	return bidToProto(&bid), nil
+}
+
+func (s *grpcService) EditBid(ctx context.Context, req *tenderpb.EditBidRequest) (*tenderpb.Bid, error) {
+	query := url.Values{"username": {req.Username}}
+
+	var bid Bid
+	if err := s.call(ctx, "PATCH", "/bids/"+url.PathEscape(req.BidId)+"/edit", query, editBidFromProto(req), &bid); err != nil {
+		return nil, err
+	}
+	return bidToProto(&bid), nil
+}
+
+func (s *grpcService) RollbackBid(ctx context.Context, req *tenderpb.RollbackBidRequest) (*tenderpb.Bid, error) {
+	query := url.Values{"username": {req.Username}}
+	path := "/bids/" + url.PathEscape(req.BidId) + "/rollback/" + strconv.Itoa(int(req.Version))
+
+	var bid Bid
+	if err := s.call(ctx, "PUT", path, query, nil, &bid); err != nil {
+		return nil, err
+	}
+	return bidToProto(&bid), nil
+}
+
+func (s *grpcService) SubmitBidDecision(ctx context.Context, req *tenderpb.SubmitBidDecisionRequest) (*tenderpb.Bid, error) {
+	query := url.Values{"decision": {string(fromProto(decisions, req.Decision))}, "username": {req.Username}}
+	setQuery(query, "lotId", req.LotId)
+
+	var bid Bid
+	if err := s.call(ctx, "PUT", "/bids/"+url.PathEscape(req.BidId)+"/submit_decision", query, nil, &bid); err != nil {
+		return nil, err
+	}
+	return bidToProto(&bid), nil
+}
+
+func (s *grpcService) SubmitBidFeedback(ctx context.Context, req *tenderpb.SubmitBidFeedbackRequest) (*tenderpb.Bid, error) {
+	query := url.Values{"bidFeedback": {req.Feedback}, "username": {req.Username}}
+	setQuery(query, "rating", req.Rating)
+	setQuery(query, "onTime", req.OnTime)
+
+	var bid Bid
+	if err := s.call(ctx, "PUT", "/bids/"+url.PathEscape(req.BidId)+"/feedback", query, nil, &bid); err != nil {
+		return nil, err
+	}
+	return bidToProto(&bid), nil
+}
+
+func (s *grpcService) ListBidReviews(ctx context.Context, req *tenderpb.ListBidReviewsRequest) (*tenderpb.ListBidReviewsResponse, error) {
+	query := url.Values{"authorUsername": {req.AuthorUsername}, "requesterUsername": {req.RequesterUsername}}
+	setQuery(query, "limit", req.Limit)
+	setQuery(query, "offset", req.Offset)
+
+	var reviews []BidReview
+	if err := s.call(ctx, "GET", "/bids/"+url.PathEscape(req.TenderId)+"/reviews", query, nil, &reviews); err != nil {
+		return nil, err
+	}
+	resp := &tenderpb.ListBidReviewsResponse{}
+	for i := range reviews {
+		resp.Reviews = append(resp.Reviews, reviewToProto(&reviews[i]))
+	}
+	return resp, nil
+}
+
+// WatchTenderBids is the event stream of the REST API narrowed to the bid
+// events of a tender, checked and followed the same way.
+func (s *grpcService) WatchTenderBids(req *tenderpb.WatchTenderBidsRequest, stream grpc.ServerStreamingServer[tenderpb.BidUpdate]) error {
+	types := bidEventKinds[1:]
+	params := StreamEventsParams{Username: req.Username, TenderId: &req.TenderId, Type: &types, LastEventID: req.After}
+	query := url.Values{"tenderId": {req.TenderId}}
+	setQuery(query, "username", req.Username)
+	if err := s.serve(stream.Context(), s.validate, "GET", "/events/stream", query, nil, nil); err != nil {
+		return err
+	}
+
+	user, filter, after, err := s.api.openEventStream(params)
+	if err != nil {
+		return grpcError(err)
+	}
+	s.api.followEvents(stream.Context(), user, filter, after, func(e LoggedEvent) error {
+		update, err := bidUpdateToProto(e)
+		if err != nil {
+			log.Printf("bid stream: %v", err)
+			return err
+		}
+		return stream.Send(update)
+	}, nil)
+	return nil
+}
+
+// call serves the REST request method path to the caller of ctx and
+// decodes the result into out.
+func (s *grpcService) call(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	return s.serve(ctx, s.handler, method, path, query, body, out)
+}
+
+// serve runs a request through handler. The request id of the call and
+// the address of the caller are those of the request, and a failed
+// response is returned as the status its HTTP status maps onto.
+func (s *grpcService) serve(ctx context.Context, handler http.Handler, method, path string, query url.Values, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return grpcError(err)
+		}
+		reader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, "/api"+path+"?"+query.Encode(), reader)
+	if err != nil {
+		return grpcError(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get("x-request-id"); len(ids) > 0 {
+			req.Header.Set("X-Request-ID", ids[0])
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		req.RemoteAddr = p.Addr.String()
+	}
+
+	resp := &grpcResponse{header: http.Header{}}
+	handler.ServeHTTP(resp, req)
+	if id := resp.header.Get("X-Request-ID"); id != "" {
+		grpc.SetHeader(ctx, metadata.Pairs("x-request-id", id))
+	}
+	if resp.status != http.StatusOK {
+		var reason ErrorResponse
+		if err := json.Unmarshal(resp.body.Bytes(), &reason); err != nil {
+			reason.Reason = http.StatusText(resp.status)
+		}
+		return status.Error(grpcCode(resp.status), reason.Reason)
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(resp.body.Bytes(), out); err != nil {
+		return grpcError(fmt.Errorf("failed to decode response of %s %s: %w", method, path, err))
+	}
+	return nil
+}
+
+// grpcResponse keeps the response a call is served with.
+type grpcResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *grpcResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *grpcResponse) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *grpcResponse) Write(p []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return r.body.Write(p)
+}
+
+// grpcCode is the code of the statuses the REST API answers with.
+func grpcCode(status int) codes.Code {
+	switch status {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusMethodNotAllowed:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	}
+	return codes.Internal
+}
+
+// grpcError is handleError for gRPC.
+func grpcError(err error) error {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return status.Error(grpcCode(httpErr.Status), httpErr.Reason)
+	}
+
+	log.Printf("internal error: %v", err)
+	return status.Error(codes.Internal, "internal server error")
+}
+
+// setQuery sets key to the value of v, if any.
+func setQuery[T any](query url.Values, key string, v *T) {
+	if v != nil {
+		query.Set(key, fmt.Sprint(*v))
+	}
+}
@@ -0,0 +1,303 @@
+package api
+
+import (
+	"encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"my_zad/api/tenderpb"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// The values of the enums of the REST API, at the numbers of the proto
+// enums they map onto. The unspecified zero maps onto the empty string.
+var (
+	serviceTypes       = []TenderServiceType{"", TenderServiceTypeConstruction, TenderServiceTypeDelivery, TenderServiceTypeManufacture}
+	tenderStatuses     = []TenderStatus{"", TenderStatusCreated, TenderStatusPublished, TenderStatusClosed}
+	tenderVisibilities = []TenderVisibility{"", TenderVisibilityPublic, TenderVisibilityPrivate}
+	lotStatuses        = []TenderLotStatus{"", TenderLotStatusOpen, TenderLotStatusAwarded, TenderLotStatusCanceled}
+	criterionKinds     = []EvaluationCriterionKind{"", EvaluationCriterionKindPrice, EvaluationCriterionKindDeliveryTime,
+		EvaluationCriterionKindWarranty, EvaluationCriterionKindCustom}
+	bidStatuses   = []BidStatus{"", BidStatusCreated, BidStatusPublished, BidStatusCanceled}
+	authorTypes   = []BidAuthorType{"", BidAuthorTypeOrganization, BidAuthorTypeUser}
+	decisions     = []BidDecision{"", BidDecisionApproved, BidDecisionRejected}
+	tenderSortBys = []TenderSortBy{"", TenderSortByName, TenderSortByBudgetMin, TenderSortByBudgetMax}
+	bidSortBys    = []BidSortBy{"", BidSortByName, BidSortByPrice, BidSortByDeliveryDays}
+	sortOrders    = []SortOrder{"", SortOrderAsc, SortOrderDesc}
+	bidEventKinds = []EventType{"", EventTypeBidCreated, EventTypeBidPublished, EventTypeBidUpdated,
+		EventTypeBidCanceled, EventTypeBidDecision, EventTypeFeedbackCreated}
+)
+
+// fromProto is the REST value of proto enum value p. A number the proto
+// enum doesn't have is passed on as is, for the REST API to reject.
+func fromProto[E ~string, P ~int32](values []E, p P) E {
+	if p >= 0 && int(p) < len(values) {
+		return values[p]
+	}
+	return E(strconv.Itoa(int(p)))
+}
+
+// optionalFromProto is fromProto for optional values, nil if unspecified.
+func optionalFromProto[E ~string, P ~int32](values []E, p P) *E {
+	if p == 0 {
+		return nil
+	}
+	v := fromProto(values, p)
+	return &v
+}
+
+func toProto[P ~int32, E ~string](values []E, v E) P {
+	return P(max(slices.Index(values, v), 0))
+}
+
+func timeToProto(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeFromProto(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime()
+	return &v
+}
+
+// createdAtToProto reads the creation time of a tender, bid or review,
+// which the REST API gives in RFC 3339.
+func createdAtToProto(createdAt string) *timestamppb.Timestamp {
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// nilIfEmpty is nil for an empty list, which proto can't tell from none.
+func nilIfEmpty[T any](items []T) *[]T {
+	if len(items) == 0 {
+		return nil
+	}
+	return &items
+}
+
+func budgetToProto(b *TenderBudget) *tenderpb.Budget {
+	if b == nil {
+		return nil
+	}
+	return &tenderpb.Budget{Currency: b.Currency, Min: b.Min, Max: b.Max}
+}
+
+func budgetFromProto(b *tenderpb.Budget) *TenderBudget {
+	if b == nil {
+		return nil
+	}
+	return &TenderBudget{Currency: b.Currency, Min: b.Min, Max: b.Max}
+}
+
+func auctionFromProto(a *tenderpb.Auction) *TenderAuction {
+	if a == nil {
+		return nil
+	}
+	return &TenderAuction{
+		StartAt:          deref(timeFromProto(a.StartAt)),
+		EndAt:            deref(timeFromProto(a.EndAt)),
+		MinDecrement:     a.MinDecrement,
+		ExtensionSeconds: a.ExtensionSeconds,
+	}
+}
+
+func criteriaFromProto(criteria []*tenderpb.Criterion) *TenderCriteria {
+	var result TenderCriteria
+	for _, c := range criteria {
+		result = append(result, EvaluationCriterion{Kind: fromProto(criterionKinds, c.Kind), Name: c.Name, Weight: c.Weight})
+	}
+	return nilIfEmpty(result)
+}
+
+func tenderToProto(t *Tender) *tenderpb.Tender {
+	tender := &tenderpb.Tender{
+		Id:                 t.Id,
+		Name:               t.Name,
+		Description:        t.Description,
+		ServiceType:        toProto[tenderpb.ServiceType](serviceTypes, t.ServiceType),
+		Status:             toProto[tenderpb.TenderStatus](tenderStatuses, t.Status),
+		OrganizationId:     t.OrganizationId,
+		Version:            t.Version,
+		CreatedAt:          createdAtToProto(t.CreatedAt),
+		Budget:             budgetToProto(t.Budget),
+		PublishAt:          timeToProto(t.PublishAt),
+		SubmissionDeadline: timeToProto(t.SubmissionDeadline),
+		RevealedAt:         timeToProto(t.RevealedAt),
+		Sealed:             t.Sealed,
+		Visibility:         toProto[tenderpb.TenderVisibility](tenderVisibilities, t.Visibility),
+	}
+	if t.Auction != nil {
+		tender.Auction = &tenderpb.Auction{
+			StartAt:          timestamppb.New(t.Auction.StartAt),
+			EndAt:            timestamppb.New(t.Auction.EndAt),
+			MinDecrement:     t.Auction.MinDecrement,
+			ExtensionSeconds: t.Auction.ExtensionSeconds,
+		}
+	}
+	for _, c := range deref(t.Criteria) {
+		tender.Criteria = append(tender.Criteria, &tenderpb.Criterion{
+			Kind: toProto[tenderpb.CriterionKind](criterionKinds, c.Kind), Name: c.Name, Weight: c.Weight})
+	}
+	for _, lot := range deref(t.Lots) {
+		tender.Lots = append(tender.Lots, &tenderpb.Lot{
+			Id:           lot.Id,
+			Name:         lot.Name,
+			Description:  lot.Description,
+			Budget:       budgetToProto(lot.Budget),
+			Status:       toProto[tenderpb.LotStatus](lotStatuses, lot.Status),
+			AwardedBidId: lot.AwardedBidId,
+		})
+	}
+	return tender
+}
+
+func tendersToProto(tenders []Tender) []*tenderpb.Tender {
+	result := make([]*tenderpb.Tender, len(tenders))
+	for i := range tenders {
+		result[i] = tenderToProto(&tenders[i])
+	}
+	return result
+}
+
+func createTenderFromProto(req *tenderpb.CreateTenderRequest) CreateTenderJSONRequestBody {
+	body := CreateTenderJSONRequestBody{
+		Name:               req.Name,
+		Description:        req.Description,
+		ServiceType:        fromProto(serviceTypes, req.ServiceType),
+		OrganizationId:     req.OrganizationId,
+		CreatorUsername:    req.CreatorUsername,
+		Budget:             budgetFromProto(req.Budget),
+		Auction:            auctionFromProto(req.Auction),
+		Criteria:           criteriaFromProto(req.Criteria),
+		PublishAt:          timeFromProto(req.PublishAt),
+		SubmissionDeadline: timeFromProto(req.SubmissionDeadline),
+		Sealed:             req.Sealed,
+		Visibility:         optionalFromProto(tenderVisibilities, req.Visibility),
+	}
+	var lots []TenderLotInput
+	for _, lot := range req.Lots {
+		lots = append(lots, TenderLotInput{Name: lot.Name, Description: lot.Description, Budget: budgetFromProto(lot.Budget)})
+	}
+	body.Lots = nilIfEmpty(lots)
+	return body
+}
+
+func editTenderFromProto(req *tenderpb.EditTenderRequest) EditTenderJSONRequestBody {
+	return EditTenderJSONRequestBody{
+		Name:               req.Name,
+		Description:        req.Description,
+		ServiceType:        optionalFromProto(serviceTypes, req.ServiceType),
+		Budget:             budgetFromProto(req.Budget),
+		PublishAt:          timeFromProto(req.PublishAt),
+		SubmissionDeadline: timeFromProto(req.SubmissionDeadline),
+	}
+}
+
+func bidToProto(b *Bid) *tenderpb.Bid {
+	return &tenderpb.Bid{
+		Id:           b.Id,
+		Name:         b.Name,
+		Description:  b.Description,
+		Status:       toProto[tenderpb.BidStatus](bidStatuses, b.Status),
+		TenderId:     b.TenderId,
+		AuthorType:   toProto[tenderpb.AuthorType](authorTypes, b.AuthorType),
+		AuthorId:     b.AuthorId,
+		Version:      b.Version,
+		CreatedAt:    createdAtToProto(b.CreatedAt),
+		Price:        b.Price,
+		Currency:     b.Currency,
+		DeliveryDays: b.DeliveryDays,
+		LotIds:       deref(b.LotIds),
+
+		AuthorReputation: reputationToProto(b.AuthorReputation),
+	}
+}
+
+func reputationToProto(r *Reputation) *tenderpb.Reputation {
+	if r == nil {
+		return nil
+	}
+	return &tenderpb.Reputation{
+		AverageRating:    r.AverageRating,
+		Ratings:          r.Ratings,
+		Bids:             r.Bids,
+		Won:              r.Won,
+		Lost:             r.Lost,
+		Canceled:         r.Canceled,
+		CancellationRate: r.CancellationRate,
+		OnTime:           r.OnTime,
+		Late:             r.Late,
+	}
+}
+
+func bidsToProto(bids []Bid) []*tenderpb.Bid {
+	result := make([]*tenderpb.Bid, len(bids))
+	for i := range bids {
+		result[i] = bidToProto(&bids[i])
+	}
+	return result
+}
+
+func createBidFromProto(req *tenderpb.CreateBidRequest) CreateBidJSONRequestBody {
+	return CreateBidJSONRequestBody{
+		Name:         req.Name,
+		Description:  req.Description,
+		TenderId:     req.TenderId,
+		AuthorType:   fromProto(authorTypes, req.AuthorType),
+		AuthorId:     req.AuthorId,
+		Price:        req.Price,
+		Currency:     req.Currency,
+		DeliveryDays: req.DeliveryDays,
+		LotIds:       nilIfEmpty(req.LotIds),
+	}
+}
+
+func editBidFromProto(req *tenderpb.EditBidRequest) EditBidJSONRequestBody {
+	return EditBidJSONRequestBody{
+		Name:         req.Name,
+		Description:  req.Description,
+		Price:        req.Price,
+		Currency:     req.Currency,
+		DeliveryDays: req.DeliveryDays,
+	}
+}
+
+func reviewToProto(r *BidReview) *tenderpb.BidReview {
+	return &tenderpb.BidReview{
+		Id: r.Id, Description: r.Description, CreatedAt: createdAtToProto(r.CreatedAt), Rating: r.Rating, OnTime: r.OnTime,
+	}
+}
+
+// bidUpdateToProto reads the bid a logged bid event tells about, which
+// decisions and feedback carry along with what they are.
+func bidUpdateToProto(e LoggedEvent) (*tenderpb.BidUpdate, error) {
+	payload := e.Event.Payload
+	if e.Event.Type == EventTypeBidDecision || e.Event.Type == EventTypeFeedbackCreated {
+		var wrapped struct {
+			Bid json.RawMessage `json:"bid"`
+		}
+		if err := json.Unmarshal(payload, &wrapped); err != nil {
+			return nil, err
+		}
+		payload = wrapped.Bid
+	}
+	var bid Bid
+	if err := json.Unmarshal(payload, &bid); err != nil {
+		return nil, err
+	}
+
+	return &tenderpb.BidUpdate{
+		Seq:        e.Seq,
+		Type:       toProto[tenderpb.BidEventType](bidEventKinds, e.Event.Type),
+		OccurredAt: timestamppb.New(e.Event.CreatedAt),
+		Bid:        bidToProto(&bid),
+	}, nil
+}
@@ -0,0 +1,392 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxImportRows caps the number of rows of an import file.
+	maxImportRows = 10000
+	// maxImportSize caps the size of an import file.
+	maxImportSize = 32 << 20
+)
+
+var importErrorColumns = []exportColumn{{"row", true}, {"reason", false}}
+
+// importRecord is a row of an import file, turned into the body of the
+// create request it stands for. err is set when the row isn't one.
+type importRecord struct {
+	row  int32
+	body []byte
+	err  error
+}
+
+// importer is what an import needs to know of the entity it creates.
+type importer[T any] struct {
+	// path is the create route whose request body the rows are.
+	path string
+	// prepare checks a row by the rules of the create route.
+	prepare func(body []byte) (T, error)
+	// create stores the rows in one transaction and audits them, returning
+	// the ids of what it created.
+	create func(r *http.Request, items []T) ([]string, error)
+}
+
+type tenderImport struct {
+	tender          *Tender
+	creatorUsername string
+}
+
+type bidImport struct {
+	bid    *Bid
+	author *User
+}
+
+func (a *APIServer) importTenders(w http.ResponseWriter, r *http.Request, params ImportTendersParams) error {
+	return runImport(w, r, "tenders", deref(params.Mode), deref(params.DryRun), deref(params.Report), importer[tenderImport]{
+		path: "/tenders/new",
+		prepare: func(body []byte) (tenderImport, error) {
+			var req CreateTenderJSONRequestBody
+			if err := json.Unmarshal(body, &req); err != nil {
+				return tenderImport{}, httpError(http.StatusBadRequest, "invalid row: %v", err)
+			}
+			tender, err := a.prepareTender(req)
+			return tenderImport{tender, req.CreatorUsername}, err
+		},
+		create: func(r *http.Request, items []tenderImport) ([]string, error) {
+			tenders := make([]*Tender, len(items))
+			creators := make([]string, len(items))
+			for i, item := range items {
+				tenders[i], creators[i] = item.tender, item.creatorUsername
+			}
+			var ids []string
+			err := a.atomically(func(tx *APIServer) error {
+				created, err := tx.store.CreateTenderBatch(tenders, creators)
+				if err != nil {
+					return storageError(err)
+				}
+				for i, t := range created {
+					if err := tx.auditTender(r, creators[i], AuditActionCreateTender, nil, t); err != nil {
+						return err
+					}
+					ids = append(ids, t.Id)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return ids, nil
+		},
+	})
+}
+
+func (a *APIServer) importBids(w http.ResponseWriter, r *http.Request, params ImportBidsParams) error {
+	return runImport(w, r, "bids", deref(params.Mode), deref(params.DryRun), deref(params.Report), importer[bidImport]{
+		path: "/bids/new",
+		prepare: func(body []byte) (bidImport, error) {
+			var req CreateBidJSONRequestBody
+			if err := json.Unmarshal(body, &req); err != nil {
+				return bidImport{}, httpError(http.StatusBadRequest, "invalid row: %v", err)
+			}
+			bid, author, err := a.prepareBid(req)
+			return bidImport{bid, author}, err
+		},
+		create: func(r *http.Request, items []bidImport) ([]string, error) {
+			bids := make([]*Bid, len(items))
+			for i, item := range items {
+				bids[i] = item.bid
+			}
+			var ids []string
+			err := a.atomically(func(tx *APIServer) error {
+				created, err := tx.store.CreateBidBatch(bids)
+				if err != nil {
+					return storageError(err)
+				}
+				for i, b := range created {
+					if err := tx.auditBid(r, items[i].author.Username, AuditActionCreateBid, b, nil, b); err != nil {
+						return err
+					}
+					ids = append(ids, b.Id)
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			return ids, nil
+		},
+	})
+}
+
+// runImport checks every row of the file in the request and, unless it is
+// a dry run, creates them: in atomic mode all at once and only if every row
+// is valid, in perRow mode each valid row as soon as it is checked. A row
+// failing a rule is reported; any other error fails the import, like it
+// would fail the create request.
+func runImport[T any](w http.ResponseWriter, r *http.Request, name string, mode ImportMode, dryRun bool,
+	format ImportReportFormat, imp importer[T]) error {
+	mode = cmp.Or(mode, ImportModeAtomic)
+	schema, err := importSchema(imp.path)
+	if err != nil {
+		return err
+	}
+	records, err := readImport(w, r, schema)
+	if err != nil {
+		return err
+	}
+
+	report := &ImportReport{Mode: mode, DryRun: dryRun, Total: int32(len(records)), Rows: make([]ImportRow, len(records))}
+	var pending []T
+	var pendingRows []*ImportRow
+	for i, rec := range records {
+		row := &report.Rows[i]
+		row.Row = rec.row
+
+		err := rec.err
+		var prepared T
+		if err == nil {
+			prepared, err = imp.prepare(rec.body)
+		}
+		if err == nil && mode == ImportModePerRow && !dryRun {
+			var ids []string
+			if ids, err = imp.create(r, []T{prepared}); err == nil {
+				row.Status, row.Id = ImportRowStatusCreated, &ids[0]
+				continue
+			}
+		}
+		if err != nil {
+			var httpErr *HTTPError
+			if !errors.As(err, &httpErr) {
+				return err
+			}
+			row.Status, row.Reason = ImportRowStatusFailed, &httpErr.Reason
+			report.Failed++
+			continue
+		}
+		row.Status = ImportRowStatusValid
+		pending = append(pending, prepared)
+		pendingRows = append(pendingRows, row)
+	}
+
+	report.Committed = !dryRun && (mode == ImportModePerRow || report.Failed == 0)
+	if mode == ImportModeAtomic && report.Committed && len(pending) > 0 {
+		ids, err := imp.create(r, pending)
+		if err != nil {
+			return err
+		}
+		for i, row := range pendingRows {
+			row.Status, row.Id = ImportRowStatusCreated, &ids[i]
+		}
+	}
+	for _, row := range report.Rows {
+		if row.Status == ImportRowStatusCreated {
+			report.Created++
+		}
+	}
+
+	if format == ImportReportFormatCsv {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+			map[string]string{"filename": name + "-import-errors.csv"}))
+		w.WriteHeader(http.StatusOK)
+		return WriteImportErrors(w, report)
+	}
+	return WriteJSON(w, http.StatusOK, report)
+}
+
+// WriteImportErrors writes the error file of an import: the number and the
+// reason of every row that wasn't accepted.
+func WriteImportErrors(w io.Writer, report *ImportReport) error {
+	out := newCSVExport(w, importErrorColumns)
+	for _, row := range report.Rows {
+		if row.Status == ImportRowStatusFailed {
+			if err := out.Write([]string{strconv.Itoa(int(row.Row)), deref(row.Reason)}); err != nil {
+				return err
+			}
+		}
+	}
+	return out.Close()
+}
+
+// importSchema returns the schema of the request body of the create route
+// at path, which every row of an import has to match.
+func importSchema(path string) (*openapi3.Schema, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+	op := swagger.Paths.Find(path).Post
+	return op.RequestBody.Value.Content.Get("application/json").Schema.Value, nil
+}
+
+// readImport reads the rows of the file in the request, CSV or JSONL by its
+// content type, and checks each against schema. Errors of the file as a
+// whole are returned, those of a row are left in its record.
+func readImport(w http.ResponseWriter, r *http.Request, schema *openapi3.Schema) ([]importRecord, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportSize)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	var records []importRecord
+	var err error
+	switch mediaType {
+	case "text/csv":
+		records, err = readCSVImport(r.Body, schema)
+	case "application/x-ndjson":
+		records, err = readJSONLImport(r.Body)
+	default:
+		return nil, httpError(http.StatusBadRequest, "import files are text/csv or application/x-ndjson")
+	}
+	var tooLarge *http.MaxBytesError
+	switch {
+	case errors.As(err, &tooLarge):
+		return nil, httpError(http.StatusBadRequest, "import file is larger than %d bytes", maxImportSize)
+	case err != nil:
+		return nil, err
+	case len(records) == 0:
+		return nil, httpError(http.StatusBadRequest, "import file has no rows")
+	}
+
+	for i := range records {
+		rec := &records[i]
+		if rec.err != nil {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(rec.body, &value); err != nil {
+			rec.err = httpError(http.StatusBadRequest, "invalid row: %v", err)
+			continue
+		}
+		if err := schema.VisitJSON(value, openapi3.VisitAsRequest()); err != nil {
+			rec.err = httpError(http.StatusBadRequest, "invalid row: %s", validationReason(err))
+		}
+	}
+	return records, nil
+}
+
+// readJSONLImport reads a JSON object per line. Rows are numbered by line,
+// blank lines included.
+func readJSONLImport(body io.Reader) ([]importRecord, error) {
+	var records []importRecord
+	lines := bufio.NewReader(body)
+	for n := int32(1); ; n++ {
+		line, err := lines.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if line = bytes.TrimSpace(line); len(line) > 0 {
+			if len(records) == maxImportRows {
+				return nil, httpError(http.StatusBadRequest, "import file has more than %d rows", maxImportRows)
+			}
+			records = append(records, importRecord{row: n, body: line})
+		}
+		if err == io.EOF {
+			return records, nil
+		}
+	}
+}
+
+// readCSVImport reads a CSV file whose header names the fields of the
+// request body. An empty cell leaves its field out, object and array fields
+// are written as JSON.
+func readCSVImport(body io.Reader, schema *openapi3.Schema) ([]importRecord, error) {
+	reader := csv.NewReader(body)
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, csvImportError(err)
+	}
+	header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	columns := make([]*openapi3.Schema, len(header))
+	for i, name := range header {
+		prop, ok := schema.Properties[name]
+		if !ok {
+			return nil, httpError(http.StatusBadRequest, "unknown column %q", name)
+		}
+		if contains(header[:i], name) {
+			return nil, httpError(http.StatusBadRequest, "duplicate column %q", name)
+		}
+		columns[i] = prop.Value
+	}
+
+	var records []importRecord
+	for n := int32(1); ; n++ {
+		cells, err := reader.Read()
+		if err == io.EOF {
+			return records, nil
+		}
+		if len(records) == maxImportRows {
+			return nil, httpError(http.StatusBadRequest, "import file has more than %d rows", maxImportRows)
+		}
+		rec := importRecord{row: n}
+		switch {
+		case errors.Is(err, csv.ErrFieldCount):
+			rec.err = httpError(http.StatusBadRequest, "invalid row: %d cells for %d columns", len(cells), len(header))
+		case err != nil:
+			return nil, csvImportError(err)
+		default:
+			rec.body, rec.err = csvImportRow(header, columns, cells)
+		}
+		records = append(records, rec)
+	}
+}
+
+func csvImportRow(header []string, columns []*openapi3.Schema, cells []string) ([]byte, error) {
+	row := map[string]any{}
+	for i, cell := range cells {
+		if cell == "" {
+			continue
+		}
+		value, err := csvImportValue(columns[i], cell)
+		if err != nil {
+			return nil, httpError(http.StatusBadRequest, "invalid row: %s: %v", header[i], err)
+		}
+		row[header[i]] = value
+	}
+	return json.Marshal(row)
+}
+
+// csvImportValue turns a cell into the JSON value of a field of schema. A
+// cell that isn't of the field's type is kept as a string, for the schema
+// check to report.
+func csvImportValue(schema *openapi3.Schema, cell string) (any, error) {
+	switch {
+	case schema.Type.Is("object"), schema.Type.Is("array"):
+		var value any
+		if err := json.Unmarshal([]byte(cell), &value); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %w", err)
+		}
+		return value, nil
+	case schema.Type.Is("integer"), schema.Type.Is("number"):
+		if n, err := strconv.ParseFloat(cell, 64); err == nil {
+			return n, nil
+		}
+	case schema.Type.Is("boolean"):
+		if b, err := strconv.ParseBool(cell); err == nil {
+			return b, nil
+		}
+	}
+	return cell, nil
+}
+
+func csvImportError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return httpError(http.StatusBadRequest, "invalid CSV: %v", err)
+	}
+	return err
+}
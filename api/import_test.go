@@ -0,0 +1,46 @@
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadCSVImport(t *testing.T) {
+	schema, err := importSchema("/bids/new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := "\ufeffname,deliveryDays,lotIds,price\n" +
+		"Ремонт,10,\"[\"\"l1\"\"]\",\n" +
+		"Ремонт,десять,,\n" +
+		"Ремонт,10\n" +
+		"Ремонт,,[l1,\n"
+
+	records, err := readCSVImport(strings.NewReader(file), schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 4 {
+		t.Fatalf("read %d records", len(records))
+	}
+	if got := string(records[0].body); got != `{"deliveryDays":10,"lotIds":["l1"],"name":"Ремонт"}` {
+		t.Errorf("row 1 = %s", got)
+	}
+	if got := string(records[1].body); got != `{"deliveryDays":"десять","name":"Ремонт"}` {
+		t.Errorf("row 2 = %s", got)
+	}
+	for i, want := range []string{"", "", "invalid row: 2 cells for 4 columns", "invalid row: lotIds: invalid JSON"} {
+		got := ""
+		if err := records[i].err; err != nil {
+			got = err.Error()
+		}
+		if !strings.HasPrefix(got, want) || (want == "") != (got == "") {
+			t.Errorf("error of row %d = %q, want %q", i+1, got, want)
+		}
+	}
+
+	if _, err := readCSVImport(strings.NewReader("name,author\n"), schema); err == nil ||
+		err.Error() != `unknown column "author"` {
+		t.Errorf("unknown column: err = %v", err)
+	}
+}
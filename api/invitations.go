@@ -0,0 +1,186 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+func (a *APIServer) createTenderInvitation(w http.ResponseWriter, r *http.Request, tenderId TenderId, params CreateTenderInvitationParams) error {
+	var req CreateTenderInvitationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+
+	tender, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+	if tender.Visibility != TenderVisibilityPrivate {
+		return httpError(http.StatusBadRequest, "tender %s is public", tender.Id)
+	}
+	if (req.OrganizationId == nil) == (req.Username == nil) {
+		return httpError(http.StatusBadRequest, "invite either an organization or an employee")
+	}
+
+	if req.Username != nil {
+		invitee, err := a.store.GetUserByUsername(*req.Username)
+		if errors.Is(err, ErrUserNotFound) {
+			return httpError(http.StatusNotFound, "employee %s not found", *req.Username)
+		}
+		if err != nil {
+			return err
+		}
+		own, err := a.store.isValidTenderCreator(invitee.Username, tender.OrganizationId)
+		if err != nil {
+			return err
+		}
+		if own {
+			return httpError(http.StatusBadRequest, "%s is responsible for the tender already", invitee.Username)
+		}
+	}
+	if req.OrganizationId != nil && *req.OrganizationId == tender.OrganizationId {
+		return httpError(http.StatusBadRequest, "organization %s owns the tender", tender.OrganizationId)
+	}
+
+	var invitation *TenderInvitation
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		invitation, err = tx.store.CreateInvitation(&TenderInvitation{
+			TenderId:       tenderId,
+			OrganizationId: req.OrganizationId,
+			Username:       req.Username,
+		})
+		if err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, params.Username, AuditActionCreateTenderInvitation, AuditEntityTypeInvitation, invitation.Id,
+			[]string{tender.OrganizationId, deref(invitation.OrganizationId)}, nil, invitation)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, invitation)
+}
+
+func (a *APIServer) getTenderInvitations(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderInvitationsParams) error {
+	if _, err := a.requireTenderResponsible(params.Username, tenderId); err != nil {
+		return err
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	invitations, err := a.store.GetTenderInvitations(tenderId, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, invitations)
+}
+
+func (a *APIServer) getUserInvitations(w http.ResponseWriter, r *http.Request, params GetUserInvitationsParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	invitations, err := a.store.GetUserInvitations(user.Username, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, invitations)
+}
+
+func (a *APIServer) respondTenderInvitation(w http.ResponseWriter, r *http.Request, invitationId InvitationId, params RespondTenderInvitationParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := a.store.GetInvitationById(invitationId)
+	if err != nil {
+		return storageError(err)
+	}
+
+	// An organization answers through any of its responsibles.
+	invitee := deref(invitation.Username) == user.Username
+	if invitation.OrganizationId != nil {
+		org, err := a.store.GetUserOrganization(user.Id)
+		if err != nil {
+			return err
+		}
+		invitee = org == *invitation.OrganizationId
+	}
+	if !invitee {
+		return httpError(http.StatusForbidden, "invitation %s is not addressed to %s", invitation.Id, user.Username)
+	}
+
+	current := invitation
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if invitation, err = tx.store.RespondInvitation(invitationId, InvitationStatus(params.Response)); err != nil {
+			return storageError(err)
+		}
+		tender, err := tx.store.GetTenderById(invitation.TenderId)
+		if err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, user.Username, AuditActionRespondTenderInvitation, AuditEntityTypeInvitation, invitation.Id,
+			[]string{tender.OrganizationId, deref(invitation.OrganizationId)}, current, invitation)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, invitation)
+}
+
+// canSeeTender reports whether user, nil for anonymous requests, may see
+// the tender: private tenders are shown to the responsibles of the owning
+// organization and to invitees that haven't declined.
+func (a *APIServer) canSeeTender(user *User, tender *Tender) (bool, error) {
+	if tender.Visibility != TenderVisibilityPrivate {
+		return true, nil
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	own, err := a.store.isValidTenderCreator(user.Username, tender.OrganizationId)
+	if err != nil || own {
+		return own, err
+	}
+
+	status, err := a.store.GetInvitationStatus(tender.Id, user.Username)
+	if err != nil {
+		return false, err
+	}
+	return status == InvitationStatusPending || status == InvitationStatusAccepted, nil
+}
+
+// checkTenderVisible applies the visibility rules of a tender: unpublished
+// tenders are shown to their responsibles only, private ones to the
+// responsibles and invitees. user is nil for anonymous requests.
+func (a *APIServer) checkTenderVisible(user *User, tender *Tender) error {
+	if tender.Status != TenderStatusPublished {
+		if user == nil {
+			return httpError(http.StatusUnauthorized, "username is required for unpublished tenders")
+		}
+		if err := a.requireResponsible(user, tender.OrganizationId); err != nil {
+			return err
+		}
+	}
+	visible, err := a.canSeeTender(user, tender)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		if user == nil {
+			return httpError(http.StatusUnauthorized, "username is required for private tenders")
+		}
+		return httpError(http.StatusForbidden, "tender %s is private", tender.Id)
+	}
+	return nil
+}
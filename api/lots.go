@@ -0,0 +1,134 @@
+package api
+
+import (
+	"net/http"
+)
+
+func (a *APIServer) cancelTenderLot(w http.ResponseWriter, r *http.Request, tenderId TenderId, lotId LotId, params CancelTenderLotParams) error {
+	current, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+
+	var tender *Tender
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if tender, err = tx.store.CancelTenderLot(tenderId, lotId); err != nil {
+			return storageError(err)
+		}
+		return tx.auditTender(r, params.Username, AuditActionCancelTenderLot, current, tender)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, tender)
+}
+
+// findLot returns the lot of the tender with the given id, or nil.
+func findLot(t *Tender, lotId string) *TenderLot {
+	for i, l := range deref(t.Lots) {
+		if l.Id == lotId {
+			return &(*t.Lots)[i]
+		}
+	}
+	return nil
+}
+
+// hasOpenLots reports whether some lot of the tender is neither awarded nor
+// canceled. Such a tender only closes once the last of its lots settles.
+func hasOpenLots(t *Tender) bool {
+	for _, l := range deref(t.Lots) {
+		if l.Status == TenderLotStatusOpen {
+			return true
+		}
+	}
+	return false
+}
+
+// newLots checks the lots of a new tender and returns them open. Their
+// budgets must be in the currency of the tender budget, if it has one.
+func newLots(inputs *[]TenderLotInput, tender *Tender) (*[]TenderLot, error) {
+	if inputs == nil || len(*inputs) == 0 {
+		return nil, nil
+	}
+	if tender.Auction != nil {
+		return nil, httpError(http.StatusBadRequest, "an auction can't have lots")
+	}
+
+	lots := make([]TenderLot, 0, len(*inputs))
+	for _, in := range *inputs {
+		if err := validateBudget(in.Budget); err != nil {
+			return nil, err
+		}
+		if in.Budget != nil && tender.Budget != nil && in.Budget.Currency != tender.Budget.Currency {
+			return nil, httpError(http.StatusBadRequest, "lot %q currency %s doesn't match tender currency %s",
+				in.Name, in.Budget.Currency, tender.Budget.Currency)
+		}
+		lots = append(lots, TenderLot{Name: in.Name, Description: in.Description, Budget: in.Budget, Status: TenderLotStatusOpen})
+	}
+	return &lots, nil
+}
+
+// validateBidLots checks the lots a new bid targets: a tender with lots
+// takes bids on its open lots only, a tender without lots takes none.
+func validateBidLots(lotIds *BidLotIds, currency *Currency, tender *Tender) error {
+	if tender.Lots == nil {
+		if lotIds != nil && len(*lotIds) > 0 {
+			return httpError(http.StatusBadRequest, "tender %s has no lots", tender.Id)
+		}
+		return nil
+	}
+	if lotIds == nil || len(*lotIds) == 0 {
+		return httpError(http.StatusBadRequest, "tender %s has lots, choose the ones to bid on", tender.Id)
+	}
+
+	seen := map[string]bool{}
+	for _, id := range *lotIds {
+		lot := findLot(tender, id)
+		if lot == nil {
+			return httpError(http.StatusBadRequest, "tender %s has no lot %s", tender.Id, id)
+		}
+		if seen[id] {
+			return httpError(http.StatusBadRequest, "lot %s is listed twice", id)
+		}
+		seen[id] = true
+		if lot.Status != TenderLotStatusOpen {
+			return httpError(http.StatusForbidden, "lot %s is %s", id, lot.Status)
+		}
+		if currency != nil && lot.Budget != nil && *currency != lot.Budget.Currency {
+			return httpError(http.StatusBadRequest, "bid currency %s doesn't match lot currency %s", *currency, lot.Budget.Currency)
+		}
+	}
+	return nil
+}
+
+// validateDecisionLot checks the lot a decision is made on: decisions on a
+// tender with lots are made per open lot the bid targets.
+func validateDecisionLot(bid *Bid, tender *Tender, lotId string) error {
+	if tender.Lots == nil {
+		if lotId != "" {
+			return httpError(http.StatusBadRequest, "tender %s has no lots", tender.Id)
+		}
+		return nil
+	}
+	if lotId == "" {
+		return httpError(http.StatusBadRequest, "tender %s has lots, lotId is required", tender.Id)
+	}
+
+	lot := findLot(tender, lotId)
+	if lot == nil {
+		return httpError(http.StatusNotFound, "tender %s has no lot %s", tender.Id, lotId)
+	}
+	targeted := false
+	for _, id := range deref(bid.LotIds) {
+		targeted = targeted || id == lotId
+	}
+	if !targeted {
+		return httpError(http.StatusBadRequest, "bid %s doesn't target lot %s", bid.Id, lotId)
+	}
+	if lot.Status != TenderLotStatusOpen {
+		return httpError(http.StatusBadRequest, "lot %s is already %s", lotId, lot.Status)
+	}
+	return nil
+}
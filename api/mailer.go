@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// smtpTimeout bounds sending a message, from dialing the server to QUIT.
+const smtpTimeout = 30 * time.Second
+
+// MailMessage is a plain-text email to one recipient. Id makes the
+// Message-ID, so that a message sent again is recognized as the same.
+type MailMessage struct {
+	Id      string
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends email. A *textproto.Error with a 5xx code is a permanent
+// failure: the message is not tried again.
+type Mailer interface {
+	Send(ctx context.Context, msg MailMessage) error
+}
+
+// SMTPMailer sends email through an SMTP server, upgrading the connection
+// with STARTTLS when the server offers it.
+type SMTPMailer struct {
+	addr string
+	host string
+	from *mail.Address
+	auth smtp.Auth
+}
+
+// NewSMTPMailer returns a mailer for the server at host:port sending as
+// from, e.g. "Тендеры <noreply@example.com>". It authenticates with PLAIN
+// when username is set, which net/smtp allows over TLS or to localhost
+// only.
+func NewSMTPMailer(addr, from, username, password string) (*SMTPMailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("smtp address %q is not of the form host:port", addr)
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender %q: %w", from, err)
+	}
+
+	m := &SMTPMailer{addr: addr, host: host, from: sender}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg MailMessage) error {
+	dialer := net.Dialer{Timeout: smtpTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to smtp server: %w", err)
+	}
+	deadline := time.Now().Add(smtpTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if err := c.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(m.from.Address); err != nil {
+		return err
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if err := m.write(w, msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// write writes the message with its headers, the subject encoded and the
+// body quoted-printable so that any text survives 7-bit servers.
+func (m *SMTPMailer) write(w io.Writer, msg MailMessage) error {
+	headers := []string{
+		"From: " + m.from.String(),
+		"To: " + msg.To,
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		"Message-ID: <" + msg.Id + "@" + m.host + ">",
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+	}
+	if _, err := io.WriteString(w, strings.Join(headers, "\r\n")+"\r\n\r\n"); err != nil {
+		return err
+	}
+
+	qp := quotedprintable.NewWriter(w)
+	if _, err := io.WriteString(qp, strings.ReplaceAll(msg.Body, "\n", "\r\n")); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// smtpReply returns the reply code of an SMTP error, or 0.
+func smtpReply(err error) int {
+	var tpErr *textproto.Error
+	if errors.As(err, &tpErr) {
+		return tpErr.Code
+	}
+	return 0
+}
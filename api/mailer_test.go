@@ -0,0 +1,132 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+// fakeSMTP is a local SMTP server accepting mail for every recipient but
+// those it rejects.
+type fakeSMTP struct {
+	ln       net.Listener
+	rejected string
+	messages chan string
+}
+
+func startFakeSMTP(t *testing.T, rejected string) *fakeSMTP {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	s := &fakeSMTP{ln: ln, rejected: rejected, messages: make(chan string, 10)}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { fmt.Fprintf(conn, "%s\r\n", line) }
+
+	reply("220 fake ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch verb := strings.ToUpper(strings.Fields(line + " ")[0]); verb {
+		case "EHLO", "HELO":
+			reply("250 fake")
+		case "MAIL":
+			reply("250 OK")
+		case "RCPT":
+			if strings.Contains(line, s.rejected) {
+				reply("550 No such user")
+				continue
+			}
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+			var msg strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				msg.WriteString(line)
+			}
+			s.messages <- msg.String()
+			reply("250 Queued")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			reply("502 Not implemented")
+		}
+	}
+}
+
+func TestSMTPMailer(t *testing.T) {
+	server := startFakeSMTP(t, "gone@example.com")
+	mailer, err := NewSMTPMailer(server.ln.Addr().String(), "Тендеры <noreply@example.com>", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = mailer.Send(context.Background(), MailMessage{Id: "n1", To: "owner@example.com",
+		Subject: "Новое предложение", Body: "Здравствуйте!\n\nНа тендер опубликовано предложение.\n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(<-server.messages))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Новое предложение" {
+		t.Errorf("subject = %q, %v", subject, err)
+	}
+	if from, err := msg.Header.AddressList("From"); err != nil || from[0].Name != "Тендеры" {
+		t.Errorf("from = %v, %v", from, err)
+	}
+	if id := msg.Header.Get("Message-ID"); !strings.HasPrefix(id, "<n1@") {
+		t.Errorf("message id = %s", id)
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	if err != nil || string(body) != "Здравствуйте!\r\n\r\nНа тендер опубликовано предложение.\r\n" {
+		t.Errorf("body = %q, %v", body, err)
+	}
+
+	// A rejected recipient is a permanent failure.
+	err = mailer.Send(context.Background(), MailMessage{Id: "n2", To: "gone@example.com", Subject: "Отзыв", Body: "Отзыв"})
+	if smtpReply(err) != 550 {
+		t.Errorf("rejected recipient: %v", err)
+	}
+
+	if _, err := NewSMTPMailer("localhost", "noreply@example.com", "", ""); err == nil {
+		t.Error("accepted an address without a port")
+	}
+}
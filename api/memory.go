@@ -0,0 +1,2420 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// MemoryStorage is an in-process Storage used by tests and local runs
+// without Postgres. It mirrors the PostgresStorage semantics: every edit
+// appends a version and reads return the latest one.
+type MemoryStorage struct {
+	mu sync.Mutex
+
+	users         map[string]*User
+	organizations map[string]string
+	responsibles  map[string]string // user id -> organization id
+
+	tenders   map[string]*memTender
+	bids      map[string]*memBid
+	reviews   []*memReview
+	decisions map[string][]memDecision
+	reveals   []memReveal
+	scores    map[string][]BidScorecard // bid id -> every scorecard version
+
+	invitations []*TenderInvitation // oldest first
+	questions   []*TenderQuestion   // oldest first
+	attachments []*Attachment       // oldest first
+	webhooks    []*memWebhook       // oldest first
+	deliveries  []*memDelivery      // oldest first
+	outbox      []*memOutboxEvent   // oldest first, the index is the id - 1
+	eventLog    []LoggedEvent       // oldest first, the index is the seq - 1
+	auditLog    []*AuditEntry       // oldest first, the index is the id - 1
+
+	preferences   map[string]NotificationPreferences // username -> preferences
+	notifications []*memNotification                 // oldest first
+}
+
+// memOutboxEvent is an event of the outbox. Events are recorded under the
+// mutex the change they report is made under, like the Postgres storage
+// records them in its transaction.
+type memOutboxEvent struct {
+	event     OutboxEvent
+	published bool
+}
+
+type memWebhook struct {
+	webhook Webhook
+	secret  string
+}
+
+// memDelivery is a webhook delivery. Dead-lettered deliveries are those in
+// the Dead status; there is no separate table to copy them to.
+type memDelivery struct {
+	delivery WebhookDelivery
+	body     []byte
+	// eventId is the outbox event the delivery was queued for, if any.
+	eventId       int64
+	nextAttemptAt time.Time
+}
+
+type memNotification struct {
+	notification  Notification
+	status        string
+	nextAttemptAt time.Time
+}
+
+type memTender struct {
+	tender          Tender
+	creatorUsername string
+	versions        []Tender
+}
+
+type memBid struct {
+	bid             Bid
+	creatorUsername string
+	organizationId  string
+	versions        []Bid
+	versionAt       time.Time
+}
+
+type memReview struct {
+	review   BidReview
+	bidId    string
+	username string
+	at       time.Time
+}
+
+// memReveal records a reveal of sealed bids. The memory storage keeps bid
+// contents in plaintext; only their visibility is sealed.
+type memReveal struct {
+	tenderId string
+	trigger  RevealTrigger
+	bids     int
+}
+
+type memDecision struct {
+	username string
+	lotId    string
+	decision BidDecision
+	at       time.Time
+}
+
+var _ Storage = (*MemoryStorage)(nil)
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		users:         map[string]*User{},
+		organizations: map[string]string{},
+		responsibles:  map[string]string{},
+		tenders:       map[string]*memTender{},
+		bids:          map[string]*memBid{},
+		decisions:     map[string][]memDecision{},
+		scores:        map[string][]BidScorecard{},
+		preferences:   map[string]NotificationPreferences{},
+	}
+}
+
+// Atomically runs fn with the storage itself. Nothing in memory outlives
+// the process, so the changes of a failed fn are not rolled back.
+func (s *MemoryStorage) Atomically(fn func(Storage) error) error {
+	return fn(s)
+}
+
+func (s *MemoryStorage) AddEmployee(username, firstName, lastName string) *User {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := &User{Id: uuid.NewString(), Username: username, FirstName: firstName, LastName: lastName}
+	s.users[u.Id] = u
+	return u
+}
+
+func (s *MemoryStorage) AddOrganization(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := uuid.NewString()
+	s.organizations[id] = name
+	return id
+}
+
+func (s *MemoryStorage) AddResponsible(organizationId, userId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.responsibles[userId] = organizationId
+}
+
+func (s *MemoryStorage) CreateUser(u *User) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.userByUsername(u.Username) != nil {
+		return nil, ErrUserExists
+	}
+	u.Id = uuid.NewString()
+	cp := *u
+	s.users[u.Id] = &cp
+	return u, nil
+}
+
+func (s *MemoryStorage) CreateOrganization(name string) (string, error) {
+	return s.AddOrganization(name), nil
+}
+
+func (s *MemoryStorage) AddOrganizationResponsible(organizationId, userId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.organizations[organizationId]; !ok {
+		return ErrOrganizationNotFound
+	}
+	if _, ok := s.users[userId]; !ok {
+		return ErrUserNotFound
+	}
+	if _, ok := s.responsibles[userId]; ok {
+		return ErrAlreadyResponsible
+	}
+	s.responsibles[userId] = organizationId
+	return nil
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func paginate[T any](items []T, limit, offset int32) []T {
+	if int(offset) >= len(items) {
+		return []T{}
+	}
+	items = items[offset:]
+	if int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+func (s *MemoryStorage) userByUsername(username string) *User {
+	for _, u := range s.users {
+		if u.Username == username {
+			return u
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorage) GetUserByUsername(username string) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.userByUsername(username)
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetUserById(id string) (*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.users[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	cp := *u
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetUserOrganization(userId string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.responsibles[userId], nil
+}
+
+func (s *MemoryStorage) GetUsersByIds(ids []string) (map[string]*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string]*User{}
+	for _, id := range ids {
+		if u, ok := s.users[id]; ok {
+			cp := *u
+			result[id] = &cp
+		}
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) GetUsersByUsernames(usernames []string) (map[string]*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string]*User{}
+	for _, username := range usernames {
+		if u := s.userByUsername(username); u != nil {
+			cp := *u
+			result[username] = &cp
+		}
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) GetUserOrganizations(userIds []string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string]string{}
+	for _, id := range userIds {
+		if org, ok := s.responsibles[id]; ok {
+			result[id] = org
+		}
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) GetOrganizationsByIds(ids []string) (map[string]*Organization, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string]*Organization{}
+	for _, id := range ids {
+		if name, ok := s.organizations[id]; ok {
+			result[id] = &Organization{Id: id, Name: name}
+		}
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) GetOrganizationResponsibles(organizationId string) ([]*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.organizationResponsibles(organizationId), nil
+}
+
+func (s *MemoryStorage) organizationResponsibles(organizationId string) []*User {
+	users := []*User{}
+	for userId, org := range s.responsibles {
+		if org == organizationId {
+			cp := *s.users[userId]
+			users = append(users, &cp)
+		}
+	}
+	slices.SortFunc(users, func(a, b *User) int { return cmp.Compare(a.Username, b.Username) })
+	return users
+}
+
+func (s *MemoryStorage) isValidTenderCreator(username, organizationId string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.userByUsername(username)
+	if u == nil {
+		return false, nil
+	}
+	return s.responsibles[u.Id] == organizationId, nil
+}
+
+// compareKeys orders sort keys like ORDER BY key NULLS LAST: a missing key
+// sorts last in both directions.
+func compareKeys[K any](a, b *K, compare func(K, K) int, desc bool) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	c := compare(*a, *b)
+	if desc {
+		c = -c
+	}
+	return c
+}
+
+func sortTenders(tenders []*Tender, f TenderFilter) {
+	desc := f.SortOrder == SortOrderDesc
+	var c func(a, b *Tender) int
+	switch f.SortBy {
+	case TenderSortByBudgetMin:
+		c = func(a, b *Tender) int {
+			return compareKeys(budgetBound(a, false), budgetBound(b, false), compareMoney, desc)
+		}
+	case TenderSortByBudgetMax:
+		c = func(a, b *Tender) int {
+			return compareKeys(budgetBound(a, true), budgetBound(b, true), compareMoney, desc)
+		}
+	default:
+		c = func(a, b *Tender) int { return compareKeys(&a.Name, &b.Name, strings.Compare, desc) }
+	}
+
+	sort.SliceStable(tenders, func(i, j int) bool {
+		if r := c(tenders[i], tenders[j]); r != 0 {
+			return r < 0
+		}
+		return tenders[i].Name < tenders[j].Name
+	})
+}
+
+func budgetBound(t *Tender, max bool) *Money {
+	if t.Budget == nil {
+		return nil
+	}
+	if max {
+		return t.Budget.Max
+	}
+	return t.Budget.Min
+}
+
+// matchTender mirrors tenderFilterClause: a budget bound matches tenders
+// whose budget range reaches it.
+func matchTender(t *Tender, f TenderFilter) bool {
+	if len(f.ServiceTypes) > 0 && !contains(f.ServiceTypes, t.ServiceType) {
+		return false
+	}
+	if f.Currency != "" && (t.Budget == nil || t.Budget.Currency != f.Currency) {
+		return false
+	}
+	if f.MinBudget != "" {
+		upper := budgetBound(t, true)
+		if upper == nil {
+			upper = budgetBound(t, false)
+		}
+		if upper == nil || compareMoney(*upper, f.MinBudget) < 0 {
+			return false
+		}
+	}
+	if f.MaxBudget != "" {
+		lower := budgetBound(t, false)
+		if lower == nil {
+			lower = budgetBound(t, true)
+		}
+		if lower == nil || compareMoney(*lower, f.MaxBudget) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func sortBids(bids []*Bid, f BidFilter) {
+	desc := f.SortOrder == SortOrderDesc
+	var c func(a, b *Bid) int
+	switch f.SortBy {
+	case BidSortByPrice:
+		c = func(a, b *Bid) int { return compareKeys(a.Price, b.Price, compareMoney, desc) }
+	case BidSortByDeliveryDays:
+		c = func(a, b *Bid) int { return compareKeys(a.DeliveryDays, b.DeliveryDays, cmp.Compare[int32], desc) }
+	default:
+		c = func(a, b *Bid) int { return compareKeys(&a.Name, &b.Name, strings.Compare, desc) }
+	}
+
+	sort.SliceStable(bids, func(i, j int) bool {
+		if r := c(bids[i], bids[j]); r != 0 {
+			return r < 0
+		}
+		return bids[i].Name < bids[j].Name
+	})
+}
+
+// matchBid mirrors bidFilterClause.
+func matchBid(b *Bid, f BidFilter) bool {
+	if f.Currency != "" && (b.Currency == nil || *b.Currency != f.Currency) {
+		return false
+	}
+	if f.MinPrice != "" && (b.Price == nil || compareMoney(*b.Price, f.MinPrice) < 0) {
+		return false
+	}
+	if f.MaxPrice != "" && (b.Price == nil || compareMoney(*b.Price, f.MaxPrice) > 0) {
+		return false
+	}
+	if f.MaxDeliveryDays > 0 && (b.DeliveryDays == nil || *b.DeliveryDays > f.MaxDeliveryDays) {
+		return false
+	}
+	return true
+}
+
+func (s *MemoryStorage) GetAllTenders(filter TenderFilter, limit, offset int32) ([]*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tenders := []*Tender{}
+	for _, t := range s.tenders {
+		if t.tender.Status != TenderStatusPublished || !matchTender(&t.tender, filter) ||
+			!s.visible(&t.tender, filter.Viewer) {
+			continue
+		}
+		cp := t.tender
+		tenders = append(tenders, &cp)
+	}
+	sortTenders(tenders, filter)
+
+	return paginate(tenders, limit, offset), nil
+}
+
+// visible reports whether a tender is listed to the viewer, empty for
+// anonymous requests. See canSeeTender.
+func (s *MemoryStorage) visible(t *Tender, viewer string) bool {
+	if t.Visibility != TenderVisibilityPrivate {
+		return true
+	}
+	u := s.userByUsername(viewer)
+	if u == nil {
+		return false
+	}
+	if s.responsibles[u.Id] == t.OrganizationId {
+		return true
+	}
+	status := s.invitationStatus(t.Id, u)
+	return status == InvitationStatusPending || status == InvitationStatusAccepted
+}
+
+// SearchTenders finds the tenders PostgresStorage.SearchTenders would, with
+// searchText in place of the Postgres full-text search.
+func (s *MemoryStorage) SearchTenders(query string, filter SearchFilter, limit, offset int32) ([]*TenderSearchHit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var org string
+	if u := s.userByUsername(filter.Viewer); u != nil {
+		org = s.responsibles[u.Id]
+	}
+
+	hits := []*TenderSearchHit{}
+	for _, t := range s.tenders {
+		own := org != "" && t.tender.OrganizationId == org
+		if !own && (t.tender.Status != TenderStatusPublished || !s.visible(&t.tender, filter.Viewer)) {
+			continue
+		}
+		if filter.Status != "" && t.tender.Status != filter.Status ||
+			len(filter.ServiceTypes) > 0 && !contains(filter.ServiceTypes, t.tender.ServiceType) ||
+			filter.OrganizationId != "" && t.tender.OrganizationId != filter.OrganizationId {
+			continue
+		}
+		rank, snippet, ok := searchText(query, t.tender.Name, t.tender.Description)
+		if !ok {
+			continue
+		}
+		hits = append(hits, &TenderSearchHit{Tender: t.tender, Rank: rank, Snippet: snippet})
+	}
+	slices.SortFunc(hits, func(a, b *TenderSearchHit) int {
+		return cmp.Or(cmp.Compare(b.Rank, a.Rank), cmp.Compare(a.Tender.Name, b.Tender.Name), cmp.Compare(a.Tender.Id, b.Tender.Id))
+	})
+
+	return paginate(hits, limit, offset), nil
+}
+
+// searchText is a crude stand-in for the Postgres full-text search. Query
+// words lose up to two trailing letters in place of stemming and must each
+// start a word of the name or description, while words prefixed with a
+// minus must not. Matches rank 1 in the name and 0.4 in the description,
+// the default weights of ts_rank.
+func searchText(query, name, description string) (float32, string, bool) {
+	var include, exclude []string
+	for _, w := range strings.Fields(strings.ToLower(query)) {
+		w = strings.Trim(w, `"`)
+		switch {
+		case w == "" || w == "or" || w == "-":
+		case strings.HasPrefix(w, "-"):
+			exclude = append(exclude, stem(w[1:]))
+		default:
+			include = append(include, stem(w))
+		}
+	}
+	if len(include) == 0 {
+		return 0, "", false
+	}
+
+	var rank float32
+	found := map[string]bool{}
+	parts := make([]string, 0, 2)
+	for i, text := range []string{name, description} {
+		words := strings.Fields(text)
+		for j, word := range words {
+			bare := strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			}))
+			for _, w := range exclude {
+				if strings.HasPrefix(bare, w) {
+					return 0, "", false
+				}
+			}
+			hit := false
+			for _, w := range include {
+				if strings.HasPrefix(bare, w) {
+					found[w], hit = true, true
+				}
+			}
+			if hit {
+				rank += []float32{1, 0.4}[i]
+				words[j] = "<b>" + word + "</b>"
+			}
+		}
+		parts = append(parts, strings.Join(words, " "))
+	}
+	if len(found) < len(include) {
+		return 0, "", false
+	}
+
+	return rank, strings.Join(parts, ". "), true
+}
+
+// stem cuts up to two trailing letters off a word, keeping at least four.
+func stem(word string) string {
+	runes := []rune(word)
+	return string(runes[:max(min(len(runes), 4), len(runes)-2)])
+}
+
+func contains[T comparable](items []T, item T) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStorage) GetTendersByUsername(username string, filter TenderFilter, limit, offset int32) ([]*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tenders := []*Tender{}
+	for _, t := range s.tenders {
+		if t.creatorUsername != username || !matchTender(&t.tender, filter) {
+			continue
+		}
+		cp := t.tender
+		tenders = append(tenders, &cp)
+	}
+	sortTenders(tenders, filter)
+
+	return paginate(tenders, limit, offset), nil
+}
+
+func (s *MemoryStorage) GetTenderById(id string) (*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[id]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+	cp := t.tender
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetTendersByIds(ids []string) (map[string]*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string]*Tender{}
+	for _, id := range ids {
+		if t, ok := s.tenders[id]; ok {
+			cp := t.tender
+			result[id] = &cp
+		}
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) CreateTender(t *Tender, creatorUsername string) (*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.createTender(t, creatorUsername)
+}
+
+func (s *MemoryStorage) CreateTenderBatch(tenders []*Tender, creatorUsernames []string) ([]*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	created := make([]*Tender, len(tenders))
+	for i, t := range tenders {
+		var err error
+		if created[i], err = s.createTender(t, creatorUsernames[i]); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+func (s *MemoryStorage) createTender(t *Tender, creatorUsername string) (*Tender, error) {
+	t.Id = uuid.NewString()
+	t.Status = TenderStatusCreated
+	t.Version = 1
+	t.CreatedAt = now()
+	if t.Lots != nil {
+		lots := append([]TenderLot(nil), *t.Lots...)
+		for i := range lots {
+			lots[i].Id = uuid.NewString()
+		}
+		t.Lots = &lots
+	}
+
+	rec := &memTender{tender: *t, creatorUsername: creatorUsername, versions: []Tender{*t}}
+	s.tenders[t.Id] = rec
+	if err := s.recordTender(EventTypeTenderCreated, rec); err != nil {
+		return nil, err
+	}
+	cp := *t
+	return &cp, nil
+}
+
+func (s *MemoryStorage) appendTenderVersion(t *memTender, v Tender) {
+	v.Id, v.Status, v.OrganizationId, v.CreatedAt = t.tender.Id, t.tender.Status, t.tender.OrganizationId, t.tender.CreatedAt
+	v.Sealed, v.RevealedAt, v.Auction, v.Criteria, v.Lots, v.Visibility =
+		t.tender.Sealed, t.tender.RevealedAt, t.tender.Auction, t.tender.Criteria, t.tender.Lots, t.tender.Visibility
+	v.Version = int32(len(t.versions) + 1)
+	t.versions = append(t.versions, v)
+	t.tender = v
+}
+
+func (s *MemoryStorage) UpdateTenderById(id string, upd EditTenderJSONRequestBody) (*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[id]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	v := t.tender
+	if upd.Name != nil {
+		v.Name = *upd.Name
+	}
+	if upd.Description != nil {
+		v.Description = *upd.Description
+	}
+	if upd.ServiceType != nil {
+		v.ServiceType = *upd.ServiceType
+	}
+	if upd.SubmissionDeadline != nil {
+		v.SubmissionDeadline = upd.SubmissionDeadline
+	}
+	if upd.PublishAt != nil {
+		v.PublishAt = upd.PublishAt
+	}
+	if upd.Budget != nil {
+		v.Budget = upd.Budget
+	}
+	s.appendTenderVersion(t, v)
+	if err := s.recordTender(EventTypeTenderUpdated, t); err != nil {
+		return nil, err
+	}
+
+	cp := t.tender
+	return &cp, nil
+}
+
+func (s *MemoryStorage) UpdateTenderStatus(id string, status TenderStatus) (*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[id]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+	if t.tender.Status != status {
+		t.tender.Status = status
+		if err := s.recordTender(tenderStatusEvent(status), t); err != nil {
+			return nil, err
+		}
+	}
+
+	cp := t.tender
+	return &cp, nil
+}
+
+func (s *MemoryStorage) RollbackTender(id string, version int32) (*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[id]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+	if version < 1 || int(version) > len(t.versions) {
+		return nil, ErrVersionNotFound
+	}
+	s.appendTenderVersion(t, t.versions[version-1])
+	if err := s.recordTender(EventTypeTenderUpdated, t); err != nil {
+		return nil, err
+	}
+
+	cp := t.tender
+	return &cp, nil
+}
+
+func (s *MemoryStorage) PublishDueTenders(now time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []string
+	for _, t := range s.tenders {
+		if t.tender.Status == TenderStatusCreated && t.tender.PublishAt != nil && !t.tender.PublishAt.After(now) {
+			t.tender.Status = TenderStatusPublished
+			if err := s.recordTender(EventTypeTenderPublished, t); err != nil {
+				return nil, err
+			}
+			ids = append(ids, t.tender.Id)
+		}
+	}
+	return ids, nil
+}
+
+func (s *MemoryStorage) CloseExpiredTenders(now time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []string
+	for _, t := range s.tenders {
+		if t.tender.Status != TenderStatusPublished || hasOpenLots(&t.tender) {
+			continue
+		}
+		expired := t.tender.SubmissionDeadline != nil && !t.tender.SubmissionDeadline.After(now)
+		if t.tender.Auction != nil && !t.tender.Auction.EndAt.After(now) {
+			expired = true
+		}
+		if expired {
+			t.tender.Status = TenderStatusClosed
+			if err := s.recordTender(EventTypeTenderClosed, t); err != nil {
+				return nil, err
+			}
+			ids = append(ids, t.tender.Id)
+		}
+	}
+	return ids, nil
+}
+
+func (s *MemoryStorage) RevealDueTenders(now time.Time) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var ids []string
+	for _, t := range s.tenders {
+		if !t.tender.Sealed || t.tender.RevealedAt != nil {
+			continue
+		}
+		switch {
+		case t.tender.SubmissionDeadline != nil && !t.tender.SubmissionDeadline.After(now):
+			s.reveal(t, RevealTriggerDeadline)
+		case t.tender.Status == TenderStatusClosed:
+			s.reveal(t, RevealTriggerClosed)
+		default:
+			continue
+		}
+		ids = append(ids, t.tender.Id)
+	}
+	return ids, nil
+}
+
+func (s *MemoryStorage) RevealBids(tenderId string, trigger RevealTrigger) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[tenderId]
+	if !ok {
+		return false, ErrTenderNotFound
+	}
+	if !t.tender.Sealed || t.tender.RevealedAt != nil {
+		return false, nil
+	}
+	s.reveal(t, trigger)
+	return true, nil
+}
+
+func (s *MemoryStorage) reveal(t *memTender, trigger RevealTrigger) {
+	bids := 0
+	for _, b := range s.bids {
+		if b.bid.TenderId == t.tender.Id {
+			bids++
+		}
+	}
+	revealedAt := time.Now().UTC()
+	t.tender.RevealedAt = &revealedAt
+	s.reveals = append(s.reveals, memReveal{tenderId: t.tender.Id, trigger: trigger, bids: bids})
+}
+
+func (s *MemoryStorage) GetBidById(id string) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[id]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+	cp := b.bid
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetBidsByTenderId(tenderId string, filter BidFilter, limit, offset int32) ([]*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	bids := []*Bid{}
+	for _, b := range s.bids {
+		if b.bid.TenderId != tenderId || b.bid.Status != BidStatusPublished || !matchBid(&b.bid, filter) {
+			continue
+		}
+		cp := b.bid
+		bids = append(bids, &cp)
+	}
+	sortBids(bids, filter)
+
+	return paginate(bids, limit, offset), nil
+}
+
+func (s *MemoryStorage) GetBidsByTenderIds(tenderIds []string) (map[string][]*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string][]*Bid{}
+	for _, b := range s.bids {
+		if b.bid.Status == BidStatusPublished && slices.Contains(tenderIds, b.bid.TenderId) {
+			cp := b.bid
+			result[cp.TenderId] = append(result[cp.TenderId], &cp)
+		}
+	}
+	for _, bids := range result {
+		sortBids(bids, BidFilter{})
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) TenderHasBids(tenderId string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range s.bids {
+		if b.bid.TenderId == tenderId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *MemoryStorage) GetOrganizationBidAuthors(organizationId string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	authorIds := []string{}
+	for _, b := range s.bids {
+		if b.organizationId == organizationId && !slices.Contains(authorIds, b.bid.AuthorId) {
+			authorIds = append(authorIds, b.bid.AuthorId)
+		}
+	}
+	slices.Sort(authorIds)
+	return authorIds, nil
+}
+
+// GetOrganizationReputations and GetAuthorReputations sum up the bids in
+// Go, see sumReputations; PostgresStorage does it in the database.
+func (s *MemoryStorage) GetOrganizationReputations(organizationIds []string) (map[string]Reputation, error) {
+	s.mu.Lock()
+	subjects := map[string][]*Bid{}
+	for _, b := range s.bids {
+		if b.organizationId != "" && slices.Contains(organizationIds, b.organizationId) {
+			cp := b.bid
+			subjects[b.organizationId] = append(subjects[b.organizationId], &cp)
+		}
+	}
+	s.mu.Unlock()
+
+	return sumReputations(s, subjects)
+}
+
+func (s *MemoryStorage) GetAuthorReputations(authorIds []string) (map[string]Reputation, error) {
+	s.mu.Lock()
+	subjects := map[string][]*Bid{}
+	for _, b := range s.bids {
+		if slices.Contains(authorIds, b.bid.AuthorId) {
+			cp := b.bid
+			subjects[cp.AuthorId] = append(subjects[cp.AuthorId], &cp)
+		}
+	}
+	s.mu.Unlock()
+
+	return sumReputations(s, subjects)
+}
+
+func (s *MemoryStorage) GetBidsByUsername(username string, filter BidFilter, limit, offset int32) ([]*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bids := []*Bid{}
+	for _, b := range s.bids {
+		if b.creatorUsername != username || !matchBid(&b.bid, filter) {
+			continue
+		}
+		cp := b.bid
+		bids = append(bids, &cp)
+	}
+	sortBids(bids, filter)
+
+	return paginate(bids, limit, offset), nil
+}
+
+// SearchBids finds the bids PostgresStorage.SearchBids would, with
+// searchText in place of the Postgres full-text search.
+func (s *MemoryStorage) SearchBids(query string, filter SearchFilter, limit, offset int32) ([]*BidSearchHit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.userByUsername(filter.Viewer)
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+	org := s.responsibles[u.Id]
+
+	hits := []*BidSearchHit{}
+	for _, b := range s.bids {
+		t := &s.tenders[b.bid.TenderId].tender
+		if filter.TenderId != "" && t.Id != filter.TenderId || t.Sealed && t.RevealedAt == nil {
+			continue
+		}
+		manages := b.bid.AuthorId == u.Id || org != "" && b.organizationId == org
+		reviews := org != "" && t.OrganizationId == org && b.bid.Status == BidStatusPublished
+		if !manages && !reviews {
+			continue
+		}
+		rank, snippet, ok := searchText(query, b.bid.Name, b.bid.Description)
+		if !ok {
+			continue
+		}
+		hits = append(hits, &BidSearchHit{Bid: b.bid, Rank: rank, Snippet: snippet})
+	}
+	slices.SortFunc(hits, func(a, b *BidSearchHit) int {
+		return cmp.Or(cmp.Compare(b.Rank, a.Rank), cmp.Compare(a.Bid.Name, b.Bid.Name), cmp.Compare(a.Bid.Id, b.Bid.Id))
+	})
+
+	return paginate(hits, limit, offset), nil
+}
+
+func (s *MemoryStorage) CreateBid(b *Bid) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.checkNewBid(b); err != nil {
+		return nil, err
+	}
+	return s.createBid(b)
+}
+
+// CreateBidBatch checks every bid before creating any, so that they are all
+// created or none is.
+func (s *MemoryStorage) CreateBidBatch(bids []*Bid) ([]*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, b := range bids {
+		if err := s.checkNewBid(b); err != nil {
+			return nil, err
+		}
+	}
+	created := make([]*Bid, len(bids))
+	for i, b := range bids {
+		var err error
+		if created[i], err = s.createBid(b); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+func (s *MemoryStorage) checkNewBid(b *Bid) error {
+	if _, ok := s.users[b.AuthorId]; !ok {
+		return ErrUserNotFound
+	}
+	if _, ok := s.tenders[b.TenderId]; !ok {
+		return ErrTenderNotFound
+	}
+	return nil
+}
+
+func (s *MemoryStorage) createBid(b *Bid) (*Bid, error) {
+	author := s.users[b.AuthorId]
+	b.Id = uuid.NewString()
+	b.Status = BidStatusCreated
+	b.Version = 1
+	b.CreatedAt = now()
+
+	rec := &memBid{bid: *b, creatorUsername: author.Username, versions: []Bid{*b}, versionAt: time.Now()}
+	if b.AuthorType == BidAuthorTypeOrganization {
+		rec.organizationId = s.responsibles[author.Id]
+	}
+	s.bids[b.Id] = rec
+	if err := s.recordBid(EventTypeBidCreated, rec, nil); err != nil {
+		return nil, err
+	}
+
+	cp := *b
+	return &cp, nil
+}
+
+func (s *MemoryStorage) appendBidVersion(b *memBid, v Bid) {
+	v.Id, v.Status, v.TenderId, v.AuthorType, v.AuthorId, v.CreatedAt =
+		b.bid.Id, b.bid.Status, b.bid.TenderId, b.bid.AuthorType, b.bid.AuthorId, b.bid.CreatedAt
+	v.LotIds = b.bid.LotIds
+	v.Version = int32(len(b.versions) + 1)
+	b.versions = append(b.versions, v)
+	b.bid = v
+	b.versionAt = time.Now()
+}
+
+// PlaceAuctionBid appends a version with the new price. The storage mutex
+// serializes the prices placed on a tender.
+func (s *MemoryStorage) PlaceAuctionBid(id string, price Money, now time.Time) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[id]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+	t, ok := s.tenders[b.bid.TenderId]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	var best *Money
+	for _, st := range s.standings(t.tender.Id) {
+		best = st.Price
+		break
+	}
+	endAt, err := auctionBid(&t.tender, price, best, now)
+	if err != nil {
+		return nil, err
+	}
+
+	v := b.bid
+	v.Price, v.Currency = &price, &t.tender.Budget.Currency
+	s.appendBidVersion(b, v)
+	auction := *t.tender.Auction
+	auction.EndAt = endAt
+	t.tender.Auction = &auction
+	if err := s.recordBid(EventTypeBidUpdated, b, nil); err != nil {
+		return nil, err
+	}
+
+	cp := b.bid
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetAuctionStandings(tenderId string) ([]AuctionStanding, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+	return s.standings(tenderId), nil
+}
+
+// standings orders the published bids of a tender by price, unpriced last,
+// and then by the time of their latest version.
+func (s *MemoryStorage) standings(tenderId string) []AuctionStanding {
+	var bids []*memBid
+	for _, b := range s.bids {
+		if b.bid.TenderId == tenderId && b.bid.Status == BidStatusPublished {
+			bids = append(bids, b)
+		}
+	}
+	sort.SliceStable(bids, func(i, j int) bool {
+		if c := compareKeys(bids[i].bid.Price, bids[j].bid.Price, compareMoney, false); c != 0 {
+			return c < 0
+		}
+		return bids[i].versionAt.Before(bids[j].versionAt)
+	})
+
+	standings := make([]AuctionStanding, 0, len(bids))
+	for _, b := range bids {
+		standings = append(standings, AuctionStanding{BidId: b.bid.Id, Username: b.creatorUsername, Price: b.bid.Price})
+	}
+	return standings
+}
+
+func (s *MemoryStorage) UpdateBidById(id string, upd EditBidJSONRequestBody) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[id]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+
+	v := b.bid
+	if upd.Name != nil {
+		v.Name = *upd.Name
+	}
+	if upd.Description != nil {
+		v.Description = *upd.Description
+	}
+	if upd.Price != nil {
+		v.Price = upd.Price
+	}
+	if upd.Currency != nil {
+		v.Currency = upd.Currency
+	}
+	if upd.DeliveryDays != nil {
+		v.DeliveryDays = upd.DeliveryDays
+	}
+	s.appendBidVersion(b, v)
+	if err := s.recordBid(EventTypeBidUpdated, b, nil); err != nil {
+		return nil, err
+	}
+
+	cp := b.bid
+	return &cp, nil
+}
+
+func (s *MemoryStorage) UpdateBidStatus(id string, status BidStatus) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[id]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+	if b.bid.Status != status {
+		b.bid.Status = status
+		if err := s.recordBid(bidStatusEvent(status), b, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	cp := b.bid
+	return &cp, nil
+}
+
+func (s *MemoryStorage) RollbackBid(id string, version int32) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[id]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+	if version < 1 || int(version) > len(b.versions) {
+		return nil, ErrVersionNotFound
+	}
+	s.appendBidVersion(b, b.versions[version-1])
+	if err := s.recordBid(EventTypeBidUpdated, b, nil); err != nil {
+		return nil, err
+	}
+
+	cp := b.bid
+	return &cp, nil
+}
+
+// SubmitBidDecision records a decision on the bid, or on one of its lots
+// when lotId is set. An approved lot is awarded to the bid.
+func (s *MemoryStorage) SubmitBidDecision(bidId, username string, decision BidDecision, lotId string) (*Bid, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[bidId]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+
+	var decisions []BidDecision
+	for _, d := range s.decisions[bidId] {
+		if d.lotId != lotId {
+			continue
+		}
+		if d.username == username {
+			return nil, ErrDecisionExists
+		}
+		decisions = append(decisions, d.decision)
+	}
+	s.decisions[bidId] = append(s.decisions[bidId], memDecision{
+		username: username, lotId: lotId, decision: decision, at: time.Now(),
+	})
+
+	t := s.tenders[b.bid.TenderId]
+	responsibles := 0
+	for _, org := range s.responsibles {
+		if org == t.tender.OrganizationId {
+			responsibles++
+		}
+	}
+
+	outcome := settledOutcome(decisions, decision, responsibles)
+	err := s.recordBid(EventTypeBidDecision, b, func(bid any) any {
+		event := bidDecisionEvent{Bid: bid, Decision: decision, Username: username, Outcome: outcome}
+		if lotId != "" {
+			event.LotId = &lotId
+		}
+		return event
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if outcome == BidDecisionApproved {
+		if lotId != "" {
+			if err := s.settleLot(t, lotId, TenderLotStatusAwarded, bidId); err != nil {
+				return nil, err
+			}
+		} else if t.tender.Status != TenderStatusClosed {
+			// A tender past its deadline is already closed when its bids are
+			// decided on.
+			t.tender.Status = TenderStatusClosed
+			if err := s.recordTender(EventTypeTenderClosed, t); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	cp := b.bid
+	return &cp, nil
+}
+
+func (s *MemoryStorage) CancelTenderLot(tenderId, lotId string) (*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[tenderId]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+	if err := s.settleLot(t, lotId, TenderLotStatusCanceled, ""); err != nil {
+		return nil, err
+	}
+
+	cp := t.tender
+	return &cp, nil
+}
+
+// settleLot awards or cancels an open lot and closes the tender once none
+// of its lots is open, recording tender.closed. The lots are copied, so
+// tenders handed out before keep their state.
+func (s *MemoryStorage) settleLot(t *memTender, lotId string, status TenderLotStatus, bidId string) error {
+	lot := findLot(&t.tender, lotId)
+	if lot == nil {
+		return ErrLotNotFound
+	}
+	if lot.Status != TenderLotStatusOpen {
+		return ErrLotSettled
+	}
+
+	lots := append([]TenderLot(nil), *t.tender.Lots...)
+	for i := range lots {
+		if lots[i].Id == lotId {
+			lots[i].Status = status
+			if bidId != "" {
+				lots[i].AwardedBidId = &bidId
+			}
+		}
+	}
+	t.tender.Lots = &lots
+
+	if !hasOpenLots(&t.tender) && t.tender.Status != TenderStatusClosed {
+		t.tender.Status = TenderStatusClosed
+		return s.recordTender(EventTypeTenderClosed, t)
+	}
+	return nil
+}
+
+func (s *MemoryStorage) SubmitBidScores(bidId, username string, scores []CriterionScore) (*BidScorecard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bids[bidId]; !ok {
+		return nil, ErrBidNotFound
+	}
+	if s.decided(bidId, username) {
+		return nil, ErrScoresLocked
+	}
+
+	card := BidScorecard{
+		BidId:            bidId,
+		ReviewerUsername: username,
+		Version:          1,
+		Scores:           append([]CriterionScore(nil), scores...),
+		CreatedAt:        time.Now().Format(time.RFC3339),
+	}
+	for _, c := range s.scores[bidId] {
+		if c.ReviewerUsername == username {
+			card.Version = c.Version + 1
+		}
+	}
+	s.scores[bidId] = append(s.scores[bidId], card)
+
+	return &card, nil
+}
+
+func (s *MemoryStorage) GetBidScorecards(bidId string) ([]BidScorecard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bids[bidId]; !ok {
+		return nil, ErrBidNotFound
+	}
+	return s.latestScorecards(bidId), nil
+}
+
+func (s *MemoryStorage) GetTenderScorecards(tenderId string) ([]BidScorecard, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	cards := []BidScorecard{}
+	for _, b := range s.bids {
+		if b.bid.TenderId == tenderId && b.bid.Status == BidStatusPublished {
+			cards = append(cards, s.latestScorecards(b.bid.Id)...)
+		}
+	}
+	return cards, nil
+}
+
+// latestScorecards returns the latest scorecard of every reviewer of a bid,
+// ordered by reviewer.
+func (s *MemoryStorage) latestScorecards(bidId string) []BidScorecard {
+	latest := map[string]BidScorecard{}
+	for _, c := range s.scores[bidId] {
+		latest[c.ReviewerUsername] = c
+	}
+
+	cards := make([]BidScorecard, 0, len(latest))
+	for _, c := range latest {
+		c.Locked = s.decided(bidId, c.ReviewerUsername)
+		cards = append(cards, c)
+	}
+	sort.Slice(cards, func(i, j int) bool { return cards[i].ReviewerUsername < cards[j].ReviewerUsername })
+	return cards
+}
+
+func (s *MemoryStorage) GetPendingDeciders(bidId, lotId string) ([]*User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[bidId]
+	if !ok {
+		return nil, ErrBidNotFound
+	}
+	responsibles := s.organizationResponsibles(s.tenders[b.bid.TenderId].tender.OrganizationId)
+
+	decided := map[string]bool{}
+	var decisions []BidDecision
+	for _, d := range s.decisions[bidId] {
+		if d.lotId == lotId {
+			decided[d.username] = true
+			decisions = append(decisions, d.decision)
+		}
+	}
+	if bidDecisionOutcome(decisions, len(responsibles)) != "" {
+		return []*User{}, nil
+	}
+	return slices.DeleteFunc(responsibles, func(u *User) bool { return decided[u.Username] }), nil
+}
+
+func (s *MemoryStorage) decided(bidId, username string) bool {
+	for _, d := range s.decisions[bidId] {
+		if d.username == username {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MemoryStorage) CreateReviewOnBid(bidId, username, comment string, rating *BidRating, onTime *BidOnTime) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.bids[bidId]
+	if !ok {
+		return ErrBidNotFound
+	}
+
+	s.reviews = append(s.reviews, &memReview{
+		review:   BidReview{Id: uuid.NewString(), Description: comment, Rating: rating, OnTime: onTime, CreatedAt: now()},
+		bidId:    bidId,
+		username: username,
+		at:       time.Now(),
+	})
+	return s.recordBid(EventTypeFeedbackCreated, b, func(bid any) any {
+		return bidFeedbackEvent{Bid: bid, Feedback: comment, Rating: rating, OnTime: onTime, Username: username}
+	})
+}
+
+func (s *MemoryStorage) CountTenderActivity(tenderIds []string) (map[string]ActivityCounts, error) {
+	return s.countActivity(tenderIds, func(b *memBid) string { return b.bid.TenderId })
+}
+
+func (s *MemoryStorage) CountBidActivity(bidIds []string) (map[string]ActivityCounts, error) {
+	return s.countActivity(bidIds, func(b *memBid) string { return b.bid.Id })
+}
+
+// countActivity sums the decisions and reviews of the bids under the ids
+// key gives them.
+func (s *MemoryStorage) countActivity(ids []string, key func(*memBid) string) (map[string]ActivityCounts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	counts := map[string]ActivityCounts{}
+	for _, id := range ids {
+		counts[id] = ActivityCounts{}
+	}
+	for bidId, decisions := range s.decisions {
+		id := key(s.bids[bidId])
+		c, ok := counts[id]
+		if !ok {
+			continue
+		}
+		for _, d := range decisions {
+			if d.decision == BidDecisionApproved {
+				c.Approvals++
+			} else {
+				c.Rejections++
+			}
+		}
+		counts[id] = c
+	}
+	for _, r := range s.reviews {
+		id := key(s.bids[r.bidId])
+		if c, ok := counts[id]; ok {
+			c.Reviews++
+			counts[id] = c
+		}
+	}
+	return counts, nil
+}
+
+func (s *MemoryStorage) GetTenderDecisions(tenderId string) ([]DecisionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	records := []DecisionRecord{}
+	for bidId, decisions := range s.decisions {
+		if s.bids[bidId].bid.TenderId != tenderId {
+			continue
+		}
+		for _, d := range decisions {
+			records = append(records, DecisionRecord{
+				BidId: bidId, LotId: d.lotId, Username: d.username, Decision: d.decision, CreatedAt: d.at,
+			})
+		}
+	}
+	slices.SortStableFunc(records, func(a, b DecisionRecord) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return records, nil
+}
+
+func (s *MemoryStorage) GetTenderReviews(tenderId string) ([]ReviewRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	records := []ReviewRecord{}
+	for _, r := range s.reviews {
+		if s.bids[r.bidId].bid.TenderId == tenderId {
+			records = append(records, r.record())
+		}
+	}
+	return records, nil
+}
+
+func (s *MemoryStorage) GetDecisionsByBidIds(bidIds []string) (map[string][]DecisionRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string][]DecisionRecord{}
+	for _, bidId := range bidIds {
+		for _, d := range s.decisions[bidId] {
+			result[bidId] = append(result[bidId], DecisionRecord{
+				BidId: bidId, LotId: d.lotId, Username: d.username, Decision: d.decision, CreatedAt: d.at,
+			})
+		}
+	}
+	return result, nil
+}
+
+func (s *MemoryStorage) GetReviewsByBidIds(bidIds []string) (map[string][]ReviewRecord, error) {
+	return s.groupReviews(bidIds, func(r *memReview) string { return r.bidId })
+}
+
+func (s *MemoryStorage) GetReviewsByAuthors(usernames []string) (map[string][]ReviewRecord, error) {
+	return s.groupReviews(usernames, func(r *memReview) string { return s.bids[r.bidId].creatorUsername })
+}
+
+// groupReviews groups the reviews under the keys key gives them, leaving
+// out those of other keys.
+func (s *MemoryStorage) groupReviews(keys []string, key func(*memReview) string) (map[string][]ReviewRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string][]ReviewRecord{}
+	for _, r := range s.reviews {
+		if k := key(r); slices.Contains(keys, k) {
+			result[k] = append(result[k], r.record())
+		}
+	}
+	return result, nil
+}
+
+func (r *memReview) record() ReviewRecord {
+	return ReviewRecord{
+		Id: r.review.Id, BidId: r.bidId, Username: r.username, Comment: r.review.Description,
+		Rating: r.review.Rating, OnTime: r.review.OnTime, CreatedAt: r.at,
+	}
+}
+
+func (s *MemoryStorage) GetReviewBids(tenderId, author string, limit, offset int32) ([]*BidReview, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	reviews := []*BidReview{}
+	bidOnTender := false
+	for _, b := range s.bids {
+		if b.creatorUsername == author && b.bid.TenderId == tenderId {
+			bidOnTender = true
+		}
+	}
+	if !bidOnTender {
+		return reviews, nil
+	}
+
+	for _, r := range s.reviews {
+		if s.bids[r.bidId].creatorUsername != author {
+			continue
+		}
+		cp := r.review
+		reviews = append(reviews, &cp)
+	}
+
+	return paginate(reviews, limit, offset), nil
+}
+
+func (s *MemoryStorage) CreateInvitation(inv *TenderInvitation) (*TenderInvitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[inv.TenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+	if inv.OrganizationId != nil {
+		if _, ok := s.organizations[*inv.OrganizationId]; !ok {
+			return nil, ErrOrganizationNotFound
+		}
+	}
+	for _, i := range s.invitations {
+		if i.TenderId == inv.TenderId && deref(i.OrganizationId) == deref(inv.OrganizationId) &&
+			deref(i.Username) == deref(inv.Username) {
+			return nil, ErrInvitationExists
+		}
+	}
+
+	cp := *inv
+	cp.Id = uuid.NewString()
+	cp.Status = InvitationStatusPending
+	cp.CreatedAt = now()
+	cp.RespondedAt = nil
+	s.invitations = append(s.invitations, &cp)
+
+	out := cp
+	return &out, nil
+}
+
+func (s *MemoryStorage) GetInvitationById(id string) (*TenderInvitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, i := range s.invitations {
+		if i.Id == id {
+			cp := *i
+			return &cp, nil
+		}
+	}
+	return nil, ErrInvitationNotFound
+}
+
+func (s *MemoryStorage) GetTenderInvitations(tenderId string, limit, offset int32) ([]*TenderInvitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	invitations := []*TenderInvitation{}
+	for _, i := range slices.Backward(s.invitations) {
+		if i.TenderId == tenderId {
+			cp := *i
+			invitations = append(invitations, &cp)
+		}
+	}
+	return paginate(invitations, limit, offset), nil
+}
+
+func (s *MemoryStorage) GetUserInvitations(username string, limit, offset int32) ([]*TenderInvitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.userByUsername(username)
+	if u == nil {
+		return nil, ErrUserNotFound
+	}
+
+	invitations := []*TenderInvitation{}
+	for _, i := range slices.Backward(s.invitations) {
+		if s.invites(i, u) {
+			cp := *i
+			invitations = append(invitations, &cp)
+		}
+	}
+	return paginate(invitations, limit, offset), nil
+}
+
+func (s *MemoryStorage) GetInvitationStatus(tenderId, username string) (InvitationStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u := s.userByUsername(username)
+	if u == nil {
+		return "", nil
+	}
+	return s.invitationStatus(tenderId, u), nil
+}
+
+func (s *MemoryStorage) RespondInvitation(id string, status InvitationStatus) (*TenderInvitation, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, i := range s.invitations {
+		if i.Id == id {
+			responded := now()
+			i.Status, i.RespondedAt = status, &responded
+			cp := *i
+			return &cp, nil
+		}
+	}
+	return nil, ErrInvitationNotFound
+}
+
+// invites reports whether the invitation covers the user, directly or
+// through the organization the user is responsible for.
+func (s *MemoryStorage) invites(i *TenderInvitation, u *User) bool {
+	if i.Username != nil {
+		return *i.Username == u.Username
+	}
+	org, ok := s.responsibles[u.Id]
+	return ok && *i.OrganizationId == org
+}
+
+// invitationStatus returns the best status among the invitations of a user
+// to a tender: accepted, then pending, then declined. It is empty when the
+// user isn't invited.
+func (s *MemoryStorage) invitationStatus(tenderId string, u *User) InvitationStatus {
+	rank := map[InvitationStatus]int{InvitationStatusDeclined: 1, InvitationStatusPending: 2, InvitationStatusAccepted: 3}
+	var best InvitationStatus
+	for _, i := range s.invitations {
+		if i.TenderId == tenderId && s.invites(i, u) && rank[i.Status] > rank[best] {
+			best = i.Status
+		}
+	}
+	return best
+}
+
+func (s *MemoryStorage) CreateQuestion(q *TenderQuestion) (*TenderQuestion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[q.TenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	cp := *q
+	cp.Id = uuid.NewString()
+	cp.Status = QuestionStatusOpen
+	cp.CreatedAt = now()
+	s.questions = append(s.questions, &cp)
+
+	out := cp
+	return &out, nil
+}
+
+func (s *MemoryStorage) GetQuestionById(id string) (*TenderQuestion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, q := range s.questions {
+		if q.Id == id {
+			cp := *q
+			return &cp, nil
+		}
+	}
+	return nil, ErrQuestionNotFound
+}
+
+func (s *MemoryStorage) GetTenderQuestions(tenderId string, filter QuestionFilter, limit, offset int32) ([]*TenderQuestion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+
+	questions := []*TenderQuestion{}
+	for _, q := range slices.Backward(s.questions) {
+		if q.TenderId != tenderId {
+			continue
+		}
+		if !filter.All && q.Status != QuestionStatusAnswered && q.AuthorUsername != filter.Username &&
+			(filter.OrganizationId == "" || deref(q.OrganizationId) != filter.OrganizationId) {
+			continue
+		}
+		cp := *q
+		questions = append(questions, &cp)
+	}
+	return paginate(questions, limit, offset), nil
+}
+
+// AnswerQuestion publishes the answer at the current tender version or, to
+// amend the tender, at a new version with the same parameters.
+func (s *MemoryStorage) AnswerQuestion(id, answer string, amend bool) (*TenderQuestion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, q := range s.questions {
+		if q.Id != id {
+			continue
+		}
+		if q.Status == QuestionStatusAnswered {
+			return nil, ErrQuestionAnswered
+		}
+
+		t := s.tenders[q.TenderId]
+		if amend {
+			s.appendTenderVersion(t, t.tender)
+		}
+		answeredAt, version := now(), t.tender.Version
+		q.Status, q.Answer, q.AnsweredAt = QuestionStatusAnswered, &answer, &answeredAt
+		q.TenderVersion, q.Amended = &version, &amend
+
+		cp := *q
+		return &cp, nil
+	}
+	return nil, ErrQuestionNotFound
+}
+
+// GetTenderVersions returns every version of a tender, newest first. Fields
+// that aren't versioned, like the status, are the current ones.
+func (s *MemoryStorage) GetTenderVersions(id string) ([]*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tenders[id]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+	return t.history(), nil
+}
+
+func (s *MemoryStorage) GetTenderVersionsByIds(ids []string) (map[string][]*Tender, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := map[string][]*Tender{}
+	for _, id := range ids {
+		if t, ok := s.tenders[id]; ok {
+			result[id] = t.history()
+		}
+	}
+	return result, nil
+}
+
+// history is every version of the tender, newest first, with the fields
+// that aren't versioned set to the current ones.
+func (t *memTender) history() []*Tender {
+	versions := make([]*Tender, 0, len(t.versions))
+	for _, v := range slices.Backward(t.versions) {
+		v.Status, v.RevealedAt, v.Lots = t.tender.Status, t.tender.RevealedAt, t.tender.Lots
+		versions = append(versions, &v)
+	}
+	return versions
+}
+
+// CreateAttachment attaches the file to the current version of its tender
+// or bid.
+func (s *MemoryStorage) CreateAttachment(a *Attachment) (*Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cp := *a
+	if a.TenderId != nil {
+		t, ok := s.tenders[*a.TenderId]
+		if !ok {
+			return nil, ErrTenderNotFound
+		}
+		cp.Version = t.tender.Version
+	} else {
+		b, ok := s.bids[*a.BidId]
+		if !ok {
+			return nil, ErrBidNotFound
+		}
+		cp.Version = b.bid.Version
+	}
+	cp.CreatedAt = now()
+	s.attachments = append(s.attachments, &cp)
+
+	out := cp
+	return &out, nil
+}
+
+func (s *MemoryStorage) GetAttachmentById(id string) (*Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, a := range s.attachments {
+		if a.Id == id {
+			cp := *a
+			return &cp, nil
+		}
+	}
+	return nil, ErrAttachmentNotFound
+}
+
+func (s *MemoryStorage) GetTenderAttachments(tenderId string, version int32) ([]*Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.tenders[tenderId]; !ok {
+		return nil, ErrTenderNotFound
+	}
+	return s.versionAttachments(func(a *Attachment) bool { return deref(a.TenderId) == tenderId }, version), nil
+}
+
+func (s *MemoryStorage) GetBidAttachments(bidId string, version int32) ([]*Attachment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.bids[bidId]; !ok {
+		return nil, ErrBidNotFound
+	}
+	return s.versionAttachments(func(a *Attachment) bool { return deref(a.BidId) == bidId }, version), nil
+}
+
+// versionAttachments lists the attachments of a version, those attached to
+// it and to the versions before. Version 0 stands for the latest one, which
+// a rollback doesn't change, see PostgresStorage.queryAttachments.
+func (s *MemoryStorage) versionAttachments(owned func(*Attachment) bool, version int32) []*Attachment {
+	attachments := []*Attachment{}
+	for _, a := range s.attachments {
+		if owned(a) && (version == 0 || a.Version <= version) {
+			cp := *a
+			attachments = append(attachments, &cp)
+		}
+	}
+	return attachments
+}
+
+func (s *MemoryStorage) CreateWebhook(w *Webhook, secret string) (*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.organizations[w.OrganizationId]; !ok {
+		return nil, ErrOrganizationNotFound
+	}
+
+	rec := &memWebhook{webhook: *w, secret: secret}
+	rec.webhook.Id = uuid.NewString()
+	rec.webhook.Secret = nil
+	rec.webhook.CreatedAt = now()
+	s.webhooks = append(s.webhooks, rec)
+
+	cp := rec.webhook
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetWebhookById(id string) (*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	w := s.webhook(id)
+	if w == nil {
+		return nil, ErrWebhookNotFound
+	}
+	cp := w.webhook
+	return &cp, nil
+}
+
+func (s *MemoryStorage) webhook(id string) *memWebhook {
+	for _, w := range s.webhooks {
+		if w.webhook.Id == id {
+			return w
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorage) GetOrganizationWebhooks(organizationId string) ([]*Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	webhooks := []*Webhook{}
+	for _, w := range slices.Backward(s.webhooks) {
+		if w.webhook.OrganizationId == organizationId {
+			cp := w.webhook
+			webhooks = append(webhooks, &cp)
+		}
+	}
+	return webhooks, nil
+}
+
+func (s *MemoryStorage) DeleteWebhook(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.webhook(id) == nil {
+		return ErrWebhookNotFound
+	}
+	s.webhooks = slices.DeleteFunc(s.webhooks, func(w *memWebhook) bool { return w.webhook.Id == id })
+	s.deliveries = slices.DeleteFunc(s.deliveries, func(d *memDelivery) bool { return d.delivery.WebhookId == id })
+	return nil
+}
+
+func (s *MemoryStorage) EnqueueWebhookEvent(eventId int64, event WebhookEvent, organizationIds []string, payload []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, w := range s.webhooks {
+		if !contains(organizationIds, w.webhook.OrganizationId) || !contains(w.webhook.Events, event) {
+			continue
+		}
+		queued := slices.ContainsFunc(s.deliveries, func(d *memDelivery) bool {
+			return d.delivery.WebhookId == w.webhook.Id && d.eventId == eventId
+		})
+		if queued {
+			continue
+		}
+		d, err := s.addDelivery(w.webhook.Id, event, payload)
+		if err != nil {
+			return err
+		}
+		d.eventId = eventId
+	}
+	return nil
+}
+
+func (s *MemoryStorage) CreateWebhookDelivery(webhookId string, event WebhookEvent, payload []byte) (*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.webhook(webhookId) == nil {
+		return nil, ErrWebhookNotFound
+	}
+	d, err := s.addDelivery(webhookId, event, payload)
+	if err != nil {
+		return nil, err
+	}
+	cp := d.delivery
+	return &cp, nil
+}
+
+func (s *MemoryStorage) addDelivery(webhookId string, event WebhookEvent, payload []byte) (*memDelivery, error) {
+	d := &memDelivery{body: payload}
+	d.delivery = WebhookDelivery{
+		Id:        uuid.NewString(),
+		WebhookId: webhookId,
+		Event:     event,
+		Status:    WebhookDeliveryStatusPending,
+		CreatedAt: now(),
+	}
+	if err := json.Unmarshal(payload, &d.delivery.Payload); err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+	d.setNextAttempt(time.Now())
+	s.deliveries = append(s.deliveries, d)
+	return d, nil
+}
+
+func (d *memDelivery) setNextAttempt(at time.Time) {
+	d.nextAttemptAt = at
+	next := at.UTC().Format(time.RFC3339)
+	d.delivery.NextAttemptAt = &next
+}
+
+func (s *MemoryStorage) GetWebhookDeliveryById(id string) (*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.delivery(id)
+	if d == nil {
+		return nil, ErrDeliveryNotFound
+	}
+	cp := d.delivery
+	return &cp, nil
+}
+
+func (s *MemoryStorage) delivery(id string) *memDelivery {
+	for _, d := range s.deliveries {
+		if d.delivery.Id == id {
+			return d
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorage) GetWebhookDeliveries(webhookId string, status WebhookDeliveryStatus, limit, offset int32) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.webhook(webhookId) == nil {
+		return nil, ErrWebhookNotFound
+	}
+
+	deliveries := []*WebhookDelivery{}
+	for _, d := range slices.Backward(s.deliveries) {
+		if d.delivery.WebhookId == webhookId && (status == "" || d.delivery.Status == status) {
+			cp := d.delivery
+			deliveries = append(deliveries, &cp)
+		}
+	}
+	return paginate(deliveries, limit, offset), nil
+}
+
+func (s *MemoryStorage) ClaimDueDeliveries(now, leaseUntil time.Time, limit int) ([]DueDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var claimed []*memDelivery
+	for _, d := range s.deliveries {
+		if d.delivery.Status == WebhookDeliveryStatusPending && !d.nextAttemptAt.After(now) {
+			claimed = append(claimed, d)
+		}
+	}
+	slices.SortStableFunc(claimed, func(a, b *memDelivery) int { return a.nextAttemptAt.Compare(b.nextAttemptAt) })
+	claimed = claimed[:min(limit, len(claimed))]
+
+	due := make([]DueDelivery, 0, len(claimed))
+	for _, d := range claimed {
+		d.setNextAttempt(leaseUntil)
+		w := s.webhook(d.delivery.WebhookId)
+		due = append(due, DueDelivery{Delivery: d.delivery, Body: d.body, URL: w.webhook.Url, Secret: w.secret})
+	}
+	return due, nil
+}
+
+func (s *MemoryStorage) RecordDeliveryAttempt(id string, attempt DeliveryAttempt, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.delivery(id)
+	if d == nil {
+		// Deleted along with its webhook while the attempt was made.
+		return nil
+	}
+
+	d.delivery.Attempts++
+	d.delivery.ResponseStatus, d.delivery.LastError, d.delivery.NextAttemptAt = nil, nil, nil
+	if attempt.ResponseStatus != 0 {
+		d.delivery.ResponseStatus = &attempt.ResponseStatus
+	}
+	if attempt.Error != "" {
+		d.delivery.LastError = &attempt.Error
+	}
+	switch {
+	case attempt.Delivered:
+		d.delivery.Status = WebhookDeliveryStatusDelivered
+		deliveredAt := at.UTC().Format(time.RFC3339)
+		d.delivery.DeliveredAt = &deliveredAt
+	case attempt.NextAttemptAt != nil:
+		d.setNextAttempt(*attempt.NextAttemptAt)
+	default:
+		d.delivery.Status = WebhookDeliveryStatusDead
+	}
+	return nil
+}
+
+func (s *MemoryStorage) RedeliverWebhookDelivery(id string) (*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d := s.delivery(id)
+	if d == nil {
+		return nil, ErrDeliveryNotFound
+	}
+	if d.delivery.Status != WebhookDeliveryStatusDead {
+		return nil, ErrDeliveryNotDead
+	}
+
+	d.delivery.Status = WebhookDeliveryStatusPending
+	d.delivery.Attempts = 0
+	d.delivery.ResponseStatus, d.delivery.LastError = nil, nil
+	d.setNextAttempt(time.Now())
+
+	cp := d.delivery
+	return &cp, nil
+}
+
+// record appends an event on an aggregate to the outbox.
+func (s *MemoryStorage) record(aggregateType EventAggregateType, aggregateId string, event EventType, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event, err)
+	}
+	s.outbox = append(s.outbox, &memOutboxEvent{event: OutboxEvent{
+		Id:            int64(len(s.outbox) + 1),
+		AggregateType: aggregateType,
+		AggregateId:   aggregateId,
+		Type:          event,
+		Payload:       payload,
+		CreatedAt:     time.Now().UTC(),
+	}})
+	return nil
+}
+
+func (s *MemoryStorage) recordTender(event EventType, t *memTender) error {
+	return s.record(EventAggregateTypeTender, t.tender.Id, event, t.tender)
+}
+
+// recordBid records an event on the bid with what bidEventData tells about
+// it, wrapped by data when it is set.
+func (s *MemoryStorage) recordBid(event EventType, b *memBid, data func(bid any) any) error {
+	payload := bidEventData(&b.bid, &s.tenders[b.bid.TenderId].tender)
+	if data != nil {
+		payload = data(payload)
+	}
+	return s.record(EventAggregateTypeBid, b.bid.Id, event, payload)
+}
+
+func (s *MemoryStorage) FetchOutbox(limit int) ([]OutboxEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := []OutboxEvent{}
+	for _, e := range s.outbox {
+		if len(events) == limit {
+			break
+		}
+		if !e.published {
+			events = append(events, e.event)
+		}
+	}
+	return events, nil
+}
+
+func (s *MemoryStorage) MarkOutboxPublished(ids []int64, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, id := range ids {
+		if id >= 1 && int(id) <= len(s.outbox) {
+			s.outbox[id-1].published = true
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorage) AppendEventLog(e LoggedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, logged := range s.eventLog {
+		if logged.Event.Id == e.Event.Id {
+			return nil
+		}
+	}
+	e.Seq = int64(len(s.eventLog) + 1)
+	s.eventLog = append(s.eventLog, e)
+	return nil
+}
+
+func (s *MemoryStorage) GetEventLog(after int64, filter EventLogFilter, limit int) ([]LoggedEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	events := []LoggedEvent{}
+	for _, e := range s.eventLog[min(max(after, 0), int64(len(s.eventLog))):] {
+		if len(events) == limit {
+			break
+		}
+		if filter.TenderId != "" && e.TenderId != filter.TenderId {
+			continue
+		}
+		if filter.OrganizationId != "" && e.OrganizationId != filter.OrganizationId {
+			continue
+		}
+		if len(filter.Types) > 0 && !contains(filter.Types, e.Event.Type) {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+func (s *MemoryStorage) GetEventLogHead() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return int64(len(s.eventLog)), nil
+}
+
+func (s *MemoryStorage) GetNotificationPreferences(username string) (*NotificationPreferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.preferences[username]
+	if !ok {
+		return &NotificationPreferences{Language: NotificationLanguageRu, Events: []NotificationEvent{}}, nil
+	}
+	return &p, nil
+}
+
+func (s *MemoryStorage) SetNotificationPreferences(username string, p NotificationPreferences) (*NotificationPreferences, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p.Events = slices.Clone(p.Events)
+	s.preferences[username] = p
+	return &p, nil
+}
+
+func (s *MemoryStorage) EnqueueNotifications(notifications []Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, n := range notifications {
+		queued := slices.ContainsFunc(s.notifications, func(q *memNotification) bool {
+			return q.notification.EventId == n.EventId && q.notification.Username == n.Username &&
+				q.notification.Event == n.Event
+		})
+		if queued {
+			continue
+		}
+		n.Id = uuid.NewString()
+		n.Attempts = 0
+		s.notifications = append(s.notifications, &memNotification{notification: n, status: notificationPending, nextAttemptAt: time.Now()})
+	}
+	return nil
+}
+
+func (s *MemoryStorage) ClaimDueNotifications(now, leaseUntil time.Time, limit int) ([]Notification, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var claimed []*memNotification
+	for _, n := range s.notifications {
+		if n.status == notificationPending && !n.nextAttemptAt.After(now) {
+			claimed = append(claimed, n)
+		}
+	}
+	slices.SortStableFunc(claimed, func(a, b *memNotification) int { return a.nextAttemptAt.Compare(b.nextAttemptAt) })
+	claimed = claimed[:min(limit, len(claimed))]
+
+	due := make([]Notification, 0, len(claimed))
+	for _, n := range claimed {
+		n.nextAttemptAt = leaseUntil
+		due = append(due, n.notification)
+	}
+	return due, nil
+}
+
+func (s *MemoryStorage) RecordNotificationAttempt(id string, attempt DeliveryAttempt, at time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, n := range s.notifications {
+		if n.notification.Id != id {
+			continue
+		}
+		n.notification.Attempts++
+		switch {
+		case attempt.Delivered:
+			n.status = notificationSent
+		case attempt.NextAttemptAt != nil:
+			n.nextAttemptAt = *attempt.NextAttemptAt
+		default:
+			n.status = notificationDead
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStorage) AppendAuditLog(e *AuditEntry) (*AuditEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prevHash := auditGenesis
+	if n := len(s.auditLog); n > 0 {
+		prevHash = s.auditLog[n-1].Hash
+	}
+	entry := *e
+	if err := chainAuditEntry(&entry, prevHash, time.Now()); err != nil {
+		return nil, err
+	}
+	entry.Id = int64(len(s.auditLog) + 1)
+	s.auditLog = append(s.auditLog, &entry)
+
+	cp := entry
+	return &cp, nil
+}
+
+func (s *MemoryStorage) GetAuditLog(filter AuditFilter, limit, offset int32) ([]*AuditEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := []*AuditEntry{}
+	for _, e := range slices.Backward(s.auditLog) {
+		at, err := time.Parse(time.RFC3339Nano, e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		if !contains(e.OrganizationIds, filter.OrganizationId) ||
+			(filter.EntityType != "" && e.EntityType != filter.EntityType) ||
+			(filter.EntityId != "" && e.EntityId != filter.EntityId) ||
+			(filter.Actor != "" && e.Actor != filter.Actor) ||
+			(filter.From != nil && at.Before(*filter.From)) ||
+			(filter.To != nil && !at.Before(*filter.To)) {
+			continue
+		}
+		cp := *e
+		entries = append(entries, &cp)
+	}
+	return paginate(entries, limit, offset), nil
+}
+
+func (s *MemoryStorage) GetAuditChain(after int64, limit int) ([]*AuditEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := []*AuditEntry{}
+	for _, e := range s.auditLog[min(max(after, 0), int64(len(s.auditLog))):] {
+		if len(entries) == limit {
+			break
+		}
+		cp := *e
+		entries = append(entries, &cp)
+	}
+	return entries, nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"math/big"
+	"net/http"
+)
+
+// Money amounts travel as decimal strings and are stored as NUMERIC(18,2),
+// so they are parsed into big.Rat instead of float64 whenever they are
+// compared.
+
+func parseMoney(m Money) (*big.Rat, bool) {
+	return new(big.Rat).SetString(m)
+}
+
+// compareMoney compares two amounts the spec pattern has already validated.
+func compareMoney(a, b Money) int {
+	x, ok := parseMoney(a)
+	if !ok {
+		x = new(big.Rat)
+	}
+	y, ok := parseMoney(b)
+	if !ok {
+		y = new(big.Rat)
+	}
+	return x.Cmp(y)
+}
+
+// subMoney returns a - b with two decimals.
+func subMoney(a, b Money) Money {
+	x, ok := parseMoney(a)
+	if !ok {
+		x = new(big.Rat)
+	}
+	y, ok := parseMoney(b)
+	if !ok {
+		y = new(big.Rat)
+	}
+	return new(big.Rat).Sub(x, y).FloatString(2)
+}
+
+// normalizeMoney rewrites an amount with exactly two decimals, the way
+// Postgres returns NUMERIC(18,2), so both storages echo the same value.
+func normalizeMoney(m *Money) error {
+	if m == nil {
+		return nil
+	}
+	r, ok := parseMoney(*m)
+	if !ok || r.Sign() < 0 {
+		return httpError(http.StatusBadRequest, "invalid amount %q", *m)
+	}
+	*m = r.FloatString(2)
+	return nil
+}
+
+// validateBudget normalizes the budget bounds and checks min <= max.
+func validateBudget(b *TenderBudget) error {
+	if b == nil {
+		return nil
+	}
+	if err := normalizeMoney(b.Min); err != nil {
+		return err
+	}
+	if err := normalizeMoney(b.Max); err != nil {
+		return err
+	}
+	if b.Min != nil && b.Max != nil && compareMoney(*b.Min, *b.Max) > 0 {
+		return httpError(http.StatusBadRequest, "budget min %s exceeds max %s", *b.Min, *b.Max)
+	}
+	return nil
+}
+
+// requireCurrencyKept checks that the tender keeps its currency under a new
+// budget once it has bids: their prices are in the currency they were made
+// in, and scoring compares them as they are.
+func (a *APIServer) requireCurrencyKept(tender *Tender, budget *TenderBudget) error {
+	if budget == nil || tender.Budget != nil && tender.Budget.Currency == budget.Currency {
+		return nil
+	}
+	hasBids, err := a.store.TenderHasBids(tender.Id)
+	if err != nil {
+		return storageError(err)
+	}
+	if hasBids {
+		return httpError(http.StatusBadRequest, "tender %s already has bids, its currency can't change to %s", tender.Id, budget.Currency)
+	}
+	return nil
+}
+
+// validateBidPrice normalizes the price and checks that it comes with a
+// currency, and that the currency is the one of the tender budget.
+func validateBidPrice(price *Money, currency *Currency, tender *Tender) error {
+	if err := normalizeMoney(price); err != nil {
+		return err
+	}
+	if price != nil && currency == nil {
+		return httpError(http.StatusBadRequest, "price requires a currency")
+	}
+	if currency != nil && tender.Budget != nil && *currency != tender.Budget.Currency {
+		return httpError(http.StatusBadRequest, "bid currency %s doesn't match tender currency %s", *currency, tender.Budget.Currency)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsTimeout bounds connecting to the server and publishing an event.
+const natsTimeout = 10 * time.Second
+
+// NATSSink publishes outbox events to a NATS server, each on the subject
+// prefix.<event type>, e.g. tenders.bid.created. It speaks the core NATS
+// text protocol over one connection and follows every PUB with a PING: the
+// server answers in order, so its PONG tells the event was taken.
+type NATSSink struct {
+	addr   string
+	prefix string
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// NewNATSSink returns a sink for the server at a nats://host:port URL. It
+// connects on the first event and reconnects after a failure.
+func NewNATSSink(serverURL, prefix string) (*NATSSink, error) {
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Scheme != "nats" || u.Host == "" {
+		return nil, fmt.Errorf("nats url %q is not of the form nats://host:port", serverURL)
+	}
+	return &NATSSink{addr: u.Host, prefix: prefix}, nil
+}
+
+func (n *NATSSink) Publish(ctx context.Context, e OutboxEvent) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to encode event: %w", err)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if err := n.publish(ctx, n.prefix+"."+string(e.Type), body); err != nil {
+		if n.conn != nil {
+			n.conn.Close()
+			n.conn = nil
+		}
+		return err
+	}
+	return nil
+}
+
+func (n *NATSSink) publish(ctx context.Context, subject string, body []byte) error {
+	if n.conn == nil {
+		if err := n.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(natsTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	n.conn.SetDeadline(deadline)
+
+	if _, err := fmt.Fprintf(n.conn, "PUB %s %d\r\n%s\r\nPING\r\n", subject, len(body), body); err != nil {
+		return fmt.Errorf("failed to publish to nats: %w", err)
+	}
+	return n.awaitPong()
+}
+
+// connect dials the server, reads its INFO and introduces the client.
+func (n *NATSSink) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: natsTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", n.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to nats: %w", err)
+	}
+	conn.SetDeadline(time.Now().Add(natsTimeout))
+
+	r := bufio.NewReader(conn)
+	line, err := r.ReadString('\n')
+	if err != nil || !strings.HasPrefix(line, "INFO ") {
+		conn.Close()
+		return fmt.Errorf("nats server did not introduce itself: %q, %v", line, err)
+	}
+	if _, err := io.WriteString(conn, `CONNECT {"verbose":false,"pedantic":false,"name":"tender-outbox"}`+"\r\n"); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	n.conn, n.r = conn, r
+	return nil
+}
+
+// awaitPong reads the server's messages up to the PONG answering our PING,
+// answering its own PINGs on the way.
+func (n *NATSSink) awaitPong() error {
+	for {
+		line, err := n.r.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read from nats: %w", err)
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := io.WriteString(n.conn, "PONG\r\n"); err != nil {
+				return fmt.Errorf("failed to answer nats: %w", err)
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New("nats: " + strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
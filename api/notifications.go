@@ -0,0 +1,381 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/mail"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	// notificationMaxAttempts is how many times a notification is sent
+	// before it is given up as dead.
+	notificationMaxAttempts = 6
+	// notificationBackoff is the delay before the first retry; it doubles
+	// with every failed attempt, about an hour over all the attempts.
+	notificationBackoff = 2 * time.Minute
+	// notificationLease hides a claimed notification from other replicas
+	// while it is being sent, like webhookLease.
+	notificationLease = 2 * smtpTimeout
+	// notificationBatch is how many notifications a tick claims.
+	notificationBatch = 50
+)
+
+// The statuses of a queued notification.
+const (
+	notificationPending = "Pending"
+	notificationSent    = "Sent"
+	notificationDead    = "Dead"
+)
+
+func (a *APIServer) getNotificationPreferences(w http.ResponseWriter, r *http.Request, params GetNotificationPreferencesParams) error {
+	if _, err := a.authenticate(params.Username); err != nil {
+		return err
+	}
+
+	preferences, err := a.store.GetNotificationPreferences(params.Username)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, preferences)
+}
+
+func (a *APIServer) setNotificationPreferences(w http.ResponseWriter, r *http.Request, params SetNotificationPreferencesParams) error {
+	var req SetNotificationPreferencesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	if req.Email != nil && *req.Email == "" {
+		req.Email = nil
+	}
+	if req.Email != nil {
+		if addr, err := mail.ParseAddress(*req.Email); err != nil || addr.Address != *req.Email {
+			return httpError(http.StatusBadRequest, "email %q is not an email address", *req.Email)
+		}
+	}
+	if len(req.Events) > 0 && req.Email == nil {
+		return httpError(http.StatusBadRequest, "an email is required to be notified of events")
+	}
+
+	if _, err := a.authenticate(params.Username); err != nil {
+		return err
+	}
+
+	var preferences *NotificationPreferences
+	err := a.atomically(func(tx *APIServer) error {
+		current, err := tx.store.GetNotificationPreferences(params.Username)
+		if err != nil {
+			return storageError(err)
+		}
+		if preferences, err = tx.store.SetNotificationPreferences(params.Username, req); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, params.Username, AuditActionSetNotificationPreferences, AuditEntityTypeNotificationPreferences,
+			params.Username, nil, current, preferences)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, preferences)
+}
+
+// notificationData is what the templates of a notification are rendered
+// with. Bid is empty while the tender is sealed.
+type notificationData struct {
+	Name     string
+	Tender   string
+	Bid      string
+	Username string
+	Feedback string
+}
+
+// notificationTemplates hold a subject and a body template per event in
+// every language, named "<event>.subject" and "<event>.body".
+var notificationTemplates = map[NotificationLanguage]*template.Template{
+	NotificationLanguageRu: template.Must(template.New("ru").Parse(`
+{{define "bid.submitted.subject"}}Новое предложение на тендер «{{.Tender}}»{{end}}
+{{define "bid.submitted.body"}}Здравствуйте, {{.Name}}!
+
+{{if .Bid}}На тендер «{{.Tender}}» опубликовано предложение «{{.Bid}}».
+{{else}}На тендер «{{.Tender}}» опубликовано новое предложение. Тендер закрытый: содержимое предложений будет доступно после вскрытия.
+{{end}}{{end}}
+
+{{define "decision.required.subject"}}Нужно ваше решение по предложению «{{.Bid}}»{{end}}
+{{define "decision.required.body"}}Здравствуйте, {{.Name}}!
+
+{{.Username}} одобрил предложение «{{.Bid}}» на тендер «{{.Tender}}». Чтобы по предложению был принят итог, нужно и ваше решение.
+{{end}}
+
+{{define "bid.approved.subject"}}Предложение «{{.Bid}}» одобрено{{end}}
+{{define "bid.approved.body"}}Здравствуйте, {{.Name}}!
+
+Предложение «{{.Bid}}» на тендер «{{.Tender}}» одобрено.
+{{end}}
+
+{{define "bid.rejected.subject"}}Предложение «{{.Bid}}» отклонено{{end}}
+{{define "bid.rejected.body"}}Здравствуйте, {{.Name}}!
+
+Предложение «{{.Bid}}» на тендер «{{.Tender}}» отклонено.
+{{end}}
+
+{{define "feedback.created.subject"}}Отзыв на предложение «{{.Bid}}»{{end}}
+{{define "feedback.created.body"}}Здравствуйте, {{.Name}}!
+
+{{.Username}} оставил отзыв на предложение «{{.Bid}}» на тендер «{{.Tender}}»:
+
+{{.Feedback}}
+{{end}}
+`)),
+	NotificationLanguageEn: template.Must(template.New("en").Parse(`
+{{define "bid.submitted.subject"}}New bid on tender "{{.Tender}}"{{end}}
+{{define "bid.submitted.body"}}Hello {{.Name}},
+
+{{if .Bid}}Bid "{{.Bid}}" has been published on tender "{{.Tender}}".
+{{else}}A new bid has been published on tender "{{.Tender}}". The tender is sealed: bids can be read once they are revealed.
+{{end}}{{end}}
+
+{{define "decision.required.subject"}}Your decision is required on bid "{{.Bid}}"{{end}}
+{{define "decision.required.body"}}Hello {{.Name}},
+
+{{.Username}} approved bid "{{.Bid}}" on tender "{{.Tender}}". The bid needs your decision too before it is settled.
+{{end}}
+
+{{define "bid.approved.subject"}}Bid "{{.Bid}}" approved{{end}}
+{{define "bid.approved.body"}}Hello {{.Name}},
+
+Bid "{{.Bid}}" on tender "{{.Tender}}" has been approved.
+{{end}}
+
+{{define "bid.rejected.subject"}}Bid "{{.Bid}}" rejected{{end}}
+{{define "bid.rejected.body"}}Hello {{.Name}},
+
+Bid "{{.Bid}}" on tender "{{.Tender}}" has been rejected.
+{{end}}
+
+{{define "feedback.created.subject"}}Feedback on bid "{{.Bid}}"{{end}}
+{{define "feedback.created.body"}}Hello {{.Name}},
+
+{{.Username}} left feedback on bid "{{.Bid}}" on tender "{{.Tender}}":
+
+{{.Feedback}}
+{{end}}
+`)),
+}
+
+// renderNotification returns the subject and the body of a notification.
+func renderNotification(language NotificationLanguage, event NotificationEvent, data notificationData) (string, string, error) {
+	tmpl, ok := notificationTemplates[language]
+	if !ok {
+		tmpl = notificationTemplates[NotificationLanguageRu]
+	}
+
+	var subject, body strings.Builder
+	if err := tmpl.ExecuteTemplate(&subject, string(event)+".subject", data); err != nil {
+		return "", "", err
+	}
+	if err := tmpl.ExecuteTemplate(&body, string(event)+".body", data); err != nil {
+		return "", "", err
+	}
+	return subject.String(), body.String(), nil
+}
+
+// NotificationSink queues the emails the relayed events call for: a
+// published bid to the responsibles of the tender, a decision still
+// awaiting others to those yet to decide, the settling decision and
+// feedback to the authors of the bid. Users get the emails they opted in to
+// in their preferences.
+type NotificationSink struct {
+	store Storage
+}
+
+func NewNotificationSink(store Storage) *NotificationSink {
+	return &NotificationSink{store: store}
+}
+
+func (ns *NotificationSink) Publish(ctx context.Context, e OutboxEvent) error {
+	switch e.Type {
+	case EventTypeBidPublished, EventTypeBidDecision, EventTypeFeedbackCreated:
+	default:
+		return nil
+	}
+
+	tender, bid, err := eventSubject(ns.store, e)
+	if errors.Is(err, ErrTenderNotFound) || errors.Is(err, ErrBidNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	data := notificationData{Tender: tender.Name, Bid: bid.Name}
+	var event NotificationEvent
+	var recipients []*User
+	switch e.Type {
+	case EventTypeBidPublished:
+		if tender.Sealed && tender.RevealedAt == nil {
+			data.Bid = ""
+		}
+		event = NotificationEventBidSubmitted
+		recipients, err = ns.store.GetOrganizationResponsibles(tender.OrganizationId)
+	case EventTypeBidDecision:
+		var decision bidDecisionEvent
+		if err := json.Unmarshal(e.Payload, &decision); err != nil {
+			return fmt.Errorf("failed to decode decision: %w", err)
+		}
+		data.Username = decision.Username
+		switch decision.Outcome {
+		case BidDecisionApproved:
+			event = NotificationEventBidApproved
+			recipients, err = ns.bidAuthors(bid)
+		case BidDecisionRejected:
+			event = NotificationEventBidRejected
+			recipients, err = ns.bidAuthors(bid)
+		default:
+			event = NotificationEventDecisionRequired
+			recipients, err = ns.store.GetPendingDeciders(bid.Id, deref(decision.LotId))
+		}
+	case EventTypeFeedbackCreated:
+		var feedback bidFeedbackEvent
+		if err := json.Unmarshal(e.Payload, &feedback); err != nil {
+			return fmt.Errorf("failed to decode feedback: %w", err)
+		}
+		data.Username, data.Feedback = feedback.Username, feedback.Feedback
+		event = NotificationEventFeedbackCreated
+		recipients, err = ns.bidAuthors(bid)
+	}
+	if err != nil {
+		return err
+	}
+
+	var notifications []Notification
+	for _, user := range recipients {
+		preferences, err := ns.store.GetNotificationPreferences(user.Username)
+		if err != nil {
+			return err
+		}
+		if preferences.Email == nil || !slices.Contains(preferences.Events, event) {
+			continue
+		}
+
+		data.Name = user.FirstName
+		if data.Name == "" {
+			data.Name = user.Username
+		}
+		subject, body, err := renderNotification(preferences.Language, event, data)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", event, err)
+		}
+		notifications = append(notifications, Notification{EventId: e.Id, Event: event, Username: user.Username,
+			Email: *preferences.Email, Subject: subject, Body: body})
+	}
+	if len(notifications) == 0 {
+		return nil
+	}
+	return ns.store.EnqueueNotifications(notifications)
+}
+
+// bidAuthors returns the user who made the bid, or the responsibles of the
+// organization it was made on behalf of.
+func (ns *NotificationSink) bidAuthors(bid *Bid) ([]*User, error) {
+	if bid.AuthorType == BidAuthorTypeUser {
+		user, err := ns.store.GetUserById(bid.AuthorId)
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []*User{user}, nil
+	}
+
+	org, err := ns.store.GetUserOrganization(bid.AuthorId)
+	if err != nil || org == "" {
+		return nil, err
+	}
+	return ns.store.GetOrganizationResponsibles(org)
+}
+
+// NotificationDispatcher sends the queued notifications and retries the
+// failed ones with exponential backoff. Replicas claim notifications from
+// the storage, so all of them can run a dispatcher.
+type NotificationDispatcher struct {
+	store    Storage
+	mailer   Mailer
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewNotificationDispatcher returns a dispatcher ticking every interval.
+func NewNotificationDispatcher(store Storage, mailer Mailer, interval time.Duration) *NotificationDispatcher {
+	return &NotificationDispatcher{
+		store:    store,
+		mailer:   mailer,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run ticks until ctx is cancelled.
+func (d *NotificationDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Tick(ctx); err != nil {
+			log.Printf("notifications: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick makes an attempt at every notification due, until none is left.
+func (d *NotificationDispatcher) Tick(ctx context.Context) error {
+	for {
+		now := d.now()
+		due, err := d.store.ClaimDueNotifications(now, now.Add(notificationLease), notificationBatch)
+		if err != nil {
+			return fmt.Errorf("failed to claim notifications: %w", err)
+		}
+
+		for _, n := range due {
+			attempt := d.send(ctx, n)
+			if err := d.store.RecordNotificationAttempt(n.Id, attempt, d.now()); err != nil {
+				return fmt.Errorf("failed to record notification %s: %w", n.Id, err)
+			}
+		}
+
+		if len(due) < notificationBatch {
+			return nil
+		}
+	}
+}
+
+// send makes an attempt at a notification and schedules the next one if it
+// fails for a reason that may pass.
+func (d *NotificationDispatcher) send(ctx context.Context, n Notification) DeliveryAttempt {
+	err := d.mailer.Send(ctx, MailMessage{Id: n.Id, To: n.Email, Subject: n.Subject, Body: n.Body})
+	if err == nil {
+		return DeliveryAttempt{Delivered: true}
+	}
+
+	attempt := DeliveryAttempt{ResponseStatus: int32(smtpReply(err)), Error: err.Error()}
+	if attempts := n.Attempts + 1; attempts < notificationMaxAttempts && attempt.ResponseStatus < 500 {
+		next := d.now().Add(notificationBackoff << (attempts - 1))
+		attempt.NextAttemptAt = &next
+	}
+	return attempt
+}
@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"net/textproto"
+	"testing"
+	"time"
+)
+
+// scriptedMailer fails the messages to an address with the errors given
+// for it, one per attempt, then sends them.
+type scriptedMailer struct {
+	errors map[string][]error
+	sent   []MailMessage
+}
+
+func (m *scriptedMailer) Send(ctx context.Context, msg MailMessage) error {
+	if errs := m.errors[msg.To]; len(errs) > 0 {
+		m.errors[msg.To] = errs[1:]
+		return errs[0]
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+func TestNotificationRetries(t *testing.T) {
+	store := NewMemoryStorage()
+	err := store.EnqueueNotifications([]Notification{
+		{EventId: 1, Event: NotificationEventBidSubmitted, Username: "owner", Email: "owner@example.com",
+			Subject: "Новое предложение", Body: "Предложение опубликовано."},
+		{EventId: 1, Event: NotificationEventBidSubmitted, Username: "gone", Email: "gone@example.com",
+			Subject: "Новое предложение", Body: "Предложение опубликовано."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An event relayed again queues nothing new.
+	if err := store.EnqueueNotifications([]Notification{{EventId: 1, Event: NotificationEventBidSubmitted,
+		Username: "owner", Email: "owner@example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	busy := &textproto.Error{Code: 451, Msg: "Try again later"}
+	mailer := &scriptedMailer{errors: map[string][]error{
+		"owner@example.com": {busy, busy},
+		"gone@example.com":  {&textproto.Error{Code: 550, Msg: "No such user"}},
+	}}
+	clock := time.Now()
+	d := NewNotificationDispatcher(store, mailer, time.Second)
+	d.now = func() time.Time { return clock }
+	tick := func() {
+		t.Helper()
+		if err := d.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tick()
+	// The rejected address is given up at once, the busy server retried.
+	if len(mailer.sent) != 0 || len(mailer.errors["gone@example.com"]) != 0 {
+		t.Fatalf("after the first tick: %+v", mailer)
+	}
+	clock = clock.Add(notificationBackoff - time.Second)
+	tick()
+	if len(mailer.errors["owner@example.com"]) != 1 {
+		t.Fatalf("retried early: %+v", mailer)
+	}
+	clock = clock.Add(time.Second)
+	tick()
+	clock = clock.Add(notificationBackoff << 1)
+	tick()
+	tick()
+	if len(mailer.sent) != 1 || mailer.sent[0].To != "owner@example.com" || mailer.sent[0].Subject != "Новое предложение" {
+		t.Fatalf("sent %+v", mailer.sent)
+	}
+
+	if due, err := store.ClaimDueNotifications(clock.Add(24*time.Hour), clock, 10); err != nil || len(due) != 0 {
+		t.Errorf("still due: %+v, %v", due, err)
+	}
+}
+
+func TestRenderNotification(t *testing.T) {
+	data := notificationData{Name: "Иван", Tender: "Ремонт дорог"}
+	for _, language := range []NotificationLanguage{NotificationLanguageRu, NotificationLanguageEn} {
+		for _, event := range []NotificationEvent{NotificationEventBidSubmitted, NotificationEventDecisionRequired,
+			NotificationEventBidApproved, NotificationEventBidRejected, NotificationEventFeedbackCreated} {
+			if subject, body, err := renderNotification(language, event, data); err != nil || subject == "" || body == "" {
+				t.Errorf("%s %s: %q, %q, %v", language, event, subject, body, err)
+			}
+		}
+	}
+
+	_, body, _ := renderNotification(NotificationLanguageEn, NotificationEventBidSubmitted, data)
+	if body != "Hello Иван,\n\nA new bid has been published on tender \"Ремонт дорог\". The tender is sealed: bids can be read once they are revealed.\n" {
+		t.Errorf("sealed bid body = %q", body)
+	}
+}
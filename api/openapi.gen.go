@@ -0,0 +1,5936 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gorilla/mux"
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionAnswerTenderQuestion       AuditAction = "answerTenderQuestion"
+	AuditActionAskTenderQuestion          AuditAction = "askTenderQuestion"
+	AuditActionCancelTenderLot            AuditAction = "cancelTenderLot"
+	AuditActionCloseTender                AuditAction = "closeTender"
+	AuditActionCreateBid                  AuditAction = "createBid"
+	AuditActionCreateTender               AuditAction = "createTender"
+	AuditActionCreateTenderInvitation     AuditAction = "createTenderInvitation"
+	AuditActionCreateWebhook              AuditAction = "createWebhook"
+	AuditActionDeleteWebhook              AuditAction = "deleteWebhook"
+	AuditActionEditBid                    AuditAction = "editBid"
+	AuditActionEditTender                 AuditAction = "editTender"
+	AuditActionPingWebhook                AuditAction = "pingWebhook"
+	AuditActionPlaceAuctionBid            AuditAction = "placeAuctionBid"
+	AuditActionPublishTender              AuditAction = "publishTender"
+	AuditActionRedeliverWebhookDelivery   AuditAction = "redeliverWebhookDelivery"
+	AuditActionRespondTenderInvitation    AuditAction = "respondTenderInvitation"
+	AuditActionRevealTenderBids           AuditAction = "revealTenderBids"
+	AuditActionRollbackBid                AuditAction = "rollbackBid"
+	AuditActionRollbackTender             AuditAction = "rollbackTender"
+	AuditActionSetNotificationPreferences AuditAction = "setNotificationPreferences"
+	AuditActionSubmitBidDecision          AuditAction = "submitBidDecision"
+	AuditActionSubmitBidFeedback          AuditAction = "submitBidFeedback"
+	AuditActionSubmitBidScores            AuditAction = "submitBidScores"
+	AuditActionUpdateBidStatus            AuditAction = "updateBidStatus"
+	AuditActionUpdateTenderStatus         AuditAction = "updateTenderStatus"
+	AuditActionUploadBidAttachment        AuditAction = "uploadBidAttachment"
+	AuditActionUploadTenderAttachment     AuditAction = "uploadTenderAttachment"
+)
+
+// Defines values for AuditEntityType.
+const (
+	AuditEntityTypeAttachment              AuditEntityType = "attachment"
+	AuditEntityTypeBid                     AuditEntityType = "bid"
+	AuditEntityTypeInvitation              AuditEntityType = "invitation"
+	AuditEntityTypeNotificationPreferences AuditEntityType = "notificationPreferences"
+	AuditEntityTypeQuestion                AuditEntityType = "question"
+	AuditEntityTypeTender                  AuditEntityType = "tender"
+	AuditEntityTypeWebhook                 AuditEntityType = "webhook"
+	AuditEntityTypeWebhookDelivery         AuditEntityType = "webhookDelivery"
+)
+
+// Defines values for BidAuthorType.
+const (
+	BidAuthorTypeOrganization BidAuthorType = "Organization"
+	BidAuthorTypeUser         BidAuthorType = "User"
+)
+
+// Defines values for BidDecision.
+const (
+	BidDecisionApproved BidDecision = "Approved"
+	BidDecisionRejected BidDecision = "Rejected"
+)
+
+// Defines values for BidSortBy.
+const (
+	BidSortByDeliveryDays BidSortBy = "deliveryDays"
+	BidSortByName         BidSortBy = "name"
+	BidSortByPrice        BidSortBy = "price"
+)
+
+// Defines values for BidStatus.
+const (
+	BidStatusCanceled  BidStatus = "Canceled"
+	BidStatusCreated   BidStatus = "Created"
+	BidStatusPublished BidStatus = "Published"
+)
+
+// Defines values for EvaluationCriterionKind.
+const (
+	EvaluationCriterionKindCustom       EvaluationCriterionKind = "custom"
+	EvaluationCriterionKindDeliveryTime EvaluationCriterionKind = "deliveryTime"
+	EvaluationCriterionKindPrice        EvaluationCriterionKind = "price"
+	EvaluationCriterionKindWarranty     EvaluationCriterionKind = "warranty"
+)
+
+// Defines values for EventAggregateType.
+const (
+	EventAggregateTypeBid    EventAggregateType = "bid"
+	EventAggregateTypeTender EventAggregateType = "tender"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeBidCanceled     EventType = "bid.canceled"
+	EventTypeBidCreated      EventType = "bid.created"
+	EventTypeBidDecision     EventType = "bid.decision"
+	EventTypeBidPublished    EventType = "bid.published"
+	EventTypeBidUpdated      EventType = "bid.updated"
+	EventTypeFeedbackCreated EventType = "feedback.created"
+	EventTypeTenderClosed    EventType = "tender.closed"
+	EventTypeTenderCreated   EventType = "tender.created"
+	EventTypeTenderPublished EventType = "tender.published"
+	EventTypeTenderUpdated   EventType = "tender.updated"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv  ExportFormat = "csv"
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// Defines values for ImportMode.
+const (
+	ImportModeAtomic ImportMode = "atomic"
+	ImportModePerRow ImportMode = "perRow"
+)
+
+// Defines values for ImportReportFormat.
+const (
+	ImportReportFormatCsv  ImportReportFormat = "csv"
+	ImportReportFormatJson ImportReportFormat = "json"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	ImportRowStatusCreated ImportRowStatus = "created"
+	ImportRowStatusFailed  ImportRowStatus = "failed"
+	ImportRowStatusValid   ImportRowStatus = "valid"
+)
+
+// Defines values for InvitationResponse.
+const (
+	InvitationResponseAccepted InvitationResponse = "Accepted"
+	InvitationResponseDeclined InvitationResponse = "Declined"
+)
+
+// Defines values for InvitationStatus.
+const (
+	InvitationStatusAccepted InvitationStatus = "Accepted"
+	InvitationStatusDeclined InvitationStatus = "Declined"
+	InvitationStatusPending  InvitationStatus = "Pending"
+)
+
+// Defines values for NotificationEvent.
+const (
+	NotificationEventBidApproved      NotificationEvent = "bid.approved"
+	NotificationEventBidRejected      NotificationEvent = "bid.rejected"
+	NotificationEventBidSubmitted     NotificationEvent = "bid.submitted"
+	NotificationEventDecisionRequired NotificationEvent = "decision.required"
+	NotificationEventFeedbackCreated  NotificationEvent = "feedback.created"
+)
+
+// Defines values for NotificationLanguage.
+const (
+	NotificationLanguageEn NotificationLanguage = "en"
+	NotificationLanguageRu NotificationLanguage = "ru"
+)
+
+// Defines values for QuestionStatus.
+const (
+	QuestionStatusAnswered QuestionStatus = "Answered"
+	QuestionStatusOpen     QuestionStatus = "Open"
+)
+
+// Defines values for SortOrder.
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Defines values for TenderLotStatus.
+const (
+	TenderLotStatusAwarded  TenderLotStatus = "Awarded"
+	TenderLotStatusCanceled TenderLotStatus = "Canceled"
+	TenderLotStatusOpen     TenderLotStatus = "Open"
+)
+
+// Defines values for TenderServiceType.
+const (
+	TenderServiceTypeConstruction TenderServiceType = "Construction"
+	TenderServiceTypeDelivery     TenderServiceType = "Delivery"
+	TenderServiceTypeManufacture  TenderServiceType = "Manufacture"
+)
+
+// Defines values for TenderSortBy.
+const (
+	TenderSortByBudgetMax TenderSortBy = "budgetMax"
+	TenderSortByBudgetMin TenderSortBy = "budgetMin"
+	TenderSortByName      TenderSortBy = "name"
+)
+
+// Defines values for TenderStatus.
+const (
+	TenderStatusClosed    TenderStatus = "Closed"
+	TenderStatusCreated   TenderStatus = "Created"
+	TenderStatusPublished TenderStatus = "Published"
+)
+
+// Defines values for TenderVisibility.
+const (
+	TenderVisibilityPrivate TenderVisibility = "private"
+	TenderVisibilityPublic  TenderVisibility = "public"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "Dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "Delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "Pending"
+)
+
+// Defines values for WebhookEvent.
+const (
+	WebhookEventBidCreated      WebhookEvent = "bid.created"
+	WebhookEventBidDecision     WebhookEvent = "bid.decision"
+	WebhookEventFeedbackCreated WebhookEvent = "feedback.created"
+	WebhookEventTenderClosed    WebhookEvent = "tender.closed"
+	WebhookEventTenderPublished WebhookEvent = "tender.published"
+	WebhookEventWebhookPing     WebhookEvent = "webhook.ping"
+)
+
+// Attachment Файл, прикрепленный к версии тендера или предложения.
+type Attachment struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId *BidId `json:"bidId,omitempty"`
+
+	// ContentType MIME-тип файла
+	ContentType string `json:"contentType"`
+
+	// CreatedAt Серверная дата и время загрузки.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Id Уникальный идентификатор вложения, присвоенный сервером.
+	Id AttachmentId `json:"id"`
+
+	// Name Имя файла
+	Name string `json:"name"`
+
+	// Sha256 Контрольная сумма SHA-256 файла в шестнадцатеричном виде
+	Sha256 string `json:"sha256"`
+
+	// Size Размер файла в байтах
+	Size int64 `json:"size"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId *TenderId `json:"tenderId,omitempty"`
+
+	// UploaderUsername Уникальный slug пользователя.
+	UploaderUsername Username `json:"uploaderUsername"`
+
+	// Version Версия тендера или предложения, к которой прикреплен файл.
+	Version int32 `json:"version"`
+}
+
+// AttachmentId Уникальный идентификатор вложения, присвоенный сервером.
+type AttachmentId = string
+
+// AuctionLeaderboard Таблица лидеров аукциона, лучшая (наименьшая) цена первой.
+type AuctionLeaderboard struct {
+	// EndAt Текущее время окончания аукциона с учетом продлений.
+	EndAt   time.Time                 `json:"endAt"`
+	Entries []AuctionLeaderboardEntry `json:"entries"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// AuctionLeaderboardEntry defines model for auctionLeaderboardEntry.
+type AuctionLeaderboardEntry struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// Own Предложение принадлежит запросившему пользователю.
+	Own bool `json:"own"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price Money `json:"price"`
+	Rank  int32 `json:"rank"`
+}
+
+// AuditAction Изменение, записанное в журнал аудита, — идентификатор операции API, которой оно сделано.
+// Изменения, которые делает планировщик, записываются от имени `scheduler` без `requestId` и `ip`:
+// публикация тендера (`publishTender`), закрытие по сроку (`closeTender`) и раскрытие закрытых предложений (`revealTenderBids`).
+// Если закрытые предложения раскрываются при чтении, до планировщика, раскрытие записывается от имени `system`;
+// в `after` поле `revealTrigger` говорит, чем оно вызвано: `deadline` — истек срок подачи, `closed` — тендер закрыт.
+type AuditAction string
+
+// AuditEntityType Тип измененного объекта.
+type AuditEntityType string
+
+// AuditEntry Запись журнала аудита.
+//
+// Хеш записи — SHA-256 в шестнадцатеричном виде от JSON-объекта с полями `prevHash`, `actor`, `action`,
+// `entityType`, `entityId`, `organizationIds`, `before`, `after`, `requestId`, `ip` и `createdAt`
+// в этом порядке, где `before` и `after` записаны с ключами объектов, упорядоченными по алфавиту.
+type AuditEntry struct {
+	// Action Изменение, записанное в журнал аудита, — идентификатор операции API, которой оно сделано.
+	// Изменения, которые делает планировщик, записываются от имени `scheduler` без `requestId` и `ip`:
+	// публикация тендера (`publishTender`), закрытие по сроку (`closeTender`) и раскрытие закрытых предложений (`revealTenderBids`).
+	// Если закрытые предложения раскрываются при чтении, до планировщика, раскрытие записывается от имени `system`;
+	// в `after` поле `revealTrigger` говорит, чем оно вызвано: `deadline` — истек срок подачи, `closed` — тендер закрыт.
+	Action AuditAction `json:"action"`
+
+	// Actor Уникальный slug пользователя.
+	Actor Username `json:"actor"`
+
+	// After Объект после изменения; отсутствует, если объект удален.
+	After *map[string]interface{} `json:"after"`
+
+	// Before Объект до изменения; отсутствует, если объект создан. Содержимое предложения на закрытый тендер
+	// до вскрытия не записывается, как и имя, тип, размер и контрольная сумма приложенных к нему файлов.
+	Before *map[string]interface{} `json:"before"`
+
+	// CreatedAt Серверная дата и время изменения.
+	// Передается в формате RFC3339 с долями секунды.
+	CreatedAt string `json:"createdAt"`
+
+	// EntityId Идентификатор измененного объекта; для настроек уведомлений — имя пользователя.
+	EntityId string `json:"entityId"`
+
+	// EntityType Тип измененного объекта.
+	EntityType AuditEntityType `json:"entityType"`
+
+	// Hash Хеш записи.
+	Hash string `json:"hash"`
+
+	// Id Номер записи в журнале.
+	Id int64 `json:"id"`
+
+	// Ip Адрес, с которого пришел запрос.
+	Ip string `json:"ip"`
+
+	// OrganizationIds Организации, к которым относится изменение.
+	OrganizationIds []OrganizationId `json:"organizationIds"`
+
+	// PrevHash Хеш предыдущей записи журнала; у первой записи — 64 нуля.
+	PrevHash string `json:"prevHash"`
+
+	// RequestId Идентификатор запроса из заголовка `X-Request-ID`.
+	RequestId string `json:"requestId"`
+}
+
+// AuditVerification Результат проверки журнала аудита.
+type AuditVerification struct {
+	// BrokenAt Номер первой записи, на которой цепочка нарушена.
+	BrokenAt *int64 `json:"brokenAt,omitempty"`
+
+	// Checked Сколько записей проверено.
+	Checked int64 `json:"checked"`
+
+	// Valid Цепочка хешей не нарушена.
+	Valid bool `json:"valid"`
+}
+
+// AuthorReputation Репутация сотрудника.
+type AuthorReputation struct {
+	// Reputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+	Reputation Reputation `json:"reputation"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// Bid Информация о предложении
+type Bid struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorReputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+	AuthorReputation *Reputation `json:"authorReputation,omitempty"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил предложение на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Currency Код валюты по ISO 4217.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// DeliveryDays Срок выполнения предложения в днях.
+	DeliveryDays *BidDeliveryDays `json:"deliveryDays,omitempty"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Id Уникальный идентификатор предложения, присвоенный сервером.
+	Id BidId `json:"id"`
+
+	// LotIds Лоты тендера, на которые подано предложение. Обязательны для тендеров с лотами.
+	LotIds *BidLotIds `json:"lotIds,omitempty"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price *Money `json:"price,omitempty"`
+
+	// Status Статус предложения
+	Status BidStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Version Номер версии посел правок
+	Version BidVersion `json:"version"`
+}
+
+// BidAuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+type BidAuthorId = string
+
+// BidAuthorType Тип автора
+type BidAuthorType string
+
+// BidDecision Решение по предложению
+type BidDecision string
+
+// BidDeliveryDays Срок выполнения предложения в днях.
+type BidDeliveryDays = int32
+
+// BidDescription Описание предложения
+type BidDescription = string
+
+// BidFeedback Отзыв на предложение
+type BidFeedback = string
+
+// BidId Уникальный идентификатор предложения, присвоенный сервером.
+type BidId = string
+
+// BidLotIds Лоты тендера, на которые подано предложение. Обязательны для тендеров с лотами.
+type BidLotIds = []LotId
+
+// BidName Полное название предложения
+type BidName = string
+
+// BidOnTime Выполнено ли предложение в срок.
+type BidOnTime = bool
+
+// BidRating Оценка предложения в звездах.
+type BidRating = int32
+
+// BidReview Отзыв о предложении
+type BidReview struct {
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил отзыв на предложение.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Description Описание предложения
+	Description BidReviewDescription `json:"description"`
+
+	// Id Уникальный идентификатор отзыва, присвоенный сервером.
+	Id BidReviewId `json:"id"`
+
+	// OnTime Выполнено ли предложение в срок.
+	OnTime *BidOnTime `json:"onTime,omitempty"`
+
+	// Rating Оценка предложения в звездах.
+	Rating *BidRating `json:"rating,omitempty"`
+}
+
+// BidReviewDescription Описание предложения
+type BidReviewDescription = string
+
+// BidReviewId Уникальный идентификатор отзыва, присвоенный сервером.
+type BidReviewId = string
+
+// BidScorecard Оценки предложения одним ответственным.
+type BidScorecard struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// CreatedAt Серверная дата и время сохранения этой версии оценок.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Locked Ответственный отправил решение по предложению, оценки зафиксированы.
+	Locked bool `json:"locked"`
+
+	// ReviewerUsername Уникальный slug пользователя.
+	ReviewerUsername Username         `json:"reviewerUsername"`
+	Scores           []CriterionScore `json:"scores"`
+
+	// Version Номер версии оценок этого ответственного.
+	Version int32 `json:"version"`
+}
+
+// BidSearchHit Предложение, найденное полнотекстовым поиском.
+type BidSearchHit struct {
+	// Bid Информация о предложении
+	Bid Bid `json:"bid"`
+
+	// Rank Релевантность найденного объекта запросу, чем больше, тем выше.
+	Rank SearchRank `json:"rank"`
+
+	// Snippet Фрагмент названия и описания с совпадениями, выделенными тегами `<b>`.
+	Snippet SearchSnippet `json:"snippet"`
+}
+
+// BidSortBy Поле, по которому сортируется список предложений.
+type BidSortBy string
+
+// BidStatus Статус предложения
+type BidStatus string
+
+// BidVersion Номер версии посел правок
+type BidVersion = int32
+
+// CriterionAverage defines model for criterionAverage.
+type CriterionAverage struct {
+	// Criterion Название критерия тендера.
+	Criterion string  `json:"criterion"`
+	Score     float64 `json:"score"`
+}
+
+// CriterionScore defines model for criterionScore.
+type CriterionScore struct {
+	// Criterion Название критерия тендера.
+	Criterion string `json:"criterion"`
+	Score     int32  `json:"score"`
+}
+
+// Currency Код валюты по ISO 4217.
+type Currency = string
+
+// ErrorResponse Используется для возвращения ошибки пользователю
+type ErrorResponse struct {
+	// Reason Описание ошибки в свободной форме
+	Reason string `json:"reason"`
+}
+
+// EvaluationCriterion Критерий оценки предложений.
+type EvaluationCriterion struct {
+	// Kind Что оценивает критерий. Критерии `price` и `deliveryTime` оцениваются по самим предложениям:
+	// наименьшая цена или срок поставки получает 10 баллов, остальные — долю от 10, равную отношению
+	// лучшего значения к своему, а предложение без цены или срока — 0. Для `price` тендеру нужен
+	// бюджет, чтобы все цены были в одной валюте. Критерии `warranty` и `custom` оценивают ответственные.
+	Kind EvaluationCriterionKind `json:"kind"`
+
+	// Name Название критерия, уникальное в пределах тендера.
+	Name string `json:"name"`
+
+	// Weight Вес критерия. Итоговая оценка нормируется на сумму весов.
+	Weight int32 `json:"weight"`
+}
+
+// EvaluationCriterionKind Что оценивает критерий. Критерии `price` и `deliveryTime` оцениваются по самим предложениям:
+// наименьшая цена или срок поставки получает 10 баллов, остальные — долю от 10, равную отношению
+// лучшего значения к своему, а предложение без цены или срока — 0. Для `price` тендеру нужен
+// бюджет, чтобы все цены были в одной валюте. Критерии `warranty` и `custom` оценивают ответственные.
+type EvaluationCriterionKind string
+
+// EventAggregateType Сущность, к которой относится событие.
+type EventAggregateType string
+
+// EventType Тип события в журнале изменений:
+//
+//   - `tender.created`, `tender.updated` — тендер создан, изменен или откачен к версии; `data` — тендер.
+//   - `tender.published`, `tender.closed` — тендер опубликован или закрыт; `data` — тендер.
+//   - `bid.created`, `bid.updated` — предложение создано, изменено, откачено к версии или получило новую цену
+//     на аукционе; `data` — предложение.
+//   - `bid.published`, `bid.canceled` — предложение опубликовано или отменено; `data` — предложение.
+//   - `bid.decision` — по предложению принято решение; `data` — предложение и решение.
+//   - `feedback.created` — на предложение оставлен отзыв; `data` — предложение и отзыв.
+//
+// Содержимое предложения на закрытый тендер до вскрытия не передается.
+type EventType string
+
+// ExportFormat Формат файла выгрузки.
+type ExportFormat string
+
+// GraphqlError Ошибка GraphQL-запроса.
+type GraphqlError struct {
+	// Extensions Подробности ошибки: `status` — HTTP-статус, который вернул бы REST API.
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+
+	// Message Описание ошибки.
+	Message string `json:"message"`
+
+	// Path Путь к полю, при выполнении которого произошла ошибка.
+	Path *[]interface{} `json:"path,omitempty"`
+}
+
+// GraphqlRequest GraphQL-запрос.
+type GraphqlRequest struct {
+	// OperationName Операция запроса, которую нужно выполнить, если их несколько.
+	OperationName *string `json:"operationName,omitempty"`
+
+	// Query Текст запроса.
+	Query string `json:"query"`
+
+	// Variables Значения переменных запроса.
+	Variables *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphqlResponse Результат GraphQL-запроса.
+type GraphqlResponse struct {
+	// Data Выбранные запросом данные.
+	Data   *map[string]interface{} `json:"data"`
+	Errors *[]GraphqlError         `json:"errors,omitempty"`
+}
+
+// ImportMode Режим импорта: `atomic` — все строки или ни одной, `perRow` — каждая корректная строка отдельно.
+type ImportMode string
+
+// ImportReport Отчет об импорте.
+type ImportReport struct {
+	// Committed Созданы ли строки. В режиме `atomic` с ошибками и при `dryRun` — нет.
+	Committed bool  `json:"committed"`
+	Created   int32 `json:"created"`
+	DryRun    bool  `json:"dryRun"`
+	Failed    int32 `json:"failed"`
+
+	// Mode Режим импорта: `atomic` — все строки или ни одной, `perRow` — каждая корректная строка отдельно.
+	Mode  ImportMode  `json:"mode"`
+	Rows  []ImportRow `json:"rows"`
+	Total int32       `json:"total"`
+}
+
+// ImportReportFormat Формат ответа импорта.
+type ImportReportFormat string
+
+// ImportRow Результат одной строки импорта.
+type ImportRow struct {
+	// Id Идентификатор созданного тендера или предложения.
+	Id *string `json:"id,omitempty"`
+
+	// Reason Причина, по которой строка не принята.
+	Reason *string `json:"reason,omitempty"`
+
+	// Row Номер строки данных в файле, начиная с 1. Заголовок CSV не считается.
+	Row int32 `json:"row"`
+
+	// Status Результат строки импорта:
+	//
+	// * `created` — создана
+	// * `valid` — прошла проверку, но не создана (пробный импорт или ошибки в других строках режима `atomic`)
+	// * `failed` — не прошла проверку или не создана
+	Status ImportRowStatus `json:"status"`
+}
+
+// ImportRowStatus Результат строки импорта:
+//
+// * `created` — создана
+// * `valid` — прошла проверку, но не создана (пробный импорт или ошибки в других строках режима `atomic`)
+// * `failed` — не прошла проверку или не создана
+type ImportRowStatus string
+
+// InvitationId Уникальный идентификатор приглашения, присвоенный сервером.
+type InvitationId = string
+
+// InvitationResponse Ответ на приглашение
+type InvitationResponse string
+
+// InvitationStatus Статус приглашения
+type InvitationStatus string
+
+// LotId Уникальный идентификатор лота, присвоенный сервером.
+type LotId = string
+
+// Money Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type Money = string
+
+// NotificationEvent Событие, о котором пользователь получает письмо:
+//
+//   - `bid.submitted` — на тендер организации пользователя опубликовано предложение.
+//   - `decision.required` — по предложению на тендер организации принято решение, и для итога нужно
+//     решение пользователя.
+//   - `bid.approved`, `bid.rejected` — предложение пользователя или его организации одобрено или отклонено.
+//   - `feedback.created` — на предложение пользователя или его организации оставлен отзыв.
+type NotificationEvent string
+
+// NotificationLanguage Язык писем.
+type NotificationLanguage string
+
+// NotificationPreferences Настройки уведомлений пользователя по электронной почте.
+type NotificationPreferences struct {
+	// Email Адрес, на который отправляются письма. Обязателен, если выбрано хотя бы одно событие.
+	Email *string `json:"email,omitempty"`
+
+	// Events События, о которых отправляются письма. Пустой список отключает уведомления.
+	Events []NotificationEvent `json:"events"`
+
+	// Language Язык писем.
+	Language NotificationLanguage `json:"language"`
+}
+
+// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+type OrganizationId = string
+
+// OrganizationReputation Репутация организации и сотрудников, подававших предложения от ее имени.
+type OrganizationReputation struct {
+	Authors []AuthorReputation `json:"authors"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Reputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+	Reputation Reputation `json:"reputation"`
+}
+
+// QuestionId Уникальный идентификатор вопроса, присвоенный сервером.
+type QuestionId = string
+
+// QuestionStatus Статус вопроса
+type QuestionStatus string
+
+// QuestionText Текст вопроса или ответа
+type QuestionText = string
+
+// RankedBid Место предложения в рейтинге тендера.
+type RankedBid struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// Criteria Оценки по каждому критерию: средние оценки ответственных или вычисленные по предложениям для
+	// цены и срока поставки.
+	Criteria []CriterionAverage `json:"criteria"`
+	Rank     int32              `json:"rank"`
+
+	// Reviewers Сколько ответственных оценили предложение. В рейтинг входят все опубликованные предложения.
+	Reviewers int32 `json:"reviewers"`
+
+	// Total Взвешенная итоговая оценка от 0 до 100.
+	Total float64 `json:"total"`
+}
+
+// Reputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+type Reputation struct {
+	// AverageRating Средняя оценка в отзывах, если предложения оценивались.
+	AverageRating *float64 `json:"averageRating,omitempty"`
+
+	// Bids Число опубликованных и отмененных предложений.
+	Bids int32 `json:"bids"`
+
+	// Canceled Число отмененных предложений.
+	Canceled int32 `json:"canceled"`
+
+	// CancellationRate Доля отмененных предложений.
+	CancellationRate float64 `json:"cancellationRate"`
+
+	// Late Число отзывов, в которых предложение отмечено выполненным с опозданием.
+	Late int32 `json:"late"`
+
+	// Lost Число проигранных предложений.
+	Lost int32 `json:"lost"`
+
+	// OnTime Число отзывов, в которых предложение отмечено выполненным в срок.
+	OnTime int32 `json:"onTime"`
+
+	// Ratings Число оценок.
+	Ratings int32 `json:"ratings"`
+
+	// Won Число выигранных предложений.
+	Won int32 `json:"won"`
+}
+
+// SearchRank Релевантность найденного объекта запросу, чем больше, тем выше.
+type SearchRank = float32
+
+// SearchSnippet Фрагмент названия и описания с совпадениями, выделенными тегами `<b>`.
+type SearchSnippet = string
+
+// SortOrder Направление сортировки.
+type SortOrder string
+
+// StreamEvent Событие журнала изменений.
+type StreamEvent struct {
+	// AggregateId Идентификатор тендера или предложения.
+	AggregateId string `json:"aggregateId"`
+
+	// AggregateType Сущность, к которой относится событие.
+	AggregateType EventAggregateType `json:"aggregateType"`
+
+	// Data Объект события, см. `eventType`.
+	Data map[string]interface{} `json:"data"`
+
+	// Id Идентификатор события. Событие может быть передано повторно с тем же идентификатором.
+	Id int64 `json:"id"`
+
+	// OccurredAt Серверная дата и время события.
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// Type Тип события в журнале изменений:
+	//
+	// * `tender.created`, `tender.updated` — тендер создан, изменен или откачен к версии; `data` — тендер.
+	// * `tender.published`, `tender.closed` — тендер опубликован или закрыт; `data` — тендер.
+	// * `bid.created`, `bid.updated` — предложение создано, изменено, откачено к версии или получило новую цену
+	//   на аукционе; `data` — предложение.
+	// * `bid.published`, `bid.canceled` — предложение опубликовано или отменено; `data` — предложение.
+	// * `bid.decision` — по предложению принято решение; `data` — предложение и решение.
+	// * `feedback.created` — на предложение оставлен отзыв; `data` — предложение и отзыв.
+	//
+	// Содержимое предложения на закрытый тендер до вскрытия не передается.
+	Type EventType `json:"type"`
+}
+
+// Tender Информация о тендере
+type Tender struct {
+	// Auction Параметры аукциона на понижение. Участники снижают цены своих предложений, пока аукцион идет.
+	// Тендер в формате аукциона должен иметь бюджет, ставки делаются в его валюте.
+	Auction *TenderAuction `json:"auction,omitempty"`
+
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Criteria Взвешенные критерии, по которым ответственные оценивают предложения. Задаются при создании тендера.
+	Criteria *TenderCriteria `json:"criteria,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор тендера, присвоенный сервером.
+	Id   TenderId     `json:"id"`
+	Lots *[]TenderLot `json:"lots,omitempty"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// PublishAt Момент автоматической публикации созданного тендера.
+	// Передается в формате RFC3339.
+	PublishAt *TenderPublishAt `json:"publishAt,omitempty"`
+
+	// RevealedAt Момент, когда предложения закрытого тендера были раскрыты.
+	// Передается в формате RFC3339.
+	RevealedAt *time.Time `json:"revealedAt,omitempty"`
+
+	// Sealed Закрытый тендер: предложения хранятся зашифрованными и скрыты от всех, включая ответственных за тендер,
+	// пока не наступит срок подачи предложений или тендер не будет закрыт. После этого все предложения раскрываются одновременно.
+	// Решения по раскрытым предложениям принимаются и после закрытия тендера, пока одно из них не будет одобрено.
+	Sealed TenderSealed `json:"sealed"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// SubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+	// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+	// Передается в формате RFC3339.
+	SubmissionDeadline *TenderSubmissionDeadline `json:"submissionDeadline,omitempty"`
+
+	// Version Номер версии посел правок
+	Version TenderVersion `json:"version"`
+
+	// Visibility Видимость тендера. Открытый тендер видят все. Тендер по приглашениям видят только ответственные
+	// за организацию-владельца и приглашенные, а предложения подают только принявшие приглашение.
+	Visibility TenderVisibility `json:"visibility"`
+}
+
+// TenderAuction Параметры аукциона на понижение. Участники снижают цены своих предложений, пока аукцион идет.
+// Тендер в формате аукциона должен иметь бюджет, ставки делаются в его валюте.
+type TenderAuction struct {
+	// EndAt Окончание приема ставок. Сдвигается при продлении аукциона.
+	EndAt time.Time `json:"endAt"`
+
+	// ExtensionSeconds Защита от ставок в последний момент: ставка, сделанная менее чем за указанное число секунд до окончания,
+	// переносит окончание на это же число секунд после ставки.
+	ExtensionSeconds int32 `json:"extensionSeconds"`
+
+	// MinDecrement Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	MinDecrement Money `json:"minDecrement"`
+
+	// StartAt Начало приема ставок.
+	StartAt time.Time `json:"startAt"`
+}
+
+// TenderBudget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+// валюту бюджета нельзя сменить ни правкой, ни откатом.
+type TenderBudget struct {
+	// Currency Код валюты по ISO 4217.
+	Currency Currency `json:"currency"`
+
+	// Max Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Max *Money `json:"max,omitempty"`
+
+	// Min Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Min *Money `json:"min,omitempty"`
+}
+
+// TenderCriteria Взвешенные критерии, по которым ответственные оценивают предложения. Задаются при создании тендера.
+type TenderCriteria = []EvaluationCriterion
+
+// TenderDescription Описание тендера
+type TenderDescription = string
+
+// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+type TenderId = string
+
+// TenderInvitation Приглашение организации или сотрудника в тендер.
+type TenderInvitation struct {
+	// CreatedAt Серверная дата и время создания приглашения.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Id Уникальный идентификатор приглашения, присвоенный сервером.
+	Id InvitationId `json:"id"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+
+	// RespondedAt Серверная дата и время последнего ответа на приглашение.
+	// Передается в формате RFC3339.
+	RespondedAt *string `json:"respondedAt,omitempty"`
+
+	// Status Статус приглашения
+	Status InvitationStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Username Уникальный slug пользователя.
+	Username *Username `json:"username,omitempty"`
+}
+
+// TenderLot Лот тендера, присуждаемый отдельно от остальных.
+type TenderLot struct {
+	// AwardedBidId Уникальный идентификатор предложения, присвоенный сервером.
+	AwardedBidId *BidId `json:"awardedBidId,omitempty"`
+
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор лота, присвоенный сервером.
+	Id LotId `json:"id"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// Status Статус лота
+	Status TenderLotStatus `json:"status"`
+}
+
+// TenderLotInput Данные нового лота.
+type TenderLotInput struct {
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+}
+
+// TenderLotStatus Статус лота
+type TenderLotStatus string
+
+// TenderName Полное название тендера
+type TenderName = string
+
+// TenderPublishAt Момент автоматической публикации созданного тендера.
+// Передается в формате RFC3339.
+type TenderPublishAt = time.Time
+
+// TenderQuestion Вопрос по тендеру и ответ на него.
+type TenderQuestion struct {
+	// Amended Ответ внесен в тендер отдельной версией.
+	Amended *bool `json:"amended,omitempty"`
+
+	// Answer Текст вопроса или ответа
+	Answer *QuestionText `json:"answer,omitempty"`
+
+	// AnsweredAt Серверная дата и время публикации ответа.
+	// Передается в формате RFC3339.
+	AnsweredAt *string `json:"answeredAt,omitempty"`
+
+	// AuthorUsername Уникальный slug пользователя.
+	AuthorUsername Username `json:"authorUsername"`
+
+	// CreatedAt Серверная дата и время создания вопроса.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Id Уникальный идентификатор вопроса, присвоенный сервером.
+	Id QuestionId `json:"id"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+
+	// Question Текст вопроса или ответа
+	Question QuestionText `json:"question"`
+
+	// Status Статус вопроса
+	Status QuestionStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// TenderVersion Версия тендера, в которой опубликован ответ.
+	TenderVersion *int32 `json:"tenderVersion,omitempty"`
+}
+
+// TenderRevision Версия тендера и уточнения, опубликованные в ней.
+type TenderRevision struct {
+	Clarifications []TenderQuestion `json:"clarifications"`
+
+	// Tender Информация о тендере
+	Tender Tender `json:"tender"`
+}
+
+// TenderSealed Закрытый тендер: предложения хранятся зашифрованными и скрыты от всех, включая ответственных за тендер,
+// пока не наступит срок подачи предложений или тендер не будет закрыт. После этого все предложения раскрываются одновременно.
+// Решения по раскрытым предложениям принимаются и после закрытия тендера, пока одно из них не будет одобрено.
+type TenderSealed = bool
+
+// TenderSearchHit Тендер, найденный полнотекстовым поиском.
+type TenderSearchHit struct {
+	// Rank Релевантность найденного объекта запросу, чем больше, тем выше.
+	Rank SearchRank `json:"rank"`
+
+	// Snippet Фрагмент названия и описания с совпадениями, выделенными тегами `<b>`.
+	Snippet SearchSnippet `json:"snippet"`
+
+	// Tender Информация о тендере
+	Tender Tender `json:"tender"`
+}
+
+// TenderServiceType Вид услуги, к которой относиться тендер
+type TenderServiceType string
+
+// TenderSortBy Поле, по которому сортируется список тендеров.
+type TenderSortBy string
+
+// TenderStatus Статус тендер
+type TenderStatus string
+
+// TenderSubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+// Передается в формате RFC3339.
+type TenderSubmissionDeadline = time.Time
+
+// TenderVersion Номер версии посел правок
+type TenderVersion = int32
+
+// TenderVisibility Видимость тендера. Открытый тендер видят все. Тендер по приглашениям видят только ответственные
+// за организацию-владельца и приглашенные, а предложения подают только принявшие приглашение.
+type TenderVisibility string
+
+// Username Уникальный slug пользователя.
+type Username = string
+
+// Webhook Подписка организации на события.
+type Webhook struct {
+	// CreatedAt Серверная дата и время создания подписки.
+	// Передается в формате RFC3339.
+	CreatedAt string         `json:"createdAt"`
+	Events    []WebhookEvent `json:"events"`
+
+	// Id Уникальный идентификатор подписки, присвоенный сервером.
+	Id WebhookId `json:"id"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Secret Секрет для проверки подписи доставок. Возвращается только при создании подписки.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес http или https, на который отправляются события.
+	Url WebhookUrl `json:"url"`
+}
+
+// WebhookDelivery Доставка события по подписке.
+type WebhookDelivery struct {
+	// Attempts Сколько попыток сделано.
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt Серверная дата и время события.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// DeliveredAt Дата и время успешной попытки.
+	// Передается в формате RFC3339.
+	DeliveredAt *string `json:"deliveredAt,omitempty"`
+
+	// Event Событие:
+	//
+	// * `tender.published` — тендер опубликован, `data` — тендер.
+	// * `tender.closed` — тендер закрыт, `data` — тендер.
+	// * `bid.created` — на тендер подано предложение, `data` — предложение.
+	//   Содержимое предложения на закрытый тендер до вскрытия не передается.
+	// * `bid.decision` — по предложению принято решение, `data` — предложение и решение.
+	// * `feedback.created` — на предложение оставлен отзыв, `data` — предложение и отзыв.
+	// * `webhook.ping` — проверка подписки, `data` — подписка.
+	//
+	// События тендера получает организация-владелец, события предложения — также организация,
+	// от имени которой оно подано.
+	Event WebhookEvent `json:"event"`
+
+	// Id Уникальный идентификатор доставки, присвоенный сервером.
+	Id WebhookDeliveryId `json:"id"`
+
+	// LastError Причина неудачи последней попытки.
+	LastError *string `json:"lastError,omitempty"`
+
+	// NextAttemptAt Дата и время следующей попытки, пока доставка ожидает.
+	// Передается в формате RFC3339.
+	NextAttemptAt *string `json:"nextAttemptAt,omitempty"`
+
+	// Payload Тело запроса, которым доставляется событие.
+	Payload WebhookPayload `json:"payload"`
+
+	// ResponseStatus HTTP-статус ответа на последнюю попытку.
+	ResponseStatus *int32 `json:"responseStatus,omitempty"`
+
+	// Status Статус доставки:
+	//
+	// * `Pending` — доставка ожидает очередной попытки.
+	// * `Delivered` — получатель ответил 2xx.
+	// * `Dead` — попытки исчерпаны, доставка в очереди недоставленных.
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// WebhookId Уникальный идентификатор подписки, присвоенный сервером.
+	WebhookId WebhookId `json:"webhookId"`
+}
+
+// WebhookDeliveryId Уникальный идентификатор доставки, присвоенный сервером.
+type WebhookDeliveryId = string
+
+// WebhookDeliveryStatus Статус доставки:
+//
+// * `Pending` — доставка ожидает очередной попытки.
+// * `Delivered` — получатель ответил 2xx.
+// * `Dead` — попытки исчерпаны, доставка в очереди недоставленных.
+type WebhookDeliveryStatus string
+
+// WebhookEvent Событие:
+//
+//   - `tender.published` — тендер опубликован, `data` — тендер.
+//   - `tender.closed` — тендер закрыт, `data` — тендер.
+//   - `bid.created` — на тендер подано предложение, `data` — предложение.
+//     Содержимое предложения на закрытый тендер до вскрытия не передается.
+//   - `bid.decision` — по предложению принято решение, `data` — предложение и решение.
+//   - `feedback.created` — на предложение оставлен отзыв, `data` — предложение и отзыв.
+//   - `webhook.ping` — проверка подписки, `data` — подписка.
+//
+// События тендера получает организация-владелец, события предложения — также организация,
+// от имени которой оно подано.
+type WebhookEvent string
+
+// WebhookId Уникальный идентификатор подписки, присвоенный сервером.
+type WebhookId = string
+
+// WebhookPayload Тело запроса, которым доставляется событие.
+type WebhookPayload struct {
+	// Data Объект события, см. `webhookEvent`.
+	Data map[string]interface{} `json:"data"`
+
+	// Event Событие:
+	//
+	// * `tender.published` — тендер опубликован, `data` — тендер.
+	// * `tender.closed` — тендер закрыт, `data` — тендер.
+	// * `bid.created` — на тендер подано предложение, `data` — предложение.
+	//   Содержимое предложения на закрытый тендер до вскрытия не передается.
+	// * `bid.decision` — по предложению принято решение, `data` — предложение и решение.
+	// * `feedback.created` — на предложение оставлен отзыв, `data` — предложение и отзыв.
+	// * `webhook.ping` — проверка подписки, `data` — подписка.
+	//
+	// События тендера получает организация-владелец, события предложения — также организация,
+	// от имени которой оно подано.
+	Event WebhookEvent `json:"event"`
+
+	// EventId Идентификатор события. Событие может быть доставлено повторно, в том числе
+	// в разных доставках, но всегда с тем же идентификатором: по нему получатель
+	// отбрасывает дубли. У проверки подписки (`webhook.ping`) его нет.
+	EventId *int64 `json:"eventId,omitempty"`
+
+	// OccurredAt Серверная дата и время события.
+	// Передается в формате RFC3339.
+	OccurredAt string `json:"occurredAt"`
+}
+
+// WebhookUrl Адрес http или https, на который отправляются события.
+type WebhookUrl = string
+
+// CurrencyFilter Код валюты по ISO 4217.
+type CurrencyFilter = Currency
+
+// ImportDryRun defines model for importDryRun.
+type ImportDryRun = bool
+
+// MaxBudget Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MaxBudget = Money
+
+// MaxDeliveryDays Срок выполнения предложения в днях.
+type MaxDeliveryDays = BidDeliveryDays
+
+// MaxPrice Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MaxPrice = Money
+
+// MinBudget Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MinBudget = Money
+
+// MinPrice Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MinPrice = Money
+
+// PaginationLimit defines model for paginationLimit.
+type PaginationLimit = int32
+
+// PaginationOffset defines model for paginationOffset.
+type PaginationOffset = int32
+
+// DownloadAttachmentParams defines parameters for DownloadAttachment.
+type DownloadAttachmentParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// ImportBidsParams defines parameters for ImportBids.
+type ImportBidsParams struct {
+	// Mode Режим импорта, по умолчанию `atomic`.
+	Mode *ImportMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Только проверить строки, ничего не создавая.
+	DryRun *ImportDryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// Report Формат ответа, по умолчанию JSON-отчет.
+	Report *ImportReportFormat `form:"report,omitempty" json:"report,omitempty"`
+}
+
+// GetUserBidsParams defines parameters for GetUserBids.
+type GetUserBidsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinPrice Возвращаются только предложения с ценой не меньше указанной.
+	MinPrice *MinPrice `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Возвращаются только предложения с ценой не больше указанной.
+	MaxPrice *MaxPrice `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// MaxDeliveryDays Возвращаются только предложения со сроком выполнения не больше указанного.
+	MaxDeliveryDays *MaxDeliveryDays `form:"maxDeliveryDays,omitempty" json:"maxDeliveryDays,omitempty"`
+
+	// SortBy Поле сортировки. Предложения без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *BidSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// CreateBidJSONBody defines parameters for CreateBid.
+type CreateBidJSONBody struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// Currency Код валюты по ISO 4217.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// DeliveryDays Срок выполнения предложения в днях.
+	DeliveryDays *BidDeliveryDays `json:"deliveryDays,omitempty"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// LotIds Лоты тендера, на которые подано предложение. Обязательны для тендеров с лотами.
+	LotIds *BidLotIds `json:"lotIds,omitempty"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price *Money `json:"price,omitempty"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// SearchBidsParams defines parameters for SearchBids.
+type SearchBidsParams struct {
+	// Q Поисковый запрос в синтаксисе веб-поиска: слова, фразы в кавычках, `or` и исключение через `-`.
+	// Слова приводятся к основе по правилам русского и английского языков.
+	Q string `form:"q" json:"q"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username Username          `form:"username" json:"username"`
+
+	// TenderId Искать только предложения на указанный тендер.
+	TenderId *TenderId `form:"tenderId,omitempty" json:"tenderId,omitempty"`
+}
+
+// GetBidAttachmentsParams defines parameters for GetBidAttachments.
+type GetBidAttachmentsParams struct {
+	Username Username `form:"username" json:"username"`
+	Version  *int32   `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// UploadBidAttachmentMultipartBody defines parameters for UploadBidAttachment.
+type UploadBidAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadBidAttachmentParams defines parameters for UploadBidAttachment.
+type UploadBidAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditBidJSONBody defines parameters for EditBid.
+type EditBidJSONBody struct {
+	// Currency Код валюты по ISO 4217.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// DeliveryDays Срок выполнения предложения в днях.
+	DeliveryDays *BidDeliveryDays `json:"deliveryDays,omitempty"`
+
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price *Money `json:"price,omitempty"`
+}
+
+// EditBidParams defines parameters for EditBid.
+type EditBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitBidFeedbackParams defines parameters for SubmitBidFeedback.
+type SubmitBidFeedbackParams struct {
+	BidFeedback BidFeedback `form:"bidFeedback" json:"bidFeedback"`
+	Rating      *BidRating  `form:"rating,omitempty" json:"rating,omitempty"`
+	OnTime      *BidOnTime  `form:"onTime,omitempty" json:"onTime,omitempty"`
+	Username    Username    `form:"username" json:"username"`
+}
+
+// PlaceAuctionBidParams defines parameters for PlaceAuctionBid.
+type PlaceAuctionBidParams struct {
+	Price    Money    `form:"price" json:"price"`
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackBidParams defines parameters for RollbackBid.
+type RollbackBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidScoresParams defines parameters for GetBidScores.
+type GetBidScoresParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitBidScoresJSONBody defines parameters for SubmitBidScores.
+type SubmitBidScoresJSONBody struct {
+	Scores []CriterionScore `json:"scores"`
+}
+
+// SubmitBidScoresParams defines parameters for SubmitBidScores.
+type SubmitBidScoresParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidStatusParams defines parameters for GetBidStatus.
+type GetBidStatusParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// UpdateBidStatusParams defines parameters for UpdateBidStatus.
+type UpdateBidStatusParams struct {
+	Status   BidStatus `form:"status" json:"status"`
+	Username Username  `form:"username" json:"username"`
+}
+
+// SubmitBidDecisionParams defines parameters for SubmitBidDecision.
+type SubmitBidDecisionParams struct {
+	Decision BidDecision `form:"decision" json:"decision"`
+
+	// LotId Лот, по которому принимается решение. Обязателен для тендеров с лотами: решения и присуждение
+	// принимаются по каждому лоту отдельно.
+	LotId    *LotId   `form:"lotId,omitempty" json:"lotId,omitempty"`
+	Username Username `form:"username" json:"username"`
+}
+
+// ExportBidsForTenderParams defines parameters for ExportBidsForTender.
+type ExportBidsForTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Format Формат файла выгрузки, по умолчанию CSV. Если выгрузка сорвалась после начала передачи файла,
+	// сервер разрывает соединение, не завершив ответ, так что неполный файл не принимается за целый.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinPrice Возвращаются только предложения с ценой не меньше указанной.
+	MinPrice *MinPrice `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Возвращаются только предложения с ценой не больше указанной.
+	MaxPrice *MaxPrice `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// MaxDeliveryDays Возвращаются только предложения со сроком выполнения не больше указанного.
+	MaxDeliveryDays *MaxDeliveryDays `form:"maxDeliveryDays,omitempty" json:"maxDeliveryDays,omitempty"`
+
+	// SortBy Поле сортировки. Предложения без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *BidSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// GetBidsForTenderParams defines parameters for GetBidsForTender.
+type GetBidsForTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinPrice Возвращаются только предложения с ценой не меньше указанной.
+	MinPrice *MinPrice `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Возвращаются только предложения с ценой не больше указанной.
+	MaxPrice *MaxPrice `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// MaxDeliveryDays Возвращаются только предложения со сроком выполнения не больше указанного.
+	MaxDeliveryDays *MaxDeliveryDays `form:"maxDeliveryDays,omitempty" json:"maxDeliveryDays,omitempty"`
+
+	// SortBy Поле сортировки. Предложения без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *BidSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// GetBidReviewsParams defines parameters for GetBidReviews.
+type GetBidReviewsParams struct {
+	// AuthorUsername Имя пользователя автора предложений, отзывы на которые нужно просмотреть.
+	AuthorUsername Username `form:"authorUsername" json:"authorUsername"`
+
+	// RequesterUsername Имя пользователя, который запрашивает отзывы.
+	RequesterUsername Username `form:"requesterUsername" json:"requesterUsername"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RedeliverWebhookDeliveryParams defines parameters for RedeliverWebhookDelivery.
+type RedeliverWebhookDeliveryParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// TenderId Только события указанного тендера и предложений на него.
+	TenderId *TenderId `form:"tenderId,omitempty" json:"tenderId,omitempty"`
+
+	// OrganizationId Только события тендеров указанной организации и предложений на них.
+	OrganizationId *OrganizationId `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+
+	// Type Только события указанных типов.
+	//
+	// Если список пустой, фильтр не применяется.
+	Type *[]EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// LastEventID Номер последнего полученного события, после которого продолжить поток.
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetUserInvitationsParams defines parameters for GetUserInvitations.
+type GetUserInvitationsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RespondTenderInvitationParams defines parameters for RespondTenderInvitation.
+type RespondTenderInvitationParams struct {
+	Response InvitationResponse `form:"response" json:"response"`
+	Username Username           `form:"username" json:"username"`
+}
+
+// GetNotificationPreferencesParams defines parameters for GetNotificationPreferences.
+type GetNotificationPreferencesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SetNotificationPreferencesParams defines parameters for SetNotificationPreferences.
+type SetNotificationPreferencesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// EntityType Только записи об объектах указанного типа.
+	EntityType *AuditEntityType `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Только записи об указанном объекте.
+	EntityId *string `form:"entityId,omitempty" json:"entityId,omitempty"`
+
+	// Actor Только изменения, сделанные указанным пользователем.
+	Actor *Username `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Только записи, сделанные не раньше указанного времени.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Только записи, сделанные раньше указанного времени.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// VerifyAuditLogParams defines parameters for VerifyAuditLog.
+type VerifyAuditLogParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetOrganizationReputationParams defines parameters for GetOrganizationReputation.
+type GetOrganizationReputationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetOrganizationWebhooksParams defines parameters for GetOrganizationWebhooks.
+type GetOrganizationWebhooksParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	Events []WebhookEvent `json:"events"`
+
+	// Url Адрес http или https, на который отправляются события.
+	Url WebhookUrl `json:"url"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AnswerTenderQuestionJSONBody defines parameters for AnswerTenderQuestion.
+type AnswerTenderQuestionJSONBody struct {
+	// AmendTender Создать новую версию тендера с этим уточнением.
+	AmendTender *bool `json:"amendTender,omitempty"`
+
+	// Answer Текст вопроса или ответа
+	Answer QuestionText `json:"answer"`
+}
+
+// AnswerTenderQuestionParams defines parameters for AnswerTenderQuestion.
+type AnswerTenderQuestionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTendersParams defines parameters for GetTenders.
+type GetTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Username Пользователь, от имени которого запрашивается список.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// ServiceType Возвращенные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinBudget Возвращаются только тендеры, бюджет которых допускает сумму не меньше указанной.
+	MinBudget *MinBudget `form:"minBudget,omitempty" json:"minBudget,omitempty"`
+
+	// MaxBudget Возвращаются только тендеры, бюджет которых допускает сумму не больше указанной.
+	MaxBudget *MaxBudget `form:"maxBudget,omitempty" json:"maxBudget,omitempty"`
+
+	// SortBy Поле сортировки. Тендеры без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *TenderSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder    `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ExportTendersParams defines parameters for ExportTenders.
+type ExportTendersParams struct {
+	// Format Формат файла выгрузки, по умолчанию CSV. Если выгрузка сорвалась после начала передачи файла,
+	// сервер разрывает соединение, не завершив ответ, так что неполный файл не принимается за целый.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Username Пользователь, от имени которого запрашивается выгрузка.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// ServiceType Выгруженные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinBudget Возвращаются только тендеры, бюджет которых допускает сумму не меньше указанной.
+	MinBudget *MinBudget `form:"minBudget,omitempty" json:"minBudget,omitempty"`
+
+	// MaxBudget Возвращаются только тендеры, бюджет которых допускает сумму не больше указанной.
+	MaxBudget *MaxBudget `form:"maxBudget,omitempty" json:"maxBudget,omitempty"`
+
+	// SortBy Поле сортировки. Тендеры без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *TenderSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder    `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ImportTendersParams defines parameters for ImportTenders.
+type ImportTendersParams struct {
+	// Mode Режим импорта, по умолчанию `atomic`.
+	Mode *ImportMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Только проверить строки, ничего не создавая.
+	DryRun *ImportDryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// Report Формат ответа, по умолчанию JSON-отчет.
+	Report *ImportReportFormat `form:"report,omitempty" json:"report,omitempty"`
+}
+
+// GetUserTendersParams defines parameters for GetUserTenders.
+type GetUserTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinBudget Возвращаются только тендеры, бюджет которых допускает сумму не меньше указанной.
+	MinBudget *MinBudget `form:"minBudget,omitempty" json:"minBudget,omitempty"`
+
+	// MaxBudget Возвращаются только тендеры, бюджет которых допускает сумму не больше указанной.
+	MaxBudget *MaxBudget `form:"maxBudget,omitempty" json:"maxBudget,omitempty"`
+
+	// SortBy Поле сортировки. Тендеры без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *TenderSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder    `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// CreateTenderJSONBody defines parameters for CreateTender.
+type CreateTenderJSONBody struct {
+	// Auction Параметры аукциона на понижение. Участники снижают цены своих предложений, пока аукцион идет.
+	// Тендер в формате аукциона должен иметь бюджет, ставки делаются в его валюте.
+	Auction *TenderAuction `json:"auction,omitempty"`
+
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername Username `json:"creatorUsername"`
+
+	// Criteria Взвешенные критерии, по которым ответственные оценивают предложения. Задаются при создании тендера.
+	Criteria *TenderCriteria `json:"criteria,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Lots Лоты тендера. Лоты присуждаются независимо друг от друга, тендер закрывается, когда каждый лот
+	// присужден или отменен.
+	Lots *[]TenderLotInput `json:"lots,omitempty"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// PublishAt Момент автоматической публикации созданного тендера.
+	// Передается в формате RFC3339.
+	PublishAt *TenderPublishAt `json:"publishAt,omitempty"`
+
+	// Sealed Закрытый тендер: предложения хранятся зашифрованными и скрыты от всех, включая ответственных за тендер,
+	// пока не наступит срок подачи предложений или тендер не будет закрыт. После этого все предложения раскрываются одновременно.
+	// Решения по раскрытым предложениям принимаются и после закрытия тендера, пока одно из них не будет одобрено.
+	Sealed *TenderSealed `json:"sealed,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// SubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+	// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+	// Передается в формате RFC3339.
+	SubmissionDeadline *TenderSubmissionDeadline `json:"submissionDeadline,omitempty"`
+
+	// Visibility Видимость тендера. Открытый тендер видят все. Тендер по приглашениям видят только ответственные
+	// за организацию-владельца и приглашенные, а предложения подают только принявшие приглашение.
+	Visibility *TenderVisibility `json:"visibility,omitempty"`
+}
+
+// SearchTendersParams defines parameters for SearchTenders.
+type SearchTendersParams struct {
+	// Q Поисковый запрос в синтаксисе веб-поиска: слова, фразы в кавычках, `or` и исключение через `-`.
+	// Слова приводятся к основе по правилам русского и английского языков.
+	Q string `form:"q" json:"q"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Username Пользователь, от имени которого выполняется поиск.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// Status Искать только тендеры в указанном статусе.
+	Status *TenderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// ServiceType Найденные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// OrganizationId Искать только тендеры указанной организации.
+	OrganizationId *OrganizationId `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+}
+
+// GetTenderAttachmentsParams defines parameters for GetTenderAttachments.
+type GetTenderAttachmentsParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+	Version  *int32    `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// UploadTenderAttachmentMultipartBody defines parameters for UploadTenderAttachment.
+type UploadTenderAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadTenderAttachmentParams defines parameters for UploadTenderAttachment.
+type UploadTenderAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuctionLeaderboardParams defines parameters for GetAuctionLeaderboard.
+type GetAuctionLeaderboardParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditTenderJSONBody defines parameters for EditTender.
+type EditTenderJSONBody struct {
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name *TenderName `json:"name,omitempty"`
+
+	// PublishAt Момент автоматической публикации созданного тендера.
+	// Передается в формате RFC3339.
+	PublishAt *TenderPublishAt `json:"publishAt,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+
+	// SubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+	// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+	// Передается в формате RFC3339.
+	SubmissionDeadline *TenderSubmissionDeadline `json:"submissionDeadline,omitempty"`
+}
+
+// EditTenderParams defines parameters for EditTender.
+type EditTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderHistoryParams defines parameters for GetTenderHistory.
+type GetTenderHistoryParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetTenderInvitationsParams defines parameters for GetTenderInvitations.
+type GetTenderInvitationsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateTenderInvitationJSONBody defines parameters for CreateTenderInvitation.
+type CreateTenderInvitationJSONBody struct {
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+
+	// Username Уникальный slug пользователя.
+	Username *Username `json:"username,omitempty"`
+}
+
+// CreateTenderInvitationParams defines parameters for CreateTenderInvitation.
+type CreateTenderInvitationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CancelTenderLotParams defines parameters for CancelTenderLot.
+type CancelTenderLotParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderQuestionsParams defines parameters for GetTenderQuestions.
+type GetTenderQuestionsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AskTenderQuestionJSONBody defines parameters for AskTenderQuestion.
+type AskTenderQuestionJSONBody struct {
+	// Question Текст вопроса или ответа
+	Question QuestionText `json:"question"`
+}
+
+// AskTenderQuestionParams defines parameters for AskTenderQuestion.
+type AskTenderQuestionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderRankingParams defines parameters for GetTenderRanking.
+type GetTenderRankingParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetTenderReportParams defines parameters for GetTenderReport.
+type GetTenderReportParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackTenderParams defines parameters for RollbackTender.
+type RollbackTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderStatusParams defines parameters for GetTenderStatus.
+type GetTenderStatusParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UpdateTenderStatusParams defines parameters for UpdateTenderStatus.
+type UpdateTenderStatusParams struct {
+	Status   TenderStatus `form:"status" json:"status"`
+	Username Username     `form:"username" json:"username"`
+}
+
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Status Показать только доставки в указанном статусе.
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PingWebhookParams defines parameters for PingWebhook.
+type PingWebhookParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CreateBidJSONRequestBody defines body for CreateBid for application/json ContentType.
+type CreateBidJSONRequestBody CreateBidJSONBody
+
+// UploadBidAttachmentMultipartRequestBody defines body for UploadBidAttachment for multipart/form-data ContentType.
+type UploadBidAttachmentMultipartRequestBody UploadBidAttachmentMultipartBody
+
+// EditBidJSONRequestBody defines body for EditBid for application/json ContentType.
+type EditBidJSONRequestBody EditBidJSONBody
+
+// SubmitBidScoresJSONRequestBody defines body for SubmitBidScores for application/json ContentType.
+type SubmitBidScoresJSONRequestBody SubmitBidScoresJSONBody
+
+// QueryGraphQLJSONRequestBody defines body for QueryGraphQL for application/json ContentType.
+type QueryGraphQLJSONRequestBody = GraphqlRequest
+
+// SetNotificationPreferencesJSONRequestBody defines body for SetNotificationPreferences for application/json ContentType.
+type SetNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
+
+// AnswerTenderQuestionJSONRequestBody defines body for AnswerTenderQuestion for application/json ContentType.
+type AnswerTenderQuestionJSONRequestBody AnswerTenderQuestionJSONBody
+
+// CreateTenderJSONRequestBody defines body for CreateTender for application/json ContentType.
+type CreateTenderJSONRequestBody CreateTenderJSONBody
+
+// UploadTenderAttachmentMultipartRequestBody defines body for UploadTenderAttachment for multipart/form-data ContentType.
+type UploadTenderAttachmentMultipartRequestBody UploadTenderAttachmentMultipartBody
+
+// EditTenderJSONRequestBody defines body for EditTender for application/json ContentType.
+type EditTenderJSONRequestBody EditTenderJSONBody
+
+// CreateTenderInvitationJSONRequestBody defines body for CreateTenderInvitation for application/json ContentType.
+type CreateTenderInvitationJSONRequestBody CreateTenderInvitationJSONBody
+
+// AskTenderQuestionJSONRequestBody defines body for AskTenderQuestion for application/json ContentType.
+type AskTenderQuestionJSONRequestBody AskTenderQuestionJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Скачивание вложения
+	// (GET /attachments/{attachmentId})
+	DownloadAttachment(w http.ResponseWriter, r *http.Request, attachmentId AttachmentId, params DownloadAttachmentParams)
+	// Импорт предложений
+	// (POST /bids/import)
+	ImportBids(w http.ResponseWriter, r *http.Request, params ImportBidsParams)
+	// Получение списка ваших предложений
+	// (GET /bids/my)
+	GetUserBids(w http.ResponseWriter, r *http.Request, params GetUserBidsParams)
+	// Создание нового предложения
+	// (POST /bids/new)
+	CreateBid(w http.ResponseWriter, r *http.Request)
+	// Полнотекстовый поиск предложений
+	// (GET /bids/search)
+	SearchBids(w http.ResponseWriter, r *http.Request, params SearchBidsParams)
+	// Вложения
+	// (GET /bids/{bidId}/attachments)
+	GetBidAttachments(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidAttachmentsParams)
+	// Загрузка вложения
+	// (POST /bids/{bidId}/attachments)
+	UploadBidAttachment(w http.ResponseWriter, r *http.Request, bidId BidId, params UploadBidAttachmentParams)
+	// Редактирование параметров предложения
+	// (PATCH /bids/{bidId}/edit)
+	EditBid(w http.ResponseWriter, r *http.Request, bidId BidId, params EditBidParams)
+	// Отправка отзыва по предложению
+	// (PUT /bids/{bidId}/feedback)
+	SubmitBidFeedback(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidFeedbackParams)
+	// Ставка в аукционе
+	// (PUT /bids/{bidId}/price)
+	PlaceAuctionBid(w http.ResponseWriter, r *http.Request, bidId BidId, params PlaceAuctionBidParams)
+	// Откат версии предложения
+	// (PUT /bids/{bidId}/rollback/{version})
+	RollbackBid(w http.ResponseWriter, r *http.Request, bidId BidId, version int32, params RollbackBidParams)
+	// Просмотр оценок предложения
+	// (GET /bids/{bidId}/scores)
+	GetBidScores(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidScoresParams)
+	// Оценка предложения по критериям
+	// (PUT /bids/{bidId}/scores)
+	SubmitBidScores(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidScoresParams)
+	// Получение текущего статуса предложения
+	// (GET /bids/{bidId}/status)
+	GetBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidStatusParams)
+	// Изменение статуса предложения
+	// (PUT /bids/{bidId}/status)
+	UpdateBidStatus(w http.ResponseWriter, r *http.Request, bidId BidId, params UpdateBidStatusParams)
+	// Отправка решения по предложению
+	// (PUT /bids/{bidId}/submit_decision)
+	SubmitBidDecision(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidDecisionParams)
+	// Выгрузка предложений для тендера
+	// (GET /bids/{tenderId}/export)
+	ExportBidsForTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params ExportBidsForTenderParams)
+	// Получение списка предложений для тендера
+	// (GET /bids/{tenderId}/list)
+	GetBidsForTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidsForTenderParams)
+	// Просмотр отзывов на прошлые предложения
+	// (GET /bids/{tenderId}/reviews)
+	GetBidReviews(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetBidReviewsParams)
+	// Повторная доставка
+	// (POST /deliveries/{deliveryId}/redeliver)
+	RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, deliveryId WebhookDeliveryId, params RedeliverWebhookDeliveryParams)
+	// Поток изменений тендеров и предложений
+	// (GET /events/stream)
+	StreamEvents(w http.ResponseWriter, r *http.Request, params StreamEventsParams)
+	// GraphQL-запрос
+	// (POST /graphql)
+	QueryGraphQL(w http.ResponseWriter, r *http.Request)
+	// Мои приглашения
+	// (GET /invitations/my)
+	GetUserInvitations(w http.ResponseWriter, r *http.Request, params GetUserInvitationsParams)
+	// Ответ на приглашение
+	// (PUT /invitations/{invitationId}/respond)
+	RespondTenderInvitation(w http.ResponseWriter, r *http.Request, invitationId InvitationId, params RespondTenderInvitationParams)
+	// Настройки уведомлений
+	// (GET /notifications/preferences)
+	GetNotificationPreferences(w http.ResponseWriter, r *http.Request, params GetNotificationPreferencesParams)
+	// Изменение настроек уведомлений
+	// (PUT /notifications/preferences)
+	SetNotificationPreferences(w http.ResponseWriter, r *http.Request, params SetNotificationPreferencesParams)
+	// Журнал аудита
+	// (GET /organizations/{organizationId}/audit)
+	GetAuditLog(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetAuditLogParams)
+	// Проверка журнала аудита
+	// (GET /organizations/{organizationId}/audit/verify)
+	VerifyAuditLog(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params VerifyAuditLogParams)
+	// Репутация организации
+	// (GET /organizations/{organizationId}/reputation)
+	GetOrganizationReputation(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetOrganizationReputationParams)
+	// Подписки организации
+	// (GET /organizations/{organizationId}/webhooks)
+	GetOrganizationWebhooks(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetOrganizationWebhooksParams)
+	// Подписка на события
+	// (POST /organizations/{organizationId}/webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params CreateWebhookParams)
+	// Проверка доступности сервера
+	// (GET /ping)
+	CheckServer(w http.ResponseWriter, r *http.Request)
+	// Ответ на вопрос
+	// (PUT /questions/{questionId}/answer)
+	AnswerTenderQuestion(w http.ResponseWriter, r *http.Request, questionId QuestionId, params AnswerTenderQuestionParams)
+	// Получение списка тендеров
+	// (GET /tenders)
+	GetTenders(w http.ResponseWriter, r *http.Request, params GetTendersParams)
+	// Выгрузка списка тендеров
+	// (GET /tenders/export)
+	ExportTenders(w http.ResponseWriter, r *http.Request, params ExportTendersParams)
+	// Импорт тендеров
+	// (POST /tenders/import)
+	ImportTenders(w http.ResponseWriter, r *http.Request, params ImportTendersParams)
+	// Получить тендеры пользователя
+	// (GET /tenders/my)
+	GetUserTenders(w http.ResponseWriter, r *http.Request, params GetUserTendersParams)
+	// Создание нового тендера
+	// (POST /tenders/new)
+	CreateTender(w http.ResponseWriter, r *http.Request)
+	// Полнотекстовый поиск тендеров
+	// (GET /tenders/search)
+	SearchTenders(w http.ResponseWriter, r *http.Request, params SearchTendersParams)
+	// Вложения
+	// (GET /tenders/{tenderId}/attachments)
+	GetTenderAttachments(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderAttachmentsParams)
+	// Загрузка вложения
+	// (POST /tenders/{tenderId}/attachments)
+	UploadTenderAttachment(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UploadTenderAttachmentParams)
+	// Таблица лидеров аукциона
+	// (GET /tenders/{tenderId}/auction/leaderboard)
+	GetAuctionLeaderboard(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetAuctionLeaderboardParams)
+	// Редактирование тендера
+	// (PATCH /tenders/{tenderId}/edit)
+	EditTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, params EditTenderParams)
+	// История тендера
+	// (GET /tenders/{tenderId}/history)
+	GetTenderHistory(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderHistoryParams)
+	// Приглашения в тендер
+	// (GET /tenders/{tenderId}/invitations)
+	GetTenderInvitations(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderInvitationsParams)
+	// Приглашение в тендер
+	// (POST /tenders/{tenderId}/invitations)
+	CreateTenderInvitation(w http.ResponseWriter, r *http.Request, tenderId TenderId, params CreateTenderInvitationParams)
+	// Отмена лота
+	// (PUT /tenders/{tenderId}/lots/{lotId}/cancel)
+	CancelTenderLot(w http.ResponseWriter, r *http.Request, tenderId TenderId, lotId LotId, params CancelTenderLotParams)
+	// Вопросы по тендеру
+	// (GET /tenders/{tenderId}/questions)
+	GetTenderQuestions(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderQuestionsParams)
+	// Вопрос по тендеру
+	// (POST /tenders/{tenderId}/questions)
+	AskTenderQuestion(w http.ResponseWriter, r *http.Request, tenderId TenderId, params AskTenderQuestionParams)
+	// Рейтинг предложений по критериям оценки
+	// (GET /tenders/{tenderId}/ranking)
+	GetTenderRanking(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderRankingParams)
+	// Протокол подведения итогов тендера
+	// (GET /tenders/{tenderId}/report.pdf)
+	GetTenderReport(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderReportParams)
+	// Откат версии тендера
+	// (PUT /tenders/{tenderId}/rollback/{version})
+	RollbackTender(w http.ResponseWriter, r *http.Request, tenderId TenderId, version int32, params RollbackTenderParams)
+	// Получение текущего статуса тендера
+	// (GET /tenders/{tenderId}/status)
+	GetTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderStatusParams)
+	// Изменение статуса тендера
+	// (PUT /tenders/{tenderId}/status)
+	UpdateTenderStatus(w http.ResponseWriter, r *http.Request, tenderId TenderId, params UpdateTenderStatusParams)
+	// Удаление подписки
+	// (DELETE /webhooks/{webhookId})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params DeleteWebhookParams)
+	// Журнал доставок
+	// (GET /webhooks/{webhookId}/deliveries)
+	GetWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params GetWebhookDeliveriesParams)
+	// Проверка подписки
+	// (POST /webhooks/{webhookId}/ping)
+	PingWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params PingWebhookParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// DownloadAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId AttachmentId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", mux.Vars(r)["attachmentId"], &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadAttachmentParams
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadAttachment(w, r, attachmentId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportBids operation middleware
+func (siw *ServerInterfaceWrapper) ImportBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportBidsParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "report" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "report", r.URL.Query(), &params.Report)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "report", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportBids(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserBids operation middleware
+func (siw *ServerInterfaceWrapper) GetUserBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserBidsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", r.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", r.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxDeliveryDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxDeliveryDays", r.URL.Query(), &params.MaxDeliveryDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxDeliveryDays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserBids(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBid operation middleware
+func (siw *ServerInterfaceWrapper) CreateBid(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBid(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchBids operation middleware
+func (siw *ServerInterfaceWrapper) SearchBids(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchBidsParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tenderId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenderId", r.URL.Query(), &params.TenderId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchBids(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetBidAttachments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidAttachmentsParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidAttachments(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadBidAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadBidAttachment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadBidAttachmentParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadBidAttachment(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditBid operation middleware
+func (siw *ServerInterfaceWrapper) EditBid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditBidParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditBid(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitBidFeedback operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidFeedback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidFeedbackParams
+
+	// ------------- Required query parameter "bidFeedback" -------------
+
+	if paramValue := r.URL.Query().Get("bidFeedback"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "bidFeedback"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "bidFeedback", r.URL.Query(), &params.BidFeedback)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidFeedback", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rating" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rating", r.URL.Query(), &params.Rating)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rating", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "onTime" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "onTime", r.URL.Query(), &params.OnTime)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "onTime", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitBidFeedback(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PlaceAuctionBid operation middleware
+func (siw *ServerInterfaceWrapper) PlaceAuctionBid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlaceAuctionBidParams
+
+	// ------------- Required query parameter "price" -------------
+
+	if paramValue := r.URL.Query().Get("price"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "price"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "price", r.URL.Query(), &params.Price)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "price", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlaceAuctionBid(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackBid operation middleware
+func (siw *ServerInterfaceWrapper) RollbackBid(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", mux.Vars(r)["version"], &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackBidParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackBid(w, r, bidId, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidScores operation middleware
+func (siw *ServerInterfaceWrapper) GetBidScores(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidScoresParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidScores(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitBidScores operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidScores(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidScoresParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitBidScores(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetBidStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidStatusParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidStatus(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBidStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBidStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateBidStatusParams
+
+	// ------------- Required query parameter "status" -------------
+
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBidStatus(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitBidDecision operation middleware
+func (siw *ServerInterfaceWrapper) SubmitBidDecision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "bidId" -------------
+	var bidId BidId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "bidId", mux.Vars(r)["bidId"], &bidId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bidId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SubmitBidDecisionParams
+
+	// ------------- Required query parameter "decision" -------------
+
+	if paramValue := r.URL.Query().Get("decision"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "decision"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "decision", r.URL.Query(), &params.Decision)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "decision", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "lotId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "lotId", r.URL.Query(), &params.LotId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lotId", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitBidDecision(w, r, bidId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportBidsForTender operation middleware
+func (siw *ServerInterfaceWrapper) ExportBidsForTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportBidsForTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", r.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", r.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxDeliveryDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxDeliveryDays", r.URL.Query(), &params.MaxDeliveryDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxDeliveryDays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportBidsForTender(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidsForTender operation middleware
+func (siw *ServerInterfaceWrapper) GetBidsForTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidsForTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minPrice", r.URL.Query(), &params.MinPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxPrice" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPrice", r.URL.Query(), &params.MaxPrice)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPrice", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxDeliveryDays" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxDeliveryDays", r.URL.Query(), &params.MaxDeliveryDays)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxDeliveryDays", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidsForTender(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBidReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetBidReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBidReviewsParams
+
+	// ------------- Required query parameter "authorUsername" -------------
+
+	if paramValue := r.URL.Query().Get("authorUsername"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "authorUsername"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "authorUsername", r.URL.Query(), &params.AuthorUsername)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "authorUsername", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "requesterUsername" -------------
+
+	if paramValue := r.URL.Query().Get("requesterUsername"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "requesterUsername"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "requesterUsername", r.URL.Query(), &params.RequesterUsername)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "requesterUsername", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBidReviews(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RedeliverWebhookDelivery operation middleware
+func (siw *ServerInterfaceWrapper) RedeliverWebhookDelivery(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId WebhookDeliveryId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", mux.Vars(r)["deliveryId"], &deliveryId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RedeliverWebhookDeliveryParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RedeliverWebhookDelivery(w, r, deliveryId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamEventsParams
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tenderId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tenderId", r.URL.Query(), &params.TenderId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", r.URL.Query(), &params.OrganizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// QueryGraphQL operation middleware
+func (siw *ServerInterfaceWrapper) QueryGraphQL(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.QueryGraphQL(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetUserInvitations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserInvitationsParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserInvitations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RespondTenderInvitation operation middleware
+func (siw *ServerInterfaceWrapper) RespondTenderInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "invitationId" -------------
+	var invitationId InvitationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "invitationId", mux.Vars(r)["invitationId"], &invitationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "invitationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RespondTenderInvitationParams
+
+	// ------------- Required query parameter "response" -------------
+
+	if paramValue := r.URL.Query().Get("response"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "response"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "response", r.URL.Query(), &params.Response)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "response", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RespondTenderInvitation(w, r, invitationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationPreferencesParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationPreferences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) SetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SetNotificationPreferencesParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetNotificationPreferences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", mux.Vars(r)["organizationId"], &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuditLog(w, r, organizationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// VerifyAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", mux.Vars(r)["organizationId"], &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerifyAuditLogParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyAuditLog(w, r, organizationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationReputation operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationReputation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", mux.Vars(r)["organizationId"], &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationReputationParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationReputation(w, r, organizationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", mux.Vars(r)["organizationId"], &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrganizationWebhooksParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationWebhooks(w, r, organizationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationId" -------------
+	var organizationId OrganizationId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationId", mux.Vars(r)["organizationId"], &organizationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateWebhookParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r, organizationId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CheckServer operation middleware
+func (siw *ServerInterfaceWrapper) CheckServer(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckServer(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AnswerTenderQuestion operation middleware
+func (siw *ServerInterfaceWrapper) AnswerTenderQuestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "questionId" -------------
+	var questionId QuestionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "questionId", mux.Vars(r)["questionId"], &questionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "questionId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AnswerTenderQuestionParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AnswerTenderQuestion(w, r, questionId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenders operation middleware
+func (siw *ServerInterfaceWrapper) GetTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTendersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minBudget" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minBudget", r.URL.Query(), &params.MinBudget)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minBudget", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxBudget" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxBudget", r.URL.Query(), &params.MaxBudget)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxBudget", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportTenders operation middleware
+func (siw *ServerInterfaceWrapper) ExportTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportTendersParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minBudget" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minBudget", r.URL.Query(), &params.MinBudget)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minBudget", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxBudget" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxBudget", r.URL.Query(), &params.MaxBudget)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxBudget", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ImportTenders operation middleware
+func (siw *ServerInterfaceWrapper) ImportTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportTendersParams
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", r.URL.Query(), &params.Mode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "report" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "report", r.URL.Query(), &params.Report)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "report", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserTenders operation middleware
+func (siw *ServerInterfaceWrapper) GetUserTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserTendersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "currency", r.URL.Query(), &params.Currency)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "currency", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "minBudget" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minBudget", r.URL.Query(), &params.MinBudget)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "minBudget", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxBudget" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxBudget", r.URL.Query(), &params.MaxBudget)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxBudget", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortBy", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sortOrder" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sortOrder", r.URL.Query(), &params.SortOrder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sortOrder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTender operation middleware
+func (siw *ServerInterfaceWrapper) CreateTender(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTender(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SearchTenders operation middleware
+func (siw *ServerInterfaceWrapper) SearchTenders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchTendersParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "service_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "service_type", r.URL.Query(), &params.ServiceType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "service_type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "organizationId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "organizationId", r.URL.Query(), &params.OrganizationId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchTenders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderAttachments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderAttachmentsParams
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "version" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "version", r.URL.Query(), &params.Version)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderAttachments(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UploadTenderAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadTenderAttachment(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UploadTenderAttachmentParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadTenderAttachment(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuctionLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetAuctionLeaderboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuctionLeaderboardParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuctionLeaderboard(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EditTender operation middleware
+func (siw *ServerInterfaceWrapper) EditTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EditTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EditTender(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderHistoryParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderHistory(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderInvitations operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderInvitations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderInvitationsParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderInvitations(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTenderInvitation operation middleware
+func (siw *ServerInterfaceWrapper) CreateTenderInvitation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateTenderInvitationParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTenderInvitation(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelTenderLot operation middleware
+func (siw *ServerInterfaceWrapper) CancelTenderLot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "lotId" -------------
+	var lotId LotId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "lotId", mux.Vars(r)["lotId"], &lotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lotId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelTenderLotParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelTenderLot(w, r, tenderId, lotId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderQuestions operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderQuestions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderQuestionsParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderQuestions(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AskTenderQuestion operation middleware
+func (siw *ServerInterfaceWrapper) AskTenderQuestion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AskTenderQuestionParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AskTenderQuestion(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderRanking operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderRanking(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderRankingParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderRanking(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderReport operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderReportParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderReport(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RollbackTender operation middleware
+func (siw *ServerInterfaceWrapper) RollbackTender(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "version" -------------
+	var version int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", mux.Vars(r)["version"], &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RollbackTenderParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RollbackTender(w, r, tenderId, version, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTenderStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTenderStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTenderStatusParams
+
+	// ------------- Optional query parameter "username" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenderStatus(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTenderStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenderStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "tenderId" -------------
+	var tenderId TenderId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tenderId", mux.Vars(r)["tenderId"], &tenderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tenderId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateTenderStatusParams
+
+	// ------------- Required query parameter "status" -------------
+
+	if paramValue := r.URL.Query().Get("status"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTenderStatus(w, r, tenderId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", mux.Vars(r)["webhookId"], &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWebhookParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, webhookId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", mux.Vars(r)["webhookId"], &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhookDeliveriesParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhookDeliveries(w, r, webhookId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PingWebhook operation middleware
+func (siw *ServerInterfaceWrapper) PingWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId WebhookId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", mux.Vars(r)["webhookId"], &webhookId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PingWebhookParams
+
+	// ------------- Required query parameter "username" -------------
+
+	if paramValue := r.URL.Query().Get("username"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "username"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "username", r.URL.Query(), &params.Username)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "username", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PingWebhook(w, r, webhookId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
+}
+
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/attachments/{attachmentId}", wrapper.DownloadAttachment).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/import", wrapper.ImportBids).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/bids/my", wrapper.GetUserBids).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/new", wrapper.CreateBid).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/bids/search", wrapper.SearchBids).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/attachments", wrapper.GetBidAttachments).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/attachments", wrapper.UploadBidAttachment).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/edit", wrapper.EditBid).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/feedback", wrapper.SubmitBidFeedback).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/price", wrapper.PlaceAuctionBid).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/rollback/{version}", wrapper.RollbackBid).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/scores", wrapper.GetBidScores).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/scores", wrapper.SubmitBidScores).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/status", wrapper.GetBidStatus).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/status", wrapper.UpdateBidStatus).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{bidId}/submit_decision", wrapper.SubmitBidDecision).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/bids/{tenderId}/export", wrapper.ExportBidsForTender).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{tenderId}/list", wrapper.GetBidsForTender).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/bids/{tenderId}/reviews", wrapper.GetBidReviews).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/deliveries/{deliveryId}/redeliver", wrapper.RedeliverWebhookDelivery).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/events/stream", wrapper.StreamEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/graphql", wrapper.QueryGraphQL).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/invitations/my", wrapper.GetUserInvitations).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/invitations/{invitationId}/respond", wrapper.RespondTenderInvitation).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/notifications/preferences", wrapper.GetNotificationPreferences).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/notifications/preferences", wrapper.SetNotificationPreferences).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/organizations/{organizationId}/audit", wrapper.GetAuditLog).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/organizations/{organizationId}/audit/verify", wrapper.VerifyAuditLog).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/organizations/{organizationId}/reputation", wrapper.GetOrganizationReputation).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/organizations/{organizationId}/webhooks", wrapper.GetOrganizationWebhooks).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/organizations/{organizationId}/webhooks", wrapper.CreateWebhook).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/ping", wrapper.CheckServer).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/questions/{questionId}/answer", wrapper.AnswerTenderQuestion).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders", wrapper.GetTenders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/export", wrapper.ExportTenders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/import", wrapper.ImportTenders).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/my", wrapper.GetUserTenders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/new", wrapper.CreateTender).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/search", wrapper.SearchTenders).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/attachments", wrapper.GetTenderAttachments).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/attachments", wrapper.UploadTenderAttachment).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/auction/leaderboard", wrapper.GetAuctionLeaderboard).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/edit", wrapper.EditTender).Methods("PATCH")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/history", wrapper.GetTenderHistory).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/invitations", wrapper.GetTenderInvitations).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/invitations", wrapper.CreateTenderInvitation).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/lots/{lotId}/cancel", wrapper.CancelTenderLot).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/questions", wrapper.GetTenderQuestions).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/questions", wrapper.AskTenderQuestion).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/ranking", wrapper.GetTenderRanking).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/report.pdf", wrapper.GetTenderReport).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/rollback/{version}", wrapper.RollbackTender).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/status", wrapper.GetTenderStatus).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tenders/{tenderId}/status", wrapper.UpdateTenderStatus).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/webhooks/{webhookId}", wrapper.DeleteWebhook).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/webhooks/{webhookId}/deliveries", wrapper.GetWebhookDeliveries).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/webhooks/{webhookId}/ping", wrapper.PingWebhook).Methods("POST")
+
+	return r
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+z9bXMb15Uvin+VHvzPCzvVpEBZUmK6pv5XtmxHuYqtSHKSmtB30ASaEsYgQANNPYyK",
+	"VaJo2c6RRpy4ck5SebBjJ/ecV1MXoggLBAnwK+z+CveT3Nprrf3YezcaJEXKEipVsUgC3ftxPf7Wb90t",
+	"VVvLK61m3Ew6pfm7pZWoHS3HSdyGnxbrtautdvL2Hf5DLe5U2/WVpN5qluZL7Bs2YrusF6TrbJTeS++z",
+	"fnqPjdgWG7D+bMC+Se+xHttmu2zEvmc9NmT9dDNgT1iPPQvYM9Zl26zLhmzIRuwpG/FfDVk3/UJ9tM+2",
+	"0430fsC2AjZgIzZMP2e9MGD76T3WD9J7rMu2+KfT9fQ+27JGkm6kj9P76Tp/0D5//JB12TO2Be/sp49n",
+	"F5qlsFTnM/l0NW7fKYWlZrQcl+ZLHZxwWOpUb8TLEZ/5f2vHS6X50v/vlFqrU/jXzim1RGtrYam62m7H",
+	"zeqd9+qNJG47Vu0rNuLD4KNPf8u6cpDpfb6c6SM+04CN2JP0v7MeG6T304d8AdINNoAJiCXbCWAuu/wB",
+	"rDfrmYsYTuHZyC/wycS3V1rt5L1WezlKHFP5B19ttse66f0g/Yx12Q7bZd2AbaUP2VO+A+wZPwshbkC6",
+	"wfZgil+ILQjeufrL2YD9j3Sd7bK+/b0ubSdOk3XT9fQRPAk+3gvotHTxnfusR+eN/7KvjSdcaKbr8Nct",
+	"/v94bp6l99KH8OQeH/w6G8F3+2yIxw/O2ZC/5RmesvRe+iXr85M4wsOW3g/5nnXZIEi/4JsHn+fjY7ts",
+	"mD5kO3IM9CQ4tvDwPXwvHs5nfKafsx7b5V/yH8sl3IaiG2nsHd/M+jL/xYX2nSurTcdmfqcfv326yXzV",
+	"+un99FHA7xj8EjeU7x+/qU9p3riEz+BKb7Fuuuk7kDV8vz6LWrwUrTaS0vxS1OjEYSm5s8I/udhqNeKo",
+	"qY39561a7Bj531iPfc9XlUuMPbZPMqDrP3eVKGkt16sV3yCX+YuKLrQ2NjXUK3Hhm6MOVN6Qf3b1ww9m",
+	"+Ef5sqf3fSNvw3snHLsxWD6H5ej226u163FyUPnFRRIbsm24Nw/DgD1JH7Ntrgb4hAcwZb5LD9MHAdtm",
+	"I7afbqTrIOHwPsIK7KUbdHWe4LPTL1nPIQm92yinUXQ9llvN+I5Yggtxo34zbt+5EN3pHFiQ7zu1IL8t",
+	"fJZ4oUZsD4UfCQ/5sQKTf8pGOdM3pjCBQjO+R8txuV2vxke+Dij6SKEdbq9xgAfY6nrzxTrte/xxk6+A",
+	"nMaBluC4tvfAkzvo9q5E1+vNiE/nUn257trkv3Atnq6TYuZz4mPpBdyQAGNjxG0tYxlYj+3hfmqWGleZ",
+	"swH7Y7qONzl9xJ6lG0rTb7NdUvioYEFnbvFVYvusy56CddBNP2d91gNLYKHJvtUsFzg7u7i+mRGBlmZ7",
+	"nqmoY8etY7aXmZ89Da8Z0oBFdOrvs6EwUuZL9WbyxukSCI768upyaf5sGY4Z/lCWWr7eTOLrcdvaqQ+X",
+	"ljrO+/gnPj+c0QAWA8wQcgCy01BLNuR/fZI+xGWC5Yf1+C0eT9gEzaA80m30rGQLJ+lcyrJrKfNXj7st",
+	"H7Zr6HT4/Br8QNFLpL7BX5DEzVrcPqg7+J0uI19CN9BYnTXYEPwL/2KUJFH1xnLcdBuD4CWIGbEByNJ9",
+	"vph8XcCVYIOAvBB+t/uGymFdvkbgQTnFMJepK+3WStxO6rHw6i/WCpgBF2sl7tK2mkncTK7BkbNH//OL",
+	"P393BmTKvuZzlbjvGC2vNPhCRisrjXoV7vWpldpSSZ7eTtKuN6/DK9pxlMS1867l0SQgnAy4gV1uLQfo",
+	"NN4DYUw3UvM7Zxea7BvlFqoLvMVHKg1w1guuvPfOG2+88SaeBTXw0+XyuZny3Ez59LW5s/PlM/Pls/9S",
+	"/vF8ueyaQn3sgqpDgOuK5ywz3z/CZIy1XI5uX4qb15MbpfnTZ886Xt65EZ0+e84pL/l9Qc8NtUE33ZQW",
+	"B+sGV396fub02XOm/x5w7QzXid+W7fRzXCdwBr9gQzJY+cVkPWPF4jcWy9UzZ06/+ZOl6lx17syb0dLi",
+	"0pnqT95889zS4punz5z+cRSfmYvPnDvz5uKbb5ypRmfePPvmm3OLP/7J2dOLPzl71rWwnfq/u70+fo/3",
+	"0KU3Bs+e8J/4AUkflEw5eu5MKSs7hWQbfyXk59bC0upKoxXV4vZHnbgtdjLvu6vic2th6Wbc7sA0HNYW",
+	"3XG0sArf8RAkhDQ10d5yiBO5VLMlh4ZxaJV2/OlqvR3XSvO/4UdcjZ3OrykeaLfkgXQsk37ZP5avbC3+",
+	"W1xN+NoYtyS7QH/n8wWrEY4zCkc4iPycs376Gf4Z14GfUnudYFXSdbCflIDVQ0T8fM8a5/rs2XL8kzPl",
+	"8kx8+s3FmTNztTMz0Y/nzs2cOXPu3NmzZ86Uy+WyeU/nymXHWY5Wq2CIxnxJFltRu+aMxHTZEzBtPufb",
+	"vovTQ2UasC43msG4GPHbGQZsN91Iv0i/hJv9Gv8dGHjCyu6mm68LK1zGybaErW3qhbjpFsHfgUWFxlLP",
+	"ELkj0sgiULGZGSA4ARsYtUDBgcbXNum3PtsxzmItSuKZpA4nJbN+cTNp01jrSbzcGStyM+v9bjNp3ymt",
+	"yWdH7XZ052AywLod8g8hraMarvOce4Y2f9falMmUdeuWS6w4YvF6RLJLu/E9D/aZlm2fbXFlgN6pNIfB",
+	"rgOdwAPQs6Vs0I4fLXIoC7hpYakdNT/hH/ZbvXNj5RM8I6QFEwPAJXHvQK2enK8mbkn8R9QuRkAYFgaE",
+	"h7BYe6Bwvk830Dhhu3j+t/lC8sv5/977fa54GtGFRHehH5y/fDHMyPEReSjwmF1494gbN9YQ0039q+lD",
+	"dPvwGxCJ2Kcvk2Ge/paPRZ8WhsSVwTzi3yJhwvpBhe9abbURtyvCgK/w9Y87ycVahVtjlfpKZX6hyT0r",
+	"FGEw189d6uy1ysrqYqPeuXEN7k3ldRrIgA+dLxUcUC1Mlm4Er1WqjVYnFt8IyPxP181vaY9BH92hMtlO",
+	"8FqlHd+MowY+7u16rVN5nS+rzEcYz2E953PSTWMI5vqRh/IFTb0PofNtNvJsBT8wvvlo+8N63v2500ni",
+	"5cpbC022FVSipQR2ap8cMzHddv36dfjDU/5qOGZ9yGZwn2tPHDcISApHaTQfVGpxVGvUm3GFDjXYhz02",
+	"kBuEb6IETBjgXtXw4/rmGwtLNneT3/DfkGmAGwLGQ03+eDWJklUexIxr9UR+ot1qNBaj6ifyF9WoWY1p",
+	"Sy+1Emlu4G8uNm/WE/BE+HfjzkqrWXP8JerQA3/Bzzb9rtm5Fbczv0b7Bn99Xnl54rVv12tyHm/Xa8Yk",
+	"8G+d1cVl+PeFuFony0r+7r04rvHpcWnWiKrxeVQa1jevVlvtuCMH83a9ZoxErBF+C8f1q3jxRqvFn1uL",
+	"G7H+80q9eV391I5rGAqmX4nAMH99nHzQSupL5NpdbsdLMU8dwkiMu83fqu4tPNW8d6WPHboexPO7zaSe",
+	"3HG7nuw78DpZ3xCDMo6gh4K6s9ohS8QwFmFB6vrOf6ptuL6Gt+SK3MosRNOzCnmTarsiKH+Q9/yRoVNY",
+	"19AqGBj8v1kv/VJXSX24aMKfm8yJQ1FCOSZ93SiqxdX+JtvjMmalHd/8adS5UQmDSlRNWm36R73VrIQL",
+	"zUosd4z/AX+6WOP/brWvR836v8M6Xax1+K8W46VWGz6IwirUFUoI6gTUinQZKiDa0v+QtiSouk22zQag",
+	"oZ/CbMRz4btCDBrKO30IMxtA/pwbr3xudvQw5AFq9YZR+oV0GODjEE/ijshnEILiidINlGemBRdJGyPf",
+	"VlXmCD8pfG0ncSphno5T9bWalJE+79vWw1twCnhsAP7/PtvCqGcYsJ5QidoKBXAiu2jG8/vVXG00okXu",
+	"LyXt1dhhcOGujBsi6MfDD07lo4ezAfsWNBM//N/L8LdHnaOfZOr+HUOBLTRxkFu6opbpOo+2BtuMowX4",
+	"SPvcewL8QJ/thwRJoGAG//tgXNiGTHc18iFaOgMYBFjrMigiw/ljN+hownCZvZs4EAd3c1uTOtw75xYg",
+	"34H0YYE43ezc6TfOnD33Lx4nEmSS0+j3WupFtMxbMjkwBLwKbiDYSBt86WBOe8rxFe7BHkWnHc7V5oEC",
+	"EZ45Cz06VgxpanctLN2IOjcci5XVQLP+wKj13b+ykTjuugaznCnENRUI4NVXHO/4T7bNj1y6HpKsV07V",
+	"U5Gz7INzu2v4vM5ZWMrLJcTSe+wpGvXsGXo9rG/F5bjmAEHGDxCfsbgJfdvb5IMoFOIwB+aKbAiN7d9C",
+	"EoTpQ7ZNUZ4da18Ma+StAIMBMpKUNUPOnQnYMN0QBziznFLLT3YJtV3CiCilkJ6Ci4PJpm5Q+fXMFXz+",
+	"zMULFcf7XXFN1LehUNbGldFkRvYk6JOBk6jLUW316R55IxG/jNvSjPSgnJ7Bij6C0PZ9E6U1YP1cmzGb",
+	"A2q3Pomb55P8y+nb5JDUpBmpgDDjPhhKfB/4RyAD9yVGHwte5uqNuPpJXHPqoIEOOlDj6bEdczl6ECMp",
+	"9r6bUcMpo/6XMZn0Ab8r+KYh6zkn50Cu6ecMX6Qm6D4KyY1W+0q8sprknQSeur6vQivrYBzdg/2m+Hh2",
+	"x9vGQ/NkivZJnumYOMNhzXtVxf61J7tmv1h3C4ShZifglD2gE9bX1eVdWk4uZkrn5qIzPzm7NE514jdQ",
+	"U5Z41sK4z/lJwXqtuIrGFS2x33NhxveShBe6HvxsMYj3/yeYCwM85NztxhDCfOkdHJSWkpmfW8u4HnL+",
+	"Y+PH58VHPaew+IHRl7Dga4WpcTQW6FYAJj4mQe5jVBRcw67PzHqEWnmf0AR9bhLsu4PmQ9Y1vAvQ1sea",
+	"apYY7cJYbgjzGGDGiQCAobkVBb6tPl0oNS7TF41WQtbVmI9fwg9qafQxX/iA3OTJ0hLito0vAcAPHjCZ",
+	"rCWEx7znl/RJpw1DQlbfLDmFUE9Q6QaKdltDJS/UmDxy+rwmWQ6Zo+2yLfyncGxd6e2TTNuaYsobiNTm",
+	"oYUbP9TsxVKIKuVj90tkCNip9NMvlRDa94EuH2tvPr+y0m7dBBVxJeZbF9f8b87FOn8rIvxOlLInjgLo",
+	"u2G6mT6YLeUhA984d7acn+ejIRoiKBNCUom5vje4Y272Wd9my7C74z3pffaMR3bIAHYqicyZ8rznSG7P",
+	"C3phLklJbs3vz2CrPrTygRmHgjJumFHCjJRzrWcDCB9ugs9NypzPVYRijNcInOYuvAWivoUdbVBNJcTC",
+	"X8QvnC1b3nZYWm3WP12N6e88zIar8YEb6/UNXaURGRYKmVjwCHuX/8PmtbrzlV9ZN3gUeGFFmN0W6T13",
+	"ln+xXrsSJfy9rruCsJOBV67z58Oce2hNjRMVZwvIiSvxzXp8K//qFnMeipr95nvONxrB9Var1ar90z/9",
+	"0z9N5BVkzPcXyRweFZF8x2sIT2aV4sE4iG2K3ySAjbxYY75ENxCwLeKCjHsPftBp25lGXT6GzjnZo9GZ",
+	"fmUmF+nwKk0eNdY9eUUG2fWqG6qnJFzfK+FGFJPZ0yr9BFRdZBMPDdE+CjkBUaQHcOWVcUep1h0Tfs5G",
+	"sq5ncLxXvtHyxAa/9qztTlaUpfeKGtOhmumAEEF4Vvk6ULgRsslu5diGK3EwjHAHQR1FkY7Vdj2J2/VW",
+	"E46rKw3gBx1r8V7fJotzgDkv11KrWsTDQPgEdi+zcjrwuCPwLnQYCojDq3HUrt74aT0pCo5Ee5TtsG05",
+	"vV4gDacRQp9g/iNwihCLAHJqwEaeG13gPutYyLyPdmBCV/gn+WFp1ldW4qTYl67Shx1rXwoFiFI80bee",
+	"WhUQlSy5og+qLIgqm42EAWSpjVodKTyg9oovJuLKXPg9HdJDrxaITyPY5fF4r8rITsbXhfQKr5Xza0Tx",
+	"YhWEvYyIJ/j3OwBC83vbv9SvIi3fXFj0WiKGoydClF1QjINJL15YkiLj/M24HV2Ps9Bj+Qmn0LC8lQHi",
+	"CAlhZOM9nVlAuMgG6rfWWuUABc3kn3PXDTZXlxcd8kONWDz9YyfOwRCWz3veY62M7DpkPJ+58eWTB1gH",
+	"LZDsKBza1ihG0od4gS9e/TA4c3rux6a5deWjt/n1i5IkbvOv/1+/OT/zLx/ffWPtv7m2PW63eXKBoy87",
+	"zgqoMZWXZuWrMrSAoeMJGWNuqLrp4LXjqAOvXFgtl9+oIpgj3UzXDdT3PiG/uLjSM9BeyIasdB6JGnBZ",
+	"eMrfcA/qOzAAMYQ3x1nPTwxtvNFuTJu767A+T8juHCEPCZphPZQJMv41LjFOg3Adnfhm1FiFmOY7OZfl",
+	"T/rdYDvKpPAZzCTW1RZ9Um9y91lIdpE1+1/w8S7gMevXbySl+XPlzBridzOD+t/I1iKG0pc0MOZVZjuz",
+	"gTUBgD/WqwQsFFqGu3kV63lm0Sls1x76AE6NwvY4at5RuqNV7mD5lwG21hKH8shvpF/QdObKWAq3C+/a",
+	"CgP5BeGF9RCAhEirxwgCnSuHoqZ2mG7Qb8Hk+VJYxQtNWXPU89TsDgLpsO2lG2HgDRiIQgKcZ/rQnifr",
+	"whjLswH7PdwtsQO6mAXmhHQDn7rQ1PkXQmLn4VcQEXs97V1P0odEPRRo98UgVnKcgVvcpm4mdwibutpJ",
+	"WsuuA+B3+Hom7N22W65h+ZN4Dzdw4SVOe8JTRjpOTwG41XDLZTmL2CgoHUkfFNBomlCZcwxR3FFnueN6",
+	"ZmhQfE/eBrIJ6YIDcCUoz0ybkTKzikyDrzzU5ucHFcUMivooZGyCdJFzc8vIuJmcv369HV/nFQju3NG3",
+	"gLga4t1MHzkLObOAMZjXE1Ei4kW3u84LjCo3kaU/nOKHBigvC1fbmeeY9B8FFXz7LPljlVD+BksgXNUg",
+	"ei49tB4tpAGswECIGLsa/q2gUouSKPvsWX1MK8JA10blL1EZGTVM5OfL4Wjo4DEvX6zX9NXgPxpL4ZGK",
+	"+qKwkb0s8BtjSdjIXhRVLyzVAv8FMCBwl5WLdhKEGwvNAG+PVbzZMybnjfjSNI0FhnmTG5Q7U/dKs5Ec",
+	"P5+nNvOJhlSjhKr4tDfQI2sh002wDswQUYF3Ui2a9iUcxRKlEuUhwGcMcxSiUutYsq3CoQXHob4gOGSO",
+	"EPwe5GHf97NxQFPTmfJBghLEnVC/WNHcaeOyomTTnqBdKfpJ/65+DunHmiq0snfHLTCPhAVRl9HVzs1S",
+	"WLrd6Nx2vvB6O1q58WnjXe4mOSOdwuDvBu/zj/7i0oyJjHVUdt9O4iafNPwU1Wp1/rSocVn7FBYFOMI3",
+	"HEbNPQpSUnjCpNMxH1QQYoLH8qfXrl2eSddVEMUqShWRZIQHgwkWXHn36jVe+opnJaNHl+NOh+ITEzhD",
+	"zojDSpTccIYAN9A/Gwh5+VgSzGQxD6yvTUlHk49ATPMxwAlQg+nqWeZMbNayL8R0XSYFHQ2CN2dn4joP",
+	"2dPA/w2+myct/bVelWxzHXWNDQUPAW1vWTUqFqtPBo0s0OnzEpUh/KwBeZ0bhWQ8PiqCdbtMvWvGI+4G",
+	"KDNeq9fmg4VC2aCFUhiI+Dv/ThJ3kn/lv1govR7cDfivg8V6rSP+vcb/Vxpr+t6M2nVeczPpvfuD7VEJ",
+	"0bqnl/tklsA6MNbJwkXNPVfeoEwWf15U9nCVNeHsOUjhCWWi0FG16Lb2Arat/lqo+gyiTsUTKoYQdl3Z",
+	"zAsORFI6L8lIUamTh6qxrUpDiJd4Ky81DCorcftK6xZ9kbtx3/NFAfcbHn+P6pJk9dh96VPD/d0WaBmg",
+	"E9D0E46IbyS8wamkdOpQdzoOOT8g5mVOupc9I9XW8nI9STyof2kKc5+dogNyfWYD9hWYXri+vPxTrGi6",
+	"bghhrNrsC8FeQS5aaZERrWo2jSeMA1eANhtWr0mG3eyTlqJ6o/CDlukkFSWf5ZXet4ofcNrA1i0nG0or",
+	"iRqFxmkrL6TOlTS/amPFQ9V6yvWgkX/svVQHpNO17ppugv1bB3EUnZt5p7t1q5Ak1GOt1s21Xm+e+vpk",
+	"5U+GUygqECdlgnOUYnkizd9QqXifeIYy+bsdS6boLNfppphy9oXOVVXZLnMJpZhPHxCkAC1sytCK8SHb",
+	"6NxsAFX0qh6Mh0rfufpLQVLNP84HJr2TIjRYRWHg8tAIMLgdTm/dUnjsnMMuH1Dk7OUcOBGUMVxP4xB1",
+	"4e9Ql6Q5ldKANQrMIIw7dPB9c5fxNfrsE4XukQPRPHkjU8H9inSD82WmD/RpQMhRyfOulOevo0cNMkNJ",
+	"7fwxa9qzl5l7hnkEcO9UpYXvcUsHSRlxZAjePnvKx59++YIgeNUUc2xCibnRAhrWRFhPW+Tz1Wq8gqt8",
+	"Ia5yMplx61s8S59ZQO29l+NmjT86LDwCBPsefmsJaHzCm4nlLNnZ/J7Cat87CAa2NHKQbSAS2UzvS+oQ",
+	"S9Gl61C3hn/EQHb6mCJFAyS5Yj0/VEx7Fli2esrGSC0hTLoXEM6GW7xdWON1weoLJvKABIsotO/bkLO5",
+	"s3zpZstlK2tdnnnz47tz4dy5tdcWFmbFj6fXXv//OxPZOvPLuzfd7K7f6oH6MMhgYHIguHZCT5DD8BCe",
+	"kO08oIVEQGZw0Ypmu2rTc/LX3phsTrxVhNVmhcorEHQtPtKc6GwYoJ0AI+9T4qirRSV4eNuB+XNNXYWO",
+	"IyqeEcHsNlXQ5AdfvStKWqgnEHTOWXIzEhECvUwIfACvoiD4gWPLhxyfNzJt+o/GoYS8pnU0KBIbqQIl",
+	"fYmLBmb163cpal5fdQcJ/x8Y4iCQteJ7uiPQXoUfxr5AZ3ZyZlulENtBkehm/PDvAL8n6X9QtS8+SXb6",
+	"AQiK23OOl6N6I5/9IltZY4Fid9NNHbAghEw3W1vD56FH9La0QM2I18jz2p5NjOoKr8iVrJSSuHWrGbf/",
+	"D/p5ttpatumOz/hSmJ18YZtuWsKW6PqLTfsbospHTadjEekupo+lWHbtNDXAKeKHZ5XIWqGqooZ24os+",
+	"X94S2zWRD5OL6/JPLK6RowD6uzlTTtJY0ic5IQ+DT272XQwNCMkRBW5cw3Km1b6Hr1LSPfYw/06cj1l5",
+	"gBW8k1DkWqX+jmhQdt8nY6Q5GPOEdUStxxpPDeW0XcdWkPodEaH0yEiFnORRFRMr5iUZI9cLlFdivoLn",
+	"geDSo2rFm67Ft5P8rIzxFsOCoaBcoXoijkKPOZOls10L0hqOcooK4fc7oHCG7CnrOTBMh6u3AcxjNLYk",
+	"CDUQRuYR8G6BnR7PI9Stx7ZVDlN7ggdBlj6QS7uVPpSNXHoqX+I1uzcxebKbbi40NdSdjrizsYXUJGOi",
+	"OhQBKneIkwNxPauakM54ZqCcVVMoPX/Zq0opyCPE1/kB5MI34ZRDmsbjJw1z2IILBhxl/D0DmINqWfJk",
+	"qJyrnwuW41qjjL74XLlsvN+Ftp8Ibm8xbqsAP10PfdNckrk9oX4dxxQBmF1fMZow4waezbGALqxL1yT3",
+	"OD2zPFgr0nC3FOE9EEXSZ2bPwnp1SvNnBFsxX8s58UMDDY8oiUvz5dnTZ7mhB//mQSme8j+talDnRG1p",
+	"B359y8MBZI7ASe7QI6oG+/BsGcWY6QPd9vdaKTquehfN6jHHzl3XLQ6dWLAsklvvX+W5iSApbRTZMIcX",
+	"vOANVVuXO6zn81r9kDiieiN1bA/wcodUyJUJ4oSOWwc8RGj2bmUcMy8YDuagUI42GgfqZzHTy/a18H5f",
+	"OPoFFhUvVt74BbrnqQIlHHYnWz6GhmNcNoveocCopbzJH7aqDi722FutZv4j+fiPcPkzegxnRZIGx0Pn",
+	"Qrvqjusn95FugUvLabWabi3HDTcsJL6vAujZAtQs4awBi+EJOsHhrzXRDFE/YYtR/gvDFynPniv/+M3T",
+	"P57TFm2p0YJGw5l7btaPOvLwsD1PBdOE3WxtEyWxjtmjJpGA0ofuedvKSoVIAN/3bRF5ktTbMKOnhOio",
+	"YPXWIvwnrhjTw46B+5T0VR6e8RXCVQHlMv7hFP3F/BxwgAPgz/oUv0ZoSfO4XQ86K1CTm66z7lFv1acK",
+	"aKNOtRS66jhkwMrAh2ea7OkIHvkopzvXSdpxtFwoZZFhFc1g/x0xCFHzMCG/66RYhsPzIkd2dUZuS+1s",
+	"PcdaKOFtOZzmZkEFpyNme7NBRVZiVGZdsNeDoEPkS4jzXNtGVzmijtfG/A4/TPhA0RZTSI/vMfDkeb0I",
+	"chTgW21Voe70CNgqtOkWbiGVFN1o3F8nVbBxBELjuNMLjFnSGXGpBqrWKcx5atyQ3lHQFbG/KvDutub2",
+	"92XBKuJ8+R/BDiELQ7hGbJewHn0uvUWZMIFB0FS5z3rpAwqz9Y+cJpW/8k+ii3D6KJgJ2F/4h9mA/x0a",
+	"lbRv1qt0w0t6B5MJqVQLtXHAHaVGLeC7yN7S479GDZxfLCJUM0H7AtCfasG38Uv6jvj0ZHxR+OWJyaJ0",
+	"Us9GKykehk9kxyJHwKwIdww+QLCcHjZiTyU05wue28vy42uiu4/n7P7Fexzd0QS9JZgb8ChqeM3OWenD",
+	"53IoC6uZDixBwabB+Nk1S1QV+6r6QmGcYqI31FqjRlIdLvcuUI+vgk/Ifq84ly0+QtLZhqWb9U59sd6o",
+	"J3cKflV9fgIyXG29NGrcTG5JIxvCzTHGN45wyNQBLtrHLsjXPTiUoMHsrplsKGQ0l7B6ZPrvPPFMfZ36",
+	"hL6iD2HVuQzqU1aq73WVKf84yFR+kqEH7dn0zt2Oy5MdudGlvk+TfBRYVfkGdYGoNX+s7qiAoui1+HZs",
+	"lfqVGjf13Hy5PF8u/0spVEVvV+Nqq8kjiHOnMbx9Ia62Y2zHXTorgGCdJGonLusJn7dWtFvq12ZPVAWK",
+	"5k5mV82cR0e4lb4NevapLqLg89lOqf3Mak/QOjWzGK7+Y7wRYXpfpA30oSIzgGgfRdmqHcO+mNd3tRua",
+	"HSvJUBGeY0+GKiCCvsG/gSYcERHInNbI6P5DoMJM39mQd51UrR+wVD7zOcnf/h+YP/w+70VyskE2F5bH",
+	"ZlweQ9Vjn8DiVOR4PJ3goy8QCJlz1AqeFEuUireqprbG4B3nyi8Q35aWsDWB3ynJYCdquTHfR/5EyVPR",
+	"pUjSHtgG65m/SQ6Ih9gNa+TiBxZg9j3xeP6dLHMPyk2MnHVRjmmv5d8BO8NtvOxb5grwnRmCUJSyocQU",
+	"nCT4nq1AUC+C622RkhiGfOYtG/idngglukmiF5rqqemGOTCs5yAOpk0IWdD3KDbZV/RjBNvFXwpeAphb",
+	"RmQryikijVqObluA3OU6PxRzZfGbLCvuAfofwGsK3jQYQKHP2qxb4oX+O/COHzlg5XYxiWxSEvUzZTiq",
+	"o5STZ8bJSuOOp2HlzLajf63p7fUdWIpCLo6LMsqg0z5d9rXlnozH1hxfEc53vWfD4fBBGVbzk8QHJXZr",
+	"W0+Rl12y4QeyUfVltt0QiSuVBy89Fy5r8yzKM2rXfhxvQGJ8WMAoGDoSQB20Lj7salr2XM+id1VuiOuQ",
+	"HO8aF6zAswuHDtiP5dAtr+o1s+GK9DSLOI6XWq5d/TOa5B75AhoftoHtoYyyKrzhFxkKuPRB9qJGt6J2",
+	"DcB3EyDiDhblfP4BOdm1YfIY2iTBlEutxFP0ObYxT+45uNhcWU2cGA8NaDakyPxTbOYw8jT8O5EdmnTZ",
+	"3Vxr+ihy16sYEpbWyIGBxaM/jsJXG/CEPT3yTJM8HX5Zj8f6Q6oSIUeytw8cDMi2shMYECmh0wsUlZ9s",
+	"JDUxW+677OaRQclqO0MGjDYQ0bWeoCu3hN8yf10tv9h1i0hsIMK1lalgM+SuSZ3fw3R5lhkiAvT1uJti",
+	"wLDltw5vBjjOhW4GHK+qRyj/QTjzn5OJaUDaXzTTUituOALD8lPtmk1yEIvpSqte4YDGmZk5cJOKwm3L",
+	"kHFbCDoi13QzPcrjfxA8mW3+WUdaW+cJTUPeZGXiaQdQjigLtBXBQC58fQulpAPeU21EqlXxpClOKcjX",
+	"fM5+sedk1px+HdrD8y/mVZmjkxispajRcZFx+fkQ532pS9FKJd0UEuIZuE/99LP0nrHYe7JGTL6ErHUo",
+	"MwDU9ZYqOpwQkY6RcZ2iRTSn30BgnMkpDVLxCy89t4wBWKAATuQM8YCeoGajuQAgXwbStS4iVELhWTwt",
+	"mWvyaQuqnS2dDQ0qovUeiTL6aiWFcxi4ZZE5xpPVG/t6KkCfmlvCyLWmkVKb8qFkvzNWyir5NhgQNfNA",
+	"HlhvFxMtS2f3LkF/8DC9S46rI8lRiYACTUyy+XOXSO2zbS4619kuMtUUoGd+BKdGPxZ6v5BWs5O0V0WP",
+	"eQ2M9POouboUVZNVo1eDbQYfa98Vu3Wio+MKOpU/rzfVv6PbeeMv4p/51s7dawVZYXNe6UQ35MJStOvu",
+	"5Pksluox+LBMoYKE+H71a1Pu+rw6nYpatDEg0l1N6qrQnpf0VyV6FL433ST+TpnRwZfQbzNjTyExpBV8",
+	"PjM+PwhEGzTjdbg+A8gSb6svSKKvkwbyOKzNE+vYkwG+GHIAMFsOBDlIMSR+pnRgJsfKfVs/4TPwAGl1",
+	"j7OBiQnZZyNnjBi1qvo2HOFxNZqst9AEG8aVg0gfzwAMvis87PRz8t+yr4dneTtP0J0V+S5rbJJuBmvy",
+	"Wc/1gn6mjYPYgJV2/aZZDKLOkx5aLpBq6jRWr/vZaoxjLhlrS84WDIs3Wq1PPPGqbVka2fXmfiTw0wBd",
+	"H0+Oxxhi/3hdcEU2UsjLoYV2M3kU8ujpCUfj0Hfiajv2bAe/8miFEpjb4M5jfXPh+yY+HNFLXxmtmNRW",
+	"OK5TNo+c2Vdjk95Ymqu+GZXjs4s/rp2unol+Ep9bmlt8o3a2+uPozbi85Nqr1Xaj4Op+1G64vfYMFJA/",
+	"U56CcV46PV3adc7qTBPIbjXb2Gcje2EcrD9RksTLK0mBUvh9MDMQPjswIVljujWW3U3jjuqGKzFyzD2D",
+	"YWs8U/i9e7wbYBdz71IRMtGqnow8mlQKFZY64uQShD3qJL42BAY9LPInb2jBAyuznFkz19Sa8e3kPJ7s",
+	"SXaH3sO56NPfOt6lO+Xb1vUDi6Avtu14d3IlutNoRUV35jJ9Wqb/O7HPm8r0YHAm9fUdSh+nj41VSzeO",
+	"lBrXOl0qAKyUXXGt6BLb6s/ihqj11WKsUm5OKMiPhDrIPHsnzXPl3pIxmVNrCoKFkghWK7KfXM4dC7Cd",
+	"ouS8ccrTHwWVC0JQKx5JwYZp1irhseaVSqdv3xbfjfSvyScDcSi9fR8J5UPHeLfMMSJ7sPGxXRVxNX0A",
+	"RTUrxw//jtwhCkNOj6nEtXpsqXZLRdtXhYX6ZPl7Ymmhz7B41ysfJyl5Xzm8omGhVk9BcOINjo6221T4",
+	"gnSbCg/QbepHQYWO9OyKEgimX9HNGP7Wm4w/dlUPqyeeoLuDKdcVO9g0Ygesl34eZq1v56GhQ95lA/a9",
+	"Bx2JaOoRsp0TUtqRahwq676b7btxoN5Xee2swpK+G3kS6GiIzO1tfREU3GVlZTlSJrvYvtTX3ughwfPV",
+	"JUk39WC5xWvqbnwzOTOArhbc5AAH8gLgSxefP61AVlNmqQVCWeCwp0pweOxvC9vPPhP5TEs7QzZ0KBOI",
+	"VAQxEU/BPMnmoWjq7DAt8DIjt226rqLrAdsWipXX4o2JmfBfvGZKxNdlZduQPA798J85fXIUCsfo+1gW",
+	"vDDYi3EmaBGcHNrj4EaSrIh0BP93Z0Ii5GysU00Vnjd/6lTcXpnVuItP8XGJ7GRnfOHBGvQ6WGpl53H+",
+	"8kURlUs31PBUftxQgEZjI0dqm59V0KJfQ6noiG0RyCP9DIrdBhTjhbfqSIPHSG/s4J/Jvv81mxUgzHLF",
+	"9EL9smlkNlLbv45gFccr/ZM7qldT8r2ewB5fg10Mfh41o+tQ7MaXR6eIKM3NQnFSayVuRit1HrKcLc/O",
+	"YUuBG6ABTkVJElVvLINUvqt+uFhb43++7onMYltXMw8WsC1z5tAReySBHEORgNzjCgzLG7GBQ9+CjQe5",
+	"3JfZDDeIyJGy0/sYN2b7QrZzyfEnpE+CL2Qr8Vg3uPrT8zOnz54zWmTuu8UN6GPVwmfAesGvZ965EVc/",
+	"6awuz1y9EZ0+ew73SjYy5EqtdKF1q8lV/Xm5zrAX7Wg5Tvh9nP/N3RLUlvH9Udwi+raUdMGEjeRQmY6l",
+	"UdYfsrYW0puw45581apCgBV7rFZg8LGK+8DZOl0ul6BzWjMhU+BHp37E/6OeLDXJYr0ZwTgcAsjhctq+",
+	"lNqzWX7gz5TnrDdHKysNAlyd+jfqJlVsgtCVT7aYcQ3oGx9PCXV04u2zkRB4C4EMessfqxNejw1pBm8c",
+	"4wz+qgcPVJ8UmQyW+ZdMp1HU3T22g/NDRQTjP3OM4//KdvoEikxCjEazoNM7q8vL/JxpQqyvt6C3JBh8",
+	"h5eQiNZZfKgrbi7Gv4AZtk6VDr0MBU0OXO2ZLnN4L7DXKkl8OzlV7dysvC4Oy8+ufvjBpeC1ir6Ot2ea",
+	"Nb6WldCg3ZON5qlnTbrxOkpA1YDR6IumvV1GS7JuR1C5/OHVawEuRzO+VQkDRQGAaALleQiqBYk6EXav",
+	"xtvDutLSTdfTBwzAcgHrIxww81FPaV3WGX7NDCwr4mhPbVK2sRVVpHZDqsRIH76OTv5XsD2kFrayK8mX",
+	"z1IOmNRSTjfZQniLesgHTL0c4HGboPx3RFxSdBeSYYP7IFEEJkHrmbIp7YfNGf00pA8Xmqyv/W2PTmqf",
+	"X2faZNYXPoROcaEGk24YDZf4YRQr4utsaXRfEjunP11vSnifeDMx7qW6EhhZWq2pB5FbyyJv7K7Wz3RX",
+	"y/TeXGjKrqQTdiRVk9DbQoEnCJ3nRPM4EJ6KYBsCnWi/icnDlhsNPs3V0ibN74J+weQjyFqWPUzJUhGO",
+	"Kl5FOUVspq8JBDiSQHJxz27YB/GOgH0bVNrQ1PKfuRjiW7+naO2HivzEk16XRqFcFjaYX2jCfhMCSYBi",
+	"VIqOtIzio9/BVxnBSKu1l8vOugjC+m1kSrXsK5emUR8x+5UW/PQF2MXinzeahaLZBHD7t1u1OzlaU0j7",
+	"SU2osCS0yeGNr3+IbTX6OIYCNqxIXQOgR9B2ajZjua6NtRcPbjPoC/28lmBEjYWQRbArLbfyMVo+cjBD",
+	"QRSj9Q4NZY0EYRr7ROAA3EzoX6+jtGFP0s+hy4Kon99l/fRLRFvzEJqsu9P20zKo/qg31HTaOpo5tXzH",
+	"715+k+sE5zLjD9DYJiypt4PaQpP9XoQuEN2O2rSLffusL6oqKxF36Enjhm1LXwTHBrofxBlqMZd4ej9O",
+	"eKXNgeTTSnS93sRWSfXlelJE6KivfLi01ImT0vNw/sYPQ3CPvFdvJBAkHPuN5XrzcrteLSSJl6Pbk3xW",
+	"JJUvRHc6Rb6yWK8Rsr3AhxV7cgGfOF86FEL0LdZrjgbwLs9ZaxPm8UY8l4ZQ+hAs4R8QtFaPySS1yJ67",
+	"RlVHAPmZzyRr68ZL4aSb0m+c0Nqi0qoH42VjM76V42d+W8CtRK98N93MzCx9LOVjliTHlFJYzfB2vVYq",
+	"aqBk98zV+asQZ8N58VFZbluEclJ+UdBNHoSIqaaLhvEvtCTJJHwE8G2DjADIIIq89RJ+sCB/wWK9Jjgj",
+	"VkBIFiWamrz6dTw9gqPuVNBUi03PJlUcN9rPMOGlYz8++xNEsksMOUEKJnzTDHSMIKMpE2Z6xrpLwSvq",
+	"8ThZKjwX0T6Nor6CUdTvMgWzWgTVET+19ND4K6jpOCyrzPcBQHfKTLjep+Oxo0/HY9MD2LErnJydWMAV",
+	"0N5FpLJm3Em2v+v7WWr1lG0vh7pcAyNsibelj+aLPXgPZz000o8Y6Qkpxuc26mxsG/f08hopE9WeAqD1",
+	"ckuU9hUtnyBhpQW1qixUwZ8FXnN5SVhL7HaSfKeF6oV3jNA19VbrgxwkZkwMIG6xHnsyo8bLuvPo+8Ku",
+	"hUH6GcX3HhIvQ5eaGRLMo9JqVwICbRrOYZ/oYzG6FlRmKrMAEaMni8DzlujXhyFRaN4r8t96h0QjCn4P",
+	"osXrRE0+gvd3oYnkLt9t7S/pJjaW5s/DFXZ5fZ/mphQVpEBvQhOoTjNWO2TilhU/zznCUS+Kj3uwPKrp",
+	"89ooJQr0Ug2jVWHkAXua3MIZuOesZ9908rZCI9eMtONySxUfQBH/9K8WJ4BP6oREPjEEC+gJZVJ6VMHb",
+	"lakWGd822ldxQXoCkbq/whgA8oRbrEGW7Eyb1ex+32Zjf1n9Zyf5w46uUMY6z3eB8G9Nx7X4rYyvMn15",
+	"x5gM6ea8G2IiyHADUUvUD7TR8hZhsEJ9tqe9hIODZgP2O9ARxrshj2gloxeaOQYOVDpmi+b1z0C2DLQH",
+	"5ZDmtcL5vMp7+piYL6WWVPl7mLMkC02+DNoVFR0Qh461CMmsI5ZmtKMf67k2ssK28mK/OxPhjryofVfU",
+	"9u26Bt3pFMLuiM6zB1M2+O21Y1JmrldofSayWZMipADHo2vUdZ88Erplt2ec6oap6/7Dct3dYaUiMKiv",
+	"bBc99AWevzFkfN/Cfg4mdr8BB/E3MHwREGDBkPiGBKfL2F/hd1gFh1gKQPbvYf6S/3M/fTgfXL7wXrjQ",
+	"vPzB+2Hws8vvvh8GF37F/+/Dd34dBr++dPXXgeAEA9sCPVxOn6Fpqx16nD4So1mM0QaUrPx1+hLPlBJj",
+	"HU7VaAlKmCZ4gAle3Es33jJhK7KBgdbcQPclLvzqfYeKU63HoWDArc017hsXswk1O/ERm3RVc3Puzv5D",
+	"GAC5cYf8UrYJOeiEjz1ie4ViCB+tcLitobZfLq2dCx5ZXm0k9ZWonZziGntGVPv40jNLdeyAUQRRosf4",
+	"4XuFQvb/8CHFjzU2r9sKBcCkkNykYyqF6EnhPGxkhkLciAYnPVtOKsZkLqW6hu4taHRMbYqpTTGBTcFj",
+	"x0+5HmLPRPLblwcQ7npcQ87IlSjBtICjCfk2KBITZNBnvbwctz8VaOqJd2v1BDPdr4pumCRv/0PLoj/H",
+	"lPhaETX3jYnF1QrETGeNn2JKHzyg/JlgyEXQtzSkZC/jJ/gxrdDOc8Qhs/U/BGDafGDPwRWABa8j0Yhj",
+	"HRGNCs//BINU2d7qYHX9QBLr1vBHmAXPQpizKz0EBDXkkMym149PwBiwkA89PUdEvVTSdTlOTVwqhCep",
+	"UTayfQEMDyIgv8eeiG9SsHJqB0ztgOJ2QJ7SdsvCcbABYS4IHgnQVc7+M1/rPcKpU7ygAcljPsnaBkDP",
+	"y62D98RLT9xKWDQGc+BXyGd4X8SXAbiCCj/zCn7D+8RW81p9AoTxYr32IX7j+EymY9ZcXxc5lhlNZpAC",
+	"aJLtOPWQNvKhj2nDN86pDpnqkCI6RJfjRP4rebvzLoxDaUg7360xvjUbmasu5T4a9DGE61t2L2xOS/1X",
+	"/Hq6SY/XI8zwg7w4NJgAUeVQnWonOuUQF5rpA74wYKhjU+Gu1jjYwITyNsHsqaMret+IdOm1vAOuwwXA",
+	"r2vkycc4IN/qPdRhs0TNJ1D8W3T0qqBKUblr6d/7Gse9Rg1p3GkBmNXJ8vhNeMa6tM7wfXeL7syiyOrL",
+	"bQkhAemArbkc8ebLjagaU1v9FyKegGf+oA+XTu/LqXmNU6LXmHZPQJlm0LeYnNLvoXk6If5L9XpSlgx9",
+	"igJTORzrMQV3n7gGlrMzdpT0IaGCf1Ba+luLnNRSfA5l3G41Gtz3OHWXECZr+b7cgBqLYLN4G/TgVcID",
+	"C13JRlng1H8hxeW6XT6rIO072U7yXANhr6i9dEN7Im7ukPLke7L6Quthw3OnGbCWZ/waQGtIzZ2IK+Gp",
+	"Kv5waaIrtL7HroUKdRxxdikaQotg6d2YO+5EaZVC11QUZMk/mckgTC+p/isWM1V70dVipuOOuzSTu1M8",
+	"1VRbviT+Kq2ycfpt7Zg+zGjHr5UQHw+KcujKTrXVjju5xVJaK4O+CfsFuA/ah766b1I0OvHKU28DJPFH",
+	"u4vjrAcwexUH//JlVo+jfIKvXTVqFyvv/5q2eeAH3E1F8VQUvzKhw2/oYO1BaOxeETnIp+f3QVyQyZ2s",
+	"JFQvUuXZfnL7+0EZ41FzZdkJU0hhcC0GCAklaOjjDLU81jJ0g8otLhqayZ3KQpMOYaW62klay5W3RKRw",
+	"w6DDY/viXIgQnTFwCUPVTPNQ1Gcod0lRunmjsVjnovMPGnmBAY+0HTD4qO+p3rw3R3eZDX/lN+hFI/9G",
+	"62Pm3TzMBgcLTf8CPA61gltNSuuEWSKHgo0tFc+bPyH50qrWowAtKaOpkLattutJ3Oat3/n3HPrWxLzS",
+	"0wuhXk29rIjh9+y7zbtRZllZjhVbo1kcY2Ziw2Klej7eDKS2tGMgLQUWe2pevCBxUV1Iguqh0BdwmIDo",
+	"1zFNphxmez8oK0Ud4dw+sI7j6/IUZY+scdR6VL9jMOXmvR21l80yI2wUL88Mdmlz+oWi49nULywsnHHJ",
+	"fNmjAZUY7xTZ1qmwm/pSk/hSWXo7m29TP3ReWeZ3sP4oobknKJo+Wqkh592LIp1kX8gDP10KjZc5iZ9/",
+	"THJR4NOY2FSOvzpy/I9206GiYjtrakIU4l9lc78JkNimvR68BgArBH/hB/Q65QGMhLTC67nQbYCc/SGn",
+	"3Njd0i1bogwcJggKAz8DzCqquNwXjHDIoy96JfLHSSIUgbrjZ1+fLPZglK2eCQuHLUD8NdS9QFsgUbdC",
+	"3Q7Eo4asp78Jun9ssO30EQfTUcAhfSDFyC5m3gUtmCe+dEE1bjxhNai1kDzw8+VsXDCFP/MFCWUA1uot",
+	"ldlVhCSa/U0D6Ju4yZ4JkcigWylKEv0U8nWH/gu7RBy/x/rz1jnRuiOsgwO8rYcZ9eFY4VcreAyv4P/g",
+	"b9rWMJJeOjigoC2Mz8dPv7TGxd8MQXUofP6JlI1b4y+O0mejqWExNSwOgdO3xVkxpL6gKFw7Fd8W/bY8",
+	"1G3pQ7PG3M9nn8GZb2EXJzw7wA0ju7Pyw/Q9NpTrY0ucTPtKPkP6zbbGwcLpQr9AdjEeszTo3xea7uGJ",
+	"RrFUXOtqHpHbt8tgk8EuQCOlbibi4pEJKt0eDJWVZPUA3zGsNvodctDqRhv9AXtmqkdpRRwjrB7qyp5C",
+	"Lovk3duin897rTa2vSxkk2gUmQdTPzqv+bFQsI2hOY1v652Dpj03jqDnxs1mbba1EjdvLzcQFNqZaS0t",
+	"1atxrVVdXY6byWxnpR1Htc6NOE6WG7Pw3xeiBdOWIQL7WPYiu4jp1f2WjbiHf0F5pe6/DWm2JSx5Ik8p",
+	"59c3GuGInth7rCdCazfiCLoMz98tvYNrP3Oh3llpdeqC1iETAtzTWoexrj2G/qxhl2aWahrImdpbR5F9",
+	"9ASbtSiB4lQyoDg9vmDSGe8qfrL04QkYc5Px+Rc1qBz+bNdtwjXqnWSSLl8+G4n3ukC/GqE46QOw12zW",
+	"7D3bydYxRyPf83vEK4hnQwMewWc5jdZ9ssZ6YODQ9dWo8JCs0MmjL4+fZy1HeKUo7eF5SBiQSfiFICO7",
+	"D9u0LfIdfrLaV91cOioi+amV9YPvbPZcGphNbY2prTG1NTK2hn9qB0cbFOgAOoFp0o5v1uNbOUCpMXBv",
+	"N0OuEVvd17HnrKfKGKFVNXJ3yTCI5GzwHAfN3LB6Au3ovbt2c2wcOJrFOv+h8XCF1ugELAenX+hrTWka",
+	"Y17pn11rq2eTVn66n97L7p6vDwt2rfvo+faVyV2BzJGQ6gColFURgrYGvtkQ+Do+6gkdh6V2XCYJ3ozJ",
+	"DRNX1NOHWLGK1m2nY2qATA2QH3R/QQ3hoUnlsaW0jpou953StGwOooVYautx59Rd+vcdtA/op5xGvF/R",
+	"XdhQdP3bWj3Vriw5oqqEJ0hUj3XEzwLYvp7qaeP5PhLC9gOKAFBRFnTdg+cNkN61K7gqZqG3udUa4EB8",
+	"/05WCbEuv4oXb7Ranwhns5CRoBb4wDrllvnalwF6YE3JQ/iq1+lB4m8oOgluWSfpRPhozeFR56lJjvhU",
+	"IbyCCsF9bnTXsOt0DYUVNMR60G3zMSjZ45s4mKQdR8v51An3wTq7Grdvxu2Zq3EzCd6FLwNG6/t0A9/E",
+	"dgXjlsWAncV2+fvNmgFpWy3ofNwUhuZ1sWT3Q8VgpV6rYMB5KIh1cFhb5lB7ofEtWA38oupWY7w/3Qxe",
+	"w4/xZtwVyNgTMbjovgKv5V/473TqR7yvDa4wrBgfhW4Vsl7ws6sffoAoBupy9wwwDiPqhMp3vXIp6iQz",
+	"8ICZixcqMG7aE1J6EP5Wgfl1c+F2CHY5gq0ZAURhW/baVy1nEahpdGlNN9/CBcZudLA82acLbg3qLNBH",
+	"hnZH3869MMAOsxIJInbUfHefbfHxsR1R4bzL+lijIIjZYTrQwwNeAThSY1Sqc7FThO3LAErX1c/Y2Hb+",
+	"LZ5SKXyKs+2O3V2NJQ3lSBADLTStuu/+vGs0WpgLzlyPaOz1vwWsR2lqUeER2o/y+FbGIz3tlD2AnZ5G",
+	"uQcxN7BEkZFLd7O9fKG9nKiYRSIAHd5HCN9xN2mWl6oiTJWKO+uft/u+waLdKf+UfkG/so+JE0CsRIIv",
+	"fOQ31o4gWvJdzmF3OdbWmRvTSFsClZ5DX97J5mLfWBfVnScvOXaK/fSBb4Kt9vWoWf93sd9Fp2l97VAb",
+	"J44iarIts9uFjBVTNmiDmsnuhAasT5O1fUG7oFH0+XaXR3zCSYNGUrG6iAXyOPP2DWonGU7Rw+PyFBsL",
+	"Fhq6T0e0S87ikWQelrSKpHjlziOER83eUNXenqDnzuiEeuUD9QQFvBQs24yy4XKwP6HfsrM0Z2D0z7CO",
+	"mW3jBIXMm6nzcuho0DMLcusLdj8W3qVQ+ycfyMoOfSzYRh3OQ/kT6Opcb0crNz5t5IWqNP8P7/r7/Du/",
+	"uDSjx21lE0+pCrlF6yP0Caltkd3BWeNnB2vJQoLzz/BXuUJOm9zNABCxC+bC9gjj+ACA2mgbVqKVOu3c",
+	"LK1DRaCm0UXsgffbU9jobPmMYUD1LZVA7XBYD4HhOgGQYl/qQ0ASGu4ZMHAfG8lvqZJnRPaa0MtX3r16",
+	"LTh/+eJbZs+0oTkDPnF7HOaTdgi5jfRM6LNoc1B+XS/MoJAUI9L3gtTIlXRCFiVyNXX90it2dZFMytF8",
+	"Sad9qjRXG40KHZgvodm/IODdCirx7SRu8pKuzizWSXPmK5NUli/VT69duzxjwtrtfJnIa2ywXWTQFxtB",
+	"NPa0F0PcZC5nrXyHAxY7rxdj9aQ7HpA1wvH6c2GgJN3mjJbk7iNSZQivxUMqvsf21CisQ5I+kK8BsBq3",
+	"5SoNnlCrkPwPNdeHrAlo8hWi4TdXxsa4UiSk6/SGIbRvnyuXywbCXwfMcR4T4UXsufyCX3BTiiRP6eA8",
+	"T3nCm2TAFXw0Su/jI1CSb88vyWLP0g1UF65emwH7Wh71vjN2F5r9ggeGQc9byH2p4yaMo+sFhvPrxBVf",
+	"pyLtmVelpZmhml2KEdVsvXmznsDsO6eW7+SEFEF7POVi2SqAcqMXcnCfz0yYArSgA6J4L0ldKPsIqoDi",
+	"FsbvnDgPnuW/qCY2sbc+hQZkHXm5nAeDLlpnh+1Mc/0n7x2Z5vtfuB527la6mRUWd9UPmFvmI6/5CRO+",
+	"UZ1I8rgQ3KelR3bZNtYpetnz002MzCLiyYgQqlpNGoVZBm/O93G40DTHJoLbnvFJ/UM5m0zU2CCVDhQS",
+	"Tx+v5jXBWrhz1bDO1+xLWSRVre/YgaWc8RB/iz1xkA//Gu1O/MCT4llJ6mk/tyVzIzoX5VRivnrxpG/c",
+	"wqbLtlHYSLNvJLsfe5xUMiUVYa8r+XIytfmOCRaszScROlStrqwnod5qtpL6Es2gc2qlHS/FvLQlr/vA",
+	"XyERhoWnO8J13aLWbHuy/fVOjgkMlRn/AR8d0JNkAoMHpaFOlRTbwB8bfCQ7aROnD2CiQ+d4eGodP2hw",
+	"X+QzL78fJx9oC3RZW55jN5yfp/hteibpFnyH3P6X0D4suiZ+NsQ/4OlF803KsDDgdZDpQ4gbZxM+IyOu",
+	"JYNBRQAC6ISkj7jCgosmfwoEtibdVLECHdmVPlK8D457JLImfOneggWEoG43/ULgd0Z2i899hfHR40vr",
+	"XEYMIEhG3BIgi7sqQnCP1l3yXqAQQIIMCBw609Uv2q0++rDYZBdaxg+GBc/x8dKUH1I6vQi85ba1qK64",
+	"xJfoSWwrg/nyCcwsLaFx9nps4Dl5aLXo0ILOqbsm0mDtVLRaq+dUokO8G9a6b4LoutjIcBsTKZAXzuTr",
+	"0k0ndsoX0RsLusiBXVEuZlsH6IywHW1PS+BATgFxaJjt6Sn0mzpSRBKoaxDIjB8Rcnyhyf6nWkkrvuAs",
+	"uLcbhDwTuwJcwBbREc8Bpl/KhUofsm1T6Pv2U2pNu6wPn0hrrNOg9LNHk27oBtqX6vfGi0fsCZzhe1BW",
+	"JtFhRMNihO9HopUz2LpAdOI2Ps/zg3ypdb1QDCMD0zmYcnLBdo4hKJyDDMqss4lK7aYPLLSQgnn1ubPt",
+	"wzXFzaSe3LlmA3zyxgyS5V31vcmHnhnpnjmbXv5oLfzVcnT7Uty8ntwozc+Vyw4eovzRZWQbYCqBNlKH",
+	"zGSZN9yGJvZicA8/qiatdum5ng3n4GXBNBtS3x3nWYGUdE+IVN8kltqtZTcKitOIzyR1uA4TbsK4GRzV",
+	"4JPWgYb+0mRrxN3lNTcF8jR+KyE35zaN5J14WYtpaupmiW7eFbckT/GKwaUxGWCh3YnxWtPu6QYZG0TN",
+	"CL2gEG/jtT3nJaho4LeR0vX0If+m3r+6m2cqIdQKHyw+piArliVFEdR9Cqth2B2NS9M8Y3v87B+lMZkx",
+	"hX4JG/CqWEPPM9IHBxqWk75fFLNiAVCmgu6FE3TfGDvUzRMvhWRfO15ZpWycV/L9zeRMSzedl1r2wfND",
+	"Sw1HF2xip6Pbz9C0pZu6DBO4eBtLqvvS3DHbAmn8FGzg9EFot+nrsac5wz2IqNtNH7MnBOjxu9B/p0Zd",
+	"gKZVAVVvyQ4sD75cmfHDnCp4Hup11yPxI9eXoMZRaJZMwRTNngSy6agozMqw1xMbfShDHKNxb7CYhGXf",
+	"A7MQTONKklTKWqXEE1R7Hpf6Q+2UX1EnfKpSDiz3Wu4V9emVYuKirywN6y7z8p+p8nn1ise/dhUQFCoh",
+	"L3rqCmlFom8Y03NxWwN2+454NiArpBmooQHyUMF5PzLbepxE/JWY3VQeHj7gQEelULTBOjTTEMMPyvIu",
+	"dOMh+e+u1/oDIMK4DdOnHJhEvGcQAVkuO6AEhBPCwD56AtpSEsDpOfr76aOMzHEOdj4wbE5JeNg3zC+q",
+	"auqxnmGi8ZFbJ9jdgsLRA8kgiNpH8Fb/aMMLC032nyr9Kmpie0gMANFVMsAVp4FVF4/5IxjOvJlqI1qT",
+	"rvx7lr4RePNp5lrsZgvMZdXPEtH9QAHFpwc/S5u7UW9+MtNoVaOGnkp+jb9ZVbNB/zP1V3lI+ghK5J+4",
+	"T9EdlV+EafJUYvf10FP5g0VM21kyF5mGHBkpbgG80u6W8bBt1qXEpSjoprUTUJRda9H4xshFM6qkn/EH",
+	"mV1UXMQr1rWQm3D5w6vXjEoQok0JKL/Bf6qQWL8c3Wm0olqFCqgUxwnPtlZ+PUOqdOZq/XozSlbbcYVT",
+	"j7gauGzLmB7hortBJfnnhdVy+Y3qarN+e0bE99NN+GUc3pyjP5vfx79WwoA95W+xnw61cT8//87M1Z+e",
+	"P332nN5bpr/QrOS8cBb/JlbBwrfSa6VFo2yXnn05eH1TwG88nmk4I5+jRgGh94VIieFC9DKLyx+hLS7U",
+	"aVcWmuZvBbdWxYxUdm1WFfSevQ1j7TNO7Xmy5Fyi9FKeI7M5mITGayKL9YLTt2/PBuyPkuqdjUjWHBEa",
+	"aqGZhUPJ+kONJMfgG9jJcM2J6jEoKGN9Gk4GE5bP9uXwxd9px1ES05a9EvbmwWBeK22+bkkdDVWkuZrU",
+	"BIVrwu2P5XrzIn5vzjJKw9Jqs/7pakx/JkzXartR8BUftRsls/jyN/DtUAz5Y/nC1uK/xdXEaadpermf",
+	"pfcxRcnxgtCkMT/WeO/qDM1DgFd+q2Si0SLCqOjUp9f3V0qb0JqtQGBXDOEyhbhNnZojdWq6rngFfOXU",
+	"CocKeKMh/wUDu8+V1xCeN4IqM76apMtgE9INecC9F+SpaGKrFTfrng9B9vg/eBfc9L5uxmsWS/pwNgBN",
+	"/r9hI5F3B6lyqC6Hw6B1N+p7hc1WvviOoebhF+oKCk8JuwwKYrsh/imzH+Q/UcX/FrW8gSTyEFzDPr9c",
+	"Ti16I65+glSGpWIcOyuNqG4dw/h2tLzSANH8SaGWZN/q7qbclqKrb0T+acEELn2BDzv48P9cKHG6s++E",
+	"valEm2QbBfMe8wjOS7Bl0PUulFqfwDP5NT2LK5M3KXjHkczsvtlxm0vqs+WyFCaQGEIbcRvi3E/JThsg",
+	"0yM3FMvl8cm+bdM7dl4PvK9ghmBwU/wToA7Nzi3iP3a39c4moVQvWb3aaIuNxIoYpFTcWiS4kvoEUX2I",
+	"3VW2f7qJN2Wdf3NDucHw9r7d0gC5EQNw43eNTi9iaFtZA9bMKhGfstk9s0f8UZNFHoyKVselPQ9LjYWq",
+	"v6AtKGQBq/06sFGqPeKHZflGy7Eo7sXTuRStNpLS/FLU6MQOCUUWmAjijJAXQtvc9LGxUaDd0Jri3KDp",
+	"hhQmkgtyVgnHxVarEUcgTdTFKbLu1+LbScZQpkcUMpFlcd/x2r+JeVpzR+bJWJ+IUdq1xM0GhpqUyCE5",
+	"rNl2Bct6pzbrK5iF/Eo7S+Np3772q0bUxXircpKJOltIlv9zXbiJeyZdFRdtBkWdjsJBWDxHJXJ7ZJeb",
+	"HBafpvZV2aZBlNcOwcqxnFOt9g8VtjZSbm3rDHoyru9k01CxP870Y/q6Xq2LkS5PwH8GQlFdhFWnj3i4",
+	"MXCTeRCls6oStiku9jyl1NleuhBDwxXjawPWhub9hgtNc2oy8q3Bu73VnB5kyzU6SxlD4piaJhaTVVn+",
+	"uSxZqbObEUVB1SL6cO5Hyy38lXHSFb7KPOIqjQTH1kFKpVnMrnoKCvyzPf+tLMZyKy9shtQwn+e2E7dv",
+	"1qvxv2b4bqVz+Bve1bmTtFerZK/KFhYfh5PwJV3FN/nZcZ9H9823V2vXizX3XI5uF/8wzeiF7aeJ45uY",
+	"l8qRzD1AM00qSM8005R5XX4HBDXb4ymHy4tWpDumK6N9SAyL5lR8e6XVzinCtdtPZ0tqt4J3rv5SDPnX",
+	"l67+WiZi96Akh/UMwQeboYgRJVUq/jaftnUjf2YhZLBHEmy6DylJbNIsqPm1jHMXFJXMrXYDd9pX9q4c",
+	"qFJWFXmwQxyhOHomsSmkHoHz0QBTyD62HgjzQvM1C88LX+lnMbh9tgO9QbQXOXtwIYO8wzJ5Fw7CQY0T",
+	"PEbvYa3acVkZbMs8nMdkaciXfv8q2hlTC+L5WhA3m7XZ1krcvL3cwNLPzkxraalejWut6upy3ExmOyvt",
+	"OKp1bsRxstyYhf+a6kqWjC7WmxFsaqZeFHMM1c7NSb+ZVW//AMd6176NyAkugSseelsQftguqYeSXuPS",
+	"NX0167IL/y9L8IxPhd8+w2fzxuAhNU2ALXgH137mQr2z0urURfGMs7srmEQ7xCpuTdG4GZmlmhpKL5ah",
+	"lLFliptJ9WVhJnmgoX+htkOYnOiZ2IK+A3gpOxSIw8WtqNcq4l5WXheT470sLgWvVfSVvz3TrPHV5xgy",
+	"vSsGJX/FtUs3Xp/NNXjU26kFmQs3FlQ43i6Qa9GMb1X0YiE0hhQESgNIBSKvmqXoZ3vz9JF16hzQC6DL",
+	"wD4bZT5qLWe2H9Vr8hs6rEM1Yk7XhSAKecb4MdvGxliUwOsLZBv8nVfgDcBiHLFhSOVR6cPXUQF/BTsl",
+	"EszZReUrabVTQyCFMnPI2JWU8FDutUEW42aQboIo24HHwVyoyagEC8NlEJZEX+tBEMp/zegHI32IXGDy",
+	"b3t0XPsI4FUFy1ZJ25Y2GBDCEvKntZD7Cqv9vsf5BZUoaS3XqxUDuKjvivZ0sG4Re0fOwZCAy7LAyB2B",
+	"owgmxv201rLWseSjng2sES40Kytx+0rrVsWq2zZFyNDjITzTGwHiyeKg141QNUMYEf442zNOWl5Y4dcP",
+	"KrX2nSurTXu1tEkvNPUZGcbbQpPHrtMv8GCQhhUuAF5JOUVIq+myQdWJ37PriKFTQMC+DSrtmAu+f+YS",
+	"iW/9nkA/aIBeLwLqM2EZyGVhg3n00UQfJRHgFd0EZV7BqHcXxPOK93jHWC6XP3Nx+TD+DMr7n7dqcRFj",
+	"Dz99ATay+OevxLrPVDgBK2T/C2Hz8fu0T2GlrojJP8Gzm35JnSnK2mYdbzZUX+jntQQKcsJdvJPJn8rB",
+	"DBHAYcCcQ4m/38P/8KzLlsB2U7ctFDjsSfq56tyAojb9EjuHBNT3RAQ3xH5mKOPkiRhjU+U2a5gspmUG",
+	"aLRmbFlWU8KG72Lvum2NdKxrYd30hhQB25JtQpWTwbY12HbXHXDydXc46TTQcwiUTN35Fy0h4L0GB8sU",
+	"sB13puClDqr3M90PKDXuWldTwjXjWzku47eWhyihbY7ep9xUe6YXgQkPwvDaqRmbp4aDQFlHBvSiBGOh",
+	"k3yePrwWlhbxkhb6mrzQpSqfQqv9kRA4hQUT/2o9idv1gtCpd8Sn7TNX5MsXtC+shaVGC2tArI3/M/qT",
+	"NjgykH+wODo0j4kf3md08dYxTyIBqRRLp5+4Oebh49CD6Fj3CIVoXeUqwE0Ht3ehaQ0GCEO03ieS0IRi",
+	"ycWl26VWcrG5sooFL9Ftqmg5W7ZlnlBPRZ75Ae25VTw0YakRZ/9ebNQ7N84XPKeX5ce5FImjRlwrGiKH",
+	"z8K3VLD8INH1zuricr3Du+5diKNao94s+pjs99bC0s16p75Yb9STO8We8kv1eRs0SQaFfgfM2YbZijH7",
+	"thdCXFq9ynyy9CTwmGPblZqViEa4C+uSFOSdriNcYtXcPN2Q/ThlWXJuzWQ/UNWjmfDaFDP5wtX5TGAt",
+	"mEZIJ47a1Rv5rhb4VW68B2abycYUvyucD5dNwL/yIBHNHviGkWWV5Pd0xKGvS8x8PguX4wW8nakO+xu4",
+	"SBKQkdEyAbdUVcieWTzSy/YIfEjBykwpzWyQpfHjtsEGedGbcKa+YD05b4zX7UKupwuXWxaShAtNgw+y",
+	"p7zXUZB+BufwKSWB74ta/2162lCYJE8pQE2l5otUOO7u0cAPl9eX9R01YpzYCcw+x7ANUHYGpso6fBj2",
+	"vceezLB9+fXuvOiguQV2zmcUg8Vd4ZvKi7M4ZXeX08ZVWm3sIIzfVm58n+pdMAIaVGYqs7Kt7JZqycOv",
+	"2bbqszHgm7hOF7AXeDIW9yCiv07mFQBY4CQ+hZO5o/1FNA+h2+LJyH+aW+ahasNQqPOzhlWsBKUohToL",
+	"9WnOQr1cb0pW6hPjFD5SrIiTuiJQB+d4ECJ/pFA/+Y0++QbnPUszbkoS34ixsXLh8ZKth19yjfmvGjXX",
+	"FNFyYETLAU9C5hjsePubuSeXsaAPyLFwjMEtVB0/rSeOlVwLJz2fQjoMwSp+QpnVXkAtNaTUkPkuQ38C",
+	"M/IU2PoixuDAuEFzU5ZdC/oTNFvzswx38R9QOpskUfXGsmDm8ABe7W6sfqznvJBBwBnB9hWVijx4PYJr",
+	"DrJE3322pz2cCGt/h8hV/Z2AJ9gyR7XQpCVxWeA8321PgmqxxWewWe29dEOIzXmBKO3aaZuuXmgj5kmp",
+	"ZZHhhevnXQre/D/Qr+Q+uTJDxxqEgSCa7QfSl3qs59q5k9W3VgTgA/qCOKqB4QN7APi4z0ZCIfWt+eYW",
+	"7ZzXDlCROmBx9A5coCsfsPacUjauR96M2x2K0mQzpPVm8sbpEtiO9eXVZd1yrDeT+HrcPi4tom7z5P28",
+	"rcMzFf3TaM0PrcL1O5MUYUyFq6UQcjguvzHEOCW/JJJnMEEpBEQ2JAbXBhvyxQ9OlwP2F9Znv0N2EGn0",
+	"Y7OKnqiBfTgfXL7wXrjQvPzB+2Hws8vvvh8GF37F/+/Dd34dYtGJeD2YCchHl27q9GaoI/psXx+JSStp",
+	"tNLAma/Tl7hrFgZUF9xTMRNZ2bovwrZaww2ELr9lItL6guqghzfIDoJd+NX7z4HL4qMVzoJoq7EXUIs9",
+	"XyaL5dVGUl+J2skprtFmalES5eU4l+qNuCi6Ss95wPcKJS0Uet7qAHCsWQpdlzqr6c0uBHavTjYiAXgS",
+	"mCcbpaTQZ0jQQ3yHumyxGs9NWSWmOvc56FxgRNULVrZsJezzUxEscaoBBSuLrahdy03fgPoYBEgvNnM1",
+	"biYBMEl2SH11iRP5c9TYu5gWFAghA+1ONIg692pFG0ZF1nYqCyD7Ag9tL89NCKy0TW7Kts24PLFMax+y",
+	"AMGCBErnfQ1t2thK3KxV0NrQQA7GhAOCU3Aj5ksIMq4rkODBVTBF+4kfFsKe/sQUFGC7G9DuC6T1Xrrh",
+	"bT4Kp+WSdlheVq0+njUPSExnOkk7jpbNmz8e0atukkV6GRjQAovu1LwecNb0ipwoszui8bpQLKqU4vh1",
+	"aFagCUt1hLERvVO8NuCM0Jhqw6OegeLSk+PPCOveC68Dv9N0Q3ec6vEqxJj6hK9ECSIZMoWamV7QNjIS",
+	"eZjMU5E+lnDxLETIqo6v1ROJn3y1XKZJMKEHw3YeFmY5OTzwcPC+Fweot1bExfzGLAvLvyOIknggu4Lw",
+	"f4r6Q2mVkJWOrdRHMsiSrcs0E7zWg3pC5ajCcCKKlX3m0Lgbqkw+MXvYzd930DZ60WF95rgDD314dmGH",
+	"SInZZ0NND39+Qmw8FsyypyNfRIuDdTlOrYJAFRaRjs5iCDAhhaWgPfZEfJPSY1MjY+py55sbfyMxMrBq",
+	"VzIF+H5b40a9k7Tad/ISxDncP75uYNgT1eKqBfR9btPQLcvv5ZQBfgofmaf8Kc3hJTRVXqZO97hyV2IO",
+	"tkdLZmwW03/4pjnMqXB/uYX7H2Gso/Sew9D0ivN682YdO93mtQJ1Udxmqef1bgGIQpSEaodM2PlF+UVt",
+	"/FNx/gMQ52rDijUUdR298W1Fp2J+KuZfUjFfSBiPBbDg99cF2sIW5U4Kco33wu5m3p0NjAd/qWIY7rbR",
+	"2OVP3AzBbaQXJSDTPHR+ebDQ9KkLjL8gCJP1DqtnvP39bGUzjXLmRDkPW1y8OnERe6E4359EFcx+9gbM",
+	"Buzves9ceQzJScaThFxc5GYqdrITiKzpWrSY1rQ5904CkvKdKWLui/7L2CPY3ZRAtjrpYU8IFxgFmTr0",
+	"jgPOpgjT9nyvuHYN3Ypt06/Ysvo4fVhEI2NwytTIHg+Mk2CcuttoJfyHatSsxo2c7mWSUaKvupZpt4hY",
+	"KbJI029cBNomfsIkJtNryKAQweDmkykLrS8actFCtnDDGoBonT0SnbDhX/bwuS7fFb3qCrFzOBU2LOE1",
+	"QaFxYprafAPs74Efj99+QYAkzyUrBNQuNmPK8WsoHIZQOUWZXaZq5RV32kQTTFFjOVZlKDnepa/lxOhk",
+	"p8u8pMtIte/UqIVphOlGXsgkUA2+LB+p50LuZfpa6v3AqNKUxDwxrfQ47muhaX4OW2Z6szt7Zp951RXD",
+	"fgj8ouevCM5LBP1Cruw0dvgDiB2qto2TF7TJQwMsEtMo4VThvOQFbWMUQk6E8A9agZd+c/AxPplNfSjM",
+	"t2BALjC+Ibs4Gt2wjfcoFVOsdWKPWic68lFOd09DnhtvZl2Xujjf+eQATY5f1eifsFYO1UlYPqRQkZh2",
+	"2F+4bsJGp1VJUTr7ImDZnRd5Wuw11UfPVx+5tZHH92lHzU94QUouNiEb2LKBZy66ONmBAtkukPOdosUE",
+	"JxMRLdFRfwQlXEM2oFST1YoBrDWjXwphctN7pLQ0jg5OyrSpP7Irm7uImJqHA0R9CW1bzJEF/hRZKKcC",
+	"XqAAnqYbclSiMQ7nu8ZuUvz2SMSzRvOmql4GARSVdrH+jHu+ZaxanyuXZ1Xbkx2oEhryd2faCeeC+5w9",
+	"//hWGryBfOIiNZA+4L+HNedGD+ZrusYaH750LdehvELHdepOvtDuJJcqce3teq2QJ/k36xS7ShF5GyRe",
+	"kwQCxIywnICu/7uLowh63ejCiSt4/XL0p7p/qvufE+p83P0hNWwpT13XDVjfbyVAB5vZldpSvqFABax8",
+	"QbWMkspbWZcmU9d5+cJ7cmuwKlvn/d2ct3Q3anhNd2cB8bJNl1cREsO8I3m3lTEV0gfG60jzG7BQV3dd",
+	"NDLSdULlYL8sZIsYcK0PpsIejFf2zIVqFWlXZNVqjw3HqlUPzKho8nL8BmYMji3RCk2eA6CK00IMWkIT",
+	"FkO+oZ9u5qt+OIOvZm25LmvoEh6ue1Tmsrqu4hE1LbUOBJa3vkhtSw1p2+PKjYSudgGmavik1bCc3f5B",
+	"pZW2tabsSR++8Do+c2FRPW4RFxitgeZQFy1QaLcajcWo+smpu8S7uJaPjsEWDoSOyWROMms+cHILm2yh",
+	"/8VHHYBeNBrH6Twr4sjA9Hcc3Zk1IwAMoQHxkIv2E5o3Ds5zbkBDZyOVooBAtE9V1wuXvrpC63ly1fqZ",
+	"Syi7XWrLznlITQJzTmsDSAzpsOtbbZeLOKaheDv9s5iMx/PlhePkFWmrpe/KIu1xh1uatNM6vKmefSmw",
+	"PsaZL4j4IYHtpwT1akFqZ1CgHyiJQ61JgssrEgfcbosk1JW3MRJvZuh1ga6Kpgs/eOrn5y92Za8J93Eb",
+	"EAf6Tt5WToXRNPY2zi7PNgq2WwHrB8zuHdxFNqAkl1RqMpEzmWz5aKUmy69eLPEiO8wc5gV6x5mX1JT8",
+	"1n8scul/pobiVDa/5IQNGVq+MaKYG4e34sUbrdYnnVN36V8Xa2sonRtxEjvk9N8BcbSrQiIqbTHAyMQe",
+	"nYoeQLK/Tzf4BYDvQAoA8plyVyDeMThAoa072J+V+BdgIr/CyRUS9nIhDiwl1RN+8JKYpuIVEHLvQf7S",
+	"2ehNSUpfUYllH4mM1GLdjNz6u3Zq+oqgWjyk7xdUp2pxo34zbtfjHF/295qoGVBVpmIbDiRGSntlT/Ey",
+	"7kMu9SF42/3nKKbejxOSURfUnF5KaeXuQEkx82zrPrZt7d8xtnG8ZezIHd26fmmwS9YcCyGYrCs15c+Z",
+	"6rBXXIf9T2XlZozbHP21QlhkT+XM11pnB9nHqBuwLtvme0zYZ11XZloy0Mtm+ZsqYZB+AcIV8KyOLkn7",
+	"hOPbkzBd8YFBumG+jFdCWoJAgh8eCGQvdqsYBDzzKNt/8ydZJZ3pA1cTgCPRtI6c5eV68/rUITicQ6D0",
+	"xVj90CUUFP1iV9QpA1hUdAPfTh9Nhe1U2BYStt/ocomOl+UwEHG8pzH+n8ngJOkDWRG0Wvj/B+cvXyyF",
+	"pdV2ozRfupEkK/OnTjVa1ahxo9VJ5n9S/kn5VLRSL619vPb/DQCcMbUFlFsCAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
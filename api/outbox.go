@@ -0,0 +1,257 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+)
+
+// OutboxEvent is a domain event written in the transaction of the change it
+// reports. Its JSON form is what the message broker sinks publish.
+type OutboxEvent struct {
+	Id            int64              `json:"id"`
+	AggregateType EventAggregateType `json:"aggregateType"`
+	AggregateId   string             `json:"aggregateId"`
+	Type          EventType          `json:"type"`
+	Payload       json.RawMessage    `json:"data"`
+	CreatedAt     time.Time          `json:"occurredAt"`
+}
+
+// tenderStatusEvent is the event a tender moving to status is recorded as.
+func tenderStatusEvent(status TenderStatus) EventType {
+	switch status {
+	case TenderStatusPublished:
+		return EventTypeTenderPublished
+	case TenderStatusClosed:
+		return EventTypeTenderClosed
+	}
+	return EventTypeTenderUpdated
+}
+
+// bidStatusEvent is the event a bid moving to status is recorded as.
+func bidStatusEvent(status BidStatus) EventType {
+	switch status {
+	case BidStatusPublished:
+		return EventTypeBidPublished
+	case BidStatusCanceled:
+		return EventTypeBidCanceled
+	}
+	return EventTypeBidUpdated
+}
+
+// bidEventData is what events tell about a bid on tender t: all of it, or
+// only that it exists while the tender is sealed.
+func bidEventData(b *Bid, t *Tender) any {
+	if t.Sealed && t.RevealedAt == nil {
+		return hiddenBid{Id: b.Id, TenderId: b.TenderId, Status: b.Status, AuthorType: b.AuthorType,
+			AuthorId: b.AuthorId, Version: b.Version, CreatedAt: b.CreatedAt}
+	}
+	return b
+}
+
+// attachmentEventData is what the audit log tells about an attachment of
+// tender t or of a bid on it: all of it, or not what the file is while the
+// bids of t are sealed.
+func attachmentEventData(a *Attachment, t *Tender) any {
+	if a.BidId != nil && t.Sealed && t.RevealedAt == nil {
+		return hiddenAttachment{Id: a.Id, BidId: a.BidId, Version: a.Version,
+			UploaderUsername: a.UploaderUsername, CreatedAt: a.CreatedAt}
+	}
+	return a
+}
+
+// hiddenAttachment is what is told about an attachment to a bid while its
+// tender is sealed: that it exists, not its name, type, size or checksum.
+type hiddenAttachment struct {
+	Id               AttachmentId `json:"id"`
+	BidId            *BidId       `json:"bidId,omitempty"`
+	Version          int32        `json:"version"`
+	UploaderUsername Username     `json:"uploaderUsername"`
+	CreatedAt        string       `json:"createdAt"`
+}
+
+// hiddenBid is what bid events tell about a bid while its tender is
+// sealed: that it exists, not what it offers.
+type hiddenBid struct {
+	Id         BidId         `json:"id"`
+	TenderId   TenderId      `json:"tenderId"`
+	Status     BidStatus     `json:"status"`
+	AuthorType BidAuthorType `json:"authorType"`
+	AuthorId   BidAuthorId   `json:"authorId"`
+	Version    BidVersion    `json:"version"`
+	CreatedAt  string        `json:"createdAt"`
+}
+
+// bidDecisionEvent is the data of bid.decision. Outcome is set on the
+// decision that settles the bid, or its lot, approving or rejecting it.
+type bidDecisionEvent struct {
+	Bid      any         `json:"bid"`
+	Decision BidDecision `json:"decision"`
+	LotId    *LotId      `json:"lotId,omitempty"`
+	Username Username    `json:"username"`
+	Outcome  BidDecision `json:"outcome,omitempty"`
+}
+
+// settledOutcome is the outcome a decision settles a bid with: the outcome
+// of all the decisions when the previous ones left the bid undecided.
+func settledOutcome(previous []BidDecision, decision BidDecision, responsibles int) BidDecision {
+	if bidDecisionOutcome(previous, responsibles) != "" {
+		return ""
+	}
+	return bidDecisionOutcome(append(slices.Clone(previous), decision), responsibles)
+}
+
+// bidFeedbackEvent is the data of feedback.created.
+type bidFeedbackEvent struct {
+	Bid      any         `json:"bid"`
+	Feedback BidFeedback `json:"feedback"`
+	Rating   *BidRating  `json:"rating,omitempty"`
+	OnTime   *BidOnTime  `json:"onTime,omitempty"`
+	Username Username    `json:"username"`
+}
+
+// eventSubject loads the tender an event concerns and, for bid events, the
+// bid.
+func eventSubject(store Storage, e OutboxEvent) (*Tender, *Bid, error) {
+	if e.AggregateType == EventAggregateTypeTender {
+		tender, err := store.GetTenderById(e.AggregateId)
+		return tender, nil, err
+	}
+
+	bid, err := store.GetBidById(e.AggregateId)
+	if err != nil {
+		return nil, nil, err
+	}
+	tender, err := store.GetTenderById(bid.TenderId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tender, bid, nil
+}
+
+// EventSink receives the events relayed from the outbox. An event is
+// relayed again until every sink has taken it, so sinks may see it more
+// than once and consumers should deduplicate by its id.
+type EventSink interface {
+	Publish(ctx context.Context, event OutboxEvent) error
+}
+
+// outboxBatch is how many events a relay tick reads at once.
+const outboxBatch = 100
+
+// OutboxRelay publishes the committed outbox events to the sinks. Only the
+// replica holding the lock relays, so the events of an aggregate are never
+// published by two replicas at once.
+type OutboxRelay struct {
+	store    Storage
+	lock     Locker
+	sinks    []EventSink
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewOutboxRelay returns a relay ticking every interval. A nil lock makes
+// this process the relay unconditionally.
+func NewOutboxRelay(store Storage, lock Locker, interval time.Duration, sinks ...EventSink) *OutboxRelay {
+	return &OutboxRelay{
+		store:    store,
+		lock:     lock,
+		sinks:    sinks,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run ticks until ctx is cancelled and releases the lock on the way out.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	if r.lock != nil {
+		defer func() {
+			if err := r.lock.Unlock(context.Background()); err != nil {
+				log.Printf("outbox: unlock failed: %v", err)
+			}
+		}()
+	}
+
+	for {
+		leader := true
+		if r.lock != nil {
+			var err error
+			if leader, err = r.lock.TryLock(ctx); err != nil {
+				log.Printf("outbox: leader election failed: %v", err)
+			}
+		}
+		if leader {
+			if err := r.Tick(ctx); err != nil {
+				log.Printf("outbox: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick publishes the pending events, oldest first. An event a sink fails
+// holds back the later events of its aggregate until the next tick; the
+// events of other aggregates go on.
+func (r *OutboxRelay) Tick(ctx context.Context) error {
+	for {
+		events, err := r.store.FetchOutbox(outboxBatch)
+		if err != nil {
+			return fmt.Errorf("failed to read outbox: %w", err)
+		}
+
+		held := map[string]bool{}
+		var published []int64
+		for _, e := range events {
+			aggregate := string(e.AggregateType) + "/" + e.AggregateId
+			if held[aggregate] {
+				continue
+			}
+			if err := r.publish(ctx, e); err != nil {
+				log.Printf("outbox: failed to publish event %d (%s of %s): %v", e.Id, e.Type, aggregate, err)
+				held[aggregate] = true
+				continue
+			}
+			published = append(published, e.Id)
+		}
+
+		if len(published) > 0 {
+			if err := r.store.MarkOutboxPublished(published, r.now()); err != nil {
+				return fmt.Errorf("failed to mark events published: %w", err)
+			}
+		}
+
+		// Held events would come back first, so a batch with any is the last.
+		if len(events) < outboxBatch || len(held) > 0 {
+			return nil
+		}
+	}
+}
+
+func (r *OutboxRelay) publish(ctx context.Context, e OutboxEvent) error {
+	for _, sink := range r.sinks {
+		if err := sink.Publish(ctx, e); err != nil {
+			return fmt.Errorf("%T: %w", sink, err)
+		}
+	}
+	return nil
+}
+
+// LogSink writes a line per event to the standard logger, without the
+// payload, which may hold what only the tender's organization may see.
+type LogSink struct{}
+
+func (LogSink) Publish(ctx context.Context, e OutboxEvent) error {
+	log.Printf("event %d: %s of %s %s", e.Id, e.Type, e.AggregateType, e.AggregateId)
+	return nil
+}
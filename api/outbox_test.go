@@ -0,0 +1,190 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+type recordingSink struct {
+	events []OutboxEvent
+}
+
+func (s *recordingSink) Publish(ctx context.Context, e OutboxEvent) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+// failingSink fails the first attempt at every event of one aggregate.
+type failingSink struct {
+	aggregateId string
+	failed      map[int64]bool
+}
+
+func (s *failingSink) Publish(ctx context.Context, e OutboxEvent) error {
+	if e.AggregateId == s.aggregateId && !s.failed[e.Id] {
+		s.failed[e.Id] = true
+		return errors.New("broker unavailable")
+	}
+	return nil
+}
+
+func TestOutboxRelay(t *testing.T) {
+	store := NewMemoryStorage()
+	org := store.AddOrganization("ERP")
+	create := func(name string) *Tender {
+		t.Helper()
+		tender, err := store.CreateTender(&Tender{Name: name, OrganizationId: org, ServiceType: TenderServiceTypeDelivery}, "owner")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tender
+	}
+	roads, roof := create("Ремонт дорог"), create("Ремонт кровли")
+	if _, err := store.UpdateTenderStatus(roads.Id, TenderStatusPublished); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.UpdateTenderStatus(roof.Id, TenderStatusPublished); err != nil {
+		t.Fatal(err)
+	}
+	// Setting the status a tender has already changes nothing.
+	if _, err := store.UpdateTenderStatus(roof.Id, TenderStatusPublished); err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := &recordingSink{}
+	relay := NewOutboxRelay(store, nil, time.Second, recorder, &failingSink{aggregateId: roads.Id, failed: map[int64]bool{}})
+	published := func() []string {
+		var got []string
+		for _, e := range recorder.events {
+			name := map[string]string{roads.Id: "roads", roof.Id: "roof"}[e.AggregateId]
+			got = append(got, fmt.Sprintf("%s %s", name, e.Type))
+		}
+		return got
+	}
+
+	// A failed event holds back the later events of its tender only, and the
+	// recorder takes it again on the next tick: delivery is at least once.
+	for i, want := range []string{
+		"roads tender.created,roof tender.created,roof tender.published",
+		"roads tender.created,roads tender.published",
+		"roads tender.published",
+	} {
+		recorder.events = nil
+		if err := relay.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Join(published(), ","); got != want {
+			t.Fatalf("tick %d published %s", i+1, got)
+		}
+	}
+
+	var payload Tender
+	if err := json.Unmarshal(recorder.events[0].Payload, &payload); err != nil || payload.Status != TenderStatusPublished {
+		t.Errorf("payload = %+v, %v", payload, err)
+	}
+	if pending, err := store.FetchOutbox(outboxBatch); err != nil || len(pending) != 0 {
+		t.Errorf("pending = %v, %v", pending, err)
+	}
+}
+
+func TestWebhookSinkRepublish(t *testing.T) {
+	store := NewMemoryStorage()
+	org := store.AddOrganization("ERP")
+	webhook, err := store.CreateWebhook(&Webhook{OrganizationId: org, Url: "https://erp.example.com/hooks",
+		Events: []WebhookEvent{WebhookEventTenderPublished}}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tender, err := store.CreateTender(&Tender{Name: "Ремонт дорог", OrganizationId: org, ServiceType: TenderServiceTypeDelivery}, "owner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.UpdateTenderStatus(tender.Id, TenderStatusPublished); err != nil {
+		t.Fatal(err)
+	}
+
+	// The sink after the webhooks fails each event once, so the relay
+	// publishes tender.published to the webhooks twice.
+	relay := NewOutboxRelay(store, nil, time.Second, NewWebhookSink(store), &failingSink{aggregateId: tender.Id, failed: map[int64]bool{}})
+	for i := 0; i < 3; i++ {
+		if err := relay.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deliveries, err := store.GetWebhookDeliveries(webhook.Id, "", 10, 0)
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("deliveries = %v, %v", deliveries, err)
+	}
+	if id := deliveries[0].Payload.EventId; id == nil || *id != 2 {
+		t.Errorf("event id = %v", id)
+	}
+}
+
+func TestNATSSink(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+
+	type published struct{ subject, body string }
+	got := make(chan published, 2)
+	go func() {
+		pubs := 0
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		fmt.Fprintf(conn, "INFO {\"server_id\":\"test\"}\r\n")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch fields[0] {
+			case "PUB":
+				body, _ := r.ReadString('\n')
+				got <- published{fields[1], strings.TrimRight(body, "\r\n")}
+				pubs++
+			case "PING":
+				if pubs == 2 {
+					fmt.Fprintf(conn, "-ERR 'Permissions Violation'\r\n")
+					continue
+				}
+				fmt.Fprintf(conn, "PING\r\nPONG\r\n")
+			}
+		}
+	}()
+
+	sink, err := NewNATSSink("nats://"+ln.Addr().String(), "tenders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := OutboxEvent{Id: 7, AggregateType: EventAggregateTypeBid, AggregateId: "b1", Type: EventTypeBidCreated,
+		Payload: json.RawMessage(`{"id":"b1"}`), CreatedAt: time.Unix(1700000000, 0).UTC()}
+	if err := sink.Publish(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+	p := <-got
+	if p.subject != "tenders.bid.created" || !strings.Contains(p.body, `"id":7`) || !strings.Contains(p.body, `"data":{"id":"b1"}`) {
+		t.Errorf("published %+v", p)
+	}
+
+	if err := sink.Publish(context.Background(), event); err == nil || !strings.Contains(err.Error(), "Permissions Violation") {
+		t.Errorf("rejected publish: %v", err)
+	}
+	if _, err := NewNATSSink("http://localhost:4222", "tenders"); err == nil {
+		t.Error("accepted an http url")
+	}
+}
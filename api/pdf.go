@@ -0,0 +1,417 @@
+package api
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf16"
+)
+
+// Page geometry of PDF documents, in points: A4 with equal margins.
+const (
+	pdfPageWidth  = 595.28
+	pdfPageHeight = 841.89
+	pdfMargin     = 50
+	pdfLineGap    = 1.3
+)
+
+// pdfStyle picks the font of a piece of text.
+type pdfStyle int
+
+const (
+	pdfRegular pdfStyle = iota
+	pdfBold
+)
+
+// pdfFont is a TrueType font embedded whole in the documents using it.
+// Text is written as glyph ids (Identity-H), so any script the font covers
+// can be shown.
+type pdfFont struct {
+	name                       string
+	data                       []byte
+	sfnt                       *sfnt.Font
+	upem                       fixed.Int26_6
+	ascent, descent, capHeight int
+	bbox                       [4]int
+}
+
+var pdfFonts = sync.OnceValues(func() ([2]*pdfFont, error) {
+	regular, err := parsePDFFont("GoRegular", goregular.TTF)
+	if err != nil {
+		return [2]*pdfFont{}, err
+	}
+	bold, err := parsePDFFont("GoBold", gobold.TTF)
+	if err != nil {
+		return [2]*pdfFont{}, err
+	}
+	return [2]*pdfFont{regular, bold}, nil
+})
+
+func parsePDFFont(name string, data []byte) (*pdfFont, error) {
+	f, err := sfnt.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse font %s: %w", name, err)
+	}
+	pf := &pdfFont{name: name, data: data, sfnt: f, upem: fixed.I(int(f.UnitsPerEm()))}
+
+	var b sfnt.Buffer
+	m, err := f.Metrics(&b, pf.upem, font.HintingNone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metrics of font %s: %w", name, err)
+	}
+	bounds, err := f.Bounds(&b, pf.upem, font.HintingNone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bounds of font %s: %w", name, err)
+	}
+	pf.ascent, pf.descent, pf.capHeight = pf.units(m.Ascent), -pf.units(m.Descent), pf.units(m.CapHeight)
+	pf.bbox = [4]int{pf.units(bounds.Min.X), -pf.units(bounds.Max.Y), pf.units(bounds.Max.X), -pf.units(bounds.Min.Y)}
+	return pf, nil
+}
+
+// units converts a length of the font to thousandths of the font size.
+func (f *pdfFont) units(v fixed.Int26_6) int {
+	return int(int64(v) * 1000 / int64(f.upem))
+}
+
+// pdfDoc lays out text top to bottom over as many pages as it takes. It
+// keeps each page's content stream and the glyphs used of each font.
+type pdfDoc struct {
+	title  string
+	fonts  [2]*pdfFont
+	buf    sfnt.Buffer
+	used   [2]map[sfnt.GlyphIndex]pdfGlyph
+	pages  []*bytes.Buffer
+	y      float64
+	footer string
+}
+
+type pdfGlyph struct {
+	r     rune
+	width int
+}
+
+func newPDFDoc(title string) (*pdfDoc, error) {
+	fonts, err := pdfFonts()
+	if err != nil {
+		return nil, err
+	}
+	d := &pdfDoc{title: title, fonts: fonts, used: [2]map[sfnt.GlyphIndex]pdfGlyph{{}, {}}}
+	d.newPage()
+	return d, nil
+}
+
+func (d *pdfDoc) newPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+	d.y = pdfPageHeight - pdfMargin
+}
+
+// glyphs maps s to glyph ids of the font, noting them as used, and returns
+// its width in thousandths of the font size. Runes the font lacks show as
+// its missing glyph.
+func (d *pdfDoc) glyphs(style pdfStyle, s string) ([]sfnt.GlyphIndex, int) {
+	f := d.fonts[style]
+	var ids []sfnt.GlyphIndex
+	width := 0
+	for _, r := range s {
+		if r == '\t' {
+			r = ' '
+		}
+		id, err := f.sfnt.GlyphIndex(&d.buf, r)
+		if err != nil {
+			id = 0
+		}
+		g, ok := d.used[style][id]
+		if !ok {
+			adv, err := f.sfnt.GlyphAdvance(&d.buf, id, f.upem, font.HintingNone)
+			if err != nil {
+				adv = 0
+			}
+			g = pdfGlyph{r: r, width: f.units(adv)}
+			d.used[style][id] = g
+		}
+		ids = append(ids, id)
+		width += g.width
+	}
+	return ids, width
+}
+
+// textWidth is the width of s in points at the given size.
+func (d *pdfDoc) textWidth(style pdfStyle, size float64, s string) float64 {
+	_, w := d.glyphs(style, s)
+	return float64(w) * size / 1000
+}
+
+// wrap breaks s into lines no wider than width, at spaces where it can.
+func (d *pdfDoc) wrap(style pdfStyle, size, width float64, s string) []string {
+	var lines []string
+	for _, para := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(para) {
+			candidate := strings.TrimSpace(line + " " + word)
+			if line == "" || d.textWidth(style, size, candidate) <= width {
+				line = candidate
+			} else {
+				lines = append(lines, line)
+				line = word
+			}
+			// A word wider than the line is cut where it overflows.
+			for d.textWidth(style, size, line) > width {
+				runes := []rune(line)
+				n := len(runes) - 1
+				for n > 1 && d.textWidth(style, size, string(runes[:n])) > width {
+					n--
+				}
+				lines = append(lines, string(runes[:n]))
+				line = string(runes[n:])
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// show writes s at x, y of the page.
+func (d *pdfDoc) show(page *bytes.Buffer, style pdfStyle, size, x, y float64, s string) {
+	ids, _ := d.glyphs(style, s)
+	fmt.Fprintf(page, "BT /F%d %.2f Tf %.2f %.2f Td <", style+1, size, x, y)
+	for _, id := range ids {
+		fmt.Fprintf(page, "%04X", uint16(id))
+	}
+	page.WriteString("> Tj ET\n")
+}
+
+// need starts a new page unless height points are left on this one.
+func (d *pdfDoc) need(height float64) {
+	if d.y-height < pdfMargin {
+		d.newPage()
+	}
+}
+
+// Text writes s as a paragraph wrapped to the width of the page.
+func (d *pdfDoc) Text(style pdfStyle, size float64, s string) {
+	d.Columns(style, size, []float64{1}, []string{s})
+}
+
+// Columns writes a row of cells side by side, each wrapped to its share
+// of the page width.
+func (d *pdfDoc) Columns(style pdfStyle, size float64, shares []float64, cells []string) {
+	width := pdfPageWidth - 2*pdfMargin
+	var total float64
+	for _, s := range shares {
+		total += s
+	}
+
+	wrapped := make([][]string, len(cells))
+	lines := 1
+	for i, cell := range cells {
+		wrapped[i] = d.wrap(style, size, width*shares[i]/total-6, cell)
+		lines = max(lines, len(wrapped[i]))
+	}
+
+	leading := size * pdfLineGap
+	for line := 0; line < lines; line++ {
+		d.need(leading)
+		d.y -= leading
+		x := float64(pdfMargin)
+		for i := range cells {
+			if line < len(wrapped[i]) && wrapped[i][line] != "" {
+				d.show(d.pages[len(d.pages)-1], style, size, x, d.y, wrapped[i][line])
+			}
+			x += width * shares[i] / total
+		}
+	}
+}
+
+// Rule draws a horizontal line across the page.
+func (d *pdfDoc) Rule() {
+	d.need(8)
+	d.y -= 4
+	fmt.Fprintf(d.pages[len(d.pages)-1], "0.5 w %.2f %.2f m %.2f %.2f l S\n",
+		float64(pdfMargin), d.y, pdfPageWidth-pdfMargin, d.y)
+	d.y -= 4
+}
+
+// Space leaves height points blank.
+func (d *pdfDoc) Space(height float64) {
+	d.y -= height
+}
+
+// Footer sets a line printed at the bottom of every page, followed by the
+// page number.
+func (d *pdfDoc) Footer(s string) {
+	d.footer = s
+}
+
+// WriteTo writes the document out as PDF 1.7.
+func (d *pdfDoc) WriteTo(w io.Writer) (int64, error) {
+	for i, page := range d.pages {
+		footer := fmt.Sprintf("%s    %d / %d", d.footer, i+1, len(d.pages))
+		d.show(page, pdfRegular, 8, pdfMargin, pdfMargin/2, strings.TrimSpace(footer))
+	}
+
+	pw := &pdfWriter{}
+	pw.buf.WriteString("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n")
+
+	// Objects 1 and 2 are the catalog and the page tree; the rest follow
+	// in the order they are written.
+	pw.reserve(2)
+	var fontRefs [2]int
+	for i, f := range d.fonts {
+		ref, err := pw.font(f, d.used[i])
+		if err != nil {
+			return 0, err
+		}
+		fontRefs[i] = ref
+	}
+
+	var kids []string
+	for _, page := range d.pages {
+		content, err := pw.stream("", page.Bytes())
+		if err != nil {
+			return 0, err
+		}
+		kids = append(kids, fmt.Sprintf("%d 0 R", pw.add(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Contents %d 0 R /Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> >>",
+			pdfPageWidth, pdfPageHeight, content, fontRefs[0], fontRefs[1]))))
+	}
+	pw.set(1, "<< /Type /Catalog /Pages 2 0 R >>")
+	pw.set(2, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids)))
+	info := pw.add(fmt.Sprintf("<< /Title %s /Producer (my_zad) /CreationDate (D:%s) >>",
+		pdfText(d.title), time.Now().UTC().Format("20060102150405Z")))
+
+	return pw.finish(w, info)
+}
+
+// pdfText encodes s as a PDF text string, in UTF-16 with a byte order mark.
+func pdfText(s string) string {
+	return "<FEFF" + utf16Hex(s) + ">"
+}
+
+func utf16Hex(s string) string {
+	var b strings.Builder
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	return b.String()
+}
+
+// pdfWriter numbers the objects of a document and keeps their offsets for
+// the cross-reference table.
+type pdfWriter struct {
+	buf     bytes.Buffer
+	offsets []int
+	pending map[int]bool
+}
+
+func (pw *pdfWriter) reserve(n int) {
+	pw.pending = map[int]bool{}
+	for range n {
+		pw.offsets = append(pw.offsets, 0)
+		pw.pending[len(pw.offsets)] = true
+	}
+}
+
+func (pw *pdfWriter) set(ref int, body string) {
+	pw.offsets[ref-1] = pw.buf.Len()
+	fmt.Fprintf(&pw.buf, "%d 0 obj\n%s\nendobj\n", ref, body)
+	delete(pw.pending, ref)
+}
+
+func (pw *pdfWriter) add(body string) int {
+	pw.offsets = append(pw.offsets, 0)
+	ref := len(pw.offsets)
+	pw.set(ref, body)
+	return ref
+}
+
+// stream adds a Flate-compressed stream with the entries given besides its
+// filter and length.
+func (pw *pdfWriter) stream(entries string, data []byte) (int, error) {
+	var z bytes.Buffer
+	zw := zlib.NewWriter(&z)
+	if _, err := zw.Write(data); err != nil {
+		return 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return 0, err
+	}
+
+	pw.offsets = append(pw.offsets, pw.buf.Len())
+	ref := len(pw.offsets)
+	fmt.Fprintf(&pw.buf, "%d 0 obj\n<< %s /Filter /FlateDecode /Length %d >>\nstream\n", ref, entries, z.Len())
+	pw.buf.Write(z.Bytes())
+	pw.buf.WriteString("\nendstream\nendobj\n")
+	return ref, nil
+}
+
+// font adds a Type0 font and the objects it refers to, with the widths and
+// the text of the glyphs used.
+func (pw *pdfWriter) font(f *pdfFont, used map[sfnt.GlyphIndex]pdfGlyph) (int, error) {
+	file, err := pw.stream(fmt.Sprintf("/Length1 %d", len(f.data)), f.data)
+	if err != nil {
+		return 0, err
+	}
+	descriptor := pw.add(fmt.Sprintf(
+		"<< /Type /FontDescriptor /FontName /%s /Flags 32 /FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 /FontFile2 %d 0 R >>",
+		f.name, f.bbox[0], f.bbox[1], f.bbox[2], f.bbox[3], f.ascent, f.descent, f.capHeight, file))
+
+	ids := make([]sfnt.GlyphIndex, 0, len(used))
+	for id := range used {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	var widths, cmap strings.Builder
+	for _, id := range ids {
+		fmt.Fprintf(&widths, "%d [%d] ", id, used[id].width)
+	}
+	// bfchar sections hold at most 100 entries each.
+	for chunk := range slices.Chunk(ids, 100) {
+		fmt.Fprintf(&cmap, "%d beginbfchar\n", len(chunk))
+		for _, id := range chunk {
+			fmt.Fprintf(&cmap, "<%04X> <%s>\n", uint16(id), utf16Hex(string(used[id].r)))
+		}
+		cmap.WriteString("endbfchar\n")
+	}
+
+	cid := pw.add(fmt.Sprintf(
+		"<< /Type /Font /Subtype /CIDFontType2 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> /FontDescriptor %d 0 R /CIDToGIDMap /Identity /DW 1000 /W [%s] >>",
+		f.name, descriptor, widths.String()))
+	toUnicode, err := pw.stream("", []byte(
+		"/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n"+
+			"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n"+
+			"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n"+
+			"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n"+
+			cmap.String()+
+			"endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n"))
+	if err != nil {
+		return 0, err
+	}
+	return pw.add(fmt.Sprintf(
+		"<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>",
+		f.name, cid, toUnicode)), nil
+}
+
+func (pw *pdfWriter) finish(w io.Writer, info int) (int64, error) {
+	if len(pw.pending) > 0 {
+		return 0, fmt.Errorf("pdf objects %v were reserved but not written", pw.pending)
+	}
+	xref := pw.buf.Len()
+	fmt.Fprintf(&pw.buf, "xref\n0 %d\n0000000000 65535 f \n", len(pw.offsets)+1)
+	for _, offset := range pw.offsets {
+		fmt.Fprintf(&pw.buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&pw.buf, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(pw.offsets)+1, info, xref)
+	return pw.buf.WriteTo(w)
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strings"
+)
+
+func (a *APIServer) askTenderQuestion(w http.ResponseWriter, r *http.Request, tenderId TenderId, params AskTenderQuestionParams) error {
+	var req AskTenderQuestionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	if strings.TrimSpace(req.Question) == "" {
+		return httpError(http.StatusBadRequest, "question is empty")
+	}
+
+	user, tender, own, err := a.requireTenderViewer(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+	if own {
+		return httpError(http.StatusForbidden, "responsibles can't ask about their own tender")
+	}
+	if tender.Status != TenderStatusPublished {
+		return httpError(http.StatusBadRequest, "tender %s is not published", tender.Id)
+	}
+
+	question := &TenderQuestion{TenderId: tenderId, AuthorUsername: user.Username, Question: req.Question}
+	org, err := a.store.GetUserOrganization(user.Id)
+	if err != nil {
+		return err
+	}
+	if org != "" {
+		question.OrganizationId = &org
+	}
+
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if question, err = tx.store.CreateQuestion(question); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, user.Username, AuditActionAskTenderQuestion, AuditEntityTypeQuestion, question.Id,
+			[]string{tender.OrganizationId, org}, nil, question)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, question)
+}
+
+func (a *APIServer) getTenderQuestions(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderQuestionsParams) error {
+	user, _, own, err := a.requireTenderViewer(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+
+	filter := QuestionFilter{All: own, Username: user.Username}
+	if filter.OrganizationId, err = a.store.GetUserOrganization(user.Id); err != nil {
+		return err
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	questions, err := a.store.GetTenderQuestions(tenderId, filter, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, questions)
+}
+
+func (a *APIServer) answerTenderQuestion(w http.ResponseWriter, r *http.Request, questionId QuestionId, params AnswerTenderQuestionParams) error {
+	var req AnswerTenderQuestionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	if strings.TrimSpace(req.Answer) == "" {
+		return httpError(http.StatusBadRequest, "answer is empty")
+	}
+
+	question, err := a.store.GetQuestionById(questionId)
+	if err != nil {
+		return storageError(err)
+	}
+	tender, err := a.requireTenderResponsible(params.Username, question.TenderId)
+	if err != nil {
+		return err
+	}
+
+	current := question
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if question, err = tx.store.AnswerQuestion(questionId, req.Answer, deref(req.AmendTender)); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, params.Username, AuditActionAnswerTenderQuestion, AuditEntityTypeQuestion, question.Id,
+			[]string{tender.OrganizationId, deref(question.OrganizationId)}, current, question)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, question)
+}
+
+func (a *APIServer) getTenderHistory(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderHistoryParams) error {
+	if _, _, _, err := a.requireTenderViewer(params.Username, tenderId); err != nil {
+		return err
+	}
+
+	versions, err := a.store.GetTenderVersions(tenderId)
+	if err != nil {
+		return storageError(err)
+	}
+	answered, err := a.store.GetTenderQuestions(tenderId, QuestionFilter{}, math.MaxInt32, 0)
+	if err != nil {
+		return storageError(err)
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	versions = versions[min(int(offset), len(versions)):]
+	versions = versions[:min(int(limit), len(versions))]
+
+	history := make([]TenderRevision, 0, len(versions))
+	for _, v := range versions {
+		revision := TenderRevision{Tender: *v, Clarifications: []TenderQuestion{}}
+		for _, q := range answered {
+			if deref(q.TenderVersion) == v.Version {
+				revision.Clarifications = append(revision.Clarifications, *q)
+			}
+		}
+		history = append(history, revision)
+	}
+
+	return WriteJSON(w, http.StatusOK, history)
+}
+
+// requireTenderViewer checks that username may see the tender, and reports
+// whether it is responsible for it.
+func (a *APIServer) requireTenderViewer(username, tenderId string) (*User, *Tender, bool, error) {
+	user, err := a.authenticate(username)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	tender, err := a.store.GetTenderById(tenderId)
+	if err != nil {
+		return nil, nil, false, storageError(err)
+	}
+
+	own, err := a.store.isValidTenderCreator(user.Username, tender.OrganizationId)
+	if err != nil || own {
+		return user, tender, own, err
+	}
+	if err := a.checkTenderVisible(user, tender); err != nil {
+		return nil, nil, false, err
+	}
+
+	return user, tender, false, nil
+}
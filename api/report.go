@@ -0,0 +1,334 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tenderReport is what the award protocol of a tender is rendered from.
+type tenderReport struct {
+	Tender       *Tender
+	Bids         []*Bid
+	Decisions    []DecisionRecord
+	Reviews      []ReviewRecord
+	Responsibles []*User
+	Users        map[string]*User // by id and by username
+	GeneratedBy  string
+	GeneratedAt  time.Time
+}
+
+func (a *APIServer) getTenderReport(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderReportParams) error {
+	tender, err := a.requireTenderBidsReader(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+	if tender.Status != TenderStatusClosed {
+		return httpError(http.StatusBadRequest, "tender %s is not closed", tender.Id)
+	}
+
+	report := &tenderReport{Tender: tender, Users: map[string]*User{}, GeneratedBy: params.Username, GeneratedAt: time.Now()}
+	for offset := int32(0); ; offset += exportBatch {
+		bids, err := a.store.GetBidsByTenderId(tenderId, BidFilter{}, exportBatch, offset)
+		if err != nil {
+			return storageError(err)
+		}
+		report.Bids = append(report.Bids, bids...)
+		if len(bids) < exportBatch {
+			break
+		}
+	}
+	if report.Decisions, err = a.store.GetTenderDecisions(tenderId); err != nil {
+		return storageError(err)
+	}
+	if report.Reviews, err = a.store.GetTenderReviews(tenderId); err != nil {
+		return storageError(err)
+	}
+	if report.Responsibles, err = a.store.GetOrganizationResponsibles(tender.OrganizationId); err != nil {
+		return err
+	}
+
+	for _, u := range report.Responsibles {
+		report.Users[u.Id], report.Users[u.Username] = u, u
+	}
+	for _, b := range report.Bids {
+		if _, ok := report.Users[b.AuthorId]; ok {
+			continue
+		}
+		u, err := a.store.GetUserById(b.AuthorId)
+		if err != nil {
+			return err
+		}
+		report.Users[u.Id] = u
+	}
+
+	doc, err := renderTenderReport(report)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Length", strconv.Itoa(buf.Len()))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment",
+		map[string]string{"filename": "tender-" + tender.Id + "-report.pdf"}))
+	w.WriteHeader(http.StatusOK)
+	// The headers are out, so a failure can only be logged.
+	if _, err := buf.WriteTo(w); err != nil {
+		log.Printf("failed to send report of tender %s: %v", tender.Id, err)
+	}
+	return nil
+}
+
+// renderTenderReport lays out the award protocol: the tender, its bids,
+// the decisions on every bid and lot with the quorum they were counted
+// against, the feedback left and the outcome, and a place for signatures.
+func renderTenderReport(rep *tenderReport) (*pdfDoc, error) {
+	t := rep.Tender
+	doc, err := newPDFDoc("Протокол подведения итогов тендера «" + t.Name + "»")
+	if err != nil {
+		return nil, err
+	}
+	doc.Footer(fmt.Sprintf("Тендер %s, версия %d. Сформирован %s пользователем %s.",
+		t.Id, t.Version, reportTime(rep.GeneratedAt), rep.GeneratedBy))
+
+	heading := func(s string) {
+		doc.Space(10)
+		doc.Text(pdfBold, 13, s)
+		doc.Rule()
+	}
+	field := func(name, value string) {
+		if value != "" {
+			doc.Columns(pdfRegular, 10, []float64{1, 3}, []string{name, value})
+		}
+	}
+
+	doc.Text(pdfBold, 16, "Протокол подведения итогов тендера")
+	doc.Text(pdfBold, 14, t.Name)
+	doc.Space(6)
+	field("Идентификатор", t.Id)
+	field("Организация", t.OrganizationId)
+	field("Вид услуги", string(t.ServiceType))
+	field("Статус", string(t.Status))
+	field("Версия", strconv.Itoa(int(t.Version)))
+	field("Создан", t.CreatedAt)
+	if t.Budget != nil {
+		field("Бюджет", reportBudget(t.Budget))
+	}
+	if t.SubmissionDeadline != nil {
+		field("Срок подачи предложений", reportTime(*t.SubmissionDeadline))
+	}
+	if t.Sealed {
+		field("Закрытый тендер", "да")
+	}
+	if t.RevealedAt != nil {
+		field("Предложения раскрыты", reportTime(*t.RevealedAt))
+	}
+	doc.Space(4)
+	doc.Text(pdfRegular, 10, t.Description)
+
+	lots := map[string]TenderLot{}
+	if t.Lots != nil && len(*t.Lots) > 0 {
+		heading("Лоты")
+		for _, lot := range *t.Lots {
+			lots[lot.Id] = lot
+			doc.Text(pdfBold, 10, lot.Name)
+			field("Идентификатор", lot.Id)
+			field("Статус", string(lot.Status))
+			if lot.Budget != nil {
+				field("Бюджет", reportBudget(lot.Budget))
+			}
+			doc.Text(pdfRegular, 10, lot.Description)
+			doc.Space(4)
+		}
+	}
+
+	bidNames := map[string]string{}
+	heading(fmt.Sprintf("Предложения (%d)", len(rep.Bids)))
+	if len(rep.Bids) == 0 {
+		doc.Text(pdfRegular, 10, "Предложений не поступило.")
+	}
+	for i, b := range rep.Bids {
+		bidNames[b.Id] = b.Name
+		doc.Text(pdfBold, 11, fmt.Sprintf("%d. %s", i+1, b.Name))
+		field("Идентификатор", b.Id)
+		field("Автор", reportUser(rep.Users[b.AuthorId], b.AuthorId)+reportAuthorType(b.AuthorType))
+		field("Версия", strconv.Itoa(int(b.Version)))
+		field("Создано", b.CreatedAt)
+		if b.Price != nil {
+			field("Цена", *b.Price+" "+string(deref(b.Currency)))
+		}
+		if b.DeliveryDays != nil {
+			field("Срок выполнения", fmt.Sprintf("%d дн.", *b.DeliveryDays))
+		}
+		var lotNames []string
+		for _, id := range deref(b.LotIds) {
+			lotNames = append(lotNames, reportLot(lots, id))
+		}
+		field("Лоты", strings.Join(lotNames, ", "))
+		doc.Text(pdfRegular, 10, b.Description)
+		doc.Space(4)
+	}
+
+	quorum := min(3, len(rep.Responsibles))
+	heading("Решения")
+	doc.Text(pdfRegular, 10, fmt.Sprintf("Ответственных за организацию: %d. Для одобрения нужно одобрений: "+
+		"min(3, %d) = %d. Одно отклонение отклоняет предложение.", len(rep.Responsibles), len(rep.Responsibles), quorum))
+	doc.Space(4)
+
+	type subject struct{ bidId, lotId string }
+	var subjects []subject
+	history := map[subject][]DecisionRecord{}
+	for _, d := range rep.Decisions {
+		s := subject{d.BidId, d.LotId}
+		if _, ok := history[s]; !ok {
+			subjects = append(subjects, s)
+		}
+		history[s] = append(history[s], d)
+	}
+	if len(subjects) == 0 {
+		doc.Text(pdfRegular, 10, "Решений не принималось.")
+	}
+
+	var approved []string
+	for _, s := range subjects {
+		title := reportBid(bidNames, s.bidId)
+		if s.lotId != "" {
+			title += ", лот «" + reportLot(lots, s.lotId) + "»"
+		}
+		doc.Text(pdfBold, 10, title)
+		doc.Columns(pdfBold, 9, []float64{2, 3, 2}, []string{"Дата", "Ответственный", "Решение"})
+
+		var decisions []BidDecision
+		approvals, rejections := 0, 0
+		for _, d := range history[s] {
+			decisions = append(decisions, d.Decision)
+			if d.Decision == BidDecisionApproved {
+				approvals++
+			} else {
+				rejections++
+			}
+			doc.Columns(pdfRegular, 9, []float64{2, 3, 2}, []string{
+				reportTime(d.CreatedAt), reportUser(rep.Users[d.Username], d.Username), reportDecision(d.Decision),
+			})
+		}
+
+		outcome := bidDecisionOutcome(decisions, len(rep.Responsibles))
+		if outcome == BidDecisionApproved {
+			approved = append(approved, title)
+		}
+		doc.Text(pdfRegular, 10, fmt.Sprintf("Одобрений: %d из %d, отклонений: %d. Итог: %s.",
+			approvals, quorum, rejections, reportOutcome(outcome)))
+		doc.Space(4)
+	}
+
+	heading("Отзывы")
+	if len(rep.Reviews) == 0 {
+		doc.Text(pdfRegular, 10, "Отзывов не оставлено.")
+	}
+	for _, r := range rep.Reviews {
+		doc.Columns(pdfRegular, 9, []float64{2, 3, 2}, []string{
+			reportTime(r.CreatedAt), reportUser(rep.Users[r.Username], r.Username), reportBid(bidNames, r.BidId),
+		})
+		doc.Columns(pdfRegular, 10, []float64{1, 6}, []string{"", r.Comment})
+		doc.Space(2)
+	}
+
+	heading("Итог")
+	if len(approved) == 0 {
+		doc.Text(pdfRegular, 10, "Ни одно предложение не одобрено.")
+	}
+	for _, title := range approved {
+		doc.Text(pdfRegular, 10, "Одобрено: "+title)
+	}
+	for _, lot := range deref(t.Lots) {
+		winner := "не присужден"
+		switch {
+		case lot.AwardedBidId != nil:
+			winner = "присужден предложению " + reportBid(bidNames, *lot.AwardedBidId)
+		case lot.Status == TenderLotStatusCanceled:
+			winner = "отменен"
+		}
+		doc.Text(pdfRegular, 10, fmt.Sprintf("Лот «%s»: %s.", lot.Name, winner))
+	}
+
+	heading("Подписи ответственных")
+	for _, u := range rep.Responsibles {
+		doc.Space(12)
+		doc.Columns(pdfRegular, 10, []float64{3, 2, 2}, []string{reportUser(u, u.Username), "____________________", "«___» ________ 20__"})
+	}
+	return doc, nil
+}
+
+func reportTime(t time.Time) string {
+	return t.UTC().Format("02.01.2006 15:04 UTC")
+}
+
+func reportBudget(b *TenderBudget) string {
+	switch {
+	case b.Min != nil && b.Max != nil:
+		return fmt.Sprintf("от %s до %s %s", *b.Min, *b.Max, b.Currency)
+	case b.Min != nil:
+		return fmt.Sprintf("от %s %s", *b.Min, b.Currency)
+	case b.Max != nil:
+		return fmt.Sprintf("до %s %s", *b.Max, b.Currency)
+	}
+	return string(b.Currency)
+}
+
+func reportUser(u *User, fallback string) string {
+	if u == nil {
+		return fallback
+	}
+	name := strings.TrimSpace(u.FirstName + " " + u.LastName)
+	if name == "" {
+		return u.Username
+	}
+	return name + " (" + u.Username + ")"
+}
+
+func reportAuthorType(t BidAuthorType) string {
+	if t == BidAuthorTypeOrganization {
+		return ", от имени организации"
+	}
+	return ""
+}
+
+func reportBid(names map[string]string, id string) string {
+	if name, ok := names[id]; ok {
+		return "«" + name + "»"
+	}
+	return id
+}
+
+func reportLot(lots map[string]TenderLot, id string) string {
+	if lot, ok := lots[id]; ok {
+		return lot.Name
+	}
+	return id
+}
+
+func reportDecision(d BidDecision) string {
+	if d == BidDecisionApproved {
+		return "Одобрено"
+	}
+	return "Отклонено"
+}
+
+func reportOutcome(d BidDecision) string {
+	switch d {
+	case BidDecisionApproved:
+		return "одобрено"
+	case BidDecisionRejected:
+		return "отклонено"
+	}
+	return "решение не принято"
+}
@@ -0,0 +1,158 @@
+package api
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	pdfObject = regexp.MustCompile(`(?s)(\d+) 0 obj\n(.*?)\nendobj\n`)
+	pdfShow   = regexp.MustCompile(`/F(\d) [\d.]+ Tf [\d.]+ [\d.]+ Td <([0-9A-F]*)> Tj`)
+)
+
+// pdfLines checks the cross-reference table of a document written by doc
+// and returns the text it shows, a line per text operator.
+func pdfLines(t *testing.T, doc *pdfDoc, data []byte) []string {
+	t.Helper()
+	if !bytes.HasPrefix(data, []byte("%PDF-1.7\n")) || !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatalf("not a pdf: %q...", data[:min(len(data), 20)])
+	}
+
+	xref := bytes.LastIndex(data, []byte("\nxref\n")) + 1
+	start, _ := strconv.Atoi(strings.Fields(string(data[bytes.LastIndex(data, []byte("startxref")):]))[1])
+	if start != xref {
+		t.Errorf("startxref = %d, xref is at %d", start, xref)
+	}
+	entries := strings.Split(string(data[xref:]), "\n")[3:]
+	for _, m := range pdfObject.FindAllSubmatchIndex(data, -1) {
+		ref, _ := strconv.Atoi(string(data[m[2]:m[3]]))
+		if want := fmt.Sprintf("%010d 00000 n ", m[0]); entries[ref-1] != want {
+			t.Errorf("xref entry of object %d = %q, want %q", ref, entries[ref-1], want)
+		}
+	}
+
+	var lines []string
+	for _, m := range pdfObject.FindAllSubmatch(data, -1) {
+		body := m[2]
+		i := bytes.Index(body, []byte("stream\n"))
+		if i < 0 || bytes.Contains(body[:i], []byte("/Length1")) {
+			continue
+		}
+		z, err := zlib.NewReader(bytes.NewReader(bytes.TrimSuffix(body[i+len("stream\n"):], []byte("\nendstream"))))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(z)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, show := range pdfShow.FindAllSubmatch(content, -1) {
+			style, _ := strconv.Atoi(string(show[1]))
+			ids, _ := hex.DecodeString(string(show[2]))
+			var line []rune
+			for j := 0; j+1 < len(ids); j += 2 {
+				for id, g := range doc.used[style-1] {
+					if int(id) == int(ids[j])<<8|int(ids[j+1]) {
+						line = append(line, g.r)
+					}
+				}
+			}
+			lines = append(lines, string(line))
+		}
+	}
+	return lines
+}
+
+func TestRenderTenderReport(t *testing.T) {
+	lots := []TenderLot{
+		{Id: "l1", Name: "Асфальт", Status: TenderLotStatusAwarded, AwardedBidId: ptr("b1")},
+		{Id: "l2", Name: "Разметка", Status: TenderLotStatusCanceled},
+	}
+	owners := []*User{
+		{Id: "u1", Username: "owner1", FirstName: "Иван", LastName: "Петров"},
+		{Id: "u2", Username: "owner2", FirstName: "Анна", LastName: "Смирнова"},
+	}
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	rep := &tenderReport{
+		Tender: &Tender{Id: "t1", Name: "Ремонт дорог", Description: strings.Repeat("Длинное описание работ. ", 40),
+			Status: TenderStatusClosed, ServiceType: TenderServiceTypeConstruction, Version: 3, Lots: &lots,
+			Budget: &TenderBudget{Currency: "RUB", Max: ptr("1000000.00")}},
+		Bids: []*Bid{
+			{Id: "b1", Name: "Быстрый ремонт", Description: "Быстро", AuthorId: "u3", AuthorType: BidAuthorTypeOrganization,
+				Version: 2, Price: ptr("900000.00"), Currency: ptr(Currency("RUB")), LotIds: &[]LotId{"l1", "l2"}},
+			{Id: "b2", Name: "Дешевый ремонт", Description: "Дешево", AuthorId: "u4", AuthorType: BidAuthorTypeUser, Version: 1},
+		},
+		Decisions: []DecisionRecord{
+			{BidId: "b1", LotId: "l1", Username: "owner1", Decision: BidDecisionApproved, CreatedAt: at},
+			{BidId: "b1", LotId: "l1", Username: "owner2", Decision: BidDecisionApproved, CreatedAt: at.Add(time.Hour)},
+			{BidId: "b2", Username: "owner1", Decision: BidDecisionRejected, CreatedAt: at},
+		},
+		Reviews:      []ReviewRecord{{BidId: "b2", Username: "owner2", Comment: "Слишком долго", CreatedAt: at}},
+		Responsibles: owners,
+		Users: map[string]*User{"u1": owners[0], "owner1": owners[0], "u2": owners[1], "owner2": owners[1],
+			"u3": {Id: "u3", Username: "bidder", FirstName: "Олег", LastName: "Иванов"}},
+		GeneratedBy: "owner1",
+		GeneratedAt: at,
+	}
+
+	doc, err := renderTenderReport(rep)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if _, err := doc.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	text := strings.Join(pdfLines(t, doc, buf.Bytes()), "\n")
+
+	for _, want := range []string{
+		"Протокол подведения итогов тендера",
+		"Олег Иванов (bidder), от имени организации",
+		"u4",
+		"Асфальт, Разметка",
+		"min(3, 2) = 2",
+		"«Быстрый ремонт», лот «Асфальт»",
+		"Одобрений: 2 из 2, отклонений: 0. Итог: одобрено.",
+		"Одобрений: 0 из 2, отклонений: 1. Итог: отклонено.",
+		"Слишком долго",
+		"Одобрено: «Быстрый ремонт», лот «Асфальт»",
+		"Лот «Разметка»: отменен.",
+		"Анна Смирнова (owner2)",
+		"Тендер t1, версия 3. Сформирован 01.03.2026 09:30 UTC пользователем owner1.    1 / 2",
+		"2 / 2",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("report lacks %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestPDFWrap(t *testing.T) {
+	doc, err := newPDFDoc("wrap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	width := doc.textWidth(pdfRegular, 10, "Ремонт дорог")
+	lines := doc.wrap(pdfRegular, 10, width, "Ремонт дорог и мостов\n\nНеразрывноеоченьдлинноеслово")
+	if len(lines) < 5 || lines[0] != "Ремонт дорог" || lines[1] != "и мостов" || lines[2] != "" {
+		t.Fatalf("lines = %q", lines)
+	}
+	for _, line := range lines[3:] {
+		if doc.textWidth(pdfRegular, 10, line) > width {
+			t.Errorf("line %q overflows", line)
+		}
+	}
+	if got := strings.Join(lines[3:], ""); got != "Неразрывноеоченьдлинноеслово" {
+		t.Errorf("cut word = %q", got)
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
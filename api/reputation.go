@@ -0,0 +1,260 @@
+package api
+
+import (
+	"maps"
+	"math"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+func (a *APIServer) getOrganizationReputation(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetOrganizationReputationParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+
+	orgs, err := a.store.GetOrganizationsByIds([]string{organizationId})
+	if err != nil {
+		return storageError(err)
+	}
+	if _, ok := orgs[organizationId]; !ok {
+		return storageError(ErrOrganizationNotFound)
+	}
+	userOrg, err := a.store.GetUserOrganization(user.Id)
+	if err != nil {
+		return storageError(err)
+	}
+	if userOrg == "" {
+		return httpError(http.StatusForbidden, "user %s is not responsible for any organization", user.Username)
+	}
+
+	orgReputations, err := a.store.GetOrganizationReputations([]string{organizationId})
+	if err != nil {
+		return storageError(err)
+	}
+	authorIds, err := a.store.GetOrganizationBidAuthors(organizationId)
+	if err != nil {
+		return storageError(err)
+	}
+	authorReputations, err := a.store.GetAuthorReputations(authorIds)
+	if err != nil {
+		return storageError(err)
+	}
+	authors, err := a.store.GetUsersByIds(authorIds)
+	if err != nil {
+		return storageError(err)
+	}
+
+	result := OrganizationReputation{
+		OrganizationId: organizationId,
+		Reputation:     orgReputations[organizationId],
+		Authors:        []AuthorReputation{},
+	}
+	for _, id := range authorIds {
+		if u, ok := authors[id]; ok {
+			result.Authors = append(result.Authors, AuthorReputation{Username: u.Username, Reputation: authorReputations[id]})
+		}
+	}
+	slices.SortFunc(result.Authors, func(a, b AuthorReputation) int { return strings.Compare(a.Username, b.Username) })
+
+	return WriteJSON(w, http.StatusOK, result)
+}
+
+// attachReputations sets the reputation of the author of each bid: of the
+// organization for bids made on its behalf, of the employee otherwise.
+func (a *APIServer) attachReputations(bids []*Bid) error {
+	var authorIds []string
+	for _, b := range bids {
+		authorIds = append(authorIds, b.AuthorId)
+	}
+	orgOf, err := a.store.GetUserOrganizations(authorIds)
+	if err != nil {
+		return storageError(err)
+	}
+
+	// subject is the organization id for bids made on behalf of one and
+	// the author id otherwise.
+	subject := func(b *Bid) (string, bool) {
+		if org := orgOf[b.AuthorId]; b.AuthorType == BidAuthorTypeOrganization && org != "" {
+			return org, true
+		}
+		return b.AuthorId, false
+	}
+
+	var orgs, users []string
+	for _, b := range bids {
+		id, isOrg := subject(b)
+		if isOrg && !slices.Contains(orgs, id) {
+			orgs = append(orgs, id)
+		} else if !isOrg && !slices.Contains(users, id) {
+			users = append(users, id)
+		}
+	}
+	reputations, err := a.store.GetOrganizationReputations(orgs)
+	if err != nil {
+		return storageError(err)
+	}
+	userReputations, err := a.store.GetAuthorReputations(users)
+	if err != nil {
+		return storageError(err)
+	}
+	maps.Copy(reputations, userReputations)
+
+	for _, b := range bids {
+		id, _ := subject(b)
+		rep := reputations[id]
+		b.AuthorReputation = &rep
+	}
+	return nil
+}
+
+// sumReputations sums up the bids of each subject in Go, for storages that
+// can't aggregate them in place.
+func sumReputations(store Storage, subjects map[string][]*Bid) (map[string]Reputation, error) {
+	var all []*Bid
+	for _, bids := range subjects {
+		all = append(all, bids...)
+	}
+	data, err := loadReputationData(store, all)
+	if err != nil {
+		return nil, err
+	}
+
+	reputations := map[string]Reputation{}
+	for id, bids := range subjects {
+		reputations[id] = data.reputation(bids)
+	}
+	return reputations, nil
+}
+
+// reputationData is what reputations are computed from: the tenders the
+// bids were made on, the decisions and feedback on the bids, and the number
+// of responsibles deciding on the bids of each organization.
+type reputationData struct {
+	tenders      map[string]*Tender
+	decisions    map[string][]DecisionRecord
+	reviews      map[string][]ReviewRecord
+	responsibles map[string]int
+}
+
+func loadReputationData(store Storage, bids []*Bid) (*reputationData, error) {
+	var bidIds, tenderIds []string
+	for _, b := range bids {
+		bidIds = append(bidIds, b.Id)
+		if !slices.Contains(tenderIds, b.TenderId) {
+			tenderIds = append(tenderIds, b.TenderId)
+		}
+	}
+
+	d := &reputationData{responsibles: map[string]int{}}
+	var err error
+	if d.tenders, err = store.GetTendersByIds(tenderIds); err != nil {
+		return nil, err
+	}
+	if d.decisions, err = store.GetDecisionsByBidIds(bidIds); err != nil {
+		return nil, err
+	}
+	if d.reviews, err = store.GetReviewsByBidIds(bidIds); err != nil {
+		return nil, err
+	}
+	for _, t := range d.tenders {
+		if _, ok := d.responsibles[t.OrganizationId]; ok {
+			continue
+		}
+		responsibles, err := store.GetOrganizationResponsibles(t.OrganizationId)
+		if err != nil {
+			return nil, err
+		}
+		d.responsibles[t.OrganizationId] = len(responsibles)
+	}
+	return d, nil
+}
+
+// reputation sums up the bids: drafts don't count, canceled bids count as
+// neither won nor lost, and the feedback on every counted bid is averaged.
+func (d *reputationData) reputation(bids []*Bid) Reputation {
+	var rep Reputation
+	var ratings int32
+	for _, b := range bids {
+		if b.Status == BidStatusCreated {
+			continue
+		}
+		rep.Bids++
+		if b.Status == BidStatusCanceled {
+			rep.Canceled++
+		} else {
+			switch d.outcome(b) {
+			case BidDecisionApproved:
+				rep.Won++
+			case BidDecisionRejected:
+				rep.Lost++
+			}
+		}
+
+		for _, r := range d.reviews[b.Id] {
+			if r.Rating != nil {
+				rep.Ratings++
+				ratings += *r.Rating
+			}
+			if r.OnTime != nil && *r.OnTime {
+				rep.OnTime++
+			} else if r.OnTime != nil {
+				rep.Late++
+			}
+		}
+	}
+
+	rep.rate(int64(ratings))
+	return rep
+}
+
+// rate derives the average rating, from the sum of the ratings, and the
+// cancellation rate from the counts.
+func (rep *Reputation) rate(ratings int64) {
+	if rep.Ratings > 0 {
+		avg := roundRate(float64(ratings) / float64(rep.Ratings))
+		rep.AverageRating = &avg
+	}
+	if rep.Bids > 0 {
+		rep.CancellationRate = roundRate(float64(rep.Canceled) / float64(rep.Bids))
+	}
+}
+
+// outcome is Approved for a bid that won its tender or a lot of it, and
+// Rejected for one that lost: rejected, or its tender closed without it
+// winning. Bids still in the running have no outcome.
+func (d *reputationData) outcome(b *Bid) BidDecision {
+	t, ok := d.tenders[b.TenderId]
+	if !ok {
+		return ""
+	}
+
+	if lots := deref(t.Lots); len(lots) > 0 {
+		for _, lot := range lots {
+			if lot.AwardedBidId != nil && *lot.AwardedBidId == b.Id {
+				return BidDecisionApproved
+			}
+		}
+	} else {
+		var decisions []BidDecision
+		for _, dec := range d.decisions[b.Id] {
+			if dec.LotId == "" {
+				decisions = append(decisions, dec.Decision)
+			}
+		}
+		if outcome := bidDecisionOutcome(decisions, d.responsibles[t.OrganizationId]); outcome != "" {
+			return outcome
+		}
+	}
+
+	if t.Status == TenderStatusClosed {
+		return BidDecisionRejected
+	}
+	return ""
+}
+
+// roundRate rounds averages and rates to hundredths.
+func roundRate(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,205 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Locker elects the replica that runs the scheduler. TryLock reports whether
+// the caller holds the lock; it is called on every tick, so it must be
+// cheap once the lock is held.
+type Locker interface {
+	TryLock(ctx context.Context) (bool, error)
+	Unlock(ctx context.Context) error
+}
+
+// Scheduler publishes tenders at their publishAt, closes them at their
+// submissionDeadline and reveals the bids of sealed ones. Only the replica
+// holding the lock fires.
+type Scheduler struct {
+	store    Storage
+	lock     Locker
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewScheduler returns a scheduler ticking every interval. A nil lock makes
+// this process the leader unconditionally.
+func NewScheduler(store Storage, lock Locker, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		store:    store,
+		lock:     lock,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run ticks until ctx is cancelled and releases the lock on the way out.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	if s.lock != nil {
+		defer func() {
+			if err := s.lock.Unlock(context.Background()); err != nil {
+				log.Printf("scheduler: unlock failed: %v", err)
+			}
+		}()
+	}
+
+	for {
+		leader := true
+		if s.lock != nil {
+			var err error
+			if leader, err = s.lock.TryLock(ctx); err != nil {
+				log.Printf("scheduler: leader election failed: %v", err)
+			}
+		}
+		if leader {
+			if err := s.Tick(s.now()); err != nil {
+				log.Printf("scheduler: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// schedulerActor is the actor the audit log records the changes of the
+// scheduler under.
+const schedulerActor = "scheduler"
+
+// Tick publishes due tenders, closes expired ones and reveals the bids of
+// sealed ones as of now.
+func (s *Scheduler) Tick(now time.Time) error {
+	published, err := s.step(AuditActionPublishTender, Storage.PublishDueTenders, now, func(t *Tender) {
+		t.Status = TenderStatusCreated
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish tenders: %w", err)
+	}
+	for _, id := range published {
+		log.Printf("scheduler: tender %s published", id)
+	}
+
+	closed, err := s.step(AuditActionCloseTender, Storage.CloseExpiredTenders, now, func(t *Tender) {
+		t.Status = TenderStatusPublished
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close tenders: %w", err)
+	}
+	for _, id := range closed {
+		log.Printf("scheduler: tender %s closed", id)
+	}
+
+	revealed, err := s.step(AuditActionRevealTenderBids, Storage.RevealDueTenders, now, func(t *Tender) {
+		t.RevealedAt = nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reveal sealed bids: %w", err)
+	}
+	for _, id := range revealed {
+		log.Printf("scheduler: bids of tender %s revealed", id)
+	}
+	return nil
+}
+
+// step makes one kind of change to the tenders due for it and records each
+// change in the audit log, in the same transaction. The state before is the
+// tender after, with undo applied.
+func (s *Scheduler) step(action AuditAction, change func(Storage, time.Time) ([]string, error), now time.Time,
+	undo func(*Tender)) ([]string, error) {
+	var ids []string
+	err := s.store.Atomically(func(store Storage) error {
+		var err error
+		if ids, err = change(store, now); err != nil {
+			return err
+		}
+		tenders, err := store.GetTendersByIds(ids)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			after, ok := tenders[id]
+			if !ok {
+				return ErrTenderNotFound
+			}
+			before := *after
+			undo(&before)
+			entry := &AuditEntry{Actor: schedulerActor, Action: action, EntityType: AuditEntityTypeTender, EntityId: id}
+			if err := appendAudit(store, entry, []string{after.OrganizationId}, &before, after); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// AdvisoryLock is a Locker backed by a Postgres session-level advisory lock.
+// The lock lives as long as the dedicated connection, so a crashed leader
+// releases it and another replica takes over on its next tick.
+type AdvisoryLock struct {
+	db   *sql.DB
+	key  int64
+	conn *sql.Conn
+}
+
+func (s *PostgresStorage) NewAdvisoryLock(key int64) *AdvisoryLock {
+	return &AdvisoryLock{db: s.db, key: key}
+}
+
+func (l *AdvisoryLock) TryLock(ctx context.Context) (bool, error) {
+	if l.conn != nil {
+		// Still the leader as long as the session holding the lock is alive.
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true, nil
+		}
+		l.conn.Close()
+		l.conn = nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get connection: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock($1)`, l.key).Scan(&locked); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+	if !locked {
+		conn.Close()
+		return false, nil
+	}
+
+	l.conn = conn
+	return true, nil
+}
+
+func (l *AdvisoryLock) Unlock(ctx context.Context) error {
+	if l.conn == nil {
+		return nil
+	}
+	defer func() {
+		l.conn.Close()
+		l.conn = nil
+	}()
+
+	if _, err := l.conn.ExecContext(ctx, `SELECT pg_advisory_unlock($1)`, l.key); err != nil {
+		return fmt.Errorf("failed to release advisory lock: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+type fakeLock struct {
+	mu       sync.Mutex
+	leader   bool
+	tries    int
+	unlocked bool
+}
+
+func (l *fakeLock) TryLock(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tries++
+	return l.leader, nil
+}
+
+func (l *fakeLock) Unlock(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.unlocked = true
+	return nil
+}
+
+func runScheduler(t *testing.T, store Storage, lock Locker) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		NewScheduler(store, lock, time.Millisecond).Run(ctx)
+		close(done)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestSchedulerLeaderElection(t *testing.T) {
+	store := NewMemoryStorage()
+	past := time.Now().Add(-time.Minute)
+	tender, err := store.CreateTender(&Tender{Name: "Тендер", PublishAt: &past}, "owner")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	follower := &fakeLock{}
+	runScheduler(t, store, follower)
+	if got, _ := store.GetTenderById(tender.Id); got.Status != TenderStatusCreated {
+		t.Fatalf("follower changed status to %s", got.Status)
+	}
+	if follower.tries == 0 || !follower.unlocked {
+		t.Fatalf("follower tries = %d, unlocked = %v", follower.tries, follower.unlocked)
+	}
+
+	runScheduler(t, store, &fakeLock{leader: true})
+	if got, _ := store.GetTenderById(tender.Id); got.Status != TenderStatusPublished {
+		t.Fatalf("leader left status %s, want Published", got.Status)
+	}
+}
+
+func TestSchedulerAudit(t *testing.T) {
+	store := NewMemoryStorage()
+	publishAt, deadline := time.Now().Add(-2*time.Minute), time.Now().Add(-time.Minute)
+	tender, err := store.CreateTender(&Tender{
+		Name: "Тендер", OrganizationId: "o1", Sealed: true, PublishAt: &publishAt, SubmissionDeadline: &deadline,
+	}, "owner")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewScheduler(store, nil, time.Minute).Tick(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	entries, err := store.GetAuditLog(AuditFilter{OrganizationId: "o1"}, 10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 {
+		t.Fatalf("audit log has %d entries, want 3", len(entries))
+	}
+
+	want := []struct {
+		action AuditAction
+		field  string
+		before any
+		after  any
+	}{
+		{AuditActionRevealTenderBids, "revealedAt", nil, "set"},
+		{AuditActionCloseTender, "status", "Published", "Closed"},
+		{AuditActionPublishTender, "status", "Created", "Published"},
+	}
+	for i, w := range want {
+		e := entries[i]
+		if e.Action != w.action || e.Actor != schedulerActor || e.EntityId != tender.Id || e.Before == nil || e.After == nil {
+			t.Errorf("entry %d = %+v", i, e)
+			continue
+		}
+		before, after := (*e.Before)[w.field], (*e.After)[w.field]
+		if w.after == "set" {
+			if before != nil || after == nil {
+				t.Errorf("%s: %s %v -> %v", w.action, w.field, before, after)
+			}
+		} else if before != w.before || after != w.after {
+			t.Errorf("%s: %s %v -> %v, want %v -> %v", w.action, w.field, before, after, w.before, w.after)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package api
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// maxScore is the top of the scale responsibles score each criterion on.
+const maxScore = 10
+
+func (a *APIServer) submitBidScores(w http.ResponseWriter, r *http.Request, bidId BidId, params SubmitBidScoresParams) error {
+	var req SubmitBidScoresJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+
+	bid, tender, err := a.requireBidReviewer(params.Username, bidId)
+	if err != nil {
+		return err
+	}
+	if bid.Status != BidStatusPublished {
+		return httpError(http.StatusBadRequest, "bid %s is not published", bid.Id)
+	}
+	if err := validateScores(deref(tender.Criteria), req.Scores); err != nil {
+		return err
+	}
+	cards, err := a.store.GetBidScorecards(bidId)
+	if err != nil {
+		return storageError(err)
+	}
+	var previous *BidScorecard
+	for i := range cards {
+		if cards[i].ReviewerUsername == params.Username {
+			previous = &cards[i]
+		}
+	}
+
+	var card *BidScorecard
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if card, err = tx.store.SubmitBidScores(bidId, params.Username, req.Scores); err != nil {
+			return storageError(err)
+		}
+		return tx.auditBid(r, params.Username, AuditActionSubmitBidScores, bid, previous, card)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, card)
+}
+
+func (a *APIServer) getBidScores(w http.ResponseWriter, r *http.Request, bidId BidId, params GetBidScoresParams) error {
+	if _, _, err := a.requireBidReviewer(params.Username, bidId); err != nil {
+		return err
+	}
+
+	cards, err := a.store.GetBidScorecards(bidId)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, cards)
+}
+
+func (a *APIServer) getTenderRanking(w http.ResponseWriter, r *http.Request, tenderId TenderId, params GetTenderRankingParams) error {
+	tender, err := a.requireTenderResponsible(params.Username, tenderId)
+	if err != nil {
+		return err
+	}
+	if tender.Criteria == nil || len(*tender.Criteria) == 0 {
+		return httpError(http.StatusBadRequest, "tender %s has no evaluation criteria", tender.Id)
+	}
+
+	hidden, err := a.bidsHidden(tender, time.Now())
+	if err != nil {
+		return storageError(err)
+	}
+	if hidden {
+		return httpError(http.StatusForbidden, "bids of sealed tender %s are not revealed yet", tender.Id)
+	}
+
+	bids, err := a.store.GetBidsByTenderIds([]string{tenderId})
+	if err != nil {
+		return storageError(err)
+	}
+	cards, err := a.store.GetTenderScorecards(tenderId)
+	if err != nil {
+		return storageError(err)
+	}
+
+	ranking := rankBids(*tender.Criteria, bids[tenderId], cards)
+	limit, offset := pagination(params.Limit, params.Offset)
+	ranking = ranking[min(int(offset), len(ranking)):]
+	ranking = ranking[:min(int(limit), len(ranking))]
+
+	return WriteJSON(w, http.StatusOK, ranking)
+}
+
+// rankBids ranks the published bids by their weighted total, normalized
+// to 0..100. Criteria on price and delivery time are scored from the bids,
+// see measuredScores; the others average the latest scorecards of every
+// reviewer. Ties rank by bid id so the order is stable.
+func rankBids(criteria []EvaluationCriterion, bids []*Bid, cards []BidScorecard) []RankedBid {
+	type tally struct {
+		sums      map[string]int32
+		reviewers int32
+	}
+	tallies := map[string]*tally{}
+	for _, b := range bids {
+		tallies[b.Id] = &tally{sums: map[string]int32{}}
+	}
+	for _, card := range cards {
+		t := tallies[card.BidId]
+		if t == nil {
+			continue
+		}
+		for _, s := range card.Scores {
+			t.sums[s.Criterion] += s.Score
+		}
+		t.reviewers++
+	}
+
+	measured := map[EvaluationCriterionKind]map[string]float64{}
+	for _, c := range criteria {
+		if !scoredByHand(c.Kind) {
+			measured[c.Kind] = measuredScores(c.Kind, bids)
+		}
+	}
+
+	var weights int32
+	for _, c := range criteria {
+		weights += c.Weight
+	}
+
+	ranking := make([]RankedBid, 0, len(tallies))
+	for bidId, t := range tallies {
+		rb := RankedBid{BidId: bidId, Criteria: make([]CriterionAverage, 0, len(criteria)), Reviewers: t.reviewers}
+		var weighted float64
+		for _, c := range criteria {
+			var avg float64
+			if !scoredByHand(c.Kind) {
+				avg = measured[c.Kind][bidId]
+			} else if t.reviewers > 0 {
+				avg = float64(t.sums[c.Name]) / float64(t.reviewers)
+			}
+			rb.Criteria = append(rb.Criteria, CriterionAverage{Criterion: c.Name, Score: round2(avg)})
+			weighted += avg * float64(c.Weight)
+		}
+		rb.Total = round2(weighted / float64(weights) * 100 / maxScore)
+		ranking = append(ranking, rb)
+	}
+
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Total != ranking[j].Total {
+			return ranking[i].Total > ranking[j].Total
+		}
+		return ranking[i].BidId < ranking[j].BidId
+	})
+	for i := range ranking {
+		ranking[i].Rank = int32(i + 1)
+	}
+	return ranking
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// scoredByHand reports whether reviewers score criteria of the kind. Price
+// and delivery time are measured from the bids instead.
+func scoredByHand(kind EvaluationCriterionKind) bool {
+	return kind != EvaluationCriterionKindPrice && kind != EvaluationCriterionKindDeliveryTime
+}
+
+// measuredScores scores the bids on price or delivery time: the lowest
+// value gets maxScore and the others the share of it their value is, so a
+// bid twice as expensive as the cheapest scores half. Bids that don't state
+// the value score 0.
+func measuredScores(kind EvaluationCriterionKind, bids []*Bid) map[string]float64 {
+	values := map[string]float64{}
+	for _, b := range bids {
+		switch {
+		case kind == EvaluationCriterionKindPrice && b.Price != nil:
+			if r, ok := parseMoney(*b.Price); ok {
+				values[b.Id], _ = r.Float64()
+			}
+		case kind == EvaluationCriterionKindDeliveryTime && b.DeliveryDays != nil:
+			values[b.Id] = float64(*b.DeliveryDays)
+		}
+	}
+
+	best := math.Inf(1)
+	for _, v := range values {
+		best = min(best, v)
+	}
+	scores := map[string]float64{}
+	for id, v := range values {
+		if v == best {
+			scores[id] = maxScore
+		} else {
+			scores[id] = maxScore * best / v
+		}
+	}
+	return scores
+}
+
+// validateCriteria checks the evaluation criteria of a new tender. Prices
+// are only comparable in one currency, so a price criterion needs the
+// budget that fixes it.
+func validateCriteria(criteria *TenderCriteria, budget *TenderBudget) error {
+	if criteria == nil {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, c := range *criteria {
+		if names[c.Name] {
+			return httpError(http.StatusBadRequest, "criterion %q is declared twice", c.Name)
+		}
+		names[c.Name] = true
+		if c.Kind == EvaluationCriterionKindPrice && budget == nil {
+			return httpError(http.StatusBadRequest, "price criterion %q requires a tender budget", c.Name)
+		}
+	}
+	return nil
+}
+
+// validateScores checks that a scorecard scores every criterion of the
+// tender reviewers score exactly once, and no other.
+func validateScores(criteria []EvaluationCriterion, scores []CriterionScore) error {
+	if len(criteria) == 0 {
+		return httpError(http.StatusBadRequest, "tender has no evaluation criteria")
+	}
+
+	declared := map[string]EvaluationCriterionKind{}
+	for _, c := range criteria {
+		declared[c.Name] = c.Kind
+	}
+	scored := map[string]bool{}
+	for _, s := range scores {
+		kind, ok := declared[s.Criterion]
+		if !ok {
+			return httpError(http.StatusBadRequest, "unknown criterion %q", s.Criterion)
+		}
+		if !scoredByHand(kind) {
+			return httpError(http.StatusBadRequest, "criterion %q of kind %s is scored from the bids", s.Criterion, kind)
+		}
+		if scored[s.Criterion] {
+			return httpError(http.StatusBadRequest, "criterion %q is scored twice", s.Criterion)
+		}
+		scored[s.Criterion] = true
+	}
+	for _, c := range criteria {
+		if scoredByHand(c.Kind) && !scored[c.Name] {
+			return httpError(http.StatusBadRequest, "criterion %q is not scored", c.Name)
+		}
+	}
+	if len(scored) == 0 {
+		return httpError(http.StatusBadRequest, "criteria of the tender are all scored from the bids")
+	}
+	return nil
+}
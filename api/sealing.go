@@ -0,0 +1,281 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+var ErrSealingDisabled = errors.New("sealed tenders are not configured")
+
+// RevealTrigger tells why the bids of a sealed tender were revealed.
+type RevealTrigger string
+
+const (
+	RevealTriggerDeadline RevealTrigger = "deadline"
+	RevealTriggerClosed   RevealTrigger = "closed"
+)
+
+// Sealer encrypts the contents of bids on sealed tenders with AES-GCM.
+type Sealer struct {
+	aead cipher.AEAD
+	// previous are the keys the sealing key replaced. They only open
+	// payloads, until a reseal moves those to the current key.
+	previous []cipher.AEAD
+}
+
+// NewSealer builds a sealer from a base64 encoded 16, 24 or 32 byte AES key,
+// and the keys it replaced, if any.
+func NewSealer(encodedKey string, previousKeys ...string) (*Sealer, error) {
+	aead, err := newAEAD(encodedKey)
+	if err != nil {
+		return nil, err
+	}
+	s := &Sealer{aead: aead}
+	for _, k := range previousKeys {
+		previous, err := newAEAD(k)
+		if err != nil {
+			return nil, fmt.Errorf("previous %w", err)
+		}
+		s.previous = append(s.previous, previous)
+	}
+	return s, nil
+}
+
+func newAEAD(encodedKey string) (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("sealing key can't be decoded: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("sealing key is invalid: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// NewSealerFromEnv builds the sealer from the BID_SEALING_KEY environment
+// variable. Without it there is no sealer, and only sealed tenders are
+// unavailable: creating one fails with ErrSealingDisabled.
+//
+// To rotate the key, set the new one and list the old ones, separated by
+// commas, in BID_SEALING_PREVIOUS_KEYS, then run the reseal command.
+func NewSealerFromEnv() (*Sealer, error) {
+	key := os.Getenv("BID_SEALING_KEY")
+	if key == "" {
+		return nil, nil
+	}
+	var previous []string
+	for _, k := range strings.Split(os.Getenv("BID_SEALING_PREVIOUS_KEYS"), ",") {
+		if k = strings.TrimSpace(k); k != "" {
+			previous = append(previous, k)
+		}
+	}
+	return NewSealer(key, previous...)
+}
+
+// sealedBid is the part of a bid version that is encrypted while the
+// tender is sealed.
+type sealedBid struct {
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	Price        *Money    `json:"price,omitempty"`
+	Currency     *Currency `json:"currency,omitempty"`
+	DeliveryDays *int32    `json:"deliveryDays,omitempty"`
+}
+
+// Seal encrypts the bid contents. The id of the bid is bound as additional
+// data, so a payload can't be moved to another bid.
+func (s *Sealer) Seal(b *Bid) ([]byte, error) {
+	plaintext, err := json.Marshal(sealedBid{
+		Name:         b.Name,
+		Description:  b.Description,
+		Price:        b.Price,
+		Currency:     b.Currency,
+		DeliveryDays: b.DeliveryDays,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.seal(plaintext, b.Id)
+}
+
+// Open decrypts a payload produced by Seal into b.
+func (s *Sealer) Open(b *Bid, payload []byte) error {
+	plaintext, err := s.open(payload, b.Id)
+	if err != nil {
+		return fmt.Errorf("failed to open sealed bid %s: %w", b.Id, err)
+	}
+
+	var sb sealedBid
+	if err := json.Unmarshal(plaintext, &sb); err != nil {
+		return fmt.Errorf("failed to decode sealed bid %s: %w", b.Id, err)
+	}
+	b.Name, b.Description = sb.Name, sb.Description
+	b.Price, b.Currency, b.DeliveryDays = sb.Price, sb.Currency, sb.DeliveryDays
+	return nil
+}
+
+// SealBlob encrypts the contents of an attachment to a bid on a sealed
+// tender. The id of the attachment is bound as additional data.
+func (s *Sealer) SealBlob(attachmentId string, data []byte) ([]byte, error) {
+	return s.seal(data, attachmentId)
+}
+
+// OpenBlob decrypts contents produced by SealBlob.
+func (s *Sealer) OpenBlob(attachmentId string, payload []byte) ([]byte, error) {
+	data, err := s.open(payload, attachmentId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sealed attachment %s: %w", attachmentId, err)
+	}
+	return data, nil
+}
+
+// ResealBlob encrypts the contents of the attachment, stored by SealBlob,
+// again with the current key.
+func (s *Sealer) ResealBlob(ctx context.Context, blobs BlobStore, attachment *Attachment) error {
+	blob, err := blobs.Get(ctx, attachment.Id)
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %s: %w", attachment.Id, err)
+	}
+	payload, err := io.ReadAll(blob)
+	blob.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %s: %w", attachment.Id, err)
+	}
+
+	resealed, err := s.reseal(payload, attachment.Id)
+	if err != nil {
+		return fmt.Errorf("failed to reseal attachment %s: %w", attachment.Id, err)
+	}
+	return blobs.Put(ctx, attachment.Id, bytes.NewReader(resealed), int64(len(resealed)), attachment.ContentType)
+}
+
+// reseal encrypts a payload, sealed with the current key or a previous one,
+// with the current key.
+func (s *Sealer) reseal(payload []byte, id string) ([]byte, error) {
+	plaintext, err := s.open(payload, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.seal(plaintext, id)
+}
+
+// seal encrypts plaintext under a random nonce, which the payload starts
+// with.
+func (s *Sealer) seal(plaintext []byte, id string) ([]byte, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return s.aead.Seal(nonce, nonce, plaintext, []byte(id)), nil
+}
+
+// open decrypts a payload with the current key, or failing that with the
+// previous ones.
+func (s *Sealer) open(payload []byte, id string) ([]byte, error) {
+	n := s.aead.NonceSize()
+	if len(payload) < n {
+		return nil, errors.New("sealed payload is too short")
+	}
+	plaintext, err := s.aead.Open(nil, payload[:n], payload[n:], []byte(id))
+	for _, aead := range s.previous {
+		if err == nil {
+			break
+		}
+		plaintext, err = aead.Open(nil, payload[:n], payload[n:], []byte(id))
+	}
+	return plaintext, err
+}
+
+// systemActor is the actor the audit log records the changes made on the
+// spot while serving a request under, such as a reveal of sealed bids the
+// scheduler has yet to make.
+const systemActor = "system"
+
+// revealedTender is the state after a reveal the audit log records: the
+// tender and what triggered the reveal.
+type revealedTender struct {
+	*Tender
+	RevealTrigger RevealTrigger `json:"revealTrigger"`
+}
+
+// bidsHidden reports whether the bids of the tender are still sealed. A
+// tender past its deadline or closed is revealed on the spot, so callers
+// don't depend on the scheduler having run; the reveal is recorded in the
+// audit log, in its transaction, as one by the scheduler would be.
+func (a *APIServer) bidsHidden(tender *Tender, now time.Time) (bool, error) {
+	if !tender.Sealed || tender.RevealedAt != nil {
+		return false, nil
+	}
+
+	trigger := RevealTriggerClosed
+	if tender.Status != TenderStatusClosed {
+		if tender.SubmissionDeadline == nil || now.Before(*tender.SubmissionDeadline) {
+			return true, nil
+		}
+		trigger = RevealTriggerDeadline
+	}
+
+	err := a.atomically(func(tx *APIServer) error {
+		revealed, err := tx.store.RevealBids(tender.Id, trigger)
+		if err != nil || !revealed {
+			return err
+		}
+		after, err := tx.store.GetTenderById(tender.Id)
+		if err != nil {
+			return err
+		}
+		before := *after
+		before.RevealedAt = nil
+		entry := &AuditEntry{Actor: systemActor, Action: AuditActionRevealTenderBids, EntityType: AuditEntityTypeTender, EntityId: tender.Id}
+		return appendAudit(tx.store, entry, []string{after.OrganizationId}, &before, revealedTender{after, trigger})
+	})
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// decisionsOpen reports whether the bids of the tender can still be decided
+// on. A tender closes at its submission deadline, before its bids are
+// decided on, and a sealed one before anyone has even seen them, so once
+// closed it stays open for decisions until a bid wins it, or every lot is
+// settled.
+func (a *APIServer) decisionsOpen(tender *Tender) (bool, error) {
+	if tender.Status != TenderStatusClosed {
+		return true, nil
+	}
+	if tender.Lots != nil {
+		return hasOpenLots(tender), nil
+	}
+
+	records, err := a.store.GetTenderDecisions(tender.Id)
+	if err != nil {
+		return false, err
+	}
+	responsibles, err := a.store.GetOrganizationResponsibles(tender.OrganizationId)
+	if err != nil {
+		return false, err
+	}
+	decisions := map[string][]BidDecision{}
+	for _, d := range records {
+		decisions[d.BidId] = append(decisions[d.BidId], d.Decision)
+	}
+	for _, ds := range decisions {
+		if bidDecisionOutcome(ds, len(responsibles)) == BidDecisionApproved {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"testing"
+	"time"
+)
+
+func newTestSealer(t *testing.T, key byte) *Sealer {
+	t.Helper()
+
+	s, err := NewSealer(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{key}, 32)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSealer(t *testing.T) {
+	sealer := newTestSealer(t, 1)
+	price, currency, days := "1500.00", "RUB", int32(7)
+	bid := &Bid{Id: "bid-1", Name: "Предложение", Description: "Описание", Price: &price, Currency: &currency, DeliveryDays: &days}
+
+	payload, err := sealer.Seal(bid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(payload, []byte("1500.00")) || bytes.Contains(payload, []byte("Описание")) {
+		t.Fatal("payload leaks the bid contents")
+	}
+
+	opened := &Bid{Id: "bid-1"}
+	if err := sealer.Open(opened, payload); err != nil {
+		t.Fatal(err)
+	}
+	if opened.Name != bid.Name || opened.Description != bid.Description || *opened.Price != price ||
+		*opened.Currency != currency || *opened.DeliveryDays != days {
+		t.Errorf("opened bid = %+v", opened)
+	}
+
+	if err := sealer.Open(&Bid{Id: "bid-2"}, payload); err == nil {
+		t.Error("payload opened for another bid")
+	}
+	if err := newTestSealer(t, 2).Open(&Bid{Id: "bid-1"}, payload); err == nil {
+		t.Error("payload opened with another key")
+	}
+	if _, err := NewSealer("c2hvcnQ="); err == nil {
+		t.Error("short key accepted")
+	}
+
+	blob, err := sealer.SealBlob("att-1", []byte("Смета"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data, err := sealer.OpenBlob("att-1", blob); err != nil || string(data) != "Смета" {
+		t.Errorf("opened blob = %q, %v", data, err)
+	}
+	if _, err := sealer.OpenBlob("att-2", blob); err == nil {
+		t.Error("blob opened for another attachment")
+	}
+}
+
+func TestSealerRotation(t *testing.T) {
+	old := newTestSealer(t, 1)
+	bid := &Bid{Id: "bid-1", Name: "Предложение"}
+	payload, err := old.Seal(bid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotated, err := NewSealer(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)),
+		base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rotated.Open(&Bid{Id: "bid-1"}, payload); err != nil {
+		t.Fatalf("payload of the previous key: %v", err)
+	}
+
+	resealed, err := rotated.reseal(payload, "bid-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := old.Open(&Bid{Id: "bid-1"}, resealed); err == nil {
+		t.Error("resealed payload opened with the previous key")
+	}
+	opened := &Bid{Id: "bid-1"}
+	if err := newTestSealer(t, 2).Open(opened, resealed); err != nil || opened.Name != bid.Name {
+		t.Errorf("resealed bid = %+v, %v", opened, err)
+	}
+
+	blobs, err := NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	blob, err := old.SealBlob("att-1", []byte("Смета"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := blobs.Put(context.Background(), "att-1", bytes.NewReader(blob), int64(len(blob)), "text/plain"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rotated.ResealBlob(context.Background(), blobs, &Attachment{Id: "att-1", ContentType: "text/plain"}); err != nil {
+		t.Fatal(err)
+	}
+	r, err := blobs.Get(context.Background(), "att-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	stored, _ := io.ReadAll(r)
+	if data, err := newTestSealer(t, 2).OpenBlob("att-1", stored); err != nil || string(data) != "Смета" {
+		t.Errorf("resealed blob = %q, %v", data, err)
+	}
+
+	if _, err := NewSealer(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)), "c2hvcnQ="); err == nil {
+		t.Error("short previous key accepted")
+	}
+}
+
+func TestRevealDueTenders(t *testing.T) {
+	store := NewMemoryStorage()
+	past, future := time.Now().Add(-time.Minute), time.Now().Add(time.Hour)
+
+	due, _ := store.CreateTender(&Tender{Name: "Срок вышел", Sealed: true, SubmissionDeadline: &past}, "owner")
+	waiting, _ := store.CreateTender(&Tender{Name: "Ждет", Sealed: true, SubmissionDeadline: &future}, "owner")
+	closed, _ := store.CreateTender(&Tender{Name: "Закрыт", Sealed: true}, "owner")
+	open, _ := store.CreateTender(&Tender{Name: "Открытый", SubmissionDeadline: &past}, "owner")
+	store.UpdateTenderStatus(closed.Id, TenderStatusClosed)
+
+	if err := NewScheduler(store, nil, time.Minute).Tick(time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, id := range []string{waiting.Id, open.Id} {
+		if got, _ := store.GetTenderById(id); got.RevealedAt != nil {
+			t.Errorf("tender %s revealed", got.Name)
+		}
+	}
+	want := map[string]RevealTrigger{due.Id: RevealTriggerDeadline, closed.Id: RevealTriggerClosed}
+	if len(store.reveals) != len(want) {
+		t.Fatalf("reveals = %+v", store.reveals)
+	}
+	for _, r := range store.reveals {
+		if want[r.tenderId] != r.trigger {
+			t.Errorf("reveal of %s by %s, want %s", r.tenderId, r.trigger, want[r.tenderId])
+		}
+	}
+
+	if revealed, err := store.RevealBids(due.Id, RevealTriggerClosed); err != nil || revealed {
+		t.Errorf("second reveal = %v, %v; want no-op", revealed, err)
+	}
+}
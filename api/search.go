@@ -0,0 +1,53 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+)
+
+func (a *APIServer) searchTenders(w http.ResponseWriter, r *http.Request, params SearchTendersParams) error {
+	if strings.TrimSpace(params.Q) == "" {
+		return httpError(http.StatusBadRequest, "search query is empty")
+	}
+
+	filter := SearchFilter{
+		Status:         deref(params.Status),
+		ServiceTypes:   deref(params.ServiceType),
+		OrganizationId: deref(params.OrganizationId),
+	}
+	if params.Username != nil {
+		user, err := a.authenticate(*params.Username)
+		if err != nil {
+			return err
+		}
+		filter.Viewer = user.Username
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	hits, err := a.store.SearchTenders(params.Q, filter, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, hits)
+}
+
+func (a *APIServer) searchBids(w http.ResponseWriter, r *http.Request, params SearchBidsParams) error {
+	if strings.TrimSpace(params.Q) == "" {
+		return httpError(http.StatusBadRequest, "search query is empty")
+	}
+
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+
+	filter := SearchFilter{Viewer: user.Username, TenderId: deref(params.TenderId)}
+	limit, offset := pagination(params.Limit, params.Offset)
+	hits, err := a.store.SearchBids(params.Q, filter, limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, hits)
+}
@@ -2,49 +2,185 @@ package api
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
-	_ "github.com/lib/pq"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"log"
-	"math/rand"
 	"os"
+	"slices"
+	"strings"
 	"time"
 )
 
 var (
-	ErrURLNotFound = errors.New("URL not found")
-	ErrURLExists   = errors.New("URL already exists")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrTenderNotFound  = errors.New("tender not found")
+	ErrBidNotFound     = errors.New("bid not found")
+	ErrVersionNotFound = errors.New("version not found")
+	ErrDecisionExists  = errors.New("decision already submitted")
+	ErrNotAuction      = errors.New("tender is not an auction")
+	ErrAuctionClosed   = errors.New("auction is not running")
+	ErrBidTooHigh      = errors.New("price is too high")
+	ErrScoresLocked    = errors.New("scores are locked by a submitted decision")
+	ErrLotNotFound     = errors.New("lot not found")
+	ErrLotSettled      = errors.New("lot is already awarded or canceled")
+
+	ErrOrganizationNotFound = errors.New("organization not found")
+	ErrInvitationNotFound   = errors.New("invitation not found")
+	ErrInvitationExists     = errors.New("already invited")
+
+	ErrUserExists         = errors.New("user already exists")
+	ErrAlreadyResponsible = errors.New("user is already responsible for an organization")
+
+	ErrQuestionNotFound = errors.New("question not found")
+	ErrQuestionAnswered = errors.New("question is already answered")
+
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	ErrWebhookNotFound  = errors.New("webhook not found")
+	ErrDeliveryNotFound = errors.New("delivery not found")
+	ErrDeliveryNotDead  = errors.New("delivery is not dead-lettered")
 )
 
 type Storage interface {
-	CreateAccount(*Account) error
-	DeleteAccount(int) error
-	UpdateAccount(*Account) error
-	GetAccounts() ([]*Account, error)
-	GetAccountById(int) (*Account, error)
-	GetAllTenders(string) ([]*Tender, error)
-	CreateTender(*Tender) (*Tender, error)
-	isValidTenderCreator(string, string) (bool, error)
-	GetTendersByUsername(string) ([]*Tender, error)
-	GetUserByUsername(string) (*User, error)
-	UpdateTenderById(string, string, string) (*Tender, error)
-	RollbackTender(string, int) (*Tender, error)
+	Atomically(func(Storage) error) error
 
-	UpdateBidById(string, string, string) (*Bid, error)
-	GetBidsByTenderId(string) ([]*Bid, error)
-	GetBidsByUsername(string) ([]*Bid, error)
+	GetUserByUsername(string) (*User, error)
+	GetUserById(string) (*User, error)
+	GetUsersByIds([]string) (map[string]*User, error)
+	GetUsersByUsernames([]string) (map[string]*User, error)
+	GetUserOrganization(string) (string, error)
+	GetUserOrganizations([]string) (map[string]string, error)
+	GetOrganizationsByIds([]string) (map[string]*Organization, error)
+	GetOrganizationResponsibles(string) ([]*User, error)
+	isValidTenderCreator(string, string) (bool, error)
+	CreateUser(*User) (*User, error)
+	CreateOrganization(string) (string, error)
+	AddOrganizationResponsible(string, string) error
+
+	GetAllTenders(TenderFilter, int32, int32) ([]*Tender, error)
+	SearchTenders(string, SearchFilter, int32, int32) ([]*TenderSearchHit, error)
+	GetTendersByUsername(string, TenderFilter, int32, int32) ([]*Tender, error)
+	GetTenderById(string) (*Tender, error)
+	GetTendersByIds([]string) (map[string]*Tender, error)
+	CreateTender(*Tender, string) (*Tender, error)
+	CreateTenderBatch([]*Tender, []string) ([]*Tender, error)
+	UpdateTenderById(string, EditTenderJSONRequestBody) (*Tender, error)
+	UpdateTenderStatus(string, TenderStatus) (*Tender, error)
+	RollbackTender(string, int32) (*Tender, error)
+	PublishDueTenders(time.Time) ([]string, error)
+	CloseExpiredTenders(time.Time) ([]string, error)
+	RevealBids(string, RevealTrigger) (bool, error)
+	RevealDueTenders(time.Time) ([]string, error)
+	CancelTenderLot(string, string) (*Tender, error)
+
+	CreateInvitation(*TenderInvitation) (*TenderInvitation, error)
+	GetInvitationById(string) (*TenderInvitation, error)
+	GetTenderInvitations(string, int32, int32) ([]*TenderInvitation, error)
+	GetUserInvitations(string, int32, int32) ([]*TenderInvitation, error)
+	GetInvitationStatus(string, string) (InvitationStatus, error)
+	RespondInvitation(string, InvitationStatus) (*TenderInvitation, error)
+
+	CreateQuestion(*TenderQuestion) (*TenderQuestion, error)
+	GetQuestionById(string) (*TenderQuestion, error)
+	GetTenderQuestions(string, QuestionFilter, int32, int32) ([]*TenderQuestion, error)
+	AnswerQuestion(string, string, bool) (*TenderQuestion, error)
+	GetTenderVersions(string) ([]*Tender, error)
+	GetTenderVersionsByIds([]string) (map[string][]*Tender, error)
+
+	CreateAttachment(*Attachment) (*Attachment, error)
+	GetAttachmentById(string) (*Attachment, error)
+	GetTenderAttachments(string, int32) ([]*Attachment, error)
+	GetBidAttachments(string, int32) ([]*Attachment, error)
+
+	CreateWebhook(*Webhook, string) (*Webhook, error)
+	GetWebhookById(string) (*Webhook, error)
+	GetOrganizationWebhooks(string) ([]*Webhook, error)
+	DeleteWebhook(string) error
+	EnqueueWebhookEvent(int64, WebhookEvent, []string, []byte) error
+	CreateWebhookDelivery(string, WebhookEvent, []byte) (*WebhookDelivery, error)
+	GetWebhookDeliveryById(string) (*WebhookDelivery, error)
+	GetWebhookDeliveries(string, WebhookDeliveryStatus, int32, int32) ([]*WebhookDelivery, error)
+	ClaimDueDeliveries(time.Time, time.Time, int) ([]DueDelivery, error)
+	RecordDeliveryAttempt(string, DeliveryAttempt, time.Time) error
+	RedeliverWebhookDelivery(string) (*WebhookDelivery, error)
+
+	FetchOutbox(int) ([]OutboxEvent, error)
+	MarkOutboxPublished([]int64, time.Time) error
+	AppendEventLog(LoggedEvent) error
+	GetEventLog(int64, EventLogFilter, int) ([]LoggedEvent, error)
+	GetEventLogHead() (int64, error)
+
+	AppendAuditLog(*AuditEntry) (*AuditEntry, error)
+	GetAuditLog(AuditFilter, int32, int32) ([]*AuditEntry, error)
+	GetAuditChain(int64, int) ([]*AuditEntry, error)
+
+	GetNotificationPreferences(string) (*NotificationPreferences, error)
+	SetNotificationPreferences(string, NotificationPreferences) (*NotificationPreferences, error)
+	EnqueueNotifications([]Notification) error
+	ClaimDueNotifications(time.Time, time.Time, int) ([]Notification, error)
+	RecordNotificationAttempt(string, DeliveryAttempt, time.Time) error
+
+	GetBidById(string) (*Bid, error)
+	GetBidsByTenderId(string, BidFilter, int32, int32) ([]*Bid, error)
+	GetBidsByTenderIds([]string) (map[string][]*Bid, error)
+	TenderHasBids(string) (bool, error)
+	GetBidsByUsername(string, BidFilter, int32, int32) ([]*Bid, error)
+	GetOrganizationBidAuthors(string) ([]string, error)
+	GetOrganizationReputations([]string) (map[string]Reputation, error)
+	GetAuthorReputations([]string) (map[string]Reputation, error)
+	SearchBids(string, SearchFilter, int32, int32) ([]*BidSearchHit, error)
 	CreateBid(*Bid) (*Bid, error)
-	RollbackBid(string, int) (*Bid, error)
-
-	CreateReviewOnBid(*Review, string) error
-	GetReviewBids(string, string, string) ([]*Review, error)
+	CreateBidBatch([]*Bid) ([]*Bid, error)
+	UpdateBidById(string, EditBidJSONRequestBody) (*Bid, error)
+	UpdateBidStatus(string, BidStatus) (*Bid, error)
+	RollbackBid(string, int32) (*Bid, error)
+	SubmitBidDecision(string, string, BidDecision, string) (*Bid, error)
+	GetPendingDeciders(string, string) ([]*User, error)
+	PlaceAuctionBid(string, Money, time.Time) (*Bid, error)
+	GetAuctionStandings(string) ([]AuctionStanding, error)
+	SubmitBidScores(string, string, []CriterionScore) (*BidScorecard, error)
+	GetBidScorecards(string) ([]BidScorecard, error)
+	GetTenderScorecards(string) ([]BidScorecard, error)
+
+	CreateReviewOnBid(string, string, string, *BidRating, *BidOnTime) error
+	GetReviewBids(string, string, int32, int32) ([]*BidReview, error)
+	CountTenderActivity([]string) (map[string]ActivityCounts, error)
+	GetTenderDecisions(string) ([]DecisionRecord, error)
+	GetTenderReviews(string) ([]ReviewRecord, error)
+	CountBidActivity([]string) (map[string]ActivityCounts, error)
+	GetDecisionsByBidIds([]string) (map[string][]DecisionRecord, error)
+	GetReviewsByBidIds([]string) (map[string][]ReviewRecord, error)
+	GetReviewsByAuthors([]string) (map[string][]ReviewRecord, error)
 }
 
 type PostgresStorage struct {
 	db *sql.DB
+	// tx is the transaction of an Atomically call every statement runs in,
+	// nil outside of one.
+	tx     *sql.Tx
+	sealer *Sealer
+}
+
+// queryer is what both *sql.DB and *sql.Tx run statements with.
+type queryer interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// conn is where statements run: the transaction of the Atomically call, if
+// any, the pool otherwise.
+func (s *PostgresStorage) conn() queryer {
+	if s.tx != nil {
+		return s.tx
+	}
+	return s.db
 }
 
-func NewPostgresStorage() (*PostgresStorage, error) {
+func NewPostgresStorage(sealer *Sealer) (*PostgresStorage, error) {
 	//connStr := "user=postgres dbname=postgres password=goes sslmode=disable host=localhost port=5432"
 
 	postgresUsername := os.Getenv("POSTGRES_USERNAME")
@@ -60,7 +196,12 @@ func NewPostgresStorage() (*PostgresStorage, error) {
 		postgresHost,
 		postgresPort,
 	)
+	return OpenPostgresStorage(connStr, sealer)
+}
 
+// OpenPostgresStorage connects to the database at connStr, a lib/pq
+// connection string or URL.
+func OpenPostgresStorage(connStr string, sealer *Sealer) (*PostgresStorage, error) {
 	db, err := sql.Open("postgres", connStr)
 	if err != nil {
 		return nil, err
@@ -71,10 +212,17 @@ func NewPostgresStorage() (*PostgresStorage, error) {
 
 	}
 
-	return &PostgresStorage{db: db}, nil
+	return &PostgresStorage{db: db, sealer: sealer}, nil
 }
 
-func (s *PostgresStorage) TransactionDecorator(fn func(tx *sql.Tx) error) error {
+// TransactionDecorator runs fn in a transaction, committing it when fn
+// succeeds. Within Atomically, fn joins the transaction of the call, which
+// commits or rolls back as a whole.
+func (s *PostgresStorage) TransactionDecorator(fn func(tx *sql.Tx) error) (err error) {
+	if s.tx != nil {
+		return fn(s.tx)
+	}
+
 	tx, err := s.db.Begin()
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -98,6 +246,21 @@ func (s *PostgresStorage) TransactionDecorator(fn func(tx *sql.Tx) error) error
 	return err
 }
 
+// Atomically runs fn with a storage making every change in one transaction,
+// committed once fn succeeds and rolled back if it fails.
+func (s *PostgresStorage) Atomically(fn func(Storage) error) error {
+	return s.TransactionDecorator(func(tx *sql.Tx) error {
+		atomic := *s
+		atomic.tx = tx
+		return fn(&atomic)
+	})
+}
+
+// Close closes the connections to the database.
+func (s *PostgresStorage) Close() error {
+	return s.db.Close()
+}
+
 func (s *PostgresStorage) Init() error {
 
 	if err := s.CreateUserTable(); err != nil {
@@ -118,6 +281,10 @@ func (s *PostgresStorage) Init() error {
 		return fmt.Errorf("failed to create CreateTenderVersion: %w", err)
 	}
 
+	if err := s.CreateTenderSchedule(); err != nil {
+		return fmt.Errorf("failed to create CreateTenderSchedule: %w", err)
+	}
+
 	if err := s.CreateBids(); err != nil {
 		return fmt.Errorf("failed to create CreateBids: %w", err)
 	}
@@ -126,6 +293,18 @@ func (s *PostgresStorage) Init() error {
 		return fmt.Errorf("failed to create CreateBidVersion: %w", err)
 	}
 
+	if err := s.CreatePricing(); err != nil {
+		return fmt.Errorf("failed to create CreatePricing: %w", err)
+	}
+
+	if err := s.CreateSealing(); err != nil {
+		return fmt.Errorf("failed to create CreateSealing: %w", err)
+	}
+
+	if err := s.CreateAuctions(); err != nil {
+		return fmt.Errorf("failed to create CreateAuctions: %w", err)
+	}
+
 	if err := s.CreateReviewsOnBid(); err != nil {
 		return fmt.Errorf("failed to create CreateReviewsOnBid: %w", err)
 	}
@@ -134,24 +313,61 @@ func (s *PostgresStorage) Init() error {
 		return fmt.Errorf("failed to create CreateBidDecisions: %w", err)
 	}
 
-	return nil
-}
+	if err := s.CreateScoring(); err != nil {
+		return fmt.Errorf("failed to create CreateScoring: %w", err)
+	}
+
+	if err := s.CreateLots(); err != nil {
+		return fmt.Errorf("failed to create CreateLots: %w", err)
+	}
+
+	if err := s.CreateInvitations(); err != nil {
+		return fmt.Errorf("failed to create CreateInvitations: %w", err)
+	}
 
-func GenerateRandomLetters() string {
-	length := 10
-	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	result := make([]byte, length)
-	rand.Seed(time.Now().UnixNano()) // Seed the random number generator
-	for i := range result {
-		result[i] = letters[rand.Intn(len(letters))]
+	if err := s.CreateQuestions(); err != nil {
+		return fmt.Errorf("failed to create CreateQuestions: %w", err)
 	}
-	return string(result)
+
+	if err := s.CreateAttachments(); err != nil {
+		return fmt.Errorf("failed to create CreateAttachments: %w", err)
+	}
+
+	if err := s.CreateSearch(); err != nil {
+		return fmt.Errorf("failed to create CreateSearch: %w", err)
+	}
+
+	if err := s.CreateWebhooks(); err != nil {
+		return fmt.Errorf("failed to create CreateWebhooks: %w", err)
+	}
+
+	if err := s.CreateOutbox(); err != nil {
+		return fmt.Errorf("failed to create CreateOutbox: %w", err)
+	}
+
+	if err := s.CreateEventLog(); err != nil {
+		return fmt.Errorf("failed to create CreateEventLog: %w", err)
+	}
+
+	if err := s.CreateNotifications(); err != nil {
+		return fmt.Errorf("failed to create CreateNotifications: %w", err)
+	}
+
+	if err := s.CreateAuditLog(); err != nil {
+		return fmt.Errorf("failed to create CreateAuditLog: %w", err)
+	}
+
+	if err := s.CreateReputation(); err != nil {
+		return fmt.Errorf("failed to create CreateReputation: %w", err)
+	}
+
+	return nil
 }
 
 func (s *PostgresStorage) CreateUserTable() error {
 	query := `
     CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
-           
+
 CREATE TABLE if not exists employee  (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     username VARCHAR(50) UNIQUE NOT NULL,
@@ -161,7 +377,7 @@ CREATE TABLE if not exists employee  (
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -173,7 +389,7 @@ organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
 user_id UUID REFERENCES employee(id) ON DELETE CASCADE
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -200,7 +416,7 @@ END $$;
 );
 
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -208,13 +424,13 @@ func (s *PostgresStorage) CreateTenderTable() error {
 	query := `
 CREATE TABLE IF NOT EXISTS CreateTenderTable (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    service_type VARCHAR(50) NOT NULL,  -- Assuming service types are strings, adjust as needed
-    status VARCHAR(20) CHECK (status IN ('CREATED', 'PUBLISHED', 'CANCELED')),  -- Example statuses
+    status VARCHAR(20) NOT NULL DEFAULT 'Created' CHECK (status IN ('Created', 'Published', 'Closed')),
     organization_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
-    creator_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE SET NULL
+    creator_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -223,13 +439,27 @@ func (s *PostgresStorage) CreateTenderVersion() error {
 	CREATE TABLE IF NOT EXISTS CreateTenderVersion (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     CreateTenderTable_id UUID NOT NULL REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
-    name VARCHAR(255) NOT NULL,
-    description TEXT,   
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL,
+    service_type VARCHAR(50) NOT NULL CHECK (service_type IN ('Construction', 'Delivery', 'Manufacture')),
     version INT DEFAULT 1,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (CreateTenderTable_id, version)
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
+	return err
+}
+
+// CreateTenderSchedule adds the versioned publication and submission
+// deadline moments the scheduler acts on.
+func (s *PostgresStorage) CreateTenderSchedule() error {
+	query := `
+	ALTER TABLE CreateTenderVersion
+    ADD COLUMN IF NOT EXISTS submission_deadline TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS publish_at TIMESTAMPTZ;
+`
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -237,13 +467,16 @@ func (s *PostgresStorage) CreateBids() error {
 	query := `
 	CREATE TABLE IF NOT EXISTS Bids (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
-    CreateTenderTable_id UUID REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
-	status VARCHAR(20) CHECK (status IN ('CREATED', 'PUBLISHED', 'CANCELED')),
-	organization_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
-	creator_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE SET NULL
+    CreateTenderTable_id UUID NOT NULL REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
+	status VARCHAR(20) NOT NULL DEFAULT 'Created' CHECK (status IN ('Created', 'Published', 'Canceled')),
+	author_type VARCHAR(20) NOT NULL CHECK (author_type IN ('Organization', 'User')),
+	author_id UUID NOT NULL REFERENCES employee(id) ON DELETE CASCADE,
+	organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+	creator_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE SET NULL,
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -252,13 +485,69 @@ func (s *PostgresStorage) CreateBidVersion() error {
 	CREATE TABLE IF NOT EXISTS BidsVersion (
     id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
     bid_id UUID REFERENCES Bids(id) ON DELETE CASCADE,
-	name VARCHAR(255) NOT NULL,
-    description TEXT,   
+	name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL,
     version INT DEFAULT 1,
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (bid_id, version)
+);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
+
+// CreatePricing adds the versioned tender budget and bid price columns.
+// Amounts are NUMERIC so they are stored exactly.
+func (s *PostgresStorage) CreatePricing() error {
+	query := `
+	ALTER TABLE CreateTenderVersion
+    ADD COLUMN IF NOT EXISTS budget_min NUMERIC(18, 2) CHECK (budget_min >= 0),
+    ADD COLUMN IF NOT EXISTS budget_max NUMERIC(18, 2) CHECK (budget_max >= 0),
+    ADD COLUMN IF NOT EXISTS currency CHAR(3);
+
+	ALTER TABLE BidsVersion
+    ADD COLUMN IF NOT EXISTS price NUMERIC(18, 2) CHECK (price >= 0),
+    ADD COLUMN IF NOT EXISTS currency CHAR(3),
+    ADD COLUMN IF NOT EXISTS delivery_days INT CHECK (delivery_days > 0);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
+
+// CreateSealing adds the sealed flag of tenders, the encrypted contents of
+// their bid versions and the log of reveals.
+func (s *PostgresStorage) CreateSealing() error {
+	query := `
+	ALTER TABLE CreateTenderTable
+    ADD COLUMN IF NOT EXISTS sealed BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS revealed_at TIMESTAMPTZ;
+
+	ALTER TABLE BidsVersion
+    ADD COLUMN IF NOT EXISTS sealed_payload BYTEA;
+
+	CREATE TABLE IF NOT EXISTS bidRevealEvents (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    CreateTenderTable_id UUID NOT NULL REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
+    trigger VARCHAR(20) NOT NULL CHECK (trigger IN ('deadline', 'closed')),
+    bids_revealed INT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
+	return err
+}
+
+// CreateAuctions adds the reverse auction parameters of tenders. The end
+// moves when a late price extends the auction, so it isn't versioned.
+func (s *PostgresStorage) CreateAuctions() error {
+	query := `
+	ALTER TABLE CreateTenderTable
+    ADD COLUMN IF NOT EXISTS auction_start TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS auction_end TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS auction_min_decrement NUMERIC(18, 2) CHECK (auction_min_decrement > 0),
+    ADD COLUMN IF NOT EXISTS auction_extension_seconds INT CHECK (auction_extension_seconds >= 0);
+`
+	_, err := s.conn().Exec(query)
 	return err
 }
 
@@ -268,719 +557,4281 @@ func (s *PostgresStorage) CreateReviewsOnBid() error {
  id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
 bid_id UUID REFERENCES Bids(id) ON DELETE CASCADE,
 creator_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE SET NULL,
-comment TEXT,
+comment VARCHAR(1000) NOT NULL,
 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
+	return err
+}
+
+// CreateReputation adds the star rating of feedback and whether the bid was
+// delivered on time, both optional.
+func (s *PostgresStorage) CreateReputation() error {
+	query := `
+	ALTER TABLE reviewsOnBid
+    ADD COLUMN IF NOT EXISTS rating SMALLINT CHECK (rating BETWEEN 1 AND 5),
+    ADD COLUMN IF NOT EXISTS on_time BOOLEAN;
+`
+	_, err := s.conn().Exec(query)
 	return err
 }
 
 func (s *PostgresStorage) CreateBidDecisions() error {
 	query := `
 	CREATE TABLE IF NOT EXISTS bidDecisions (
-	id UUID PRIMARY KEY,
+	id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
 	bid_id UUID REFERENCES Bids(id) ON DELETE CASCADE,
 	creator_username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE SET NULL,
- 	decision VARCHAR(20) CHECK (decision IN ('APPROVE', 'REJECT')),
+ 	decision VARCHAR(20) CHECK (decision IN ('Approved', 'Rejected')),
 	comment TEXT,
-	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+	created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+	UNIQUE (bid_id, creator_username)
 );
 `
-	_, err := s.db.Exec(query)
+	_, err := s.conn().Exec(query)
 	return err
 }
 
-func (s *PostgresStorage) CreateAccount(a *Account) error {
-	query := `insert into account (name) values ($1)`
-	resp, err := s.db.Query(query, a.name)
+// CreateScoring adds the evaluation criteria of tenders and the versioned
+// scorecards responsibles fill in for bids.
+func (s *PostgresStorage) CreateScoring() error {
+	query := `
+	ALTER TABLE CreateTenderTable
+    ADD COLUMN IF NOT EXISTS criteria JSONB;
 
-	if err != nil {
-		return err
-	}
-	fmt.Printf("%+v\n", resp)
-	return nil
+	CREATE TABLE IF NOT EXISTS bidScores (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    bid_id UUID NOT NULL REFERENCES Bids(id) ON DELETE CASCADE,
+    creator_username VARCHAR(50) NOT NULL REFERENCES employee(username),
+    version INT NOT NULL,
+    scores JSONB NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (bid_id, creator_username, version)
+);
+`
+	_, err := s.conn().Exec(query)
+	return err
 }
 
-func (s *PostgresStorage) CreateBid(bid *Bid) (*Bid, error) {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
-
+// CreateLots adds tender lots, the lots bids target and the lot of a
+// decision. Decisions used to be unique per bid and responsible; with lots
+// they are unique per lot, a NULL lot standing for the whole tender.
+func (s *PostgresStorage) CreateLots() error {
 	query := `
-        INSERT INTO Bids (CreateTenderTable_id, status, organization_id, creator_username)
-        VALUES ($1, $2, $3, $4)
-        RETURNING id;
-    `
-
-	err = tx.QueryRow(query, bid.TenderId, bid.Status, bid.OrganizationId, bid.CreatorUsername).Scan(&bid.Id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to insert bid: %w", err)
-	}
+	CREATE TABLE IF NOT EXISTS tenderLots (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    CreateTenderTable_id UUID NOT NULL REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
+    position INT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL,
+    budget_min NUMERIC(18, 2) CHECK (budget_min >= 0),
+    budget_max NUMERIC(18, 2) CHECK (budget_max >= 0),
+    currency CHAR(3),
+    status VARCHAR(20) NOT NULL DEFAULT 'Open' CHECK (status IN ('Open', 'Awarded', 'Canceled')),
+    awarded_bid_id UUID REFERENCES Bids(id) ON DELETE SET NULL,
+    UNIQUE (CreateTenderTable_id, position)
+);
 
-	query = `
-        INSERT INTO BidsVersion ( name, description, bid_id)
-        VALUES ($1, $2, $3)
-    `
+	CREATE TABLE IF NOT EXISTS bidLots (
+    bid_id UUID NOT NULL REFERENCES Bids(id) ON DELETE CASCADE,
+    lot_id UUID NOT NULL REFERENCES tenderLots(id) ON DELETE CASCADE,
+    PRIMARY KEY (bid_id, lot_id)
+);
 
-	_, err = tx.Exec(query, bid.Name, bid.Description, bid.Id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to insert CreateTenderVersion: %w", err)
-	}
+	ALTER TABLE bidDecisions
+    ADD COLUMN IF NOT EXISTS lot_id UUID REFERENCES tenderLots(id) ON DELETE CASCADE,
+    DROP CONSTRAINT IF EXISTS biddecisions_bid_id_creator_username_key;
 
-	return bid, nil
+	CREATE UNIQUE INDEX IF NOT EXISTS biddecisions_lot_key
+    ON bidDecisions (bid_id, creator_username, COALESCE(lot_id, '00000000-0000-0000-0000-000000000000'));
+`
+	_, err := s.conn().Exec(query)
+	return err
 }
 
-func (s *PostgresStorage) CreateTender(t *Tender) (*Tender, error) {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
+// CreateInvitations adds tender visibility and the invitations to private
+// tenders, addressed either to an organization or to an employee.
+func (s *PostgresStorage) CreateInvitations() error {
+	query := `
+	ALTER TABLE CreateTenderTable
+    ADD COLUMN IF NOT EXISTS visibility VARCHAR(10) NOT NULL DEFAULT 'public' CHECK (visibility IN ('public', 'private'));
 
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
-		}
-	}()
+	CREATE TABLE IF NOT EXISTS tenderInvitations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    CreateTenderTable_id UUID NOT NULL REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organization(id) ON DELETE CASCADE,
+    username VARCHAR(50) REFERENCES employee(username) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'Pending' CHECK (status IN ('Pending', 'Accepted', 'Declined')),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    responded_at TIMESTAMPTZ,
+    CHECK ((organization_id IS NULL) <> (username IS NULL)),
+    UNIQUE (CreateTenderTable_id, organization_id),
+    UNIQUE (CreateTenderTable_id, username)
+);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
 
+// CreateQuestions adds the clarification questions on tenders. An answer is
+// published at a tender version, a new one when it amends the tender.
+func (s *PostgresStorage) CreateQuestions() error {
 	query := `
-        INSERT INTO CreateTenderTable ( service_type, status, organization_id, creator_username)
-        VALUES ($1, $2, $3, $4)
-        RETURNING id;
-    `
-
-	var id string
+	CREATE TABLE IF NOT EXISTS tenderQuestions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    CreateTenderTable_id UUID NOT NULL REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
+    author_username VARCHAR(50) NOT NULL REFERENCES employee(username),
+    organization_id UUID REFERENCES organization(id) ON DELETE SET NULL,
+    question VARCHAR(1000) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Open' CHECK (status IN ('Open', 'Answered')),
+    answer VARCHAR(1000),
+    tender_version INT,
+    amended BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    answered_at TIMESTAMPTZ
+);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
 
-	err = tx.QueryRow(query, t.ServiceType, t.Status, t.OrganizationID, t.CreatorUsername).Scan(&id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to insert CreateTenderTable: %w", err)
-	}
+// CreateAttachments adds the metadata of files attached to tender and bid
+// versions. The files themselves live in the BlobStore under the id.
+func (s *PostgresStorage) CreateAttachments() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS attachments (
+    id UUID PRIMARY KEY,
+    CreateTenderTable_id UUID REFERENCES CreateTenderTable(id) ON DELETE CASCADE,
+    bid_id UUID REFERENCES Bids(id) ON DELETE CASCADE,
+    version INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL CHECK (size >= 0),
+    sha256 CHAR(64) NOT NULL,
+    uploader_username VARCHAR(50) NOT NULL REFERENCES employee(username),
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    CHECK ((CreateTenderTable_id IS NULL) <> (bid_id IS NULL))
+);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
 
-	t.Id = id
+// CreateSearch indexes the names and descriptions of tender and bid
+// versions for full-text search. The russian configuration stems Cyrillic
+// words with the Russian stemmer and Latin ones with the English one.
+func (s *PostgresStorage) CreateSearch() error {
+	query := `
+	ALTER TABLE CreateTenderVersion
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian'::regconfig, COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('russian'::regconfig, COALESCE(description, '')), 'B')
+    ) STORED;
 
-	query = `
-        INSERT INTO CreateTenderVersion ( name, description, createtendertable_id)
-        VALUES ($1, $2, $3)
-    `
+	CREATE INDEX IF NOT EXISTS tenderversion_search_idx ON CreateTenderVersion USING GIN (search_vector);
 
-	_, err = tx.Exec(query, t.Name, t.Description, t.Id)
-	if err != nil {
-		return nil, fmt.Errorf("failed to insert CreateTenderVersion: %w", err)
-	}
+	ALTER TABLE BidsVersion
+    ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('russian'::regconfig, COALESCE(name, '')), 'A') ||
+        setweight(to_tsvector('russian'::regconfig, COALESCE(description, '')), 'B')
+    ) STORED;
 
-	return t, nil
+	CREATE INDEX IF NOT EXISTS bidsversion_search_idx ON BidsVersion USING GIN (search_vector);
+`
+	_, err := s.conn().Exec(query)
+	return err
 }
 
-func (s *PostgresStorage) RollbackTender(tender_id string, version int) (*Tender, error) {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	defer func() {
-		if err != nil {
-			rollbackErr := tx.Rollback()
-			if rollbackErr != nil {
-				fmt.Printf("rollback failed: %v", rollbackErr)
-			}
-		} else {
-			commitErr := tx.Commit()
-			if commitErr != nil {
-				fmt.Printf("commit failed: %v", commitErr)
-				err = commitErr
-			}
-		}
-	}()
+// CreateWebhooks adds the webhook subscriptions of organizations and the
+// log of their deliveries. Deliveries out of attempts are copied to the
+// dead-letter table until they are redelivered.
+func (s *PostgresStorage) CreateWebhooks() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS webhooks (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    organization_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    url VARCHAR(500) NOT NULL,
+    events TEXT[] NOT NULL,
+    secret VARCHAR(100) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
 
-	// Check if the version exists
-	var exists bool
-	err = tx.QueryRow(`
-        SELECT EXISTS (
-            SELECT 1
-            FROM CreateTenderVersion
-            WHERE CreateTenderTable_id = $1 AND version = $2
-        )
-    `, tender_id, version).Scan(&exists)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check version existence: %w", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("version %d does not exist for tender %d", version, tender_id)
-	}
+	CREATE TABLE IF NOT EXISTS webhookDeliveries (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Pending' CHECK (status IN ('Pending', 'Delivered', 'Dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    response_status INT,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMPTZ
+);
 
-	// Delete all versions higher than the specified version
-	_, err = tx.Exec(`
-        DELETE FROM CreateTenderVersion
-        WHERE CreateTenderTable_id = $1 AND version > $2
-    `, tender_id, version)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete higher versions: %w", err)
-	}
+	CREATE INDEX IF NOT EXISTS webhookdeliveries_due_idx
+    ON webhookDeliveries (next_attempt_at) WHERE status = 'Pending';
+
+	-- The outbox event a delivery was queued for: a relay publishing an
+	-- event again doesn't queue it again.
+	ALTER TABLE webhookDeliveries ADD COLUMN IF NOT EXISTS outbox_event_id BIGINT;
+	CREATE UNIQUE INDEX IF NOT EXISTS webhookdeliveries_event_idx
+    ON webhookDeliveries (webhook_id, outbox_event_id);
+
+	CREATE TABLE IF NOT EXISTS webhookDeadLetters (
+    delivery_id UUID PRIMARY KEY REFERENCES webhookDeliveries(id) ON DELETE CASCADE,
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    attempts INT NOT NULL,
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
 
-	// Retrieve data for the specified version
-	t := &Tender{}
-	err = tx.QueryRow(`
-        SELECT 
-            t.id, v.name,
-            v.description,
-            t.service_type, 
-            t.status, t.organization_id, 
-            t.creator_username     
-        FROM 
-            CreateTenderTable t
-        JOIN 
-            CreateTenderVersion v
-        ON 
-            t.id = v.CreateTenderTable_id
-        WHERE 
-            t.id = $1 AND v.version = $2
-    `, tender_id, version).Scan(&t.Id, &t.Name, &t.Description, &t.ServiceType, &t.Status,
-		&t.OrganizationID, &t.CreatorUsername)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve rolled back version: %w", err)
-	}
+// CreateOutbox creates the outbox the write transactions record their
+// events in. Published events are kept; the partial index covers those the
+// relay has yet to publish.
+func (s *PostgresStorage) CreateOutbox() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_type VARCHAR(20) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMPTZ
+);
 
-	return t, nil
+	CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (id) WHERE published_at IS NULL;
+`
+	_, err := s.conn().Exec(query)
+	return err
 }
 
-func (s *PostgresStorage) RollbackBid(bid_id string, version int) (*Bid, error) {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
-	}
-
-	defer func() {
-		if err != nil {
-			rollbackErr := tx.Rollback()
-			if rollbackErr != nil {
-				fmt.Printf("rollback failed: %v", rollbackErr)
-			}
-		} else {
-			commitErr := tx.Commit()
-			if commitErr != nil {
-				fmt.Printf("commit failed: %v", commitErr)
-				err = commitErr
-			}
-		}
-	}()
+// CreateEventLog creates the log of relayed events the event stream is
+// served from. An outbox event is logged once however often it is relayed.
+func (s *PostgresStorage) CreateEventLog() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS eventLog (
+    seq BIGSERIAL PRIMARY KEY,
+    outbox_id BIGINT NOT NULL UNIQUE,
+    aggregate_type VARCHAR(20) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    payload JSON NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    CreateTenderTable_id UUID NOT NULL,
+    organization_id UUID NOT NULL
+);
 
-	var exists bool
-	err = tx.QueryRow(`
-        SELECT EXISTS (
-            SELECT 1
-            FROM BidsVersion
-            WHERE bid_id = $1 AND version = $2
-        )
-    `, bid_id, version).Scan(&exists)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check version existence: %w", err)
-	}
-	if !exists {
-		return nil, fmt.Errorf("version %d does not exist for tender %d", version, bid_id)
-	}
+	CREATE INDEX IF NOT EXISTS eventlog_tender_idx ON eventLog (CreateTenderTable_id, seq);
+	CREATE INDEX IF NOT EXISTS eventlog_organization_idx ON eventLog (organization_id, seq);
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
 
-	// Delete all versions higher than the specified version
-	_, err = tx.Exec(`
-        DELETE FROM BidsVersion
-        WHERE bid_id = $1 AND version > $2
-    `, bid_id, version)
-	if err != nil {
-		return nil, fmt.Errorf("failed to delete higher versions: %w", err)
-	}
+// CreateNotifications adds the notification preferences of employees and
+// the queue of the emails sent to them.
+func (s *PostgresStorage) CreateNotifications() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS notificationPreferences (
+    username VARCHAR(50) PRIMARY KEY REFERENCES employee(username) ON DELETE CASCADE,
+    email VARCHAR(254),
+    language VARCHAR(2) NOT NULL CHECK (language IN ('ru', 'en')),
+    events TEXT[] NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP
+);
 
-	// Retrieve data for the specified version
-	t := &Bid{}
-	err = tx.QueryRow(`
-        SELECT 
-            t.id, v.name,
-            v.description,
-            t.CreateTenderTable_id, 
-            t.status, t.organization_id, 
-            t.creator_username     
-        FROM 
-            Bids t
-        JOIN 
-            BidsVersion v
-        ON 
-            t.id = v.bid_id
-        WHERE 
-            t.id = $1 AND v.version = $2
-    `, bid_id, version).Scan(&t.Id, &t.Name, &t.Description, &t.TenderId, &t.Status,
-		&t.OrganizationId, &t.CreatorUsername)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve rolled back version: %w", err)
-	}
+	CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    event_id BIGINT NOT NULL,
+    event VARCHAR(50) NOT NULL,
+    username VARCHAR(50) NOT NULL REFERENCES employee(username) ON DELETE CASCADE,
+    email VARCHAR(254) NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'Pending' CHECK (status IN ('Pending', 'Sent', 'Dead')),
+    attempts INT NOT NULL DEFAULT 0,
+    response_status INT,
+    last_error TEXT,
+    next_attempt_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMPTZ,
+    UNIQUE (event_id, username, event)
+);
 
-	return t, nil
+	CREATE INDEX IF NOT EXISTS notifications_due_idx
+    ON notifications (next_attempt_at) WHERE status = 'Pending';
+`
+	_, err := s.conn().Exec(query)
+	return err
 }
 
-func (s *PostgresStorage) UpdateTenderById(CreateTenderTable_id, name, description string) (*Tender, error) {
-	tx, err := s.db.Begin()
+// CreateAuditLog creates the audit log. A trigger refuses every UPDATE,
+// DELETE and TRUNCATE, so rows can only be added; the hash chain reveals
+// changes made around it.
+func (s *PostgresStorage) CreateAuditLog() error {
+	query := `
+	CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    actor VARCHAR(50) NOT NULL,
+    action VARCHAR(50) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(100) NOT NULL,
+    organization_ids TEXT[] NOT NULL,
+    before JSON,
+    after JSON,
+    request_id VARCHAR(100) NOT NULL,
+    ip VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL
+);
+
+	CREATE INDEX IF NOT EXISTS audit_log_organizations_idx ON audit_log USING GIN (organization_ids);
+	CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity_type, entity_id, id);
+	CREATE INDEX IF NOT EXISTS audit_log_actor_idx ON audit_log (actor, id);
+
+	CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+	BEGIN
+		RAISE EXCEPTION 'audit_log is append-only';
+	END;
+	$$ LANGUAGE plpgsql;
+
+	DROP TRIGGER IF EXISTS audit_log_append_only ON audit_log;
+	CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_log
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_append_only();
+`
+	_, err := s.conn().Exec(query)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// withColumns scans the columns selected after those a scan function
+// expects into dest.
+type withColumns struct {
+	rowScanner
+	dest []any
+}
+
+func (r withColumns) Scan(dest ...any) error {
+	return r.rowScanner.Scan(append(dest, r.dest...)...)
+}
+
+const tenderSelect = `
+	SELECT
+	    t.id,
+	    v.name,
+	    v.description,
+		v.service_type,
+		t.status,
+		t.organization_id,
+		v.version,
+		v.submission_deadline,
+		v.publish_at,
+		v.budget_min,
+		v.budget_max,
+		v.currency,
+		t.sealed,
+		t.revealed_at,
+		t.auction_start,
+		t.auction_end,
+		t.auction_min_decrement,
+		t.auction_extension_seconds,
+		t.criteria,
+		t.visibility,
+		(
+			SELECT json_agg(json_build_object(
+				'id', l.id,
+				'name', l.name,
+				'description', l.description,
+				'budget', CASE WHEN l.currency IS NOT NULL THEN json_build_object(
+					'min', l.budget_min::text,
+					'max', l.budget_max::text,
+					'currency', l.currency
+				) END,
+				'status', l.status,
+				'awardedBidId', l.awarded_bid_id
+			) ORDER BY l.position)
+			FROM tenderLots l
+			WHERE l.CreateTenderTable_id = t.id
+		),
+		t.created_at
+	FROM
+		CreateTenderTable t
+	JOIN
+		CreateTenderVersion v
+	ON
+		t.id = v.CreateTenderTable_id
+	WHERE
+		v.version = (
+			SELECT MAX(version)
+			FROM CreateTenderVersion
+			WHERE CreateTenderTable_id = t.id
+		)
+`
+
+func scanTender(row rowScanner) (*Tender, error) {
+	t := &Tender{}
+	var createdAt time.Time
+	var deadline, publishAt, revealedAt, auctionStart, auctionEnd sql.NullTime
+	var budgetMin, budgetMax, currency, minDecrement sql.NullString
+	var extension sql.NullInt32
+	var criteria, lots []byte
+	if err := row.Scan(&t.Id, &t.Name, &t.Description, &t.ServiceType, &t.Status,
+		&t.OrganizationId, &t.Version, &deadline, &publishAt,
+		&budgetMin, &budgetMax, &currency, &t.Sealed, &revealedAt,
+		&auctionStart, &auctionEnd, &minDecrement, &extension, &criteria, &t.Visibility, &lots, &createdAt); err != nil {
+		return nil, err
+	}
+	if criteria != nil {
+		if err := json.Unmarshal(criteria, &t.Criteria); err != nil {
+			return nil, fmt.Errorf("failed to decode criteria: %w", err)
+		}
+	}
+	if lots != nil {
+		if err := json.Unmarshal(lots, &t.Lots); err != nil {
+			return nil, fmt.Errorf("failed to decode lots: %w", err)
+		}
+	}
+	t.CreatedAt = createdAt.Format(time.RFC3339)
+	t.SubmissionDeadline = nullTime(deadline)
+	t.PublishAt = nullTime(publishAt)
+	t.RevealedAt = nullTime(revealedAt)
+	if currency.Valid {
+		t.Budget = &TenderBudget{
+			Min:      nullString(budgetMin),
+			Max:      nullString(budgetMax),
+			Currency: currency.String,
+		}
+	}
+	if auctionStart.Valid {
+		t.Auction = &TenderAuction{
+			StartAt:          auctionStart.Time.UTC(),
+			EndAt:            auctionEnd.Time.UTC(),
+			MinDecrement:     minDecrement.String,
+			ExtensionSeconds: extension.Int32,
+		}
+	}
+	return t, nil
+}
+
+// auctionArgs splits auction parameters into their column values, all NULL
+// when the tender isn't an auction.
+func auctionArgs(a *TenderAuction) (start, end *time.Time, minDecrement *Money, extension *int32) {
+	if a == nil {
+		return nil, nil, nil, nil
+	}
+	return &a.StartAt, &a.EndAt, &a.MinDecrement, &a.ExtensionSeconds
+}
+
+func nullString(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+// budgetArgs splits a budget into its column values, all NULL when the
+// tender has no budget.
+func budgetArgs(b *TenderBudget) (min, max *Money, currency *Currency) {
+	if b == nil {
+		return nil, nil, nil
+	}
+	return b.Min, b.Max, &b.Currency
+}
+
+// jsonArg encodes v for a JSONB column. It is passed as text, as the driver
+// would send a []byte as bytea.
+func jsonArg[T any](v *T) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T: %w", v, err)
+	}
+	return string(data), nil
+}
+
+func nullTime(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time.UTC()
+	return &v
+}
+
+func nullRating(r sql.NullInt32) *BidRating {
+	if !r.Valid {
+		return nil
+	}
+	return &r.Int32
+}
+
+func nullBool(b sql.NullBool) *bool {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bool
+}
+
+func scanTenders(rows *sql.Rows) ([]*Tender, error) {
+	defer rows.Close()
+
+	tenders := []*Tender{}
+	for rows.Next() {
+		t, err := scanTender(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		tenders = append(tenders, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return tenders, nil
+}
+
+const bidSelect = `
+	SELECT
+	    b.id,
+	    v.name,
+	    v.description,
+		b.status,
+		b.CreateTenderTable_id,
+		b.author_type,
+		b.author_id,
+		v.version,
+		v.price,
+		v.currency,
+		v.delivery_days,
+		v.sealed_payload,
+		(
+			SELECT json_agg(bl.lot_id ORDER BY l.position)
+			FROM bidLots bl
+			JOIN tenderLots l ON l.id = bl.lot_id
+			WHERE bl.bid_id = b.id
+		),
+		b.created_at
+	FROM
+		Bids b
+	JOIN
+		BidsVersion v
+	ON
+		b.id = v.bid_id
+	WHERE
+		v.version = (
+			SELECT MAX(version)
+			FROM BidsVersion
+			WHERE bid_id = b.id
+		)
+`
+
+// scanBid reads a bid row, decrypting the contents of a sealed version.
+func (s *PostgresStorage) scanBid(row rowScanner) (*Bid, error) {
+	b := &Bid{}
+	var createdAt time.Time
+	var price, currency sql.NullString
+	var deliveryDays sql.NullInt32
+	var payload, lotIds []byte
+	if err := row.Scan(&b.Id, &b.Name, &b.Description, &b.Status, &b.TenderId,
+		&b.AuthorType, &b.AuthorId, &b.Version, &price, &currency, &deliveryDays, &payload, &lotIds, &createdAt); err != nil {
+		return nil, err
+	}
+	if lotIds != nil {
+		if err := json.Unmarshal(lotIds, &b.LotIds); err != nil {
+			return nil, fmt.Errorf("failed to decode lots: %w", err)
+		}
+	}
+	b.CreatedAt = createdAt.Format(time.RFC3339)
+	b.Price = nullString(price)
+	b.Currency = nullString(currency)
+	if deliveryDays.Valid {
+		b.DeliveryDays = &deliveryDays.Int32
+	}
+	if payload != nil {
+		if s.sealer == nil {
+			return nil, ErrSealingDisabled
+		}
+		if err := s.sealer.Open(b, payload); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+func (s *PostgresStorage) scanBids(rows *sql.Rows) ([]*Bid, error) {
+	defer rows.Close()
+
+	bids := []*Bid{}
+	for rows.Next() {
+		b, err := s.scanBid(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+		bids = append(bids, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return bids, nil
+}
+
+// isUUID guards queries against ids Postgres would reject as malformed
+// uuid input, so callers get a not-found error instead of a driver error.
+func isUUID(id string) bool {
+	_, err := uuid.Parse(id)
+	return err == nil
+}
+
+func (s *PostgresStorage) CreateBid(bid *Bid) (*Bid, error) {
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		return s.insertBid(tx, bid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bid, nil
+}
+
+// CreateBidBatch creates the bids in one transaction: all of them or none.
+func (s *PostgresStorage) CreateBidBatch(bids []*Bid) ([]*Bid, error) {
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		for _, bid := range bids {
+			if err := s.insertBid(tx, bid); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bids, nil
+}
+
+func (s *PostgresStorage) insertBid(tx *sql.Tx, bid *Bid) error {
+	query := `
+        INSERT INTO Bids (CreateTenderTable_id, status, author_type, author_id, organization_id, creator_username)
+        VALUES (
+            $1, 'Created', $2, $3,
+            CASE WHEN $2 = 'Organization' THEN (
+                SELECT organization_id FROM organization_responsible WHERE user_id = $3 LIMIT 1
+            ) END,
+            (SELECT username FROM employee WHERE id = $3)
+        )
+        RETURNING id, status, created_at;
+    `
+
+	var createdAt time.Time
+	err := tx.QueryRow(query, bid.TenderId, bid.AuthorType, bid.AuthorId).Scan(&bid.Id, &bid.Status, &createdAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert bid: %w", err)
+	}
+	bid.CreatedAt = createdAt.Format(time.RFC3339)
+
+	stored, payload, err := s.sealBidVersion(tx, bid)
+	if err != nil {
+		return err
+	}
+
+	query = `
+        INSERT INTO BidsVersion ( name, description, price, currency, delivery_days, sealed_payload, bid_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING version
+    `
+
+	err = tx.QueryRow(query, stored.Name, stored.Description, stored.Price, stored.Currency, stored.DeliveryDays,
+		payload, bid.Id).Scan(&bid.Version)
+	if err != nil {
+		return fmt.Errorf("failed to insert BidsVersion: %w", err)
+	}
+
+	for _, lotId := range deref(bid.LotIds) {
+		if _, err := tx.Exec(`INSERT INTO bidLots (bid_id, lot_id) VALUES ($1, $2)`, bid.Id, lotId); err != nil {
+			return fmt.Errorf("failed to insert bidLots: %w", err)
+		}
+	}
+
+	return s.outboxBid(tx, EventTypeBidCreated, bid.Id, nil)
+}
+
+func (s *PostgresStorage) CreateTender(t *Tender, creatorUsername string) (*Tender, error) {
+	if t.Sealed && s.sealer == nil {
+		return nil, ErrSealingDisabled
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		return insertTender(tx, t, creatorUsername)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// CreateTenderBatch creates the tenders, each by the creator at the same index,
+// in one transaction: all of them or none.
+func (s *PostgresStorage) CreateTenderBatch(tenders []*Tender, creatorUsernames []string) ([]*Tender, error) {
+	for _, t := range tenders {
+		if t.Sealed && s.sealer == nil {
+			return nil, ErrSealingDisabled
+		}
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		for i, t := range tenders {
+			if err := insertTender(tx, t, creatorUsernames[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tenders, nil
+}
+
+func insertTender(tx *sql.Tx, t *Tender, creatorUsername string) error {
+	query := `
+        INSERT INTO CreateTenderTable ( status, organization_id, creator_username, sealed,
+                                        auction_start, auction_end, auction_min_decrement, auction_extension_seconds,
+                                        criteria, visibility)
+        VALUES ('Created', $1, $2, $3, $4, $5, $6, $7, $8, $9)
+        RETURNING id, status, created_at;
+    `
+
+	var createdAt time.Time
+	auctionStart, auctionEnd, minDecrement, extension := auctionArgs(t.Auction)
+	criteria, err := jsonArg(t.Criteria)
+	if err != nil {
+		return err
+	}
+	err = tx.QueryRow(query, t.OrganizationId, creatorUsername, t.Sealed,
+		auctionStart, auctionEnd, minDecrement, extension, criteria, t.Visibility).Scan(&t.Id, &t.Status, &createdAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert CreateTenderTable: %w", err)
+	}
+	t.CreatedAt = createdAt.Format(time.RFC3339)
+
+	query = `
+        INSERT INTO CreateTenderVersion ( name, description, service_type, submission_deadline, publish_at,
+                                          budget_min, budget_max, currency, createtendertable_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+        RETURNING version
+    `
+
+	budgetMin, budgetMax, currency := budgetArgs(t.Budget)
+	err = tx.QueryRow(query, t.Name, t.Description, t.ServiceType, t.SubmissionDeadline, t.PublishAt,
+		budgetMin, budgetMax, currency, t.Id).Scan(&t.Version)
+	if err != nil {
+		return fmt.Errorf("failed to insert CreateTenderVersion: %w", err)
+	}
+
+	for i := range deref(t.Lots) {
+		lot := &(*t.Lots)[i]
+		budgetMin, budgetMax, currency := budgetArgs(lot.Budget)
+		err = tx.QueryRow(`
+        INSERT INTO tenderLots (CreateTenderTable_id, position, name, description, budget_min, budget_max, currency)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+        RETURNING id
+    `, t.Id, i, lot.Name, lot.Description, budgetMin, budgetMax, currency).Scan(&lot.Id)
+		if err != nil {
+			return fmt.Errorf("failed to insert tenderLots: %w", err)
+		}
+	}
+
+	return outboxTender(tx, EventTypeTenderCreated, t.Id)
+}
+
+// RollbackTender copies the parameters of the given version into a new
+// version, so a rollback is itself recorded as an edit.
+func (s *PostgresStorage) RollbackTender(tender_id string, version int32) (*Tender, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		res, err := tx.Exec(`
+        INSERT INTO CreateTenderVersion (name, description, service_type, submission_deadline, publish_at,
+                                         budget_min, budget_max, currency, version, CreateTenderTable_id)
+        SELECT name, description, service_type, submission_deadline, publish_at,
+               budget_min, budget_max, currency,
+               (SELECT MAX(version) + 1 FROM CreateTenderVersion WHERE CreateTenderTable_id = $1),
+               CreateTenderTable_id
+        FROM CreateTenderVersion
+        WHERE CreateTenderTable_id = $1 AND version = $2
+    `, tender_id, version)
+		if err != nil {
+			return fmt.Errorf("failed to insert rolled back version: %w", err)
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrVersionNotFound
+		}
+		return outboxTender(tx, EventTypeTenderUpdated, tender_id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetTenderById(tender_id)
+}
+
+// RollbackBid copies the parameters of the given version into a new
+// version, so a rollback is itself recorded as an edit.
+func (s *PostgresStorage) RollbackBid(bid_id string, version int32) (*Bid, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		res, err := tx.Exec(`
+        INSERT INTO BidsVersion (name, description, price, currency, delivery_days, sealed_payload, version, bid_id)
+        SELECT name, description, price, currency, delivery_days, sealed_payload,
+               (SELECT MAX(version) + 1 FROM BidsVersion WHERE bid_id = $1),
+               bid_id
+        FROM BidsVersion
+        WHERE bid_id = $1 AND version = $2
+    `, bid_id, version)
+		if err != nil {
+			return fmt.Errorf("failed to insert rolled back version: %w", err)
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rowsAffected == 0 {
+			return ErrVersionNotFound
+		}
+		return s.outboxBid(tx, EventTypeBidUpdated, bid_id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBidById(bid_id)
+}
+
+func (s *PostgresStorage) UpdateTenderById(CreateTenderTable_id string, upd EditTenderJSONRequestBody) (*Tender, error) {
+	if !isUUID(CreateTenderTable_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	var t *Tender
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var err error
+		t, err = scanTender(tx.QueryRow(tenderSelect+` AND t.id = $1 FOR UPDATE OF t`, CreateTenderTable_id))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenderNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve current version: %w", err)
+		}
+
+		if upd.Name != nil {
+			t.Name = *upd.Name
+		}
+		if upd.Description != nil {
+			t.Description = *upd.Description
+		}
+		if upd.ServiceType != nil {
+			t.ServiceType = *upd.ServiceType
+		}
+		if upd.SubmissionDeadline != nil {
+			t.SubmissionDeadline = upd.SubmissionDeadline
+		}
+		if upd.PublishAt != nil {
+			t.PublishAt = upd.PublishAt
+		}
+		if upd.Budget != nil {
+			t.Budget = upd.Budget
+		}
+		t.Version++
+
+		query := `
+        INSERT INTO CreateTenderVersion (name, description, service_type, submission_deadline, publish_at,
+                                         budget_min, budget_max, currency, version, CreateTenderTable_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+    `
+		budgetMin, budgetMax, currency := budgetArgs(t.Budget)
+		_, err = tx.Exec(query, t.Name, t.Description, t.ServiceType, t.SubmissionDeadline, t.PublishAt,
+			budgetMin, budgetMax, currency, t.Version, CreateTenderTable_id)
+		if err != nil {
+			return fmt.Errorf("failed to update CreateTenderVersion: %w", err)
+		}
+
+		return insertOutbox(tx, EventAggregateTypeTender, CreateTenderTable_id, EventTypeTenderUpdated, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func (s *PostgresStorage) UpdateBidById(bid_id string, upd EditBidJSONRequestBody) (*Bid, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+
+	var b *Bid
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var err error
+		b, err = s.scanBid(tx.QueryRow(bidSelect+` AND b.id = $1 FOR UPDATE OF b`, bid_id))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrBidNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve current version: %w", err)
+		}
+
+		if upd.Name != nil {
+			b.Name = *upd.Name
+		}
+		if upd.Description != nil {
+			b.Description = *upd.Description
+		}
+		if upd.Price != nil {
+			b.Price = upd.Price
+		}
+		if upd.Currency != nil {
+			b.Currency = upd.Currency
+		}
+		if upd.DeliveryDays != nil {
+			b.DeliveryDays = upd.DeliveryDays
+		}
+		b.Version++
+
+		stored, payload, err := s.sealBidVersion(tx, b)
+		if err != nil {
+			return err
+		}
+
+		query := `
+        INSERT INTO BidsVersion (name, description, price, currency, delivery_days, sealed_payload, version, bid_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+    `
+		_, err = tx.Exec(query, stored.Name, stored.Description, stored.Price, stored.Currency, stored.DeliveryDays,
+			payload, b.Version, bid_id)
+		if err != nil {
+			return fmt.Errorf("failed to update BidsVersion: %w", err)
+		}
+
+		return s.outboxBid(tx, EventTypeBidUpdated, bid_id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// UpdateTenderStatus moves the tender to status, recording an event when
+// the status changes.
+func (s *PostgresStorage) UpdateTenderStatus(tender_id string, status TenderStatus) (*Tender, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var current TenderStatus
+		err := tx.QueryRow(`SELECT status FROM CreateTenderTable WHERE id = $1 FOR UPDATE`, tender_id).Scan(&current)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenderNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock tender: %w", err)
+		}
+		if current == status {
+			return nil
+		}
+
+		if _, err := tx.Exec(`UPDATE CreateTenderTable SET status = $1 WHERE id = $2`, status, tender_id); err != nil {
+			return fmt.Errorf("failed to update tender status: %w", err)
+		}
+		return outboxTender(tx, tenderStatusEvent(status), tender_id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetTenderById(tender_id)
+}
+
+// UpdateBidStatus moves the bid to status, recording an event when the
+// status changes.
+func (s *PostgresStorage) UpdateBidStatus(bid_id string, status BidStatus) (*Bid, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var current BidStatus
+		err := tx.QueryRow(`SELECT status FROM Bids WHERE id = $1 FOR UPDATE`, bid_id).Scan(&current)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrBidNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock bid: %w", err)
+		}
+		if current == status {
+			return nil
+		}
+
+		if _, err := tx.Exec(`UPDATE Bids SET status = $1 WHERE id = $2`, status, bid_id); err != nil {
+			return fmt.Errorf("failed to update bid status: %w", err)
+		}
+		return s.outboxBid(tx, bidStatusEvent(status), bid_id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBidById(bid_id)
+}
+
+// PlaceAuctionBid records a new price of a bid as a new version. The tender
+// row stays locked until the price is committed, so concurrent prices on the
+// same auction are judged against each other one at a time.
+func (s *PostgresStorage) PlaceAuctionBid(bid_id string, price Money, now time.Time) (*Bid, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var tender_id string
+		err := tx.QueryRow(`SELECT CreateTenderTable_id FROM Bids WHERE id = $1`, bid_id).Scan(&tender_id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrBidNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve bid tender: %w", err)
+		}
+
+		t, err := scanTender(tx.QueryRow(tenderSelect+` AND t.id = $1 FOR UPDATE OF t`, tender_id))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenderNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock tender: %w", err)
+		}
+
+		b, err := s.scanBid(tx.QueryRow(bidSelect+` AND b.id = $1`, bid_id))
+		if err != nil {
+			return fmt.Errorf("failed to retrieve current version: %w", err)
+		}
+
+		var best sql.NullString
+		err = tx.QueryRow(`
+        SELECT MIN(v.price)
+        FROM Bids b
+        JOIN BidsVersion v ON b.id = v.bid_id
+        WHERE b.CreateTenderTable_id = $1
+          AND b.status = 'Published'
+          AND v.version = (SELECT MAX(version) FROM BidsVersion WHERE bid_id = b.id)
+    `, tender_id).Scan(&best)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve leading price: %w", err)
+		}
+
+		endAt, err := auctionBid(t, price, nullString(best), now)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`
+        INSERT INTO BidsVersion (name, description, price, currency, delivery_days, version, bid_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7)
+    `, b.Name, b.Description, price, t.Budget.Currency, b.DeliveryDays, b.Version+1, bid_id)
+		if err != nil {
+			return fmt.Errorf("failed to insert BidsVersion: %w", err)
+		}
+
+		if !endAt.Equal(t.Auction.EndAt) {
+			if _, err := tx.Exec(`UPDATE CreateTenderTable SET auction_end = $1 WHERE id = $2`, endAt, tender_id); err != nil {
+				return fmt.Errorf("failed to extend auction: %w", err)
+			}
+		}
+		return s.outboxBid(tx, EventTypeBidUpdated, bid_id, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBidById(bid_id)
+}
+
+// GetAuctionStandings returns the published bids of a tender, best price
+// first. Equal prices rank by which came first.
+func (s *PostgresStorage) GetAuctionStandings(tender_id string) ([]AuctionStanding, error) {
+	if _, err := s.GetTenderById(tender_id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT b.id, b.creator_username, v.price
+        FROM Bids b
+        JOIN BidsVersion v ON b.id = v.bid_id
+        WHERE b.CreateTenderTable_id = $1
+          AND b.status = 'Published'
+          AND v.version = (SELECT MAX(version) FROM BidsVersion WHERE bid_id = b.id)
+        ORDER BY v.price ASC NULLS LAST, v.created_at
+    `, tender_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query standings: %w", err)
+	}
+	defer rows.Close()
+
+	standings := []AuctionStanding{}
+	for rows.Next() {
+		var st AuctionStanding
+		var price sql.NullString
+		if err := rows.Scan(&st.BidId, &st.Username, &price); err != nil {
+			return nil, fmt.Errorf("failed to scan standing: %w", err)
+		}
+		st.Price = nullString(price)
+		standings = append(standings, st)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return standings, nil
+}
+
+// PublishDueTenders publishes created tenders whose publishAt has come and
+// returns their ids.
+func (s *PostgresStorage) PublishDueTenders(now time.Time) ([]string, error) {
+	return s.updateScheduledStatus(`
+        UPDATE CreateTenderTable t
+        SET status = 'Published'
+        FROM CreateTenderVersion v
+        WHERE v.CreateTenderTable_id = t.id
+          AND v.version = (SELECT MAX(version) FROM CreateTenderVersion WHERE CreateTenderTable_id = t.id)
+          AND t.status = 'Created'
+          AND v.publish_at <= $1
+        RETURNING t.id
+    `, now, EventTypeTenderPublished)
+}
+
+// CloseExpiredTenders closes published tenders whose submission deadline
+// has passed or whose auction has ended and returns their ids. Tenders with
+// open lots are left to close as their lots settle.
+func (s *PostgresStorage) CloseExpiredTenders(now time.Time) ([]string, error) {
+	return s.updateScheduledStatus(`
+        UPDATE CreateTenderTable t
+        SET status = 'Closed'
+        FROM CreateTenderVersion v
+        WHERE v.CreateTenderTable_id = t.id
+          AND v.version = (SELECT MAX(version) FROM CreateTenderVersion WHERE CreateTenderTable_id = t.id)
+          AND t.status = 'Published'
+          AND (v.submission_deadline <= $1 OR t.auction_end <= $1)
+          AND NOT EXISTS (SELECT 1 FROM tenderLots l WHERE l.CreateTenderTable_id = t.id AND l.status = 'Open')
+        RETURNING t.id
+    `, now, EventTypeTenderClosed)
+}
+
+// RevealDueTenders reveals the bids of sealed tenders that are closed or
+// past their submission deadline and returns their ids.
+func (s *PostgresStorage) RevealDueTenders(now time.Time) ([]string, error) {
+	rows, err := s.conn().Query(tenderSelect+`
+		AND t.sealed AND t.revealed_at IS NULL
+		AND (t.status = 'Closed' OR v.submission_deadline <= $1)
+    `, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sealed tenders: %w", err)
+	}
+	tenders, err := scanTenders(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	for _, t := range tenders {
+		trigger := RevealTriggerClosed
+		if t.SubmissionDeadline != nil && !t.SubmissionDeadline.After(now) {
+			trigger = RevealTriggerDeadline
+		}
+		revealed, err := s.RevealBids(t.Id, trigger)
+		if err != nil {
+			return ids, err
+		}
+		if revealed {
+			ids = append(ids, t.Id)
+		}
+	}
+	return ids, nil
+}
+
+// RevealBids decrypts every sealed bid version of the tender in a single
+// transaction and records the reveal. It reports false if the bids were
+// already revealed or the tender isn't sealed.
+func (s *PostgresStorage) RevealBids(tender_id string, trigger RevealTrigger) (bool, error) {
+	if !isUUID(tender_id) {
+		return false, ErrTenderNotFound
+	}
+
+	revealed := false
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var pending bool
+		err := tx.QueryRow(`
+        SELECT sealed AND revealed_at IS NULL FROM CreateTenderTable WHERE id = $1 FOR UPDATE
+    `, tender_id).Scan(&pending)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenderNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock tender: %w", err)
+		}
+		if !pending {
+			return nil
+		}
+
+		rows, err := tx.Query(`
+        SELECT v.id, v.bid_id, v.sealed_payload
+        FROM BidsVersion v
+        JOIN Bids b ON b.id = v.bid_id
+        WHERE b.CreateTenderTable_id = $1 AND v.sealed_payload IS NOT NULL
+    `, tender_id)
+		if err != nil {
+			return fmt.Errorf("failed to query sealed bids: %w", err)
+		}
+		type sealedVersion struct {
+			id      string
+			bid     Bid
+			payload []byte
+		}
+		var versions []sealedVersion
+		for rows.Next() {
+			var v sealedVersion
+			if err := rows.Scan(&v.id, &v.bid.Id, &v.payload); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan sealed bid: %w", err)
+			}
+			versions = append(versions, v)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows iteration error: %w", err)
+		}
+
+		bids := map[string]bool{}
+		for _, v := range versions {
+			if s.sealer == nil {
+				return ErrSealingDisabled
+			}
+			if err := s.sealer.Open(&v.bid, v.payload); err != nil {
+				return err
+			}
+			_, err := tx.Exec(`
+            UPDATE BidsVersion
+            SET name = $1, description = $2, price = $3, currency = $4, delivery_days = $5, sealed_payload = NULL
+            WHERE id = $6
+        `, v.bid.Name, v.bid.Description, v.bid.Price, v.bid.Currency, v.bid.DeliveryDays, v.id)
+			if err != nil {
+				return fmt.Errorf("failed to reveal bid %s: %w", v.bid.Id, err)
+			}
+			bids[v.bid.Id] = true
+		}
+
+		if _, err := tx.Exec(`UPDATE CreateTenderTable SET revealed_at = CURRENT_TIMESTAMP WHERE id = $1`, tender_id); err != nil {
+			return fmt.Errorf("failed to mark tender revealed: %w", err)
+		}
+		_, err = tx.Exec(`
+        INSERT INTO bidRevealEvents (CreateTenderTable_id, trigger, bids_revealed)
+        VALUES ($1, $2, $3)
+    `, tender_id, trigger, len(bids))
+		if err != nil {
+			return fmt.Errorf("failed to record reveal: %w", err)
+		}
+
+		revealed = true
+		log.Printf("tender %s: %d sealed bids revealed (%s)", tender_id, len(bids), trigger)
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return revealed, nil
+}
+
+// ResealBids encrypts the contents of every bid version still sealed again
+// with the current sealing key, after a rotation, and returns how many it
+// resealed. The versions are locked, so a concurrent reveal either sees the
+// new payloads or has already cleared them.
+func (s *PostgresStorage) ResealBids() (int, error) {
+	if s.sealer == nil {
+		return 0, ErrSealingDisabled
+	}
+
+	resealed := 0
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`
+        SELECT id, bid_id, sealed_payload FROM BidsVersion
+        WHERE sealed_payload IS NOT NULL
+        FOR UPDATE
+    `)
+		if err != nil {
+			return fmt.Errorf("failed to query sealed bids: %w", err)
+		}
+		type sealedVersion struct {
+			id, bidId string
+			payload   []byte
+		}
+		var versions []sealedVersion
+		for rows.Next() {
+			var v sealedVersion
+			if err := rows.Scan(&v.id, &v.bidId, &v.payload); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan sealed bid: %w", err)
+			}
+			versions = append(versions, v)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows iteration error: %w", err)
+		}
+
+		for _, v := range versions {
+			payload, err := s.sealer.reseal(v.payload, v.bidId)
+			if err != nil {
+				return fmt.Errorf("failed to reseal bid %s: %w", v.bidId, err)
+			}
+			if _, err := tx.Exec(`UPDATE BidsVersion SET sealed_payload = $1 WHERE id = $2`, payload, v.id); err != nil {
+				return fmt.Errorf("failed to reseal bid %s: %w", v.bidId, err)
+			}
+		}
+		resealed = len(versions)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resealed, nil
+}
+
+// GetSealedAttachments lists the attachments whose contents are encrypted
+// with the sealing key: those of bids on sealed tenders, see sealedBlob.
+func (s *PostgresStorage) GetSealedAttachments() ([]*Attachment, error) {
+	rows, err := s.conn().Query(`
+        SELECT ` + attachmentColumns + ` FROM attachments
+        WHERE bid_id IN (
+            SELECT b.id FROM Bids b
+            JOIN CreateTenderTable t ON t.id = b.CreateTenderTable_id
+            WHERE t.sealed
+        )
+        ORDER BY created_at, id
+    `)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sealed attachments: %w", err)
+	}
+	defer rows.Close()
+
+	attachments := []*Attachment{}
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// sealBidVersion returns the contents to store in a new version of b. While
+// the tender is sealed they are blanked out and encrypted into the payload
+// instead. The tender row is share-locked, so a concurrent reveal either
+// sees this version or happens before it.
+func (s *PostgresStorage) sealBidVersion(tx *sql.Tx, b *Bid) (*Bid, []byte, error) {
+	var sealed bool
+	err := tx.QueryRow(`
+        SELECT sealed AND revealed_at IS NULL FROM CreateTenderTable WHERE id = $1 FOR SHARE
+    `, b.TenderId).Scan(&sealed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrTenderNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to check tender sealing: %w", err)
+	}
+	if !sealed {
+		return b, nil, nil
+	}
+	if s.sealer == nil {
+		return nil, nil, ErrSealingDisabled
+	}
+
+	payload, err := s.sealer.Seal(b)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Bid{}, payload, nil
+}
+
+// updateScheduledStatus runs a status update of the scheduler and records
+// the event for each tender it changed.
+func (s *PostgresStorage) updateScheduledStatus(query string, now time.Time, event EventType) ([]string, error) {
+	var ids []string
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		rows, err := tx.Query(query, now)
+		if err != nil {
+			return fmt.Errorf("failed to update scheduled tenders: %w", err)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				return fmt.Errorf("failed to scan tender id: %w", err)
+			}
+			ids = append(ids, id)
+		}
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows iteration error: %w", err)
+		}
+		rows.Close()
+
+		for _, id := range ids {
+			if err := outboxTender(tx, event, id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// SubmitBidDecision records the decision of a tender responsible, on the
+// whole tender or on one of its lots when lot_id is set. Once the bid
+// collects a quorum of approvals it wins: the tender closes, or the lot is
+// awarded and the tender closes with its last open lot.
+func (s *PostgresStorage) SubmitBidDecision(bid_id, username string, decision BidDecision, lot_id string) (*Bid, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+	if lot_id != "" && !isUUID(lot_id) {
+		return nil, ErrLotNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var tenderId, organizationId string
+		err := tx.QueryRow(`
+        SELECT t.id, t.organization_id
+        FROM Bids b
+        JOIN CreateTenderTable t ON t.id = b.CreateTenderTable_id
+        WHERE b.id = $1
+        FOR UPDATE OF t
+    `, bid_id).Scan(&tenderId, &organizationId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrBidNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve tender: %w", err)
+		}
+
+		_, err = tx.Exec(`
+        INSERT INTO bidDecisions (bid_id, creator_username, decision, lot_id)
+        VALUES ($1, $2, $3, $4)
+    `, bid_id, username, decision, nullUUID(lot_id))
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return ErrDecisionExists
+		}
+		if err != nil {
+			return fmt.Errorf("failed to insert decision: %w", err)
+		}
+
+		rows, err := tx.Query(`
+        SELECT decision FROM bidDecisions
+        WHERE bid_id = $1 AND lot_id IS NOT DISTINCT FROM $2 AND creator_username <> $3
+    `, bid_id, nullUUID(lot_id), username)
+		if err != nil {
+			return fmt.Errorf("failed to query decisions: %w", err)
+		}
+		var decisions []BidDecision
+		for rows.Next() {
+			var d BidDecision
+			if err := rows.Scan(&d); err != nil {
+				rows.Close()
+				return fmt.Errorf("failed to scan decision: %w", err)
+			}
+			decisions = append(decisions, d)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("rows iteration error: %w", err)
+		}
+
+		var responsibles int
+		err = tx.QueryRow(`
+        SELECT COUNT(*) FROM organization_responsible WHERE organization_id = $1
+    `, organizationId).Scan(&responsibles)
+		if err != nil {
+			return fmt.Errorf("failed to count responsibles: %w", err)
+		}
+
+		var lotId *LotId
+		if lot_id != "" {
+			lotId = &lot_id
+		}
+		outcome := settledOutcome(decisions, decision, responsibles)
+		err = s.outboxBid(tx, EventTypeBidDecision, bid_id, func(bid any) any {
+			return bidDecisionEvent{Bid: bid, Decision: decision, LotId: lotId, Username: username, Outcome: outcome}
+		})
+		if err != nil {
+			return err
+		}
+
+		if outcome != BidDecisionApproved {
+			return nil
+		}
+		if lot_id != "" {
+			return settleLot(tx, tenderId, lot_id, TenderLotStatusAwarded, bid_id)
+		}
+		// A tender past its deadline is already closed when its bids are
+		// decided on.
+		res, err := tx.Exec(`UPDATE CreateTenderTable SET status = 'Closed' WHERE id = $1 AND status <> 'Closed'`, tenderId)
+		if err != nil {
+			return fmt.Errorf("failed to close tender: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		} else if n == 0 {
+			return nil
+		}
+		return outboxTender(tx, EventTypeTenderClosed, tenderId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetBidById(bid_id)
+}
+
+// GetPendingDeciders lists the responsibles of the tender's organization
+// yet to decide on the bid, or on its lot when lot_id is set. None are
+// pending once the decisions settle the bid.
+func (s *PostgresStorage) GetPendingDeciders(bid_id, lot_id string) ([]*User, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+	if lot_id != "" && !isUUID(lot_id) {
+		return nil, ErrLotNotFound
+	}
+
+	var organizationId string
+	err := s.conn().QueryRow(`
+        SELECT t.organization_id
+        FROM Bids b
+        JOIN CreateTenderTable t ON t.id = b.CreateTenderTable_id
+        WHERE b.id = $1
+    `, bid_id).Scan(&organizationId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBidNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve tender: %w", err)
+	}
+
+	responsibles, err := s.GetOrganizationResponsibles(organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT creator_username, decision FROM bidDecisions
+        WHERE bid_id = $1 AND lot_id IS NOT DISTINCT FROM $2
+    `, bid_id, nullUUID(lot_id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query decisions: %w", err)
+	}
+	defer rows.Close()
+
+	decided := map[string]bool{}
+	var decisions []BidDecision
+	for rows.Next() {
+		var username string
+		var d BidDecision
+		if err := rows.Scan(&username, &d); err != nil {
+			return nil, fmt.Errorf("failed to scan decision: %w", err)
+		}
+		decided[username] = true
+		decisions = append(decisions, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	if bidDecisionOutcome(decisions, len(responsibles)) != "" {
+		return []*User{}, nil
+	}
+	return slices.DeleteFunc(responsibles, func(u *User) bool { return decided[u.Username] }), nil
+}
+
+// SubmitBidScores stores a new version of the scorecard of username. It
+// locks the tender row like SubmitBidDecision, so a decision committed
+// concurrently either locks the scores or comes after them.
+func (s *PostgresStorage) SubmitBidScores(bid_id, username string, scores []CriterionScore) (*BidScorecard, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
+
+	card := &BidScorecard{BidId: bid_id, ReviewerUsername: username, Scores: scores}
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var tenderId string
+		err := tx.QueryRow(`
+        SELECT t.id
+        FROM Bids b
+        JOIN CreateTenderTable t ON t.id = b.CreateTenderTable_id
+        WHERE b.id = $1
+        FOR UPDATE OF t
+    `, bid_id).Scan(&tenderId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrBidNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve tender: %w", err)
+		}
+
+		var decided bool
+		err = tx.QueryRow(`
+        SELECT EXISTS (SELECT 1 FROM bidDecisions WHERE bid_id = $1 AND creator_username = $2)
+    `, bid_id, username).Scan(&decided)
+		if err != nil {
+			return fmt.Errorf("failed to check decision: %w", err)
+		}
+		if decided {
+			return ErrScoresLocked
+		}
+
+		payload, err := jsonArg(&scores)
+		if err != nil {
+			return err
+		}
+		var createdAt time.Time
+		err = tx.QueryRow(`
+        INSERT INTO bidScores (bid_id, creator_username, version, scores)
+        VALUES ($1, $2, (
+            SELECT COALESCE(MAX(version), 0) + 1 FROM bidScores WHERE bid_id = $1 AND creator_username = $2
+        ), $3)
+        RETURNING version, created_at
+    `, bid_id, username, payload).Scan(&card.Version, &createdAt)
+		if err != nil {
+			return fmt.Errorf("failed to insert scores: %w", err)
+		}
+		card.CreatedAt = createdAt.Format(time.RFC3339)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+const scorecardSelect = `
+	SELECT
+		s.bid_id,
+		s.creator_username,
+		s.version,
+		s.scores,
+		EXISTS (SELECT 1 FROM bidDecisions d WHERE d.bid_id = s.bid_id AND d.creator_username = s.creator_username),
+		s.created_at
+	FROM
+		bidScores s
+	JOIN
+		Bids b
+	ON
+		b.id = s.bid_id
+	WHERE
+		s.version = (
+			SELECT MAX(version)
+			FROM bidScores
+			WHERE bid_id = s.bid_id AND creator_username = s.creator_username
+		)
+`
+
+func (s *PostgresStorage) GetBidScorecards(bid_id string) ([]BidScorecard, error) {
+	if _, err := s.GetBidById(bid_id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.conn().Query(scorecardSelect+` AND s.bid_id = $1 ORDER BY s.creator_username`, bid_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scores: %w", err)
+	}
+	return scanScorecards(rows)
+}
+
+// GetTenderScorecards returns the latest scorecards of the published bids
+// of a tender.
+func (s *PostgresStorage) GetTenderScorecards(tender_id string) ([]BidScorecard, error) {
+	if _, err := s.GetTenderById(tender_id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.conn().Query(scorecardSelect+`
+		AND b.CreateTenderTable_id = $1
+		AND b.status = 'Published'
+		ORDER BY s.bid_id, s.creator_username
+    `, tender_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query scores: %w", err)
+	}
+	return scanScorecards(rows)
+}
+
+func scanScorecards(rows *sql.Rows) ([]BidScorecard, error) {
+	defer rows.Close()
+
+	cards := []BidScorecard{}
+	for rows.Next() {
+		var c BidScorecard
+		var scores []byte
+		var createdAt time.Time
+		if err := rows.Scan(&c.BidId, &c.ReviewerUsername, &c.Version, &scores, &c.Locked, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan scores: %w", err)
+		}
+		if err := json.Unmarshal(scores, &c.Scores); err != nil {
+			return nil, fmt.Errorf("failed to decode scores: %w", err)
+		}
+		c.CreatedAt = createdAt.Format(time.RFC3339)
+		cards = append(cards, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return cards, nil
+}
+
+func (s *PostgresStorage) CancelTenderLot(tender_id, lot_id string) (*Tender, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+	if !isUUID(lot_id) {
+		return nil, ErrLotNotFound
+	}
+
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var id string
+		err := tx.QueryRow(`SELECT id FROM CreateTenderTable WHERE id = $1 FOR UPDATE`, tender_id).Scan(&id)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTenderNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to lock tender: %w", err)
+		}
+		return settleLot(tx, tender_id, lot_id, TenderLotStatusCanceled, "")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetTenderById(tender_id)
+}
+
+// settleLot awards or cancels an open lot and closes the tender once none
+// of its lots is open, recording tender.closed. The caller holds the lock on
+// the tender row.
+func settleLot(tx *sql.Tx, tender_id, lot_id string, status TenderLotStatus, bid_id string) error {
+	var current TenderLotStatus
+	err := tx.QueryRow(`
+        SELECT status FROM tenderLots WHERE id = $1 AND CreateTenderTable_id = $2
+    `, lot_id, tender_id).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrLotNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve lot: %w", err)
+	}
+	if current != TenderLotStatusOpen {
+		return ErrLotSettled
+	}
+
+	_, err = tx.Exec(`UPDATE tenderLots SET status = $1, awarded_bid_id = $2 WHERE id = $3`, status, nullUUID(bid_id), lot_id)
+	if err != nil {
+		return fmt.Errorf("failed to settle lot: %w", err)
+	}
+
+	res, err := tx.Exec(`
+        UPDATE CreateTenderTable SET status = 'Closed'
+        WHERE id = $1 AND status <> 'Closed'
+          AND NOT EXISTS (SELECT 1 FROM tenderLots WHERE CreateTenderTable_id = $1 AND status = 'Open')
+    `, tender_id)
+	if err != nil {
+		return fmt.Errorf("failed to close tender: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	} else if n > 0 {
+		return outboxTender(tx, EventTypeTenderClosed, tender_id)
+	}
+	return nil
+}
+
+// nullUUID passes an optional id, empty meaning NULL.
+func nullUUID(id string) any {
+	if id == "" {
+		return nil
+	}
+	return id
+}
+
+// bidDecisionOutcome applies the approval rules: a single rejection rejects
+// the bid, and min(3, responsibles) approvals approve it. An empty result
+// means the bid is still waiting for decisions.
+func bidDecisionOutcome(decisions []BidDecision, responsibles int) BidDecision {
+	approvals := 0
+	for _, d := range decisions {
+		if d == BidDecisionRejected {
+			return BidDecisionRejected
+		}
+		approvals++
+	}
+
+	quorum := min(3, responsibles)
+	if quorum > 0 && approvals >= quorum {
+		return BidDecisionApproved
+	}
+	return ""
+}
+
+const invitationSelect = `
+	SELECT id, CreateTenderTable_id, organization_id, username, status, created_at, responded_at
+	FROM tenderInvitations
+`
+
+func scanInvitation(row rowScanner) (*TenderInvitation, error) {
+	i := &TenderInvitation{}
+	var orgId, username sql.NullString
+	var createdAt time.Time
+	var respondedAt sql.NullTime
+	if err := row.Scan(&i.Id, &i.TenderId, &orgId, &username, &i.Status, &createdAt, &respondedAt); err != nil {
+		return nil, err
+	}
+	i.OrganizationId = nullString(orgId)
+	i.Username = nullString(username)
+	i.CreatedAt = createdAt.Format(time.RFC3339)
+	if respondedAt.Valid {
+		at := respondedAt.Time.Format(time.RFC3339)
+		i.RespondedAt = &at
+	}
+	return i, nil
+}
+
+func scanInvitations(rows *sql.Rows) ([]*TenderInvitation, error) {
+	defer rows.Close()
+
+	invitations := []*TenderInvitation{}
+	for rows.Next() {
+		i, err := scanInvitation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan invitation: %w", err)
+		}
+		invitations = append(invitations, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return invitations, nil
+}
+
+func (s *PostgresStorage) CreateInvitation(inv *TenderInvitation) (*TenderInvitation, error) {
+	if !isUUID(inv.TenderId) {
+		return nil, ErrTenderNotFound
+	}
+	if inv.OrganizationId != nil && !isUUID(*inv.OrganizationId) {
+		return nil, ErrOrganizationNotFound
+	}
+
+	i, err := scanInvitation(s.conn().QueryRow(`
+        INSERT INTO tenderInvitations (CreateTenderTable_id, organization_id, username)
+        VALUES ($1, $2, $3)
+        RETURNING id, CreateTenderTable_id, organization_id, username, status, created_at, responded_at
+    `, inv.TenderId, inv.OrganizationId, inv.Username))
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "23505":
+			return nil, ErrInvitationExists
+		case "23503":
+			if pqErr.Constraint == "tenderinvitations_createtendertable_id_fkey" {
+				return nil, ErrTenderNotFound
+			}
+			return nil, ErrOrganizationNotFound
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert invitation: %w", err)
+	}
+
+	return i, nil
+}
+
+func (s *PostgresStorage) GetInvitationById(id string) (*TenderInvitation, error) {
+	if !isUUID(id) {
+		return nil, ErrInvitationNotFound
+	}
+
+	i, err := scanInvitation(s.conn().QueryRow(invitationSelect+` WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve invitation: %w", err)
+	}
+
+	return i, nil
+}
+
+func (s *PostgresStorage) GetTenderInvitations(tender_id string, limit, offset int32) ([]*TenderInvitation, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	rows, err := s.conn().Query(invitationSelect+`
+        WHERE CreateTenderTable_id = $1
+        ORDER BY created_at DESC, id
+        LIMIT $2 OFFSET $3
+    `, tender_id, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invitations: %w", err)
+	}
+
+	return scanInvitations(rows)
+}
+
+// GetUserInvitations lists the invitations addressed to the employee and to
+// the organization the employee is responsible for, newest first.
+func (s *PostgresStorage) GetUserInvitations(username string, limit, offset int32) ([]*TenderInvitation, error) {
+	rows, err := s.conn().Query(invitationSelect+`
+        WHERE username = $1 OR organization_id IN (`+fmt.Sprintf(viewerOrganization, 1)+`)
+        ORDER BY created_at DESC, id
+        LIMIT $2 OFFSET $3
+    `, username, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query invitations: %w", err)
+	}
+
+	return scanInvitations(rows)
+}
+
+// GetInvitationStatus returns the best status among the invitations of the
+// employee to the tender: accepted, then pending, then declined. It is
+// empty when the employee isn't invited.
+func (s *PostgresStorage) GetInvitationStatus(tender_id, username string) (InvitationStatus, error) {
+	if !isUUID(tender_id) {
+		return "", nil
+	}
+
+	var status InvitationStatus
+	err := s.conn().QueryRow(`
+        SELECT status FROM tenderInvitations
+        WHERE CreateTenderTable_id = $1
+          AND (username = $2 OR organization_id IN (`+fmt.Sprintf(viewerOrganization, 2)+`))
+        ORDER BY CASE status WHEN 'Accepted' THEN 0 WHEN 'Pending' THEN 1 ELSE 2 END
+        LIMIT 1
+    `, tender_id, username).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve invitation status: %w", err)
+	}
+
+	return status, nil
+}
+
+func (s *PostgresStorage) RespondInvitation(id string, status InvitationStatus) (*TenderInvitation, error) {
+	if !isUUID(id) {
+		return nil, ErrInvitationNotFound
+	}
+
+	i, err := scanInvitation(s.conn().QueryRow(`
+        UPDATE tenderInvitations SET status = $1, responded_at = CURRENT_TIMESTAMP
+        WHERE id = $2
+        RETURNING id, CreateTenderTable_id, organization_id, username, status, created_at, responded_at
+    `, status, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update invitation: %w", err)
+	}
+
+	return i, nil
+}
+
+const questionColumns = `
+	id, CreateTenderTable_id, author_username, organization_id, question, status,
+	answer, tender_version, amended, created_at, answered_at
+`
+
+func scanQuestion(row rowScanner) (*TenderQuestion, error) {
+	q := &TenderQuestion{}
+	var orgId, answer sql.NullString
+	var version sql.NullInt32
+	var amended bool
+	var createdAt time.Time
+	var answeredAt sql.NullTime
+	if err := row.Scan(&q.Id, &q.TenderId, &q.AuthorUsername, &orgId, &q.Question, &q.Status,
+		&answer, &version, &amended, &createdAt, &answeredAt); err != nil {
+		return nil, err
+	}
+	q.OrganizationId = nullString(orgId)
+	q.Answer = nullString(answer)
+	q.CreatedAt = createdAt.Format(time.RFC3339)
+	if q.Status == QuestionStatusAnswered {
+		q.TenderVersion, q.Amended = &version.Int32, &amended
+		at := answeredAt.Time.Format(time.RFC3339)
+		q.AnsweredAt = &at
+	}
+	return q, nil
+}
+
+func (s *PostgresStorage) CreateQuestion(q *TenderQuestion) (*TenderQuestion, error) {
+	if !isUUID(q.TenderId) {
+		return nil, ErrTenderNotFound
+	}
+
+	q, err := scanQuestion(s.conn().QueryRow(`
+        INSERT INTO tenderQuestions (CreateTenderTable_id, author_username, organization_id, question)
+        VALUES ($1, $2, $3, $4)
+        RETURNING `+questionColumns, q.TenderId, q.AuthorUsername, q.OrganizationId, q.Question))
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return nil, ErrTenderNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert question: %w", err)
+	}
+
+	return q, nil
+}
+
+func (s *PostgresStorage) GetQuestionById(id string) (*TenderQuestion, error) {
+	if !isUUID(id) {
+		return nil, ErrQuestionNotFound
+	}
+
+	q, err := scanQuestion(s.conn().QueryRow(`SELECT `+questionColumns+` FROM tenderQuestions WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrQuestionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve question: %w", err)
+	}
+
+	return q, nil
+}
+
+func (s *PostgresStorage) GetTenderQuestions(tender_id string, filter QuestionFilter, limit, offset int32) ([]*TenderQuestion, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT `+questionColumns+` FROM tenderQuestions
+        WHERE CreateTenderTable_id = $1
+          AND ($2 OR status = 'Answered' OR author_username = $3 OR organization_id = $4)
+        ORDER BY created_at DESC, id
+        LIMIT $5 OFFSET $6
+    `, tender_id, filter.All, filter.Username, nullUUID(filter.OrganizationId), limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query questions: %w", err)
+	}
+	defer rows.Close()
+
+	questions := []*TenderQuestion{}
+	for rows.Next() {
+		q, err := scanQuestion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan question: %w", err)
+		}
+		questions = append(questions, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return questions, nil
+}
+
+// AnswerQuestion publishes the answer at the current tender version or, to
+// amend the tender, at a new version copying the parameters of the latest.
+func (s *PostgresStorage) AnswerQuestion(id, answer string, amend bool) (*TenderQuestion, error) {
+	if !isUUID(id) {
+		return nil, ErrQuestionNotFound
+	}
+
+	var q *TenderQuestion
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var tender_id string
+		var status QuestionStatus
+		err := tx.QueryRow(`
+        SELECT CreateTenderTable_id, status FROM tenderQuestions WHERE id = $1 FOR UPDATE
+    `, id).Scan(&tender_id, &status)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrQuestionNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve question: %w", err)
+		}
+		if status == QuestionStatusAnswered {
+			return ErrQuestionAnswered
+		}
+
+		if _, err := tx.Exec(`SELECT id FROM CreateTenderTable WHERE id = $1 FOR UPDATE`, tender_id); err != nil {
+			return fmt.Errorf("failed to lock tender: %w", err)
+		}
+		var version int32
+		if amend {
+			err = tx.QueryRow(`
+        INSERT INTO CreateTenderVersion (CreateTenderTable_id, name, description, service_type, submission_deadline,
+                                         publish_at, budget_min, budget_max, currency, version)
+        SELECT CreateTenderTable_id, name, description, service_type, submission_deadline,
+               publish_at, budget_min, budget_max, currency, version + 1
+        FROM CreateTenderVersion
+        WHERE CreateTenderTable_id = $1
+        ORDER BY version DESC
+        LIMIT 1
+        RETURNING version
+    `, tender_id).Scan(&version)
+		} else {
+			err = tx.QueryRow(`
+        SELECT MAX(version) FROM CreateTenderVersion WHERE CreateTenderTable_id = $1
+    `, tender_id).Scan(&version)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to resolve tender version: %w", err)
+		}
+
+		q, err = scanQuestion(tx.QueryRow(`
+        UPDATE tenderQuestions
+        SET status = 'Answered', answer = $1, tender_version = $2, amended = $3, answered_at = CURRENT_TIMESTAMP
+        WHERE id = $4
+        RETURNING `+questionColumns, answer, version, amend, id))
+		if err != nil {
+			return fmt.Errorf("failed to answer question: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// GetTenderVersions returns every version of a tender, newest first. Fields
+// that aren't versioned, like the status, are the current ones.
+func (s *PostgresStorage) GetTenderVersions(tender_id string) ([]*Tender, error) {
+	versions, err := s.GetTenderVersionsByIds([]string{tender_id})
+	if err != nil {
+		return nil, err
+	}
+	v, ok := versions[tender_id]
+	if !ok {
+		return nil, ErrTenderNotFound
+	}
+	return v, nil
+}
+
+// GetTenderVersionsByIds is GetTenderVersions for each of the tenders
+// there are.
+func (s *PostgresStorage) GetTenderVersionsByIds(tenderIds []string) (map[string][]*Tender, error) {
+	current, err := s.GetTendersByIds(tenderIds)
+	if err != nil || len(current) == 0 {
+		return map[string][]*Tender{}, err
+	}
+	ids := make([]string, 0, len(current))
+	for id := range current {
+		ids = append(ids, id)
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT CreateTenderTable_id, name, description, service_type, version, submission_deadline, publish_at,
+               budget_min, budget_max, currency
+        FROM CreateTenderVersion
+        WHERE CreateTenderTable_id = ANY($1::uuid[])
+        ORDER BY CreateTenderTable_id, version DESC
+    `, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tender versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := map[string][]*Tender{}
+	for rows.Next() {
+		var id string
+		var t Tender
+		var deadline, publishAt sql.NullTime
+		var budgetMin, budgetMax, currency sql.NullString
+		if err := rows.Scan(&id, &t.Name, &t.Description, &t.ServiceType, &t.Version, &deadline, &publishAt,
+			&budgetMin, &budgetMax, &currency); err != nil {
+			return nil, fmt.Errorf("failed to scan tender version: %w", err)
+		}
+		v := *current[id]
+		v.Name, v.Description, v.ServiceType, v.Version = t.Name, t.Description, t.ServiceType, t.Version
+		v.SubmissionDeadline = nullTime(deadline)
+		v.PublishAt = nullTime(publishAt)
+		v.Budget = nil
+		if currency.Valid {
+			v.Budget = &TenderBudget{Min: nullString(budgetMin), Max: nullString(budgetMax), Currency: currency.String}
+		}
+		versions[id] = append(versions[id], &v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return versions, nil
+}
+
+const attachmentColumns = `
+	id, CreateTenderTable_id, bid_id, version, name, content_type, size, sha256, uploader_username, created_at
+`
+
+func scanAttachment(row rowScanner) (*Attachment, error) {
+	a := &Attachment{}
+	var tenderId, bidId sql.NullString
+	var createdAt time.Time
+	if err := row.Scan(&a.Id, &tenderId, &bidId, &a.Version, &a.Name, &a.ContentType, &a.Size, &a.Sha256,
+		&a.UploaderUsername, &createdAt); err != nil {
+		return nil, err
+	}
+	a.TenderId = nullString(tenderId)
+	a.BidId = nullString(bidId)
+	a.CreatedAt = createdAt.Format(time.RFC3339)
+	return a, nil
+}
+
+// CreateAttachment attaches the file to the current version of its tender
+// or bid.
+func (s *PostgresStorage) CreateAttachment(a *Attachment) (*Attachment, error) {
+	version := `(SELECT MAX(version) FROM CreateTenderVersion WHERE CreateTenderTable_id = $2)`
+	if a.BidId != nil {
+		version = `(SELECT MAX(version) FROM BidsVersion WHERE bid_id = $3)`
+	}
+
+	created, err := scanAttachment(s.conn().QueryRow(`
+        INSERT INTO attachments (id, CreateTenderTable_id, bid_id, version, name, content_type, size, sha256, uploader_username)
+        VALUES ($1, $2, $3, `+version+`, $4, $5, $6, $7, $8)
+        RETURNING `+attachmentColumns,
+		a.Id, a.TenderId, a.BidId, a.Name, a.ContentType, a.Size, a.Sha256, a.UploaderUsername))
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert attachment: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *PostgresStorage) GetAttachmentById(id string) (*Attachment, error) {
+	if !isUUID(id) {
+		return nil, ErrAttachmentNotFound
+	}
+
+	a, err := scanAttachment(s.conn().QueryRow(`SELECT `+attachmentColumns+` FROM attachments WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve attachment: %w", err)
+	}
+
+	return a, nil
+}
+
+func (s *PostgresStorage) GetTenderAttachments(tender_id string, version int32) ([]*Attachment, error) {
+	if _, err := s.GetTenderById(tender_id); err != nil {
+		return nil, err
+	}
+	return s.queryAttachments(`CreateTenderTable_id`, tender_id, version)
+}
+
+func (s *PostgresStorage) GetBidAttachments(bid_id string, version int32) ([]*Attachment, error) {
+	if _, err := s.GetBidById(bid_id); err != nil {
+		return nil, err
+	}
+	return s.queryAttachments(`bid_id`, bid_id, version)
+}
+
+// queryAttachments lists the attachments of a version, those attached to
+// it and to the versions before. Version 0 stands for the latest one.
+// Attachments aren't versioned themselves: a rollback, which adds a version,
+// keeps the files attached to the versions after the one it goes back to.
+func (s *PostgresStorage) queryAttachments(column, id string, version int32) ([]*Attachment, error) {
+	rows, err := s.conn().Query(`
+        SELECT `+attachmentColumns+` FROM attachments
+        WHERE `+column+` = $1 AND ($2 = 0 OR version <= $2)
+        ORDER BY created_at, id
+    `, id, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	attachments := []*Attachment{}
+	for rows.Next() {
+		a, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return attachments, nil
+}
+
+const webhookColumns = `id, organization_id, url, events, created_at`
+
+func scanWebhook(row rowScanner) (*Webhook, error) {
+	w := &Webhook{}
+	var events pq.StringArray
+	var createdAt time.Time
+	if err := row.Scan(&w.Id, &w.OrganizationId, &w.Url, &events, &createdAt); err != nil {
+		return nil, err
+	}
+	w.Events = make([]WebhookEvent, 0, len(events))
+	for _, e := range events {
+		w.Events = append(w.Events, WebhookEvent(e))
+	}
+	w.CreatedAt = createdAt.Format(time.RFC3339)
+	return w, nil
+}
+
+// CreateWebhook subscribes the organization of the webhook to its events,
+// signing the deliveries with secret.
+func (s *PostgresStorage) CreateWebhook(w *Webhook, secret string) (*Webhook, error) {
+	if !isUUID(w.OrganizationId) {
+		return nil, ErrOrganizationNotFound
+	}
+
+	events := make([]string, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, string(e))
+	}
+	created, err := scanWebhook(s.conn().QueryRow(`
+        INSERT INTO webhooks (organization_id, url, events, secret)
+        VALUES ($1, $2, $3, $4)
+        RETURNING `+webhookColumns,
+		w.OrganizationId, w.Url, pq.Array(events), secret))
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return nil, ErrOrganizationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert webhook: %w", err)
+	}
+
+	return created, nil
+}
+
+func (s *PostgresStorage) GetWebhookById(id string) (*Webhook, error) {
+	if !isUUID(id) {
+		return nil, ErrWebhookNotFound
+	}
+
+	w, err := scanWebhook(s.conn().QueryRow(`SELECT `+webhookColumns+` FROM webhooks WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhook: %w", err)
+	}
+
+	return w, nil
+}
+
+func (s *PostgresStorage) GetOrganizationWebhooks(org_id string) ([]*Webhook, error) {
+	if !isUUID(org_id) {
+		return []*Webhook{}, nil
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT `+webhookColumns+` FROM webhooks
+        WHERE organization_id = $1
+        ORDER BY created_at DESC, id
+    `, org_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := []*Webhook{}
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook: %w", err)
+		}
+		webhooks = append(webhooks, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (s *PostgresStorage) DeleteWebhook(id string) error {
+	if !isUUID(id) {
+		return ErrWebhookNotFound
+	}
+
+	res, err := s.conn().Exec(`DELETE FROM webhooks WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+// EnqueueWebhookEvent queues a delivery of the payload of the outbox event
+// to every webhook of the organizations subscribed to the event, once: the
+// webhooks it was already queued for are skipped.
+func (s *PostgresStorage) EnqueueWebhookEvent(event_id int64, event WebhookEvent, org_ids []string, payload []byte) error {
+	_, err := s.conn().Exec(`
+        INSERT INTO webhookDeliveries (webhook_id, event, payload, outbox_event_id)
+        SELECT id, $1::text, $3, $4 FROM webhooks
+        WHERE organization_id::text = ANY($2) AND $1::text = ANY(events)
+        ON CONFLICT (webhook_id, outbox_event_id) DO NOTHING
+    `, event, pq.Array(org_ids), string(payload), event_id)
+	if err != nil {
+		return fmt.Errorf("failed to queue webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// CreateWebhookDelivery queues a delivery of the payload to one webhook,
+// whatever its events.
+func (s *PostgresStorage) CreateWebhookDelivery(webhook_id string, event WebhookEvent, payload []byte) (*WebhookDelivery, error) {
+	if !isUUID(webhook_id) {
+		return nil, ErrWebhookNotFound
+	}
+
+	d, err := scanDelivery(s.conn().QueryRow(`
+        INSERT INTO webhookDeliveries (webhook_id, event, payload)
+        VALUES ($1, $2, $3)
+        RETURNING `+deliveryColumns,
+		webhook_id, event, string(payload)))
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23503" {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert webhook delivery: %w", err)
+	}
+
+	return d, nil
+}
+
+const deliveryColumns = `
+	id, webhook_id, event, payload, status, attempts, response_status, last_error,
+	next_attempt_at, created_at, delivered_at
+`
+
+func scanDelivery(row rowScanner) (*WebhookDelivery, error) {
+	d := &WebhookDelivery{}
+	var payload []byte
+	var responseStatus sql.NullInt32
+	var lastError sql.NullString
+	var nextAttemptAt, deliveredAt sql.NullTime
+	var createdAt time.Time
+	if err := row.Scan(&d.Id, &d.WebhookId, &d.Event, &payload, &d.Status, &d.Attempts, &responseStatus, &lastError,
+		&nextAttemptAt, &createdAt, &deliveredAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(payload, &d.Payload); err != nil {
+		return nil, fmt.Errorf("failed to decode payload: %w", err)
+	}
+	if responseStatus.Valid {
+		d.ResponseStatus = &responseStatus.Int32
+	}
+	d.LastError = nullString(lastError)
+	if d.Status == WebhookDeliveryStatusPending && nextAttemptAt.Valid {
+		at := nextAttemptAt.Time.Format(time.RFC3339)
+		d.NextAttemptAt = &at
+	}
+	d.CreatedAt = createdAt.Format(time.RFC3339)
+	if deliveredAt.Valid {
+		at := deliveredAt.Time.Format(time.RFC3339)
+		d.DeliveredAt = &at
+	}
+	return d, nil
+}
+
+func (s *PostgresStorage) GetWebhookDeliveryById(id string) (*WebhookDelivery, error) {
+	if !isUUID(id) {
+		return nil, ErrDeliveryNotFound
+	}
+
+	d, err := scanDelivery(s.conn().QueryRow(`SELECT `+deliveryColumns+` FROM webhookDeliveries WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve webhook delivery: %w", err)
+	}
+
+	return d, nil
+}
+
+// GetWebhookDeliveries lists the deliveries of a webhook in the status,
+// any when empty, newest first.
+func (s *PostgresStorage) GetWebhookDeliveries(webhook_id string, status WebhookDeliveryStatus, limit, offset int32) ([]*WebhookDelivery, error) {
+	if _, err := s.GetWebhookById(webhook_id); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT `+deliveryColumns+` FROM webhookDeliveries
+        WHERE webhook_id = $1 AND ($2::text = '' OR status = $2::text)
+        ORDER BY created_at DESC, id
+        LIMIT $3 OFFSET $4
+    `, webhook_id, status, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := []*WebhookDelivery{}
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ClaimDueDeliveries takes up to limit pending deliveries due at now and
+// hides them from other replicas until leaseUntil, when a delivery whose
+// attempt never got recorded is due again.
+func (s *PostgresStorage) ClaimDueDeliveries(now, leaseUntil time.Time, limit int) ([]DueDelivery, error) {
+	rows, err := s.conn().Query(`
+        UPDATE webhookDeliveries d
+        SET next_attempt_at = $2
+        FROM webhooks w
+        WHERE w.id = d.webhook_id
+          AND d.id IN (
+            SELECT id FROM webhookDeliveries
+            WHERE status = 'Pending' AND next_attempt_at <= $1
+            ORDER BY next_attempt_at
+            LIMIT $3
+            FOR UPDATE SKIP LOCKED
+          )
+        RETURNING d.id, d.webhook_id, d.event, d.payload, d.status, d.attempts, d.response_status, d.last_error,
+            d.next_attempt_at, d.created_at, d.delivered_at, d.payload, w.url, w.secret
+    `, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	due := []DueDelivery{}
+	for rows.Next() {
+		var dd DueDelivery
+		d, err := scanDelivery(withColumns{rows, []any{&dd.Body, &dd.URL, &dd.Secret}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook delivery: %w", err)
+		}
+		dd.Delivery = *d
+		due = append(due, dd)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return due, nil
+}
+
+// RecordDeliveryAttempt logs an attempt made at the given time. A failed
+// attempt without a next one moves the delivery to the dead letters.
+func (s *PostgresStorage) RecordDeliveryAttempt(id string, attempt DeliveryAttempt, at time.Time) error {
+	status := WebhookDeliveryStatusPending
+	var deliveredAt *time.Time
+	switch {
+	case attempt.Delivered:
+		status, deliveredAt = WebhookDeliveryStatusDelivered, &at
+	case attempt.NextAttemptAt == nil:
+		status = WebhookDeliveryStatusDead
+	}
+
+	return s.TransactionDecorator(func(tx *sql.Tx) error {
+		_, err := tx.Exec(`
+            UPDATE webhookDeliveries
+            SET status = $2, attempts = attempts + 1, response_status = $3, last_error = $4,
+                next_attempt_at = $5, delivered_at = $6
+            WHERE id = $1
+        `, id, status, sql.NullInt32{Int32: attempt.ResponseStatus, Valid: attempt.ResponseStatus != 0},
+			sql.NullString{String: attempt.Error, Valid: attempt.Error != ""}, attempt.NextAttemptAt, deliveredAt)
+		if err != nil {
+			return fmt.Errorf("failed to record webhook delivery attempt: %w", err)
+		}
+
+		if status == WebhookDeliveryStatusDead {
+			_, err = tx.Exec(`
+                INSERT INTO webhookDeadLetters (delivery_id, webhook_id, event, payload, attempts, last_error)
+                SELECT id, webhook_id, event, payload, attempts, last_error FROM webhookDeliveries WHERE id = $1
+                ON CONFLICT (delivery_id) DO NOTHING
+            `, id)
+			if err != nil {
+				return fmt.Errorf("failed to dead-letter webhook delivery: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// RedeliverWebhookDelivery takes a delivery out of the dead letters and
+// queues it again with a fresh set of attempts.
+func (s *PostgresStorage) RedeliverWebhookDelivery(id string) (*WebhookDelivery, error) {
+	if _, err := s.GetWebhookDeliveryById(id); err != nil {
+		return nil, err
+	}
+
+	var d *WebhookDelivery
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		var err error
+		d, err = scanDelivery(tx.QueryRow(`
+            UPDATE webhookDeliveries
+            SET status = 'Pending', attempts = 0, response_status = NULL, last_error = NULL,
+                next_attempt_at = CURRENT_TIMESTAMP
+            WHERE id = $1 AND status = 'Dead'
+            RETURNING `+deliveryColumns, id))
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrDeliveryNotDead
+		}
+		if err != nil {
+			return fmt.Errorf("failed to requeue webhook delivery: %w", err)
+		}
+
+		if _, err := tx.Exec(`DELETE FROM webhookDeadLetters WHERE delivery_id = $1`, id); err != nil {
+			return fmt.Errorf("failed to delete dead letter: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// insertOutbox records an event on an aggregate in tx. It takes a
+// transaction lock on the aggregate first, so the events of an aggregate
+// are numbered in the order their transactions commit, which is the order
+// the relay publishes them in.
+func insertOutbox(tx *sql.Tx, aggregateType EventAggregateType, aggregate_id string, event EventType, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", event, err)
+	}
+
+	if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext($1))`, string(aggregateType)+"/"+aggregate_id); err != nil {
+		return fmt.Errorf("failed to lock %s %s: %w", aggregateType, aggregate_id, err)
+	}
+	_, err = tx.Exec(`
+        INSERT INTO outbox (aggregate_type, aggregate_id, event_type, payload)
+        VALUES ($1, $2, $3, $4)
+    `, aggregateType, aggregate_id, event, string(payload))
+	if err != nil {
+		return fmt.Errorf("failed to insert outbox event: %w", err)
+	}
+	return nil
+}
+
+// outboxTender records an event carrying the tender as it stands in tx.
+func outboxTender(tx *sql.Tx, event EventType, tender_id string) error {
+	t, err := scanTender(tx.QueryRow(tenderSelect+` AND t.id = $1`, tender_id))
+	if err != nil {
+		return fmt.Errorf("failed to retrieve tender: %w", err)
+	}
+	return insertOutbox(tx, EventAggregateTypeTender, tender_id, event, t)
+}
+
+// outboxBid records an event on the bid as it stands in tx. The payload is
+// what bidEventData tells about the bid, wrapped by data when it is set.
+func (s *PostgresStorage) outboxBid(tx *sql.Tx, event EventType, bid_id string, data func(bid any) any) error {
+	b, err := s.scanBid(tx.QueryRow(bidSelect+` AND b.id = $1`, bid_id))
+	if err != nil {
+		return fmt.Errorf("failed to retrieve bid: %w", err)
+	}
+	t, err := scanTender(tx.QueryRow(tenderSelect+` AND t.id = $1`, b.TenderId))
+	if err != nil {
+		return fmt.Errorf("failed to retrieve tender: %w", err)
+	}
+
+	payload := bidEventData(b, t)
+	if data != nil {
+		payload = data(payload)
+	}
+	return insertOutbox(tx, EventAggregateTypeBid, bid_id, event, payload)
+}
+
+// FetchOutbox returns the oldest events not published yet.
+func (s *PostgresStorage) FetchOutbox(limit int) ([]OutboxEvent, error) {
+	rows, err := s.conn().Query(`
+        SELECT id, aggregate_type, aggregate_id, event_type, payload, created_at
+        FROM outbox
+        WHERE published_at IS NULL
+        ORDER BY id
+        LIMIT $1
+    `, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query outbox: %w", err)
+	}
+	defer rows.Close()
+
+	events := []OutboxEvent{}
+	for rows.Next() {
+		var e OutboxEvent
+		var payload []byte
+		if err := rows.Scan(&e.Id, &e.AggregateType, &e.AggregateId, &e.Type, &payload, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		e.Payload = payload
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return events, nil
+}
+
+func (s *PostgresStorage) MarkOutboxPublished(ids []int64, at time.Time) error {
+	_, err := s.conn().Exec(`UPDATE outbox SET published_at = $2 WHERE id = ANY($1)`, pq.Array(ids), at)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox events published: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStorage) AppendEventLog(e LoggedEvent) error {
+	_, err := s.conn().Exec(`
+        INSERT INTO eventLog (outbox_id, aggregate_type, aggregate_id, event_type, payload, occurred_at,
+                              CreateTenderTable_id, organization_id)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+        ON CONFLICT (outbox_id) DO NOTHING
+    `, e.Event.Id, e.Event.AggregateType, e.Event.AggregateId, e.Event.Type, string(e.Event.Payload), e.Event.CreatedAt,
+		e.TenderId, e.OrganizationId)
+	if err != nil {
+		return fmt.Errorf("failed to append to event log: %w", err)
+	}
+	return nil
+}
+
+// GetEventLog returns the logged events after seq matching the filter,
+// oldest first.
+func (s *PostgresStorage) GetEventLog(after int64, filter EventLogFilter, limit int) ([]LoggedEvent, error) {
+	if filter.TenderId != "" && !isUUID(filter.TenderId) {
+		return []LoggedEvent{}, nil
+	}
+	if filter.OrganizationId != "" && !isUUID(filter.OrganizationId) {
+		return []LoggedEvent{}, nil
+	}
+
+	types := make([]string, 0, len(filter.Types))
+	for _, t := range filter.Types {
+		types = append(types, string(t))
+	}
+	rows, err := s.conn().Query(`
+        SELECT seq, outbox_id, aggregate_type, aggregate_id, event_type, payload, occurred_at,
+               CreateTenderTable_id, organization_id
+        FROM eventLog
+        WHERE seq > $1
+          AND ($2 = '' OR CreateTenderTable_id::text = $2)
+          AND ($3 = '' OR organization_id::text = $3)
+          AND (cardinality($4::text[]) = 0 OR event_type = ANY($4))
+        ORDER BY seq
+        LIMIT $5
+    `, after, filter.TenderId, filter.OrganizationId, pq.Array(types), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query event log: %w", err)
+	}
+	defer rows.Close()
+
+	events := []LoggedEvent{}
+	for rows.Next() {
+		var e LoggedEvent
+		var payload []byte
+		if err := rows.Scan(&e.Seq, &e.Event.Id, &e.Event.AggregateType, &e.Event.AggregateId, &e.Event.Type,
+			&payload, &e.Event.CreatedAt, &e.TenderId, &e.OrganizationId); err != nil {
+			return nil, fmt.Errorf("failed to scan logged event: %w", err)
+		}
+		e.Event.Payload = payload
+		events = append(events, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return events, nil
+}
+
+// GetEventLogHead returns the seq of the latest logged event, 0 when the
+// log is empty.
+func (s *PostgresStorage) GetEventLogHead() (int64, error) {
+	var head int64
+	if err := s.conn().QueryRow(`SELECT COALESCE(MAX(seq), 0) FROM eventLog`).Scan(&head); err != nil {
+		return 0, fmt.Errorf("failed to read event log head: %w", err)
+	}
+	return head, nil
+}
+
+const auditColumns = `id, actor, action, entity_type, entity_id, organization_ids, before, after, request_id, ip,
+    created_at, prev_hash, hash`
+
+func scanAuditEntry(row rowScanner) (*AuditEntry, error) {
+	e := &AuditEntry{}
+	var organizationIds pq.StringArray
+	var before, after []byte
+	var createdAt time.Time
+	err := row.Scan(&e.Id, &e.Actor, &e.Action, &e.EntityType, &e.EntityId, &organizationIds, &before, &after,
+		&e.RequestId, &e.Ip, &createdAt, &e.PrevHash, &e.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	e.OrganizationIds = []OrganizationId(organizationIds)
+	if e.OrganizationIds == nil {
+		e.OrganizationIds = []OrganizationId{}
+	}
+	if before != nil {
+		if err := json.Unmarshal(before, &e.Before); err != nil {
+			return nil, fmt.Errorf("failed to decode audit snapshot: %w", err)
+		}
+	}
+	if after != nil {
+		if err := json.Unmarshal(after, &e.After); err != nil {
+			return nil, fmt.Errorf("failed to decode audit snapshot: %w", err)
+		}
+	}
+	e.CreatedAt = auditTime(createdAt)
+	return e, nil
+}
+
+func scanAuditEntries(rows *sql.Rows) ([]*AuditEntry, error) {
+	defer rows.Close()
+
+	entries := []*AuditEntry{}
+	for rows.Next() {
+		e, err := scanAuditEntry(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+		entries = append(entries, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+	return entries, nil
+}
+
+// AppendAuditLog chains the entry to the last one and appends it. Appends
+// are serialized by a transaction lock, held until the transaction of the
+// change audited commits, so ids follow the chain.
+func (s *PostgresStorage) AppendAuditLog(e *AuditEntry) (*AuditEntry, error) {
+	entry := *e
+	err := s.TransactionDecorator(func(tx *sql.Tx) error {
+		if _, err := tx.Exec(`SELECT pg_advisory_xact_lock(hashtext('audit_log'))`); err != nil {
+			return fmt.Errorf("failed to lock audit log: %w", err)
+		}
+
+		prevHash := auditGenesis
+		err := tx.QueryRow(`SELECT hash FROM audit_log ORDER BY id DESC LIMIT 1`).Scan(&prevHash)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to read audit log head: %w", err)
+		}
+		if err := chainAuditEntry(&entry, prevHash, time.Now()); err != nil {
+			return err
+		}
+
+		before, err := json.Marshal(entry.Before)
+		if err != nil {
+			return err
+		}
+		after, err := json.Marshal(entry.After)
+		if err != nil {
+			return err
+		}
+		err = tx.QueryRow(`
+            INSERT INTO audit_log (actor, action, entity_type, entity_id, organization_ids, before, after,
+                request_id, ip, created_at, prev_hash, hash)
+            VALUES ($1, $2, $3, $4, $5, NULLIF($6, 'null')::json, NULLIF($7, 'null')::json, $8, $9, $10, $11, $12)
+            RETURNING id
+        `, entry.Actor, entry.Action, entry.EntityType, entry.EntityId, pq.Array(entry.OrganizationIds),
+			string(before), string(after), entry.RequestId, entry.Ip, entry.CreatedAt, entry.PrevHash,
+			entry.Hash).Scan(&entry.Id)
+		if err != nil {
+			return fmt.Errorf("failed to append audit entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// GetAuditLog lists the entries concerning the organization, newest first.
+func (s *PostgresStorage) GetAuditLog(filter AuditFilter, limit, offset int32) ([]*AuditEntry, error) {
+	rows, err := s.conn().Query(`
+        SELECT `+auditColumns+`
+        FROM audit_log
+        WHERE $1 = ANY(organization_ids)
+          AND ($2 = '' OR entity_type = $2)
+          AND ($3 = '' OR entity_id = $3)
+          AND ($4 = '' OR actor = $4)
+          AND ($5::timestamptz IS NULL OR created_at >= $5)
+          AND ($6::timestamptz IS NULL OR created_at < $6)
+        ORDER BY id DESC
+        LIMIT $7 OFFSET $8
+    `, filter.OrganizationId, filter.EntityType, filter.EntityId, filter.Actor, filter.From, filter.To, limit, offset)
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
 	}
+	return scanAuditEntries(rows)
+}
 
-	defer func() {
+// GetAuditChain lists up to limit entries after the given id, in the order
+// they were chained.
+func (s *PostgresStorage) GetAuditChain(after int64, limit int) ([]*AuditEntry, error) {
+	rows, err := s.conn().Query(`
+        SELECT `+auditColumns+`
+        FROM audit_log
+        WHERE id > $1
+        ORDER BY id
+        LIMIT $2
+    `, after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	return scanAuditEntries(rows)
+}
+
+// GetNotificationPreferences returns the preferences of the employee, or
+// the defaults sending nothing when none were set.
+func (s *PostgresStorage) GetNotificationPreferences(username string) (*NotificationPreferences, error) {
+	p := &NotificationPreferences{}
+	var email sql.NullString
+	var events pq.StringArray
+	err := s.conn().QueryRow(`
+        SELECT email, language, events FROM notificationPreferences WHERE username = $1
+    `, username).Scan(&email, &p.Language, &events)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &NotificationPreferences{Language: NotificationLanguageRu, Events: []NotificationEvent{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read notification preferences: %w", err)
+	}
+
+	p.Email = nullString(email)
+	p.Events = make([]NotificationEvent, 0, len(events))
+	for _, e := range events {
+		p.Events = append(p.Events, NotificationEvent(e))
+	}
+	return p, nil
+}
+
+func (s *PostgresStorage) SetNotificationPreferences(username string, p NotificationPreferences) (*NotificationPreferences, error) {
+	events := make([]string, 0, len(p.Events))
+	for _, e := range p.Events {
+		events = append(events, string(e))
+	}
+
+	_, err := s.conn().Exec(`
+        INSERT INTO notificationPreferences (username, email, language, events)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (username) DO UPDATE
+        SET email = EXCLUDED.email, language = EXCLUDED.language, events = EXCLUDED.events,
+            updated_at = CURRENT_TIMESTAMP
+    `, username, p.Email, p.Language, pq.Array(events))
+	if err != nil {
+		return nil, fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+
+	return s.GetNotificationPreferences(username)
+}
+
+// EnqueueNotifications queues the notifications, skipping those already
+// queued for their event.
+func (s *PostgresStorage) EnqueueNotifications(notifications []Notification) error {
+	return s.TransactionDecorator(func(tx *sql.Tx) error {
+		for _, n := range notifications {
+			_, err := tx.Exec(`
+                INSERT INTO notifications (event_id, event, username, email, subject, body)
+                VALUES ($1, $2, $3, $4, $5, $6)
+                ON CONFLICT (event_id, username, event) DO NOTHING
+            `, n.EventId, n.Event, n.Username, n.Email, n.Subject, n.Body)
+			if err != nil {
+				return fmt.Errorf("failed to queue notification: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// ClaimDueNotifications takes up to limit pending notifications due at now
+// and hides them from other replicas until leaseUntil, like
+// ClaimDueDeliveries.
+func (s *PostgresStorage) ClaimDueNotifications(now, leaseUntil time.Time, limit int) ([]Notification, error) {
+	rows, err := s.conn().Query(`
+        UPDATE notifications
+        SET next_attempt_at = $2
+        WHERE id IN (
+            SELECT id FROM notifications
+            WHERE status = 'Pending' AND next_attempt_at <= $1
+            ORDER BY next_attempt_at
+            LIMIT $3
+            FOR UPDATE SKIP LOCKED
+        )
+        RETURNING id, event_id, event, username, email, subject, body, attempts
+    `, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim notifications: %w", err)
+	}
+	defer rows.Close()
+
+	due := []Notification{}
+	for rows.Next() {
+		var n Notification
+		if err := rows.Scan(&n.Id, &n.EventId, &n.Event, &n.Username, &n.Email, &n.Subject, &n.Body, &n.Attempts); err != nil {
+			return nil, fmt.Errorf("failed to scan notification: %w", err)
+		}
+		due = append(due, n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return due, nil
+}
+
+// RecordNotificationAttempt logs an attempt made at the given time. A
+// failed attempt without a next one leaves the notification Dead.
+func (s *PostgresStorage) RecordNotificationAttempt(id string, attempt DeliveryAttempt, at time.Time) error {
+	status := notificationPending
+	var sentAt *time.Time
+	switch {
+	case attempt.Delivered:
+		status, sentAt = notificationSent, &at
+	case attempt.NextAttemptAt == nil:
+		status = notificationDead
+	}
+
+	_, err := s.conn().Exec(`
+        UPDATE notifications
+        SET status = $2, attempts = attempts + 1, response_status = $3, last_error = $4,
+            next_attempt_at = $5, sent_at = $6
+        WHERE id = $1
+    `, id, status, sql.NullInt32{Int32: attempt.ResponseStatus, Valid: attempt.ResponseStatus != 0},
+		sql.NullString{String: attempt.Error, Valid: attempt.Error != ""}, attempt.NextAttemptAt, sentAt)
+	if err != nil {
+		return fmt.Errorf("failed to record notification attempt: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStorage) isValidTenderCreator(name string, org_id string) (bool, error) {
+	if !isUUID(org_id) {
+		return false, nil
+	}
+
+	var exists bool
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM organization_responsible r
+			JOIN employee e ON e.id = r.user_id
+			WHERE e.username = $1 AND r.organization_id = $2
+		);
+	`
+
+	if err := s.conn().QueryRow(query, name, org_id).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (s *PostgresStorage) CreateUser(u *User) (*User, error) {
+	err := s.conn().QueryRow(`
+        INSERT INTO employee (username, first_name, last_name)
+        VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+        RETURNING id
+    `, u.Username, u.FirstName, u.LastName).Scan(&u.Id)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+		return nil, ErrUserExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert employee: %w", err)
+	}
+
+	return u, nil
+}
+
+func (s *PostgresStorage) CreateOrganization(name string) (string, error) {
+	var id string
+	err := s.conn().QueryRow(`INSERT INTO organization (name) VALUES ($1) RETURNING id`, name).Scan(&id)
+	if err != nil {
+		return "", fmt.Errorf("failed to insert organization: %w", err)
+	}
+
+	return id, nil
+}
+
+// AddOrganizationResponsible makes the user responsible for the
+// organization. A user answers for one organization at most.
+func (s *PostgresStorage) AddOrganizationResponsible(org_id, user_id string) error {
+	if !isUUID(org_id) {
+		return ErrOrganizationNotFound
+	}
+	if !isUUID(user_id) {
+		return ErrUserNotFound
+	}
+
+	return s.TransactionDecorator(func(tx *sql.Tx) error {
+		var exists bool
+		err := tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM organization WHERE id = $1)`, org_id).Scan(&exists)
 		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
+			return fmt.Errorf("failed to retrieve organization: %w", err)
+		}
+		if !exists {
+			return ErrOrganizationNotFound
 		}
-	}()
 
-	var currentVersion int
-	err = tx.QueryRow(`
-        SELECT version FROM CreateTenderVersion WHERE CreateTenderTable_id = $1
-        ORDER BY version DESC
+		// Lock the employee so two calls can't both find them free.
+		err = tx.QueryRow(`SELECT true FROM employee WHERE id = $1 FOR UPDATE`, user_id).Scan(&exists)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrUserNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to retrieve employee: %w", err)
+		}
+
+		err = tx.QueryRow(`SELECT EXISTS (SELECT 1 FROM organization_responsible WHERE user_id = $1)`, user_id).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve responsible: %w", err)
+		}
+		if exists {
+			return ErrAlreadyResponsible
+		}
+
+		_, err = tx.Exec(`INSERT INTO organization_responsible (organization_id, user_id) VALUES ($1, $2)`, org_id, user_id)
+		if err != nil {
+			return fmt.Errorf("failed to insert organization_responsible: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *PostgresStorage) GetUserOrganization(user_id string) (string, error) {
+	if !isUUID(user_id) {
+		return "", nil
+	}
+
+	var org_id string
+	query := `
+		SELECT organization_id
+		FROM organization_responsible
+		WHERE user_id = $1
 		LIMIT 1;
-    `, CreateTenderTable_id).Scan(&currentVersion)
+	`
+
+	err := s.conn().QueryRow(query, user_id).Scan(&org_id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return org_id, nil
+}
+
+// GetOrganizationResponsibles lists the employees responsible for the
+// organization, by username.
+func (s *PostgresStorage) GetOrganizationResponsibles(org_id string) ([]*User, error) {
+	if !isUUID(org_id) {
+		return []*User{}, nil
+	}
 
+	rows, err := s.conn().Query(`
+        SELECT e.id, e.username, COALESCE(e.first_name, ''), COALESCE(e.last_name, '')
+        FROM organization_responsible r
+        JOIN employee e ON e.id = r.user_id
+        WHERE r.organization_id = $1
+        ORDER BY e.username
+    `, org_id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve current version: %w", err)
+		return nil, fmt.Errorf("failed to query responsibles: %w", err)
 	}
+	return scanUsers(rows)
+}
+
+func scanUsers(rows *sql.Rows) ([]*User, error) {
+	defer rows.Close()
 
-	newVersion := currentVersion + 1
-	fmt.Println(newVersion)
+	users := []*User{}
+	for rows.Next() {
+		u := &User{}
+		if err := rows.Scan(&u.Id, &u.Username, &u.FirstName, &u.LastName); err != nil {
+			return nil, fmt.Errorf("failed to scan user: %w", err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+	return users, nil
+}
 
+func (s *PostgresStorage) GetUserByUsername(username string) (*User, error) {
 	query := `
-        INSERT INTO CreateTenderVersion (name, description, version, CreateTenderTable_id)
-        VALUES ($1, $2, $3, $4)
+        SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, '')
+        FROM employee
+        WHERE username =$1;
+    `
+
+	u := &User{}
+	err := s.conn().QueryRow(query, username).Scan(&u.Id, &u.Username, &u.FirstName, &u.LastName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+func (s *PostgresStorage) GetUserById(id string) (*User, error) {
+	if !isUUID(id) {
+		return nil, ErrUserNotFound
+	}
+
+	query := `
+        SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, '')
+        FROM employee
+        WHERE id =$1;
     `
-	res, err := tx.Exec(query, name, description, newVersion, CreateTenderTable_id)
 
+	u := &User{}
+	err := s.conn().QueryRow(query, id).Scan(&u.Id, &u.Username, &u.FirstName, &u.LastName)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserNotFound
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to update CreateTenderVersion: %w", err)
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// GetUsersByIds returns the employees of the ids there are employees of.
+func (s *PostgresStorage) GetUsersByIds(ids []string) (map[string]*User, error) {
+	result := map[string]*User{}
+	ids = uuids(ids)
+	if ids == nil {
+		return result, nil
 	}
 
-	rowsAffected, err := res.RowsAffected()
+	rows, err := s.conn().Query(`
+        SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, '')
+        FROM employee
+        WHERE id = ANY($1::uuid[])
+    `, pq.Array(ids))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		return nil, fmt.Errorf("failed to query employees: %w", err)
 	}
-	if rowsAffected == 0 {
-		return nil, fmt.Errorf("no rows updated; possible invalid CreateTenderTable_id or no changes made")
+	users, err := scanUsers(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	query = `
-        SELECT id, service_type, status, organization_id, creator_username
-        FROM CreateTenderTable
-        WHERE id = $1
+	for _, u := range users {
+		result[u.Id] = u
+	}
+	return result, nil
+}
+
+// GetUsersByUsernames returns the employees of the usernames there are
+// employees of.
+func (s *PostgresStorage) GetUsersByUsernames(usernames []string) (map[string]*User, error) {
+	result := map[string]*User{}
+	if len(usernames) == 0 {
+		return result, nil
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT id, username, COALESCE(first_name, ''), COALESCE(last_name, '')
+        FROM employee
+        WHERE username = ANY($1::text[])
+    `, pq.Array(usernames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query employees: %w", err)
+	}
+	users, err := scanUsers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, u := range users {
+		result[u.Username] = u
+	}
+	return result, nil
+}
+
+// GetUserOrganizations returns the organization each of the users is
+// responsible for, leaving out those responsible for none.
+func (s *PostgresStorage) GetUserOrganizations(user_ids []string) (map[string]string, error) {
+	result := map[string]string{}
+	user_ids = uuids(user_ids)
+	if user_ids == nil {
+		return result, nil
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT user_id, organization_id
+        FROM organization_responsible
+        WHERE user_id = ANY($1::uuid[])
+    `, pq.Array(user_ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query responsibles: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var user_id, org_id string
+		if err := rows.Scan(&user_id, &org_id); err != nil {
+			return nil, fmt.Errorf("failed to scan responsible: %w", err)
+		}
+		result[user_id] = org_id
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetOrganizationsByIds returns the organizations of the ids there are
+// organizations of.
+func (s *PostgresStorage) GetOrganizationsByIds(org_ids []string) (map[string]*Organization, error) {
+	result := map[string]*Organization{}
+	org_ids = uuids(org_ids)
+	if org_ids == nil {
+		return result, nil
+	}
+
+	rows, err := s.conn().Query(`SELECT id, name FROM organization WHERE id = ANY($1::uuid[])`, pq.Array(org_ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query organizations: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		o := &Organization{}
+		if err := rows.Scan(&o.Id, &o.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan organization: %w", err)
+		}
+		result[o.Id] = o
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetReviewBids returns reviews left on any bid of the author, provided the
+// author has bid on the given tender.
+func (s *PostgresStorage) GetReviewBids(tender_id, author string, limit, offset int32) ([]*BidReview, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	query := `
+        SELECT r.id, r.comment, r.rating, r.on_time, r.created_at
+        FROM reviewsOnBid r
+        JOIN Bids b ON r.bid_id = b.id
+        WHERE b.creator_username = $2
+          AND EXISTS (
+              SELECT 1 FROM Bids WHERE CreateTenderTable_id = $1 AND creator_username = $2
+          )
+        ORDER BY r.created_at
+        LIMIT $3 OFFSET $4
     `
 
-	t := &Tender{}
-	err = tx.QueryRow(query, CreateTenderTable_id).Scan(&t.Id, &t.ServiceType, &t.Status, &t.OrganizationID, &t.CreatorUsername)
+	rows, err := s.conn().Query(query, tender_id, author, limit, offset)
+
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve tender: %w", err)
+		return nil, fmt.Errorf("failed to query reviews: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := []*BidReview{}
+	for rows.Next() {
+		r := &BidReview{}
+		var createdAt time.Time
+		var rating sql.NullInt32
+		var onTime sql.NullBool
+		if err := rows.Scan(&r.Id, &r.Description, &rating, &onTime, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan review: %w", err)
+		}
+		r.Rating, r.OnTime = nullRating(rating), nullBool(onTime)
+		r.CreatedAt = createdAt.Format(time.RFC3339)
+		reviews = append(reviews, r)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// GetTenderDecisions returns every decision made on the bids of a tender,
+// oldest first.
+func (s *PostgresStorage) GetTenderDecisions(tender_id string) ([]DecisionRecord, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT d.bid_id, COALESCE(d.lot_id::text, ''), d.creator_username, d.decision, d.created_at
+        FROM bidDecisions d
+        JOIN Bids b ON b.id = d.bid_id
+        WHERE b.CreateTenderTable_id = $1
+        ORDER BY d.created_at, d.id
+    `, tender_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query decisions: %w", err)
+	}
+	defer rows.Close()
+
+	records := []DecisionRecord{}
+	for rows.Next() {
+		var r DecisionRecord
+		if err := rows.Scan(&r.BidId, &r.LotId, &r.Username, &r.Decision, &r.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan decision: %w", err)
+		}
+		records = append(records, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return records, nil
+}
+
+// GetTenderReviews returns the feedback left on the bids of a tender,
+// oldest first.
+func (s *PostgresStorage) GetTenderReviews(tender_id string) ([]ReviewRecord, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
+
+	rows, err := s.conn().Query(`
+        SELECT r.id, r.bid_id, r.creator_username, r.comment, r.rating, r.on_time, r.created_at
+        FROM reviewsOnBid r
+        JOIN Bids b ON b.id = r.bid_id
+        WHERE b.CreateTenderTable_id = $1
+        ORDER BY r.created_at, r.id
+    `, tender_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviews: %w", err)
+	}
+	defer rows.Close()
+
+	records := []ReviewRecord{}
+	for rows.Next() {
+		r, err := scanReviewRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	t.Name = name
-	t.Description = description
+	return records, nil
+}
+
+// activityCounts selects, for the ids $1, the decisions and reviews of the
+// bids joined on the condition given.
+const activityCounts = `
+        SELECT ids.id::text,
+               (SELECT COUNT(*) FROM bidDecisions d JOIN Bids b ON b.id = d.bid_id WHERE %[1]s AND d.decision = 'Approved'),
+               (SELECT COUNT(*) FROM bidDecisions d JOIN Bids b ON b.id = d.bid_id WHERE %[1]s AND d.decision = 'Rejected'),
+               (SELECT COUNT(*) FROM reviewsOnBid r JOIN Bids b ON b.id = r.bid_id WHERE %[1]s)
+        FROM unnest($1::uuid[]) AS ids(id)
+    `
+
+// CountTenderActivity counts the decisions and reviews on the bids of each
+// of the tenders.
+func (s *PostgresStorage) CountTenderActivity(tenderIds []string) (map[string]ActivityCounts, error) {
+	return s.countActivity(fmt.Sprintf(activityCounts, "b.CreateTenderTable_id = ids.id"), tenderIds)
+}
 
-	return t, nil
+// CountBidActivity counts the decisions and reviews on each of the bids.
+func (s *PostgresStorage) CountBidActivity(bidIds []string) (map[string]ActivityCounts, error) {
+	return s.countActivity(fmt.Sprintf(activityCounts, "b.id = ids.id"), bidIds)
 }
 
-func (s *PostgresStorage) UpdateBidById(bid_id, name, description string) (*Bid, error) {
-	tx, err := s.db.Begin()
+func (s *PostgresStorage) countActivity(query string, ids []string) (map[string]ActivityCounts, error) {
+	counts := map[string]ActivityCounts{}
+	ids = uuids(ids)
+	if ids == nil {
+		return counts, nil
+	}
+
+	rows, err := s.conn().Query(query, pq.Array(ids))
 	if err != nil {
-		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+		return nil, fmt.Errorf("failed to count activity: %w", err)
 	}
+	defer rows.Close()
 
-	defer func() {
-		if err != nil {
-			tx.Rollback()
-		} else {
-			tx.Commit()
+	for rows.Next() {
+		var id string
+		var c ActivityCounts
+		if err := rows.Scan(&id, &c.Approvals, &c.Rejections, &c.Reviews); err != nil {
+			return nil, fmt.Errorf("failed to scan activity: %w", err)
 		}
-	}()
+		counts[id] = c
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
 
-	var currentVersion int
-	err = tx.QueryRow(`
-        SELECT version FROM BidsVersion WHERE bid_id = $1
-        ORDER BY version DESC
-		LIMIT 1;
-    `, bid_id).Scan(&currentVersion)
+	return counts, nil
+}
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve current version: %w", err)
+// uuids keeps the ids that are uuids, which are the only ones Postgres
+// can have; nil if none is.
+func uuids(ids []string) []string {
+	ids = slices.DeleteFunc(slices.Clone(ids), func(id string) bool { return !isUUID(id) })
+	if len(ids) == 0 {
+		return nil
 	}
+	return ids
+}
 
-	newVersion := currentVersion + 1
-
-	query := `
-        INSERT INTO BidsVersion (name, description, version, bid_id)
-        VALUES ($1, $2, $3, $4)
-    `
-	res, err := tx.Exec(query, name, description, newVersion, bid_id)
+// GetDecisionsByBidIds returns the decisions made on each of the bids,
+// oldest first.
+func (s *PostgresStorage) GetDecisionsByBidIds(bidIds []string) (map[string][]DecisionRecord, error) {
+	decisions := map[string][]DecisionRecord{}
+	bidIds = uuids(bidIds)
+	if bidIds == nil {
+		return decisions, nil
+	}
 
+	rows, err := s.conn().Query(`
+        SELECT d.bid_id, COALESCE(d.lot_id::text, ''), d.creator_username, d.decision, d.created_at
+        FROM bidDecisions d
+        WHERE d.bid_id = ANY($1::uuid[])
+        ORDER BY d.created_at, d.id
+    `, pq.Array(bidIds))
 	if err != nil {
-		return nil, fmt.Errorf("failed to update CreateTenderVersion: %w", err)
+		return nil, fmt.Errorf("failed to query decisions: %w", err)
 	}
+	defer rows.Close()
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	for rows.Next() {
+		var r DecisionRecord
+		if err := rows.Scan(&r.BidId, &r.LotId, &r.Username, &r.Decision, &r.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan decision: %w", err)
+		}
+		decisions[r.BidId] = append(decisions[r.BidId], r)
 	}
-	if rowsAffected == 0 {
-		return nil, fmt.Errorf("no rows updated; possible invalid CreateTenderTable_id or no changes made")
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	query = `
-        SELECT id, CreateTenderTable_id, status, organization_id, creator_username
-        FROM Bids
-        WHERE id = $1
-    `
+	return decisions, nil
+}
 
-	t := &Bid{}
-	err = tx.QueryRow(query, bid_id).Scan(&t.Id, &t.TenderId, &t.Status, &t.OrganizationId, &t.CreatorUsername)
-	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve tender: %w", err)
+// GetReviewsByBidIds returns the feedback left on each of the bids, oldest
+// first.
+func (s *PostgresStorage) GetReviewsByBidIds(bidIds []string) (map[string][]ReviewRecord, error) {
+	bidIds = uuids(bidIds)
+	if bidIds == nil {
+		return map[string][]ReviewRecord{}, nil
 	}
 
-	t.Name = name
-	t.Description = description
-
-	return t, nil
+	rows, err := s.conn().Query(`
+        SELECT r.bid_id::text, r.id, r.bid_id, r.creator_username, r.comment, r.rating, r.on_time, r.created_at
+        FROM reviewsOnBid r
+        WHERE r.bid_id = ANY($1::uuid[])
+        ORDER BY r.created_at, r.id
+    `, pq.Array(bidIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviews: %w", err)
+	}
+	return groupReviews(rows)
 }
 
-func (s *PostgresStorage) UpdateAccount(a *Account) error {
-	return nil
-}
+// GetReviewsByAuthors returns, for each of the usernames, the feedback
+// left on any bid it created, oldest first.
+func (s *PostgresStorage) GetReviewsByAuthors(usernames []string) (map[string][]ReviewRecord, error) {
+	if len(usernames) == 0 {
+		return map[string][]ReviewRecord{}, nil
+	}
 
-func (s *PostgresStorage) DeleteAccount(id int) error {
-	return nil
+	rows, err := s.conn().Query(`
+        SELECT b.creator_username, r.id, r.bid_id, r.creator_username, r.comment, r.rating, r.on_time, r.created_at
+        FROM reviewsOnBid r
+        JOIN Bids b ON b.id = r.bid_id
+        WHERE b.creator_username = ANY($1::text[])
+        ORDER BY r.created_at, r.id
+    `, pq.Array(usernames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query reviews: %w", err)
+	}
+	return groupReviews(rows)
 }
 
-func (s *PostgresStorage) GetAccountById(id int) (*Account, error) {
-	return nil, nil
+// scanReviewRecord reads the columns of a review, after the leading ones
+// scanned into keys.
+func scanReviewRecord(row rowScanner, keys ...any) (ReviewRecord, error) {
+	var r ReviewRecord
+	var rating sql.NullInt32
+	var onTime sql.NullBool
+	dest := append(keys, &r.Id, &r.BidId, &r.Username, &r.Comment, &rating, &onTime, &r.CreatedAt)
+	if err := row.Scan(dest...); err != nil {
+		return r, fmt.Errorf("failed to scan review: %w", err)
+	}
+	r.Rating, r.OnTime = nullRating(rating), nullBool(onTime)
+	return r, nil
 }
 
-func (s *PostgresStorage) isValidTenderCreator(name string, org_id string) (bool, error) {
-	var id_username string
-	query := `
-		SELECT id
-		FROM employee
-		WHERE username = $1;
-	`
-
-	row := s.db.QueryRow(query, name)
-	err := row.Scan(&id_username)
+// groupReviews reads rows of a key followed by the columns of a review,
+// grouping the reviews by key.
+func groupReviews(rows *sql.Rows) (map[string][]ReviewRecord, error) {
+	defer rows.Close()
 
-	if id_username == "" {
-		return false, err
+	reviews := map[string][]ReviewRecord{}
+	for rows.Next() {
+		var key string
+		r, err := scanReviewRecord(rows, &key)
+		if err != nil {
+			return nil, err
+		}
+		reviews[key] = append(reviews[key], r)
 	}
-
-	query = `
-		SELECT id
-		FROM organization_responsible
-		WHERE organization_id = $1 and user_id = $2;
-	`
-
-	row = s.db.QueryRow(query, org_id, id_username)
-	var id_org_resp string
-	err = row.Scan(&id_org_resp)
-	if id_org_resp == "" {
-		return false, err
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	return true, nil
+	return reviews, nil
 }
 
-func (s *PostgresStorage) GetUserByUsername(username string) (*User, error) {
-	query := `
-        SELECT id, first_name, last_name
-        FROM employee
-        WHERE username =$1;
-    `
+// GetOrganizationBidAuthors returns the employees who made bids on behalf
+// of the organization.
+func (s *PostgresStorage) GetOrganizationBidAuthors(organization_id string) ([]string, error) {
+	authorIds := []string{}
+	if !isUUID(organization_id) {
+		return authorIds, nil
+	}
 
-	rows, error := s.db.Query(query, username)
-	if error != nil {
-		return nil, error
+	rows, err := s.conn().Query(`
+        SELECT DISTINCT author_id FROM Bids
+        WHERE author_type = 'Organization' AND organization_id = $1
+        ORDER BY author_id
+    `, organization_id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bid authors: %w", err)
 	}
-	u := &User{}
+	defer rows.Close()
+
 	for rows.Next() {
-		if err := rows.Scan(&u.Id, &u.FirstName, &u.LastName); err != nil {
-			return nil, err
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan bid author: %w", err)
 		}
+		authorIds = append(authorIds, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	return u, nil
+	return authorIds, nil
 }
 
-func (s *PostgresStorage) GetReviewBids(tender_id, org_id, author string) ([]*Review, error) {
-	query := `
-        SELECT r.creator_username, r.comment
-        FROM reviewsOnBid r
-        JOIN Bids b ON r.bid_id = b.id
-        WHERE b.CreateTenderTable_id = $1
-          AND r.creator_username = $2
-          AND b.organization_id = $3
-    `
+// GetOrganizationReputations sums up the bids made on behalf of each of the
+// organizations.
+func (s *PostgresStorage) GetOrganizationReputations(organizationIds []string) (map[string]Reputation, error) {
+	return s.queryReputations(`b.organization_id`,
+		`b.author_type = 'Organization' AND b.organization_id = ANY($1::uuid[])`, organizationIds)
+}
+
+// GetAuthorReputations sums up the bids each of the employees authored.
+func (s *PostgresStorage) GetAuthorReputations(authorIds []string) (map[string]Reputation, error) {
+	return s.queryReputations(`b.author_id`, `b.author_id = ANY($1::uuid[])`, authorIds)
+}
 
-	rows, err := s.db.Query(query, tender_id, author, org_id)
+// queryReputations sums up the bids matching filter by subject in the
+// database, following the rules of reputationData: drafts don't count,
+// canceled bids are neither won nor lost, a bid on a tender with lots wins
+// by being awarded one, any other by min(3, responsibles) approvals, and a
+// bid loses by a rejection or when its tender closes without it winning.
+func (s *PostgresStorage) queryReputations(subject, filter string, ids []string) (map[string]Reputation, error) {
+	result := map[string]Reputation{}
+	ids = uuids(ids)
+	if ids == nil {
+		return result, nil
+	}
 
+	rows, err := s.conn().Query(`
+        WITH counted AS (
+            SELECT
+                `+subject+` AS subject,
+                b.id,
+                b.status,
+                t.status AS tender_status,
+                EXISTS (SELECT 1 FROM tenderLots l WHERE l.CreateTenderTable_id = t.id) AS has_lots,
+                EXISTS (SELECT 1 FROM tenderLots l WHERE l.awarded_bid_id = b.id) AS awarded,
+                EXISTS (
+                    SELECT 1 FROM bidDecisions d
+                    WHERE d.bid_id = b.id AND d.lot_id IS NULL AND d.decision = 'Rejected'
+                ) AS rejected,
+                (
+                    SELECT COUNT(*) FROM bidDecisions d
+                    WHERE d.bid_id = b.id AND d.lot_id IS NULL AND d.decision = 'Approved'
+                ) AS approvals,
+                LEAST(3, (
+                    SELECT COUNT(DISTINCT r.user_id) FROM organization_responsible r
+                    WHERE r.organization_id = t.organization_id
+                )) AS quorum
+            FROM Bids b
+            JOIN CreateTenderTable t ON t.id = b.CreateTenderTable_id
+            WHERE b.status <> 'Created' AND `+filter+`
+        ), outcomes AS (
+            SELECT subject, id, status,
+                CASE
+                    WHEN status = 'Canceled' THEN NULL
+                    WHEN has_lots AND awarded THEN 'Approved'
+                    WHEN NOT has_lots AND rejected THEN 'Rejected'
+                    WHEN NOT has_lots AND quorum > 0 AND approvals >= quorum THEN 'Approved'
+                    WHEN tender_status = 'Closed' THEN 'Rejected'
+                END AS outcome
+            FROM counted
+        )
+        SELECT
+            o.subject,
+            COUNT(*),
+            COUNT(*) FILTER (WHERE o.outcome = 'Approved'),
+            COUNT(*) FILTER (WHERE o.outcome = 'Rejected'),
+            COUNT(*) FILTER (WHERE o.status = 'Canceled'),
+            COALESCE(SUM(f.ratings), 0),
+            COALESCE(SUM(f.rating_sum), 0),
+            COALESCE(SUM(f.on_time), 0),
+            COALESCE(SUM(f.late), 0)
+        FROM outcomes o
+        LEFT JOIN (
+            SELECT
+                bid_id,
+                COUNT(rating) AS ratings,
+                SUM(rating) AS rating_sum,
+                COUNT(*) FILTER (WHERE on_time) AS on_time,
+                COUNT(*) FILTER (WHERE NOT on_time) AS late
+            FROM reviewsOnBid
+            GROUP BY bid_id
+        ) f ON f.bid_id = o.id
+        GROUP BY o.subject
+    `, pq.Array(ids))
 	if err != nil {
-		return nil, fmt.Errorf("failed to query reviews: %w", err)
+		return nil, fmt.Errorf("failed to query reputations: %w", err)
 	}
 	defer rows.Close()
 
-	var reviews []*Review
 	for rows.Next() {
-		r := &Review{}
-		if err := rows.Scan(&r.CreatorUsername, &r.Comment); err != nil {
-			return nil, fmt.Errorf("failed to scan review: %w", err)
+		var id string
+		var rep Reputation
+		var ratings int64
+		if err := rows.Scan(&id, &rep.Bids, &rep.Won, &rep.Lost, &rep.Canceled,
+			&rep.Ratings, &ratings, &rep.OnTime, &rep.Late); err != nil {
+			return nil, fmt.Errorf("failed to scan reputation: %w", err)
 		}
-		reviews = append(reviews, r)
+		rep.rate(ratings)
+		result[id] = rep
 	}
-
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 
-	return reviews, nil
+	return result, nil
 }
 
-func (s *PostgresStorage) GetBidsByUsername(username string) ([]*Bid, error) {
-	query := `
-	SELECT 
-	    t.id,
-	    v.name,
-	    v.description,
-		t.status,
-		t.organization_id,
-		t.creator_username	
-	FROM 
-		Bids t
-	JOIN 
-		BidsVersion v 
-	ON 
-		t.id = v.bid_id
-	WHERE 
-		v.version = (
-			SELECT MAX(version)
-			FROM BidsVersion
-			WHERE bid_id = t.id
-		) AND t.creator_username = $1
-    `
+func (s *PostgresStorage) GetBidsByUsername(username string, filter BidFilter, limit, offset int32) ([]*Bid, error) {
+	clause, args := bidFilterClause(filter, []any{username})
+	rows, err := s.conn().Query(bidSelect+`
+		AND b.creator_username = $1
+    `+clause+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), append(args, limit, offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bids: %w", err)
+	}
+
+	return s.scanBids(rows)
+}
+
+// SearchBids ranks the current versions of the bids the viewer may see
+// against a web search query: those it manages and the published ones on
+// tenders of its organization. Sealed versions aren't searchable until
+// they are revealed, as only their placeholders are indexed.
+func (s *PostgresStorage) SearchBids(query string, filter SearchFilter, limit, offset int32) ([]*BidSearchHit, error) {
+	args := []any{query, filter.Viewer}
+	var clause string
+	if filter.TenderId != "" {
+		if !isUUID(filter.TenderId) {
+			return []*BidSearchHit{}, nil
+		}
+		args = append(args, filter.TenderId)
+		clause = " AND b.CreateTenderTable_id = $3"
+	}
 
-	rows, err := s.db.Query(query, username)
+	rows, err := s.conn().Query(`
+		SELECT found.*, ts_rank(v.search_vector, query) AS rank, `+searchHeadline+`
+		FROM (`+bidSelect+`
+			AND v.sealed_payload IS NULL
+			AND (
+				b.creator_username = $2
+				OR (b.author_type = 'Organization' AND b.organization_id IN (`+fmt.Sprintf(viewerOrganization, 2)+`))
+				OR (b.status = 'Published' AND EXISTS (
+					SELECT 1 FROM CreateTenderTable t
+					WHERE t.id = b.CreateTenderTable_id
+					  AND t.organization_id IN (`+fmt.Sprintf(viewerOrganization, 2)+`)
+				))
+			)`+clause+`
+		) found
+		JOIN BidsVersion v ON v.bid_id = found.id AND v.version = found.version
+		CROSS JOIN websearch_to_tsquery('russian'::regconfig, $1) query
+		WHERE v.search_vector @@ query
+		ORDER BY rank DESC, found.name, found.id
+    `+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), append(args, limit, offset)...)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to search bids: %w", err)
 	}
 	defer rows.Close()
 
-	var CreateTenderTables []*Bid
+	hits := []*BidSearchHit{}
 	for rows.Next() {
-		t := &Bid{}
-		if err := rows.Scan(&t.Id, &t.Name, &t.Description, &t.Status, &t.OrganizationId, &t.CreatorUsername); err != nil {
-			return nil, err
+		hit := &BidSearchHit{}
+		b, err := s.scanBid(withColumns{rows, []any{&hit.Rank, &hit.Snippet}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
-		CreateTenderTables = append(CreateTenderTables, t)
+		hit.Bid = *b
+		hits = append(hits, hit)
 	}
-
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
 	}
 
-	if len(CreateTenderTables) == 0 {
-		return nil, nil
+	return hits, nil
+}
+
+func (s *PostgresStorage) CreateReviewOnBid(bid_id, username, comment string, rating *BidRating, onTime *BidOnTime) error {
+	if !isUUID(bid_id) {
+		return ErrBidNotFound
 	}
 
-	return CreateTenderTables, nil
+	return s.TransactionDecorator(func(tx *sql.Tx) error {
+		query := `
+	INSERT INTO reviewsOnBid (bid_id, creator_username, comment, rating, on_time)
+	VALUES ($1, $2, $3, $4, $5)
+	`
+
+		_, err := tx.Exec(query, bid_id, username, comment, rating, onTime)
+		if err != nil {
+			return err
+		}
+
+		return s.outboxBid(tx, EventTypeFeedbackCreated, bid_id, func(bid any) any {
+			return bidFeedbackEvent{Bid: bid, Feedback: comment, Rating: rating, OnTime: onTime, Username: username}
+		})
+	})
 }
 
-func (s *PostgresStorage) CreateReviewOnBid(rev *Review, bid_id string) error {
-	query := `
-	INSERT INTO reviewsOnBid (bid_id, creator_username, comment)
-	VALUES ($1, $2, $3)
-	`
+func (s *PostgresStorage) GetBidById(bid_id string) (*Bid, error) {
+	if !isUUID(bid_id) {
+		return nil, ErrBidNotFound
+	}
 
-	fmt.Print(bid_id)
-	_, err := s.db.Exec(query, bid_id, rev.CreatorUsername, rev.Comment)
+	b, err := s.scanBid(s.conn().QueryRow(bidSelect+` AND b.id = $1`, bid_id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBidNotFound
+	}
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("failed to retrieve bid: %w", err)
 	}
 
-	return nil
+	return b, nil
 }
 
-func (s *PostgresStorage) GetBidsByTenderId(tender_id string) ([]*Bid, error) {
-	query := `
-        SELECT b.id, bv.name, bv.description, b.status, b.organization_id,
-               b.creator_username
-        FROM Bids b
-        JOIN (
-            SELECT bid_id, MAX(version) AS max_version
-            FROM BidsVersion
-            GROUP BY bid_id
-        ) bv_max ON b.id = bv_max.bid_id
-        JOIN BidsVersion bv ON 
-        bv.bid_id = bv_max.bid_id AND bv.version = bv_max.max_version
-        WHERE b.CreateTenderTable_id = $1
-    `
+// GetBidsByTenderId returns the published bids of a tender, which is what
+// the tender's responsibles are allowed to review.
+func (s *PostgresStorage) GetBidsByTenderId(tender_id string, filter BidFilter, limit, offset int32) ([]*Bid, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
 
-	rows, err := s.db.Query(query, tender_id)
+	clause, args := bidFilterClause(filter, []any{tender_id})
+	rows, err := s.conn().Query(bidSelect+`
+		AND b.CreateTenderTable_id = $1
+		AND b.status = 'Published'
+    `+clause+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), append(args, limit, offset)...)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to query bids: %w", err)
 	}
-	defer rows.Close()
 
-	var CreateBidsTables []*Bid
-	for rows.Next() {
-		t := &Bid{}
-		if err := rows.Scan(&t.Id, &t.Name, &t.Description, &t.Status, &t.OrganizationId, &t.CreatorUsername); err != nil {
-			return nil, err
-		}
-		CreateBidsTables = append(CreateBidsTables, t)
+	return s.scanBids(rows)
+}
+
+// TenderHasBids reports whether any bid, in any status, was made on the
+// tender.
+func (s *PostgresStorage) TenderHasBids(tender_id string) (bool, error) {
+	if !isUUID(tender_id) {
+		return false, ErrTenderNotFound
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+	var exists bool
+	err := s.conn().QueryRow(`
+        SELECT EXISTS (SELECT 1 FROM Bids WHERE CreateTenderTable_id = $1)
+    `, tender_id).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check tender bids: %w", err)
 	}
+	return exists, nil
+}
 
-	if len(CreateBidsTables) == 0 {
-		return nil, nil
+// GetBidsByTenderIds returns the published bids on each of the tenders, in
+// the order GetBidsByTenderId lists them by default.
+func (s *PostgresStorage) GetBidsByTenderIds(tenderIds []string) (map[string][]*Bid, error) {
+	result := map[string][]*Bid{}
+	tenderIds = uuids(tenderIds)
+	if tenderIds == nil {
+		return result, nil
 	}
 
-	return CreateBidsTables, nil
+	clause, args := bidFilterClause(BidFilter{}, []any{pq.Array(tenderIds)})
+	rows, err := s.conn().Query(bidSelect+`
+		AND b.CreateTenderTable_id = ANY($1::uuid[])
+		AND b.status = 'Published'
+    `+clause, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bids: %w", err)
+	}
+	bids, err := s.scanBids(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range bids {
+		result[b.TenderId] = append(result[b.TenderId], b)
+	}
+	return result, nil
 }
 
-func (s *PostgresStorage) GetTendersByUsername(username string) ([]*Tender, error) {
-	query := `
-	SELECT 
-	    t.id,
-	    v.name,
-	    v.description,
-		t.service_type,
-		t.status,
-		t.organization_id,
-		t.creator_username	
-	FROM 
-		CreateTenderTable t
-	JOIN 
-		CreateTenderVersion v 
-	ON 
-		t.id = v.CreateTenderTable_id
-	WHERE 
-		v.version = (
-			SELECT MAX(version)
-			FROM CreateTenderVersion
-			WHERE CreateTenderTable_id = t.id
-		) AND creator_username = $1
-    `
+func (s *PostgresStorage) GetTenderById(tender_id string) (*Tender, error) {
+	if !isUUID(tender_id) {
+		return nil, ErrTenderNotFound
+	}
 
-	rows, err := s.db.Query(query, username)
+	t, err := scanTender(s.conn().QueryRow(tenderSelect+` AND t.id = $1`, tender_id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTenderNotFound
+	}
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to retrieve tender: %w", err)
 	}
-	defer rows.Close()
 
-	var CreateTenderTables []*Tender
-	for rows.Next() {
-		t := &Tender{}
-		if err := rows.Scan(&t.Id, &t.Name, &t.Description, &t.ServiceType, &t.Status, &t.OrganizationID, &t.CreatorUsername); err != nil {
-			return nil, err
-		}
-		CreateTenderTables = append(CreateTenderTables, t)
+	return t, nil
+}
+
+// GetTendersByIds returns the tenders of the ids there are tenders of.
+func (s *PostgresStorage) GetTendersByIds(tenderIds []string) (map[string]*Tender, error) {
+	result := map[string]*Tender{}
+	tenderIds = uuids(tenderIds)
+	if tenderIds == nil {
+		return result, nil
 	}
 
-	if err := rows.Err(); err != nil {
+	rows, err := s.conn().Query(tenderSelect+` AND t.id = ANY($1::uuid[])`, pq.Array(tenderIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query CreateTenderTables: %w", err)
+	}
+	tenders, err := scanTenders(rows)
+	if err != nil {
 		return nil, err
 	}
 
-	if len(CreateTenderTables) == 0 {
-		return nil, nil
+	for _, t := range tenders {
+		result[t.Id] = t
+	}
+	return result, nil
+}
+
+func (s *PostgresStorage) GetTendersByUsername(username string, filter TenderFilter, limit, offset int32) ([]*Tender, error) {
+	clause, args := tenderFilterClause(filter, []any{username})
+	rows, err := s.conn().Query(tenderSelect+`
+		AND t.creator_username = $1
+    `+clause+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), append(args, limit, offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query CreateTenderTables: %w", err)
 	}
 
-	return CreateTenderTables, nil
+	return scanTenders(rows)
 }
 
-func (s *PostgresStorage) GetAllTenders(serviceType string) ([]*Tender, error) {
+// GetAllTenders lists published tenders matching the filter and visible to
+// its viewer.
+func (s *PostgresStorage) GetAllTenders(filter TenderFilter, limit, offset int32) ([]*Tender, error) {
+	visibility, args := visibilityClause(filter.Viewer, nil)
+	clause, args := tenderFilterClause(filter, args)
+	rows, err := s.conn().Query(tenderSelect+`
+		AND t.status = 'Published'
+    `+visibility+clause+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), append(args, limit, offset)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query CreateTenderTables: %w", err)
+	}
 
-	query := `
-	SELECT 
-	    t.id,
-	    v.name,
-	    v.description,
-		t.service_type,
-		t.status,
-		t.organization_id,
-		t.creator_username	
-	FROM 
-		CreateTenderTable t
-	JOIN 
-		CreateTenderVersion v 
-	ON 
-		t.id = v.CreateTenderTable_id
-	WHERE 
-		v.version = (
-			SELECT MAX(version)
-			FROM CreateTenderVersion
-			WHERE CreateTenderTable_id = t.id
-		)
-    `
-	var args []interface{}
+	return scanTenders(rows)
+}
 
-	if serviceType != "" {
-		query += " WHERE service_type = $1"
-		args = append(args, serviceType)
+// SearchTenders ranks the current versions of the tenders matching the
+// filter against a web search query. The viewer finds the published
+// tenders it may see and those of its organization in any status.
+func (s *PostgresStorage) SearchTenders(query string, filter SearchFilter, limit, offset int32) ([]*TenderSearchHit, error) {
+	visibility, args := visibilityClause(filter.Viewer, []any{query})
+	status := " AND t.status = 'Published'"
+	if filter.Viewer != "" {
+		// visibilityClause numbers the viewer last.
+		status = fmt.Sprintf(" AND (t.status = 'Published' OR t.organization_id IN (%s))",
+			fmt.Sprintf(viewerOrganization, len(args)))
+	}
+
+	var b strings.Builder
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if filter.Status != "" {
+		fmt.Fprintf(&b, " AND t.status = %s", arg(filter.Status))
+	}
+	if len(filter.ServiceTypes) > 0 {
+		types := make([]string, 0, len(filter.ServiceTypes))
+		for _, st := range filter.ServiceTypes {
+			types = append(types, string(st))
+		}
+		fmt.Fprintf(&b, " AND v.service_type = ANY(%s)", arg(pq.Array(types)))
+	}
+	if filter.OrganizationId != "" {
+		if !isUUID(filter.OrganizationId) {
+			return []*TenderSearchHit{}, nil
+		}
+		fmt.Fprintf(&b, " AND t.organization_id = %s", arg(filter.OrganizationId))
 	}
 
-	rows, err := s.db.Query(query, args...)
+	rows, err := s.conn().Query(`
+		SELECT found.*, ts_rank(v.search_vector, query) AS rank, `+searchHeadline+`
+		FROM (`+tenderSelect+status+visibility+b.String()+`) found
+		JOIN CreateTenderVersion v ON v.CreateTenderTable_id = found.id AND v.version = found.version
+		CROSS JOIN websearch_to_tsquery('russian'::regconfig, $1) query
+		WHERE v.search_vector @@ query
+		ORDER BY rank DESC, found.name, found.id
+    `+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)+1, len(args)+2), append(args, limit, offset)...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query CreateTenderTables: %w", err)
+		return nil, fmt.Errorf("failed to search tenders: %w", err)
 	}
 	defer rows.Close()
 
-	var CreateTenderTables []*Tender
+	hits := []*TenderSearchHit{}
 	for rows.Next() {
-		t := &Tender{}
-		if err := rows.Scan(
-			&t.Id,
-			&t.Name,
-			&t.Description,
-			&t.ServiceType,
-			&t.Status,
-			&t.OrganizationID,
-			&t.CreatorUsername,
-		); err != nil {
+		hit := &TenderSearchHit{}
+		t, err := scanTender(withColumns{rows, []any{&hit.Rank, &hit.Snippet}})
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
-		CreateTenderTables = append(CreateTenderTables, t)
+		hit.Tender = *t
+		hits = append(hits, hit)
 	}
-
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("error occurred during row iteration: %w", err)
 	}
 
-	return CreateTenderTables, nil
+	return hits, nil
 }
 
-func (s *PostgresStorage) GetAccounts() ([]*Account, error) {
-	rows, err := s.db.Query("select * from account ")
-	if err != nil {
-		return nil, err
+// searchHeadline highlights the matches of query in the name and
+// description of the version v.
+const searchHeadline = `ts_headline('russian'::regconfig, v.name || '. ' || v.description, query,
+			'StartSel=<b>, StopSel=</b>, MinWords=10, MaxWords=30')`
+
+// visibilityClause limits tenderSelect to the tenders the viewer may see:
+// public ones, those of the viewer's organization and those the viewer or
+// the organization is invited to and hasn't declined.
+func visibilityClause(viewer string, args []any) (string, []any) {
+	if viewer == "" {
+		return " AND t.visibility = 'public'", args
 	}
+	args = append(args, viewer)
+	n := len(args)
+	return fmt.Sprintf(`
+		AND (
+			t.visibility = 'public'
+			OR t.organization_id IN (%[2]s)
+			OR EXISTS (
+				SELECT 1 FROM tenderInvitations i
+				WHERE i.CreateTenderTable_id = t.id
+				  AND i.status <> 'Declined'
+				  AND (i.username = $%[1]d OR i.organization_id IN (%[2]s))
+			)
+		)`, n, fmt.Sprintf(viewerOrganization, n)), args
+}
 
-	accounts := []*Account{}
-	for rows.Next() {
-		account := new(Account)
-		if err := rows.Scan(
-			&account.ID,
-			&account.name); err != nil {
-			return nil, err
+// viewerOrganization selects the organization of the employee whose
+// username is the placeholder with the given number.
+const viewerOrganization = `
+				SELECT r.organization_id
+				FROM organization_responsible r
+				JOIN employee e ON e.id = r.user_id
+				WHERE e.username = $%d`
+
+// tenderFilterClause renders the filter as conditions and an ORDER BY to
+// append to tenderSelect, numbering its placeholders after args. A budget
+// bound matches tenders whose budget range reaches it.
+func tenderFilterClause(f TenderFilter, args []any) (string, []any) {
+	var b strings.Builder
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if len(f.ServiceTypes) > 0 {
+		types := make([]string, 0, len(f.ServiceTypes))
+		for _, st := range f.ServiceTypes {
+			types = append(types, string(st))
 		}
+		fmt.Fprintf(&b, " AND v.service_type = ANY(%s)", arg(pq.Array(types)))
+	}
+	if f.Currency != "" {
+		fmt.Fprintf(&b, " AND v.currency = %s", arg(f.Currency))
+	}
+	if f.MinBudget != "" {
+		fmt.Fprintf(&b, " AND COALESCE(v.budget_max, v.budget_min) >= %s::numeric", arg(f.MinBudget))
+	}
+	if f.MaxBudget != "" {
+		fmt.Fprintf(&b, " AND COALESCE(v.budget_min, v.budget_max) <= %s::numeric", arg(f.MaxBudget))
+	}
+
+	column := "v.name"
+	switch f.SortBy {
+	case TenderSortByBudgetMin:
+		column = "v.budget_min"
+	case TenderSortByBudgetMax:
+		column = "v.budget_max"
+	}
+	fmt.Fprintf(&b, " ORDER BY %s %s NULLS LAST, v.name", column, sortDirection(f.SortOrder))
+
+	return b.String(), args
+}
+
+// bidFilterClause is tenderFilterClause for bidSelect.
+func bidFilterClause(f BidFilter, args []any) (string, []any) {
+	var b strings.Builder
+	arg := func(v any) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if f.Currency != "" {
+		fmt.Fprintf(&b, " AND v.currency = %s", arg(f.Currency))
+	}
+	if f.MinPrice != "" {
+		fmt.Fprintf(&b, " AND v.price >= %s::numeric", arg(f.MinPrice))
+	}
+	if f.MaxPrice != "" {
+		fmt.Fprintf(&b, " AND v.price <= %s::numeric", arg(f.MaxPrice))
+	}
+	if f.MaxDeliveryDays > 0 {
+		fmt.Fprintf(&b, " AND v.delivery_days <= %s", arg(f.MaxDeliveryDays))
+	}
+
+	column := "v.name"
+	switch f.SortBy {
+	case BidSortByPrice:
+		column = "v.price"
+	case BidSortByDeliveryDays:
+		column = "v.delivery_days"
+	}
+	fmt.Fprintf(&b, " ORDER BY %s %s NULLS LAST, v.name", column, sortDirection(f.SortOrder))
+
+	return b.String(), args
+}
 
-		accounts = append(accounts, account)
+func sortDirection(order SortOrder) string {
+	if order == SortOrderDesc {
+		return "DESC"
 	}
-	return accounts, nil
+	return "ASC"
 }
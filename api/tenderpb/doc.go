@@ -0,0 +1,6 @@
+// Package tenderpb holds the gRPC API of the service, generated from
+// tender.proto with buf (https://buf.build), the plugins being pinned in
+// tools/tools.go.
+package tenderpb
+
+//go:generate buf generate
@@ -0,0 +1,823 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: tender.proto
+
+package tenderpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TenderService_ListTenders_FullMethodName        = "/tender.v1.TenderService/ListTenders"
+	TenderService_CreateTender_FullMethodName       = "/tender.v1.TenderService/CreateTender"
+	TenderService_ListUserTenders_FullMethodName    = "/tender.v1.TenderService/ListUserTenders"
+	TenderService_GetTenderStatus_FullMethodName    = "/tender.v1.TenderService/GetTenderStatus"
+	TenderService_UpdateTenderStatus_FullMethodName = "/tender.v1.TenderService/UpdateTenderStatus"
+	TenderService_EditTender_FullMethodName         = "/tender.v1.TenderService/EditTender"
+	TenderService_RollbackTender_FullMethodName     = "/tender.v1.TenderService/RollbackTender"
+	TenderService_CreateBid_FullMethodName          = "/tender.v1.TenderService/CreateBid"
+	TenderService_ListUserBids_FullMethodName       = "/tender.v1.TenderService/ListUserBids"
+	TenderService_ListTenderBids_FullMethodName     = "/tender.v1.TenderService/ListTenderBids"
+	TenderService_GetBidStatus_FullMethodName       = "/tender.v1.TenderService/GetBidStatus"
+	TenderService_UpdateBidStatus_FullMethodName    = "/tender.v1.TenderService/UpdateBidStatus"
+	TenderService_EditBid_FullMethodName            = "/tender.v1.TenderService/EditBid"
+	TenderService_RollbackBid_FullMethodName        = "/tender.v1.TenderService/RollbackBid"
+	TenderService_SubmitBidDecision_FullMethodName  = "/tender.v1.TenderService/SubmitBidDecision"
+	TenderService_SubmitBidFeedback_FullMethodName  = "/tender.v1.TenderService/SubmitBidFeedback"
+	TenderService_ListBidReviews_FullMethodName     = "/tender.v1.TenderService/ListBidReviews"
+	TenderService_WatchTenderBids_FullMethodName    = "/tender.v1.TenderService/WatchTenderBids"
+)
+
+// TenderServiceClient is the client API for TenderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TenderService is the gRPC API of the service. Every unary call is the
+// REST operation named in its comment, with the same rules, checks and
+// errors: a REST status is returned as the gRPC code it maps onto, with the
+// reason of the error response as the message.
+type TenderServiceClient interface {
+	// GET /tenders
+	ListTenders(ctx context.Context, in *ListTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	// POST /tenders/new
+	CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	// GET /tenders/my
+	ListUserTenders(ctx context.Context, in *ListUserTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error)
+	// GET /tenders/{tenderId}/status
+	GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*GetTenderStatusResponse, error)
+	// PUT /tenders/{tenderId}/status
+	UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error)
+	// PATCH /tenders/{tenderId}/edit
+	EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	// PUT /tenders/{tenderId}/rollback/{version}
+	RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*Tender, error)
+	// POST /bids/new
+	CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	// GET /bids/my
+	ListUserBids(ctx context.Context, in *ListUserBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	// GET /bids/{tenderId}/list
+	ListTenderBids(ctx context.Context, in *ListTenderBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error)
+	// GET /bids/{bidId}/status
+	GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*GetBidStatusResponse, error)
+	// PUT /bids/{bidId}/status
+	UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*Bid, error)
+	// PATCH /bids/{bidId}/edit
+	EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	// PUT /bids/{bidId}/rollback/{version}
+	RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*Bid, error)
+	// PUT /bids/{bidId}/submit_decision
+	SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error)
+	// PUT /bids/{bidId}/feedback
+	SubmitBidFeedback(ctx context.Context, in *SubmitBidFeedbackRequest, opts ...grpc.CallOption) (*Bid, error)
+	// GET /bids/{tenderId}/reviews
+	ListBidReviews(ctx context.Context, in *ListBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error)
+	// WatchTenderBids streams the bid events of a tender, as
+	// GET /events/stream?tenderId={tenderId} does, with the bid each is
+	// about. Like that stream it follows the event log, sending the events
+	// the user may see when they are sent.
+	WatchTenderBids(ctx context.Context, in *WatchTenderBidsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BidUpdate], error)
+}
+
+type tenderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTenderServiceClient(cc grpc.ClientConnInterface) TenderServiceClient {
+	return &tenderServiceClient{cc}
+}
+
+func (c *tenderServiceClient) ListTenders(ctx context.Context, in *ListTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateTender(ctx context.Context, in *CreateTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_CreateTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListUserTenders(ctx context.Context, in *ListUserTendersRequest, opts ...grpc.CallOption) (*ListTendersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTendersResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListUserTenders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetTenderStatus(ctx context.Context, in *GetTenderStatusRequest, opts ...grpc.CallOption) (*GetTenderStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTenderStatusResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) UpdateTenderStatus(ctx context.Context, in *UpdateTenderStatusRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_UpdateTenderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) EditTender(ctx context.Context, in *EditTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_EditTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RollbackTender(ctx context.Context, in *RollbackTenderRequest, opts ...grpc.CallOption) (*Tender, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Tender)
+	err := c.cc.Invoke(ctx, TenderService_RollbackTender_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) CreateBid(ctx context.Context, in *CreateBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, TenderService_CreateBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListUserBids(ctx context.Context, in *ListUserBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListUserBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListTenderBids(ctx context.Context, in *ListTenderBidsRequest, opts ...grpc.CallOption) (*ListBidsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListTenderBids_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) GetBidStatus(ctx context.Context, in *GetBidStatusRequest, opts ...grpc.CallOption) (*GetBidStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBidStatusResponse)
+	err := c.cc.Invoke(ctx, TenderService_GetBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) UpdateBidStatus(ctx context.Context, in *UpdateBidStatusRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, TenderService_UpdateBidStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) EditBid(ctx context.Context, in *EditBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, TenderService_EditBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) RollbackBid(ctx context.Context, in *RollbackBidRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, TenderService_RollbackBid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) SubmitBidDecision(ctx context.Context, in *SubmitBidDecisionRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, TenderService_SubmitBidDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) SubmitBidFeedback(ctx context.Context, in *SubmitBidFeedbackRequest, opts ...grpc.CallOption) (*Bid, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Bid)
+	err := c.cc.Invoke(ctx, TenderService_SubmitBidFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) ListBidReviews(ctx context.Context, in *ListBidReviewsRequest, opts ...grpc.CallOption) (*ListBidReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBidReviewsResponse)
+	err := c.cc.Invoke(ctx, TenderService_ListBidReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenderServiceClient) WatchTenderBids(ctx context.Context, in *WatchTenderBidsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BidUpdate], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TenderService_ServiceDesc.Streams[0], TenderService_WatchTenderBids_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTenderBidsRequest, BidUpdate]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TenderService_WatchTenderBidsClient = grpc.ServerStreamingClient[BidUpdate]
+
+// TenderServiceServer is the server API for TenderService service.
+// All implementations must embed UnimplementedTenderServiceServer
+// for forward compatibility.
+//
+// TenderService is the gRPC API of the service. Every unary call is the
+// REST operation named in its comment, with the same rules, checks and
+// errors: a REST status is returned as the gRPC code it maps onto, with the
+// reason of the error response as the message.
+type TenderServiceServer interface {
+	// GET /tenders
+	ListTenders(context.Context, *ListTendersRequest) (*ListTendersResponse, error)
+	// POST /tenders/new
+	CreateTender(context.Context, *CreateTenderRequest) (*Tender, error)
+	// GET /tenders/my
+	ListUserTenders(context.Context, *ListUserTendersRequest) (*ListTendersResponse, error)
+	// GET /tenders/{tenderId}/status
+	GetTenderStatus(context.Context, *GetTenderStatusRequest) (*GetTenderStatusResponse, error)
+	// PUT /tenders/{tenderId}/status
+	UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*Tender, error)
+	// PATCH /tenders/{tenderId}/edit
+	EditTender(context.Context, *EditTenderRequest) (*Tender, error)
+	// PUT /tenders/{tenderId}/rollback/{version}
+	RollbackTender(context.Context, *RollbackTenderRequest) (*Tender, error)
+	// POST /bids/new
+	CreateBid(context.Context, *CreateBidRequest) (*Bid, error)
+	// GET /bids/my
+	ListUserBids(context.Context, *ListUserBidsRequest) (*ListBidsResponse, error)
+	// GET /bids/{tenderId}/list
+	ListTenderBids(context.Context, *ListTenderBidsRequest) (*ListBidsResponse, error)
+	// GET /bids/{bidId}/status
+	GetBidStatus(context.Context, *GetBidStatusRequest) (*GetBidStatusResponse, error)
+	// PUT /bids/{bidId}/status
+	UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*Bid, error)
+	// PATCH /bids/{bidId}/edit
+	EditBid(context.Context, *EditBidRequest) (*Bid, error)
+	// PUT /bids/{bidId}/rollback/{version}
+	RollbackBid(context.Context, *RollbackBidRequest) (*Bid, error)
+	// PUT /bids/{bidId}/submit_decision
+	SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*Bid, error)
+	// PUT /bids/{bidId}/feedback
+	SubmitBidFeedback(context.Context, *SubmitBidFeedbackRequest) (*Bid, error)
+	// GET /bids/{tenderId}/reviews
+	ListBidReviews(context.Context, *ListBidReviewsRequest) (*ListBidReviewsResponse, error)
+	// WatchTenderBids streams the bid events of a tender, as
+	// GET /events/stream?tenderId={tenderId} does, with the bid each is
+	// about. Like that stream it follows the event log, sending the events
+	// the user may see when they are sent.
+	WatchTenderBids(*WatchTenderBidsRequest, grpc.ServerStreamingServer[BidUpdate]) error
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+// UnimplementedTenderServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTenderServiceServer struct{}
+
+func (UnimplementedTenderServiceServer) ListTenders(context.Context, *ListTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateTender(context.Context, *CreateTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTender not implemented")
+}
+func (UnimplementedTenderServiceServer) ListUserTenders(context.Context, *ListUserTendersRequest) (*ListTendersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserTenders not implemented")
+}
+func (UnimplementedTenderServiceServer) GetTenderStatus(context.Context, *GetTenderStatusRequest) (*GetTenderStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) UpdateTenderStatus(context.Context, *UpdateTenderStatusRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTenderStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) EditTender(context.Context, *EditTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTender not implemented")
+}
+func (UnimplementedTenderServiceServer) RollbackTender(context.Context, *RollbackTenderRequest) (*Tender, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackTender not implemented")
+}
+func (UnimplementedTenderServiceServer) CreateBid(context.Context, *CreateBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBid not implemented")
+}
+func (UnimplementedTenderServiceServer) ListUserBids(context.Context, *ListUserBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserBids not implemented")
+}
+func (UnimplementedTenderServiceServer) ListTenderBids(context.Context, *ListTenderBidsRequest) (*ListBidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenderBids not implemented")
+}
+func (UnimplementedTenderServiceServer) GetBidStatus(context.Context, *GetBidStatusRequest) (*GetBidStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBidStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) UpdateBidStatus(context.Context, *UpdateBidStatusRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBidStatus not implemented")
+}
+func (UnimplementedTenderServiceServer) EditBid(context.Context, *EditBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditBid not implemented")
+}
+func (UnimplementedTenderServiceServer) RollbackBid(context.Context, *RollbackBidRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackBid not implemented")
+}
+func (UnimplementedTenderServiceServer) SubmitBidDecision(context.Context, *SubmitBidDecisionRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidDecision not implemented")
+}
+func (UnimplementedTenderServiceServer) SubmitBidFeedback(context.Context, *SubmitBidFeedbackRequest) (*Bid, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBidFeedback not implemented")
+}
+func (UnimplementedTenderServiceServer) ListBidReviews(context.Context, *ListBidReviewsRequest) (*ListBidReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBidReviews not implemented")
+}
+func (UnimplementedTenderServiceServer) WatchTenderBids(*WatchTenderBidsRequest, grpc.ServerStreamingServer[BidUpdate]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTenderBids not implemented")
+}
+func (UnimplementedTenderServiceServer) mustEmbedUnimplementedTenderServiceServer() {}
+func (UnimplementedTenderServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeTenderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TenderServiceServer will
+// result in compilation errors.
+type UnsafeTenderServiceServer interface {
+	mustEmbedUnimplementedTenderServiceServer()
+}
+
+func RegisterTenderServiceServer(s grpc.ServiceRegistrar, srv TenderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTenderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TenderService_ServiceDesc, srv)
+}
+
+func _TenderService_ListTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenders(ctx, req.(*ListTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateTender(ctx, req.(*CreateTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListUserTenders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserTendersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListUserTenders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListUserTenders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListUserTenders(ctx, req.(*ListUserTendersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetTenderStatus(ctx, req.(*GetTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_UpdateTenderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTenderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_UpdateTenderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).UpdateTenderStatus(ctx, req.(*UpdateTenderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_EditTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).EditTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_EditTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).EditTender(ctx, req.(*EditTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RollbackTender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackTenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RollbackTender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RollbackTender_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RollbackTender(ctx, req.(*RollbackTenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_CreateBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).CreateBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_CreateBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).CreateBid(ctx, req.(*CreateBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListUserBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListUserBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListUserBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListUserBids(ctx, req.(*ListUserBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListTenderBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTenderBidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListTenderBids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListTenderBids_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListTenderBids(ctx, req.(*ListTenderBidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_GetBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).GetBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_GetBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).GetBidStatus(ctx, req.(*GetBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_UpdateBidStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBidStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).UpdateBidStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_UpdateBidStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).UpdateBidStatus(ctx, req.(*UpdateBidStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_EditBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).EditBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_EditBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).EditBid(ctx, req.(*EditBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_RollbackBid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackBidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).RollbackBid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_RollbackBid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).RollbackBid(ctx, req.(*RollbackBidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_SubmitBidDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidDecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).SubmitBidDecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_SubmitBidDecision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).SubmitBidDecision(ctx, req.(*SubmitBidDecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_SubmitBidFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBidFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).SubmitBidFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_SubmitBidFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).SubmitBidFeedback(ctx, req.(*SubmitBidFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_ListBidReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBidReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenderServiceServer).ListBidReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenderService_ListBidReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenderServiceServer).ListBidReviews(ctx, req.(*ListBidReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenderService_WatchTenderBids_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTenderBidsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TenderServiceServer).WatchTenderBids(m, &grpc.GenericServerStream[WatchTenderBidsRequest, BidUpdate]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TenderService_WatchTenderBidsServer = grpc.ServerStreamingServer[BidUpdate]
+
+// TenderService_ServiceDesc is the grpc.ServiceDesc for TenderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TenderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "tender.v1.TenderService",
+	HandlerType: (*TenderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListTenders",
+			Handler:    _TenderService_ListTenders_Handler,
+		},
+		{
+			MethodName: "CreateTender",
+			Handler:    _TenderService_CreateTender_Handler,
+		},
+		{
+			MethodName: "ListUserTenders",
+			Handler:    _TenderService_ListUserTenders_Handler,
+		},
+		{
+			MethodName: "GetTenderStatus",
+			Handler:    _TenderService_GetTenderStatus_Handler,
+		},
+		{
+			MethodName: "UpdateTenderStatus",
+			Handler:    _TenderService_UpdateTenderStatus_Handler,
+		},
+		{
+			MethodName: "EditTender",
+			Handler:    _TenderService_EditTender_Handler,
+		},
+		{
+			MethodName: "RollbackTender",
+			Handler:    _TenderService_RollbackTender_Handler,
+		},
+		{
+			MethodName: "CreateBid",
+			Handler:    _TenderService_CreateBid_Handler,
+		},
+		{
+			MethodName: "ListUserBids",
+			Handler:    _TenderService_ListUserBids_Handler,
+		},
+		{
+			MethodName: "ListTenderBids",
+			Handler:    _TenderService_ListTenderBids_Handler,
+		},
+		{
+			MethodName: "GetBidStatus",
+			Handler:    _TenderService_GetBidStatus_Handler,
+		},
+		{
+			MethodName: "UpdateBidStatus",
+			Handler:    _TenderService_UpdateBidStatus_Handler,
+		},
+		{
+			MethodName: "EditBid",
+			Handler:    _TenderService_EditBid_Handler,
+		},
+		{
+			MethodName: "RollbackBid",
+			Handler:    _TenderService_RollbackBid_Handler,
+		},
+		{
+			MethodName: "SubmitBidDecision",
+			Handler:    _TenderService_SubmitBidDecision_Handler,
+		},
+		{
+			MethodName: "SubmitBidFeedback",
+			Handler:    _TenderService_SubmitBidFeedback_Handler,
+		},
+		{
+			MethodName: "ListBidReviews",
+			Handler:    _TenderService_ListBidReviews_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTenderBids",
+			Handler:       _TenderService_WatchTenderBids_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "tender.proto",
+}
@@ -1,28 +1,11 @@
 package api
 
-type CreateAccountRequest struct {
-	name string `json:"name"`
-}
+import "time"
 
-type Account struct {
-	ID   int    `json:"id"`
-	name string `json:"name"`
-}
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config oapi-codegen.yaml ../задание/openapi.yml
 
-type Tender struct {
-	Id              string `json:"id"`
-	Name            string `json:"name"`
-	Description     string `json:"description"`
-	ServiceType     string `json:"serviceType"`
-	Status          string `json:"status"`
-	OrganizationID  string `json:"organizationId"`
-	CreatorUsername string `json:"creatorUsername"`
-}
-
-type TenderUpdate struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-}
+// Tender, Bid, BidReview, ErrorResponse and the request bodies and params
+// are generated from задание/openapi.yml into openapi.gen.go.
 
 type User struct {
 	Id        string `json:"id"`
@@ -31,23 +14,146 @@ type User struct {
 	LastName  string `json:"last_name"`
 }
 
-type Bid struct {
-	Id              string `json:"id"`
-	Name            string `json:"name"`
-	Description     string `json:"description"`
-	Status          string `json:"status"`
-	TenderId        string `json:"tenderId"`
-	OrganizationId  string `json:"organizationId"`
-	CreatorUsername string `json:"creatorUsername"`
+// Organization is an organization employees answer for.
+type Organization struct {
+	Id   string
+	Name string
+}
+
+// TenderFilter narrows and orders a tender list. Empty fields don't filter;
+// the list is ordered by name unless SortBy says otherwise. Viewer is the
+// username the list of all tenders is shown to: private tenders are listed
+// only to those who can see them.
+type TenderFilter struct {
+	Viewer       string
+	ServiceTypes []TenderServiceType
+	Currency     Currency
+	MinBudget    Money
+	MaxBudget    Money
+	SortBy       TenderSortBy
+	SortOrder    SortOrder
+}
+
+// QuestionFilter selects the questions on a tender shown to a viewer. All
+// shows every question; otherwise answered questions are shown along with
+// those asked by Username or on behalf of OrganizationId.
+type QuestionFilter struct {
+	All            bool
+	Username       string
+	OrganizationId string
+}
+
+// SearchFilter limits a full-text search to what Viewer may see, empty for
+// anonymous requests, and to the optional Status, ServiceTypes,
+// OrganizationId and TenderId.
+type SearchFilter struct {
+	Viewer         string
+	Status         TenderStatus
+	ServiceTypes   []TenderServiceType
+	OrganizationId string
+	TenderId       string
+}
+
+// BidFilter narrows and orders a bid list, like TenderFilter.
+type BidFilter struct {
+	Currency        Currency
+	MinPrice        Money
+	MaxPrice        Money
+	MaxDeliveryDays int32
+	SortBy          BidSortBy
+	SortOrder       SortOrder
+}
+
+// ActivityCounts sums up what responsibles did with a bid, or with every
+// bid on a tender: decisions on it or on its lots and reviews left.
+type ActivityCounts struct {
+	Approvals  int
+	Rejections int
+	Reviews    int
+}
+
+// DecisionRecord is a decision a responsible made on a bid, or on one of
+// its lots when LotId is set.
+type DecisionRecord struct {
+	BidId     string
+	LotId     string
+	Username  string
+	Decision  BidDecision
+	CreatedAt time.Time
+}
+
+// ReviewRecord is feedback a responsible left on a bid, with the optional
+// star rating and whether the bid was delivered on time.
+type ReviewRecord struct {
+	Id        string
+	BidId     string
+	Username  string
+	Comment   string
+	Rating    *BidRating
+	OnTime    *BidOnTime
+	CreatedAt time.Time
+}
+
+// DueDelivery is a webhook delivery claimed for an attempt, along with the
+// payload as stored, the webhook URL and the secret to sign it with.
+type DueDelivery struct {
+	Delivery WebhookDelivery
+	Body     []byte
+	URL      string
+	Secret   string
+}
+
+// DeliveryAttempt is the outcome of an attempt to deliver a webhook or a
+// notification, whose ResponseStatus is the SMTP reply code. A failed
+// attempt is retried at NextAttemptAt, or dead-lettered when nil.
+type DeliveryAttempt struct {
+	Delivered      bool
+	ResponseStatus int32
+	Error          string
+	NextAttemptAt  *time.Time
+}
+
+// LoggedEvent is an outbox event as kept in the event log clients stream,
+// along with the tender it concerns and the organization owning it. Seq
+// numbers the log in the order the events were relayed.
+type LoggedEvent struct {
+	Seq            int64
+	Event          OutboxEvent
+	TenderId       string
+	OrganizationId string
+}
+
+// EventLogFilter narrows the event log to the events of a tender, of the
+// tenders of an organization and of some types. Empty fields don't filter.
+type EventLogFilter struct {
+	TenderId       string
+	OrganizationId string
+	Types          []EventType
 }
 
-type Review struct {
-	CreatorUsername string `json:"creatorUsername"`
-	Comment         string `json:"comment"`
+// Notification is an email to a user about an event, rendered in the
+// user's language when it is queued. EventId is the outbox event it tells
+// about: a user gets one notification of each kind per event, however often
+// the event is relayed.
+type Notification struct {
+	Id       string
+	EventId  int64
+	Event    NotificationEvent
+	Username string
+	Email    string
+	Subject  string
+	Body     string
+	Attempts int32
 }
 
-func NewAccount(name string) *Account {
-	return &Account{
-		name: name,
-	}
+// AuditFilter narrows the audit log to the entries concerning an
+// organization, and optionally to an entity, an actor and the time range
+// [From, To). Empty fields don't filter.
+type AuditFilter struct {
+	OrganizationId string
+	EntityType     AuditEntityType
+	EntityId       string
+	Actor          string
+	From           *time.Time
+	To             *time.Time
 }
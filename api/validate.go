@@ -0,0 +1,119 @@
+package api
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"net/http"
+	"strings"
+)
+
+// The validator decodes the parts of multipart bodies by their media type,
+// so it has to know the types of attachments. To it they are opaque files,
+// as are JSONL import files, which the import handlers check row by row.
+func init() {
+	for contentType := range attachmentTypes {
+		if openapi3filter.RegisteredBodyDecoder(contentType) == nil {
+			openapi3filter.RegisterBodyDecoder(contentType, openapi3filter.FileBodyDecoder)
+		}
+	}
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.FileBodyDecoder)
+}
+
+// maxRequestSize caps the body of requests to operations missing from
+// bodyLimits.
+const maxRequestSize = 1 << 20
+
+// bodyLimits caps the body of the operations taking files. The embedded
+// spec names operations like the ServerInterface methods.
+var bodyLimits = map[string]int64{
+	"UploadTenderAttachment": maxUploadSize,
+	"UploadBidAttachment":    maxUploadSize,
+	"ImportTenders":          maxImportSize,
+	"ImportBids":             maxImportSize,
+}
+
+// newRequestValidator checks every request against the embedded OpenAPI
+// spec (enums, length limits, required fields and parameters) before it
+// reaches a handler. Requests to routes the spec doesn't describe pass
+// through unchecked. The validator reads bodies whole, so they are capped
+// first, by the limit of the operation.
+func newRequestValidator() (func(http.Handler) http.Handler, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openapi spec: %w", err)
+	}
+	// Match on the path only, whatever host the server is reached at.
+	swagger.Servers = openapi3.Servers{{URL: "/api"}}
+
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build openapi router: %w", err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			limit := int64(maxRequestSize)
+			if err == nil {
+				limit = cmp.Or(bodyLimits[route.Operation.OperationID], limit)
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+				},
+			}
+			err = openapi3filter.ValidateRequest(r.Context(), input)
+			var tooLarge *http.MaxBytesError
+			switch {
+			case errors.As(err, &tooLarge):
+				WriteJSON(w, http.StatusBadRequest, ErrorResponse{
+					Reason: fmt.Sprintf("request body is larger than %d bytes", tooLarge.Limit),
+				})
+				return
+			case err != nil:
+				WriteJSON(w, http.StatusBadRequest, ErrorResponse{Reason: validationReason(err)})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func validationReason(err error) string {
+	reason := err.Error()
+
+	var schemaErr *openapi3.SchemaError
+	if errors.As(err, &schemaErr) {
+		reason = schemaErr.Reason
+		if path := schemaErr.JSONPointer(); len(path) > 0 {
+			reason = fmt.Sprintf("%s: %s", strings.Join(path, "."), reason)
+		}
+	}
+
+	var requestErr *openapi3filter.RequestError
+	var routeErr *routers.RouteError
+	switch {
+	case errors.As(err, &requestErr) && requestErr.Parameter != nil:
+		return fmt.Sprintf("invalid parameter %q: %s", requestErr.Parameter.Name, reason)
+	case errors.As(err, &requestErr) && requestErr.RequestBody != nil:
+		return fmt.Sprintf("invalid request body: %s", reason)
+	case errors.As(err, &routeErr):
+		return routeErr.Reason
+	}
+	return reason
+}
@@ -0,0 +1,301 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+)
+
+func (a *APIServer) createWebhook(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params CreateWebhookParams) error {
+	var req CreateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return httpError(http.StatusBadRequest, "Invalid request payload: %v", err)
+	}
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return httpError(http.StatusBadRequest, "webhook url %q is not an http or https url", req.Url)
+	}
+
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+	if err := a.requireResponsible(user, organizationId); err != nil {
+		return err
+	}
+	if err := checkWebhookHost(r.Context(), u.Hostname()); err != nil {
+		return err
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	secret := hex.EncodeToString(key)
+
+	webhook := &Webhook{OrganizationId: organizationId, Url: req.Url, Events: req.Events}
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if webhook, err = tx.store.CreateWebhook(webhook, secret); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, user.Username, AuditActionCreateWebhook, AuditEntityTypeWebhook, webhook.Id,
+			[]string{organizationId}, nil, webhook)
+	})
+	if err != nil {
+		return err
+	}
+	// The secret is shown once, when the webhook is created.
+	webhook.Secret = &secret
+
+	return WriteJSON(w, http.StatusOK, webhook)
+}
+
+func (a *APIServer) getOrganizationWebhooks(w http.ResponseWriter, r *http.Request, organizationId OrganizationId, params GetOrganizationWebhooksParams) error {
+	user, err := a.authenticate(params.Username)
+	if err != nil {
+		return err
+	}
+	if err := a.requireResponsible(user, organizationId); err != nil {
+		return err
+	}
+
+	webhooks, err := a.store.GetOrganizationWebhooks(organizationId)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, webhooks)
+}
+
+func (a *APIServer) deleteWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params DeleteWebhookParams) error {
+	webhook, err := a.requireWebhookResponsible(params.Username, webhookId)
+	if err != nil {
+		return err
+	}
+
+	err = a.atomically(func(tx *APIServer) error {
+		if err := tx.store.DeleteWebhook(webhookId); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, params.Username, AuditActionDeleteWebhook, AuditEntityTypeWebhook, webhook.Id,
+			[]string{webhook.OrganizationId}, webhook, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, webhook)
+}
+
+func (a *APIServer) pingWebhook(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params PingWebhookParams) error {
+	webhook, err := a.requireWebhookResponsible(params.Username, webhookId)
+	if err != nil {
+		return err
+	}
+
+	payload, err := webhookPayload(WebhookEventWebhookPing, webhook)
+	if err != nil {
+		return err
+	}
+	var delivery *WebhookDelivery
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if delivery, err = tx.store.CreateWebhookDelivery(webhookId, WebhookEventWebhookPing, payload); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, params.Username, AuditActionPingWebhook, AuditEntityTypeWebhookDelivery, delivery.Id,
+			[]string{webhook.OrganizationId}, nil, delivery)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, delivery)
+}
+
+func (a *APIServer) getWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookId WebhookId, params GetWebhookDeliveriesParams) error {
+	if _, err := a.requireWebhookResponsible(params.Username, webhookId); err != nil {
+		return err
+	}
+
+	limit, offset := pagination(params.Limit, params.Offset)
+	deliveries, err := a.store.GetWebhookDeliveries(webhookId, deref(params.Status), limit, offset)
+	if err != nil {
+		return storageError(err)
+	}
+
+	return WriteJSON(w, http.StatusOK, deliveries)
+}
+
+func (a *APIServer) redeliverWebhookDelivery(w http.ResponseWriter, r *http.Request, deliveryId WebhookDeliveryId, params RedeliverWebhookDeliveryParams) error {
+	delivery, err := a.store.GetWebhookDeliveryById(deliveryId)
+	if err != nil {
+		return storageError(err)
+	}
+	webhook, err := a.requireWebhookResponsible(params.Username, delivery.WebhookId)
+	if err != nil {
+		return err
+	}
+
+	current := delivery
+	err = a.atomically(func(tx *APIServer) error {
+		var err error
+		if delivery, err = tx.store.RedeliverWebhookDelivery(deliveryId); err != nil {
+			return storageError(err)
+		}
+		return tx.audit(r, params.Username, AuditActionRedeliverWebhookDelivery, AuditEntityTypeWebhookDelivery, delivery.Id,
+			[]string{webhook.OrganizationId}, current, delivery)
+	})
+	if err != nil {
+		return err
+	}
+
+	return WriteJSON(w, http.StatusOK, delivery)
+}
+
+// nonPublicPrefixes are the ranges publicAddress rejects beyond those
+// netip.Addr tells apart: "this network" and the carrier-grade NAT space,
+// where some clouds put their metadata service.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// publicAddress reports whether webhooks may be sent to addr: not to
+// loopback, private or link-local addresses, which include the cloud
+// metadata services, lest a webhook probe the network of the service.
+func publicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	return !slices.ContainsFunc(nonPublicPrefixes, func(p netip.Prefix) bool { return p.Contains(addr) })
+}
+
+// privateWebhooksAllowed reports whether WEBHOOK_ALLOW_PRIVATE lifts the
+// restriction to public addresses, for receivers on a private network.
+func privateWebhooksAllowed() bool {
+	return os.Getenv("WEBHOOK_ALLOW_PRIVATE") == "true"
+}
+
+// checkWebhookHost checks that every address host resolves to is public.
+// The dispatcher checks the address it dials again, as the host may
+// resolve elsewhere by then.
+func checkWebhookHost(ctx context.Context, host string) error {
+	if privateWebhooksAllowed() {
+		return nil
+	}
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return httpError(http.StatusBadRequest, "webhook host %q can't be resolved", host)
+	}
+	for _, addr := range addrs {
+		if !publicAddress(addr) {
+			return httpError(http.StatusBadRequest, "webhook host %q resolves to %s, which is not a public address", host, addr.Unmap())
+		}
+	}
+	return nil
+}
+
+// requireWebhookResponsible checks that username is responsible for the
+// organization owning the webhook.
+func (a *APIServer) requireWebhookResponsible(username, webhookId string) (*Webhook, error) {
+	user, err := a.authenticate(username)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := a.store.GetWebhookById(webhookId)
+	if err != nil {
+		return nil, storageError(err)
+	}
+
+	if err := a.requireResponsible(user, webhook.OrganizationId); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// webhookEnvelope is the body a webhook delivery is sent with, the
+// webhookPayload of the spec with data left as is.
+type webhookEnvelope struct {
+	EventId    *int64       `json:"eventId,omitempty"`
+	Event      WebhookEvent `json:"event"`
+	OccurredAt string       `json:"occurredAt"`
+	Data       any          `json:"data"`
+}
+
+func webhookPayload(event WebhookEvent, data any) ([]byte, error) {
+	return json.Marshal(webhookEnvelope{Event: event, OccurredAt: time.Now().UTC().Format(time.RFC3339), Data: data})
+}
+
+// WebhookSink queues the outbox events organizations can subscribe to as
+// deliveries to their webhooks: tender events go to the organization owning
+// the tender, bid events to it and to the one the bid was made on behalf of.
+type WebhookSink struct {
+	store Storage
+}
+
+func NewWebhookSink(store Storage) *WebhookSink {
+	return &WebhookSink{store: store}
+}
+
+func (ws *WebhookSink) Publish(ctx context.Context, e OutboxEvent) error {
+	event := WebhookEvent(e.Type)
+	switch event {
+	case WebhookEventTenderPublished, WebhookEventTenderClosed, WebhookEventBidCreated,
+		WebhookEventBidDecision, WebhookEventFeedbackCreated:
+	default:
+		return nil
+	}
+
+	organizationIds, err := ws.organizations(e)
+	if errors.Is(err, ErrTenderNotFound) || errors.Is(err, ErrBidNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(organizationIds) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(webhookEnvelope{EventId: &e.Id, Event: event,
+		OccurredAt: e.CreatedAt.UTC().Format(time.RFC3339), Data: e.Payload})
+	if err != nil {
+		return err
+	}
+	return ws.store.EnqueueWebhookEvent(e.Id, event, organizationIds, payload)
+}
+
+// organizations returns the organizations notified of the event.
+func (ws *WebhookSink) organizations(e OutboxEvent) ([]string, error) {
+	tender, bid, err := eventSubject(ws.store, e)
+	if err != nil {
+		return nil, err
+	}
+
+	organizationIds := []string{tender.OrganizationId}
+	if bid != nil && bid.AuthorType == BidAuthorTypeOrganization {
+		org, err := ws.store.GetUserOrganization(bid.AuthorId)
+		if err != nil {
+			log.Printf("webhooks: failed to read organization of bid %s: %v", bid.Id, err)
+		}
+		organizationIds = append(organizationIds, org)
+	}
+
+	organizationIds = slices.DeleteFunc(organizationIds, func(id string) bool { return id == "" })
+	slices.Sort(organizationIds)
+	return slices.Compact(organizationIds), nil
+}
@@ -0,0 +1,12555 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for AuditAction.
+const (
+	AuditActionAnswerTenderQuestion       AuditAction = "answerTenderQuestion"
+	AuditActionAskTenderQuestion          AuditAction = "askTenderQuestion"
+	AuditActionCancelTenderLot            AuditAction = "cancelTenderLot"
+	AuditActionCloseTender                AuditAction = "closeTender"
+	AuditActionCreateBid                  AuditAction = "createBid"
+	AuditActionCreateTender               AuditAction = "createTender"
+	AuditActionCreateTenderInvitation     AuditAction = "createTenderInvitation"
+	AuditActionCreateWebhook              AuditAction = "createWebhook"
+	AuditActionDeleteWebhook              AuditAction = "deleteWebhook"
+	AuditActionEditBid                    AuditAction = "editBid"
+	AuditActionEditTender                 AuditAction = "editTender"
+	AuditActionPingWebhook                AuditAction = "pingWebhook"
+	AuditActionPlaceAuctionBid            AuditAction = "placeAuctionBid"
+	AuditActionPublishTender              AuditAction = "publishTender"
+	AuditActionRedeliverWebhookDelivery   AuditAction = "redeliverWebhookDelivery"
+	AuditActionRespondTenderInvitation    AuditAction = "respondTenderInvitation"
+	AuditActionRevealTenderBids           AuditAction = "revealTenderBids"
+	AuditActionRollbackBid                AuditAction = "rollbackBid"
+	AuditActionRollbackTender             AuditAction = "rollbackTender"
+	AuditActionSetNotificationPreferences AuditAction = "setNotificationPreferences"
+	AuditActionSubmitBidDecision          AuditAction = "submitBidDecision"
+	AuditActionSubmitBidFeedback          AuditAction = "submitBidFeedback"
+	AuditActionSubmitBidScores            AuditAction = "submitBidScores"
+	AuditActionUpdateBidStatus            AuditAction = "updateBidStatus"
+	AuditActionUpdateTenderStatus         AuditAction = "updateTenderStatus"
+	AuditActionUploadBidAttachment        AuditAction = "uploadBidAttachment"
+	AuditActionUploadTenderAttachment     AuditAction = "uploadTenderAttachment"
+)
+
+// Defines values for AuditEntityType.
+const (
+	AuditEntityTypeAttachment              AuditEntityType = "attachment"
+	AuditEntityTypeBid                     AuditEntityType = "bid"
+	AuditEntityTypeInvitation              AuditEntityType = "invitation"
+	AuditEntityTypeNotificationPreferences AuditEntityType = "notificationPreferences"
+	AuditEntityTypeQuestion                AuditEntityType = "question"
+	AuditEntityTypeTender                  AuditEntityType = "tender"
+	AuditEntityTypeWebhook                 AuditEntityType = "webhook"
+	AuditEntityTypeWebhookDelivery         AuditEntityType = "webhookDelivery"
+)
+
+// Defines values for BidAuthorType.
+const (
+	BidAuthorTypeOrganization BidAuthorType = "Organization"
+	BidAuthorTypeUser         BidAuthorType = "User"
+)
+
+// Defines values for BidDecision.
+const (
+	BidDecisionApproved BidDecision = "Approved"
+	BidDecisionRejected BidDecision = "Rejected"
+)
+
+// Defines values for BidSortBy.
+const (
+	BidSortByDeliveryDays BidSortBy = "deliveryDays"
+	BidSortByName         BidSortBy = "name"
+	BidSortByPrice        BidSortBy = "price"
+)
+
+// Defines values for BidStatus.
+const (
+	BidStatusCanceled  BidStatus = "Canceled"
+	BidStatusCreated   BidStatus = "Created"
+	BidStatusPublished BidStatus = "Published"
+)
+
+// Defines values for EvaluationCriterionKind.
+const (
+	EvaluationCriterionKindCustom       EvaluationCriterionKind = "custom"
+	EvaluationCriterionKindDeliveryTime EvaluationCriterionKind = "deliveryTime"
+	EvaluationCriterionKindPrice        EvaluationCriterionKind = "price"
+	EvaluationCriterionKindWarranty     EvaluationCriterionKind = "warranty"
+)
+
+// Defines values for EventAggregateType.
+const (
+	EventAggregateTypeBid    EventAggregateType = "bid"
+	EventAggregateTypeTender EventAggregateType = "tender"
+)
+
+// Defines values for EventType.
+const (
+	EventTypeBidCanceled     EventType = "bid.canceled"
+	EventTypeBidCreated      EventType = "bid.created"
+	EventTypeBidDecision     EventType = "bid.decision"
+	EventTypeBidPublished    EventType = "bid.published"
+	EventTypeBidUpdated      EventType = "bid.updated"
+	EventTypeFeedbackCreated EventType = "feedback.created"
+	EventTypeTenderClosed    EventType = "tender.closed"
+	EventTypeTenderCreated   EventType = "tender.created"
+	EventTypeTenderPublished EventType = "tender.published"
+	EventTypeTenderUpdated   EventType = "tender.updated"
+)
+
+// Defines values for ExportFormat.
+const (
+	ExportFormatCsv  ExportFormat = "csv"
+	ExportFormatXlsx ExportFormat = "xlsx"
+)
+
+// Defines values for ImportMode.
+const (
+	ImportModeAtomic ImportMode = "atomic"
+	ImportModePerRow ImportMode = "perRow"
+)
+
+// Defines values for ImportReportFormat.
+const (
+	ImportReportFormatCsv  ImportReportFormat = "csv"
+	ImportReportFormatJson ImportReportFormat = "json"
+)
+
+// Defines values for ImportRowStatus.
+const (
+	ImportRowStatusCreated ImportRowStatus = "created"
+	ImportRowStatusFailed  ImportRowStatus = "failed"
+	ImportRowStatusValid   ImportRowStatus = "valid"
+)
+
+// Defines values for InvitationResponse.
+const (
+	InvitationResponseAccepted InvitationResponse = "Accepted"
+	InvitationResponseDeclined InvitationResponse = "Declined"
+)
+
+// Defines values for InvitationStatus.
+const (
+	InvitationStatusAccepted InvitationStatus = "Accepted"
+	InvitationStatusDeclined InvitationStatus = "Declined"
+	InvitationStatusPending  InvitationStatus = "Pending"
+)
+
+// Defines values for NotificationEvent.
+const (
+	NotificationEventBidApproved      NotificationEvent = "bid.approved"
+	NotificationEventBidRejected      NotificationEvent = "bid.rejected"
+	NotificationEventBidSubmitted     NotificationEvent = "bid.submitted"
+	NotificationEventDecisionRequired NotificationEvent = "decision.required"
+	NotificationEventFeedbackCreated  NotificationEvent = "feedback.created"
+)
+
+// Defines values for NotificationLanguage.
+const (
+	NotificationLanguageEn NotificationLanguage = "en"
+	NotificationLanguageRu NotificationLanguage = "ru"
+)
+
+// Defines values for QuestionStatus.
+const (
+	QuestionStatusAnswered QuestionStatus = "Answered"
+	QuestionStatusOpen     QuestionStatus = "Open"
+)
+
+// Defines values for SortOrder.
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// Defines values for TenderLotStatus.
+const (
+	TenderLotStatusAwarded  TenderLotStatus = "Awarded"
+	TenderLotStatusCanceled TenderLotStatus = "Canceled"
+	TenderLotStatusOpen     TenderLotStatus = "Open"
+)
+
+// Defines values for TenderServiceType.
+const (
+	TenderServiceTypeConstruction TenderServiceType = "Construction"
+	TenderServiceTypeDelivery     TenderServiceType = "Delivery"
+	TenderServiceTypeManufacture  TenderServiceType = "Manufacture"
+)
+
+// Defines values for TenderSortBy.
+const (
+	TenderSortByBudgetMax TenderSortBy = "budgetMax"
+	TenderSortByBudgetMin TenderSortBy = "budgetMin"
+	TenderSortByName      TenderSortBy = "name"
+)
+
+// Defines values for TenderStatus.
+const (
+	TenderStatusClosed    TenderStatus = "Closed"
+	TenderStatusCreated   TenderStatus = "Created"
+	TenderStatusPublished TenderStatus = "Published"
+)
+
+// Defines values for TenderVisibility.
+const (
+	TenderVisibilityPrivate TenderVisibility = "private"
+	TenderVisibilityPublic  TenderVisibility = "public"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "Dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "Delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "Pending"
+)
+
+// Defines values for WebhookEvent.
+const (
+	WebhookEventBidCreated      WebhookEvent = "bid.created"
+	WebhookEventBidDecision     WebhookEvent = "bid.decision"
+	WebhookEventFeedbackCreated WebhookEvent = "feedback.created"
+	WebhookEventTenderClosed    WebhookEvent = "tender.closed"
+	WebhookEventTenderPublished WebhookEvent = "tender.published"
+	WebhookEventWebhookPing     WebhookEvent = "webhook.ping"
+)
+
+// Attachment Файл, прикрепленный к версии тендера или предложения.
+type Attachment struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId *BidId `json:"bidId,omitempty"`
+
+	// ContentType MIME-тип файла
+	ContentType string `json:"contentType"`
+
+	// CreatedAt Серверная дата и время загрузки.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Id Уникальный идентификатор вложения, присвоенный сервером.
+	Id AttachmentId `json:"id"`
+
+	// Name Имя файла
+	Name string `json:"name"`
+
+	// Sha256 Контрольная сумма SHA-256 файла в шестнадцатеричном виде
+	Sha256 string `json:"sha256"`
+
+	// Size Размер файла в байтах
+	Size int64 `json:"size"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId *TenderId `json:"tenderId,omitempty"`
+
+	// UploaderUsername Уникальный slug пользователя.
+	UploaderUsername Username `json:"uploaderUsername"`
+
+	// Version Версия тендера или предложения, к которой прикреплен файл.
+	Version int32 `json:"version"`
+}
+
+// AttachmentId Уникальный идентификатор вложения, присвоенный сервером.
+type AttachmentId = string
+
+// AuctionLeaderboard Таблица лидеров аукциона, лучшая (наименьшая) цена первой.
+type AuctionLeaderboard struct {
+	// EndAt Текущее время окончания аукциона с учетом продлений.
+	EndAt   time.Time                 `json:"endAt"`
+	Entries []AuctionLeaderboardEntry `json:"entries"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// AuctionLeaderboardEntry defines model for auctionLeaderboardEntry.
+type AuctionLeaderboardEntry struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// Own Предложение принадлежит запросившему пользователю.
+	Own bool `json:"own"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price Money `json:"price"`
+	Rank  int32 `json:"rank"`
+}
+
+// AuditAction Изменение, записанное в журнал аудита, — идентификатор операции API, которой оно сделано.
+// Изменения, которые делает планировщик, записываются от имени `scheduler` без `requestId` и `ip`:
+// публикация тендера (`publishTender`), закрытие по сроку (`closeTender`) и раскрытие закрытых предложений (`revealTenderBids`).
+// Если закрытые предложения раскрываются при чтении, до планировщика, раскрытие записывается от имени `system`;
+// в `after` поле `revealTrigger` говорит, чем оно вызвано: `deadline` — истек срок подачи, `closed` — тендер закрыт.
+type AuditAction string
+
+// AuditEntityType Тип измененного объекта.
+type AuditEntityType string
+
+// AuditEntry Запись журнала аудита.
+//
+// Хеш записи — SHA-256 в шестнадцатеричном виде от JSON-объекта с полями `prevHash`, `actor`, `action`,
+// `entityType`, `entityId`, `organizationIds`, `before`, `after`, `requestId`, `ip` и `createdAt`
+// в этом порядке, где `before` и `after` записаны с ключами объектов, упорядоченными по алфавиту.
+type AuditEntry struct {
+	// Action Изменение, записанное в журнал аудита, — идентификатор операции API, которой оно сделано.
+	// Изменения, которые делает планировщик, записываются от имени `scheduler` без `requestId` и `ip`:
+	// публикация тендера (`publishTender`), закрытие по сроку (`closeTender`) и раскрытие закрытых предложений (`revealTenderBids`).
+	// Если закрытые предложения раскрываются при чтении, до планировщика, раскрытие записывается от имени `system`;
+	// в `after` поле `revealTrigger` говорит, чем оно вызвано: `deadline` — истек срок подачи, `closed` — тендер закрыт.
+	Action AuditAction `json:"action"`
+
+	// Actor Уникальный slug пользователя.
+	Actor Username `json:"actor"`
+
+	// After Объект после изменения; отсутствует, если объект удален.
+	After *map[string]interface{} `json:"after"`
+
+	// Before Объект до изменения; отсутствует, если объект создан. Содержимое предложения на закрытый тендер
+	// до вскрытия не записывается, как и имя, тип, размер и контрольная сумма приложенных к нему файлов.
+	Before *map[string]interface{} `json:"before"`
+
+	// CreatedAt Серверная дата и время изменения.
+	// Передается в формате RFC3339 с долями секунды.
+	CreatedAt string `json:"createdAt"`
+
+	// EntityId Идентификатор измененного объекта; для настроек уведомлений — имя пользователя.
+	EntityId string `json:"entityId"`
+
+	// EntityType Тип измененного объекта.
+	EntityType AuditEntityType `json:"entityType"`
+
+	// Hash Хеш записи.
+	Hash string `json:"hash"`
+
+	// Id Номер записи в журнале.
+	Id int64 `json:"id"`
+
+	// Ip Адрес, с которого пришел запрос.
+	Ip string `json:"ip"`
+
+	// OrganizationIds Организации, к которым относится изменение.
+	OrganizationIds []OrganizationId `json:"organizationIds"`
+
+	// PrevHash Хеш предыдущей записи журнала; у первой записи — 64 нуля.
+	PrevHash string `json:"prevHash"`
+
+	// RequestId Идентификатор запроса из заголовка `X-Request-ID`.
+	RequestId string `json:"requestId"`
+}
+
+// AuditVerification Результат проверки журнала аудита.
+type AuditVerification struct {
+	// BrokenAt Номер первой записи, на которой цепочка нарушена.
+	BrokenAt *int64 `json:"brokenAt,omitempty"`
+
+	// Checked Сколько записей проверено.
+	Checked int64 `json:"checked"`
+
+	// Valid Цепочка хешей не нарушена.
+	Valid bool `json:"valid"`
+}
+
+// AuthorReputation Репутация сотрудника.
+type AuthorReputation struct {
+	// Reputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+	Reputation Reputation `json:"reputation"`
+
+	// Username Уникальный slug пользователя.
+	Username Username `json:"username"`
+}
+
+// Bid Информация о предложении
+type Bid struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorReputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+	AuthorReputation *Reputation `json:"authorReputation,omitempty"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил предложение на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Currency Код валюты по ISO 4217.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// DeliveryDays Срок выполнения предложения в днях.
+	DeliveryDays *BidDeliveryDays `json:"deliveryDays,omitempty"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// Id Уникальный идентификатор предложения, присвоенный сервером.
+	Id BidId `json:"id"`
+
+	// LotIds Лоты тендера, на которые подано предложение. Обязательны для тендеров с лотами.
+	LotIds *BidLotIds `json:"lotIds,omitempty"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price *Money `json:"price,omitempty"`
+
+	// Status Статус предложения
+	Status BidStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Version Номер версии посел правок
+	Version BidVersion `json:"version"`
+}
+
+// BidAuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+type BidAuthorId = string
+
+// BidAuthorType Тип автора
+type BidAuthorType string
+
+// BidDecision Решение по предложению
+type BidDecision string
+
+// BidDeliveryDays Срок выполнения предложения в днях.
+type BidDeliveryDays = int32
+
+// BidDescription Описание предложения
+type BidDescription = string
+
+// BidFeedback Отзыв на предложение
+type BidFeedback = string
+
+// BidId Уникальный идентификатор предложения, присвоенный сервером.
+type BidId = string
+
+// BidLotIds Лоты тендера, на которые подано предложение. Обязательны для тендеров с лотами.
+type BidLotIds = []LotId
+
+// BidName Полное название предложения
+type BidName = string
+
+// BidOnTime Выполнено ли предложение в срок.
+type BidOnTime = bool
+
+// BidRating Оценка предложения в звездах.
+type BidRating = int32
+
+// BidReview Отзыв о предложении
+type BidReview struct {
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил отзыв на предложение.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Description Описание предложения
+	Description BidReviewDescription `json:"description"`
+
+	// Id Уникальный идентификатор отзыва, присвоенный сервером.
+	Id BidReviewId `json:"id"`
+
+	// OnTime Выполнено ли предложение в срок.
+	OnTime *BidOnTime `json:"onTime,omitempty"`
+
+	// Rating Оценка предложения в звездах.
+	Rating *BidRating `json:"rating,omitempty"`
+}
+
+// BidReviewDescription Описание предложения
+type BidReviewDescription = string
+
+// BidReviewId Уникальный идентификатор отзыва, присвоенный сервером.
+type BidReviewId = string
+
+// BidScorecard Оценки предложения одним ответственным.
+type BidScorecard struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// CreatedAt Серверная дата и время сохранения этой версии оценок.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Locked Ответственный отправил решение по предложению, оценки зафиксированы.
+	Locked bool `json:"locked"`
+
+	// ReviewerUsername Уникальный slug пользователя.
+	ReviewerUsername Username         `json:"reviewerUsername"`
+	Scores           []CriterionScore `json:"scores"`
+
+	// Version Номер версии оценок этого ответственного.
+	Version int32 `json:"version"`
+}
+
+// BidSearchHit Предложение, найденное полнотекстовым поиском.
+type BidSearchHit struct {
+	// Bid Информация о предложении
+	Bid Bid `json:"bid"`
+
+	// Rank Релевантность найденного объекта запросу, чем больше, тем выше.
+	Rank SearchRank `json:"rank"`
+
+	// Snippet Фрагмент названия и описания с совпадениями, выделенными тегами `<b>`.
+	Snippet SearchSnippet `json:"snippet"`
+}
+
+// BidSortBy Поле, по которому сортируется список предложений.
+type BidSortBy string
+
+// BidStatus Статус предложения
+type BidStatus string
+
+// BidVersion Номер версии посел правок
+type BidVersion = int32
+
+// CriterionAverage defines model for criterionAverage.
+type CriterionAverage struct {
+	// Criterion Название критерия тендера.
+	Criterion string  `json:"criterion"`
+	Score     float64 `json:"score"`
+}
+
+// CriterionScore defines model for criterionScore.
+type CriterionScore struct {
+	// Criterion Название критерия тендера.
+	Criterion string `json:"criterion"`
+	Score     int32  `json:"score"`
+}
+
+// Currency Код валюты по ISO 4217.
+type Currency = string
+
+// ErrorResponse Используется для возвращения ошибки пользователю
+type ErrorResponse struct {
+	// Reason Описание ошибки в свободной форме
+	Reason string `json:"reason"`
+}
+
+// EvaluationCriterion Критерий оценки предложений.
+type EvaluationCriterion struct {
+	// Kind Что оценивает критерий. Критерии `price` и `deliveryTime` оцениваются по самим предложениям:
+	// наименьшая цена или срок поставки получает 10 баллов, остальные — долю от 10, равную отношению
+	// лучшего значения к своему, а предложение без цены или срока — 0. Для `price` тендеру нужен
+	// бюджет, чтобы все цены были в одной валюте. Критерии `warranty` и `custom` оценивают ответственные.
+	Kind EvaluationCriterionKind `json:"kind"`
+
+	// Name Название критерия, уникальное в пределах тендера.
+	Name string `json:"name"`
+
+	// Weight Вес критерия. Итоговая оценка нормируется на сумму весов.
+	Weight int32 `json:"weight"`
+}
+
+// EvaluationCriterionKind Что оценивает критерий. Критерии `price` и `deliveryTime` оцениваются по самим предложениям:
+// наименьшая цена или срок поставки получает 10 баллов, остальные — долю от 10, равную отношению
+// лучшего значения к своему, а предложение без цены или срока — 0. Для `price` тендеру нужен
+// бюджет, чтобы все цены были в одной валюте. Критерии `warranty` и `custom` оценивают ответственные.
+type EvaluationCriterionKind string
+
+// EventAggregateType Сущность, к которой относится событие.
+type EventAggregateType string
+
+// EventType Тип события в журнале изменений:
+//
+//   - `tender.created`, `tender.updated` — тендер создан, изменен или откачен к версии; `data` — тендер.
+//   - `tender.published`, `tender.closed` — тендер опубликован или закрыт; `data` — тендер.
+//   - `bid.created`, `bid.updated` — предложение создано, изменено, откачено к версии или получило новую цену
+//     на аукционе; `data` — предложение.
+//   - `bid.published`, `bid.canceled` — предложение опубликовано или отменено; `data` — предложение.
+//   - `bid.decision` — по предложению принято решение; `data` — предложение и решение.
+//   - `feedback.created` — на предложение оставлен отзыв; `data` — предложение и отзыв.
+//
+// Содержимое предложения на закрытый тендер до вскрытия не передается.
+type EventType string
+
+// ExportFormat Формат файла выгрузки.
+type ExportFormat string
+
+// GraphqlError Ошибка GraphQL-запроса.
+type GraphqlError struct {
+	// Extensions Подробности ошибки: `status` — HTTP-статус, который вернул бы REST API.
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+
+	// Message Описание ошибки.
+	Message string `json:"message"`
+
+	// Path Путь к полю, при выполнении которого произошла ошибка.
+	Path *[]interface{} `json:"path,omitempty"`
+}
+
+// GraphqlRequest GraphQL-запрос.
+type GraphqlRequest struct {
+	// OperationName Операция запроса, которую нужно выполнить, если их несколько.
+	OperationName *string `json:"operationName,omitempty"`
+
+	// Query Текст запроса.
+	Query string `json:"query"`
+
+	// Variables Значения переменных запроса.
+	Variables *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphqlResponse Результат GraphQL-запроса.
+type GraphqlResponse struct {
+	// Data Выбранные запросом данные.
+	Data   *map[string]interface{} `json:"data"`
+	Errors *[]GraphqlError         `json:"errors,omitempty"`
+}
+
+// ImportMode Режим импорта: `atomic` — все строки или ни одной, `perRow` — каждая корректная строка отдельно.
+type ImportMode string
+
+// ImportReport Отчет об импорте.
+type ImportReport struct {
+	// Committed Созданы ли строки. В режиме `atomic` с ошибками и при `dryRun` — нет.
+	Committed bool  `json:"committed"`
+	Created   int32 `json:"created"`
+	DryRun    bool  `json:"dryRun"`
+	Failed    int32 `json:"failed"`
+
+	// Mode Режим импорта: `atomic` — все строки или ни одной, `perRow` — каждая корректная строка отдельно.
+	Mode  ImportMode  `json:"mode"`
+	Rows  []ImportRow `json:"rows"`
+	Total int32       `json:"total"`
+}
+
+// ImportReportFormat Формат ответа импорта.
+type ImportReportFormat string
+
+// ImportRow Результат одной строки импорта.
+type ImportRow struct {
+	// Id Идентификатор созданного тендера или предложения.
+	Id *string `json:"id,omitempty"`
+
+	// Reason Причина, по которой строка не принята.
+	Reason *string `json:"reason,omitempty"`
+
+	// Row Номер строки данных в файле, начиная с 1. Заголовок CSV не считается.
+	Row int32 `json:"row"`
+
+	// Status Результат строки импорта:
+	//
+	// * `created` — создана
+	// * `valid` — прошла проверку, но не создана (пробный импорт или ошибки в других строках режима `atomic`)
+	// * `failed` — не прошла проверку или не создана
+	Status ImportRowStatus `json:"status"`
+}
+
+// ImportRowStatus Результат строки импорта:
+//
+// * `created` — создана
+// * `valid` — прошла проверку, но не создана (пробный импорт или ошибки в других строках режима `atomic`)
+// * `failed` — не прошла проверку или не создана
+type ImportRowStatus string
+
+// InvitationId Уникальный идентификатор приглашения, присвоенный сервером.
+type InvitationId = string
+
+// InvitationResponse Ответ на приглашение
+type InvitationResponse string
+
+// InvitationStatus Статус приглашения
+type InvitationStatus string
+
+// LotId Уникальный идентификатор лота, присвоенный сервером.
+type LotId = string
+
+// Money Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type Money = string
+
+// NotificationEvent Событие, о котором пользователь получает письмо:
+//
+//   - `bid.submitted` — на тендер организации пользователя опубликовано предложение.
+//   - `decision.required` — по предложению на тендер организации принято решение, и для итога нужно
+//     решение пользователя.
+//   - `bid.approved`, `bid.rejected` — предложение пользователя или его организации одобрено или отклонено.
+//   - `feedback.created` — на предложение пользователя или его организации оставлен отзыв.
+type NotificationEvent string
+
+// NotificationLanguage Язык писем.
+type NotificationLanguage string
+
+// NotificationPreferences Настройки уведомлений пользователя по электронной почте.
+type NotificationPreferences struct {
+	// Email Адрес, на который отправляются письма. Обязателен, если выбрано хотя бы одно событие.
+	Email *string `json:"email,omitempty"`
+
+	// Events События, о которых отправляются письма. Пустой список отключает уведомления.
+	Events []NotificationEvent `json:"events"`
+
+	// Language Язык писем.
+	Language NotificationLanguage `json:"language"`
+}
+
+// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+type OrganizationId = string
+
+// OrganizationReputation Репутация организации и сотрудников, подававших предложения от ее имени.
+type OrganizationReputation struct {
+	Authors []AuthorReputation `json:"authors"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Reputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+	Reputation Reputation `json:"reputation"`
+}
+
+// QuestionId Уникальный идентификатор вопроса, присвоенный сервером.
+type QuestionId = string
+
+// QuestionStatus Статус вопроса
+type QuestionStatus string
+
+// QuestionText Текст вопроса или ответа
+type QuestionText = string
+
+// RankedBid Место предложения в рейтинге тендера.
+type RankedBid struct {
+	// BidId Уникальный идентификатор предложения, присвоенный сервером.
+	BidId BidId `json:"bidId"`
+
+	// Criteria Оценки по каждому критерию: средние оценки ответственных или вычисленные по предложениям для
+	// цены и срока поставки.
+	Criteria []CriterionAverage `json:"criteria"`
+	Rank     int32              `json:"rank"`
+
+	// Reviewers Сколько ответственных оценили предложение. В рейтинг входят все опубликованные предложения.
+	Reviewers int32 `json:"reviewers"`
+
+	// Total Взвешенная итоговая оценка от 0 до 100.
+	Total float64 `json:"total"`
+}
+
+// Reputation Репутация автора предложений. Передается в списке предложений тендера для ответственных за тендер.
+type Reputation struct {
+	// AverageRating Средняя оценка в отзывах, если предложения оценивались.
+	AverageRating *float64 `json:"averageRating,omitempty"`
+
+	// Bids Число опубликованных и отмененных предложений.
+	Bids int32 `json:"bids"`
+
+	// Canceled Число отмененных предложений.
+	Canceled int32 `json:"canceled"`
+
+	// CancellationRate Доля отмененных предложений.
+	CancellationRate float64 `json:"cancellationRate"`
+
+	// Late Число отзывов, в которых предложение отмечено выполненным с опозданием.
+	Late int32 `json:"late"`
+
+	// Lost Число проигранных предложений.
+	Lost int32 `json:"lost"`
+
+	// OnTime Число отзывов, в которых предложение отмечено выполненным в срок.
+	OnTime int32 `json:"onTime"`
+
+	// Ratings Число оценок.
+	Ratings int32 `json:"ratings"`
+
+	// Won Число выигранных предложений.
+	Won int32 `json:"won"`
+}
+
+// SearchRank Релевантность найденного объекта запросу, чем больше, тем выше.
+type SearchRank = float32
+
+// SearchSnippet Фрагмент названия и описания с совпадениями, выделенными тегами `<b>`.
+type SearchSnippet = string
+
+// SortOrder Направление сортировки.
+type SortOrder string
+
+// StreamEvent Событие журнала изменений.
+type StreamEvent struct {
+	// AggregateId Идентификатор тендера или предложения.
+	AggregateId string `json:"aggregateId"`
+
+	// AggregateType Сущность, к которой относится событие.
+	AggregateType EventAggregateType `json:"aggregateType"`
+
+	// Data Объект события, см. `eventType`.
+	Data map[string]interface{} `json:"data"`
+
+	// Id Идентификатор события. Событие может быть передано повторно с тем же идентификатором.
+	Id int64 `json:"id"`
+
+	// OccurredAt Серверная дата и время события.
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// Type Тип события в журнале изменений:
+	//
+	// * `tender.created`, `tender.updated` — тендер создан, изменен или откачен к версии; `data` — тендер.
+	// * `tender.published`, `tender.closed` — тендер опубликован или закрыт; `data` — тендер.
+	// * `bid.created`, `bid.updated` — предложение создано, изменено, откачено к версии или получило новую цену
+	//   на аукционе; `data` — предложение.
+	// * `bid.published`, `bid.canceled` — предложение опубликовано или отменено; `data` — предложение.
+	// * `bid.decision` — по предложению принято решение; `data` — предложение и решение.
+	// * `feedback.created` — на предложение оставлен отзыв; `data` — предложение и отзыв.
+	//
+	// Содержимое предложения на закрытый тендер до вскрытия не передается.
+	Type EventType `json:"type"`
+}
+
+// Tender Информация о тендере
+type Tender struct {
+	// Auction Параметры аукциона на понижение. Участники снижают цены своих предложений, пока аукцион идет.
+	// Тендер в формате аукциона должен иметь бюджет, ставки делаются в его валюте.
+	Auction *TenderAuction `json:"auction,omitempty"`
+
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// CreatedAt Серверная дата и время в момент, когда пользователь отправил тендер на создание.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Criteria Взвешенные критерии, по которым ответственные оценивают предложения. Задаются при создании тендера.
+	Criteria *TenderCriteria `json:"criteria,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор тендера, присвоенный сервером.
+	Id   TenderId     `json:"id"`
+	Lots *[]TenderLot `json:"lots,omitempty"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// PublishAt Момент автоматической публикации созданного тендера.
+	// Передается в формате RFC3339.
+	PublishAt *TenderPublishAt `json:"publishAt,omitempty"`
+
+	// RevealedAt Момент, когда предложения закрытого тендера были раскрыты.
+	// Передается в формате RFC3339.
+	RevealedAt *time.Time `json:"revealedAt,omitempty"`
+
+	// Sealed Закрытый тендер: предложения хранятся зашифрованными и скрыты от всех, включая ответственных за тендер,
+	// пока не наступит срок подачи предложений или тендер не будет закрыт. После этого все предложения раскрываются одновременно.
+	// Решения по раскрытым предложениям принимаются и после закрытия тендера, пока одно из них не будет одобрено.
+	Sealed TenderSealed `json:"sealed"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// Status Статус тендер
+	Status TenderStatus `json:"status"`
+
+	// SubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+	// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+	// Передается в формате RFC3339.
+	SubmissionDeadline *TenderSubmissionDeadline `json:"submissionDeadline,omitempty"`
+
+	// Version Номер версии посел правок
+	Version TenderVersion `json:"version"`
+
+	// Visibility Видимость тендера. Открытый тендер видят все. Тендер по приглашениям видят только ответственные
+	// за организацию-владельца и приглашенные, а предложения подают только принявшие приглашение.
+	Visibility TenderVisibility `json:"visibility"`
+}
+
+// TenderAuction Параметры аукциона на понижение. Участники снижают цены своих предложений, пока аукцион идет.
+// Тендер в формате аукциона должен иметь бюджет, ставки делаются в его валюте.
+type TenderAuction struct {
+	// EndAt Окончание приема ставок. Сдвигается при продлении аукциона.
+	EndAt time.Time `json:"endAt"`
+
+	// ExtensionSeconds Защита от ставок в последний момент: ставка, сделанная менее чем за указанное число секунд до окончания,
+	// переносит окончание на это же число секунд после ставки.
+	ExtensionSeconds int32 `json:"extensionSeconds"`
+
+	// MinDecrement Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	MinDecrement Money `json:"minDecrement"`
+
+	// StartAt Начало приема ставок.
+	StartAt time.Time `json:"startAt"`
+}
+
+// TenderBudget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+// валюту бюджета нельзя сменить ни правкой, ни откатом.
+type TenderBudget struct {
+	// Currency Код валюты по ISO 4217.
+	Currency Currency `json:"currency"`
+
+	// Max Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Max *Money `json:"max,omitempty"`
+
+	// Min Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Min *Money `json:"min,omitempty"`
+}
+
+// TenderCriteria Взвешенные критерии, по которым ответственные оценивают предложения. Задаются при создании тендера.
+type TenderCriteria = []EvaluationCriterion
+
+// TenderDescription Описание тендера
+type TenderDescription = string
+
+// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+type TenderId = string
+
+// TenderInvitation Приглашение организации или сотрудника в тендер.
+type TenderInvitation struct {
+	// CreatedAt Серверная дата и время создания приглашения.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Id Уникальный идентификатор приглашения, присвоенный сервером.
+	Id InvitationId `json:"id"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+
+	// RespondedAt Серверная дата и время последнего ответа на приглашение.
+	// Передается в формате RFC3339.
+	RespondedAt *string `json:"respondedAt,omitempty"`
+
+	// Status Статус приглашения
+	Status InvitationStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// Username Уникальный slug пользователя.
+	Username *Username `json:"username,omitempty"`
+}
+
+// TenderLot Лот тендера, присуждаемый отдельно от остальных.
+type TenderLot struct {
+	// AwardedBidId Уникальный идентификатор предложения, присвоенный сервером.
+	AwardedBidId *BidId `json:"awardedBidId,omitempty"`
+
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Id Уникальный идентификатор лота, присвоенный сервером.
+	Id LotId `json:"id"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// Status Статус лота
+	Status TenderLotStatus `json:"status"`
+}
+
+// TenderLotInput Данные нового лота.
+type TenderLotInput struct {
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+}
+
+// TenderLotStatus Статус лота
+type TenderLotStatus string
+
+// TenderName Полное название тендера
+type TenderName = string
+
+// TenderPublishAt Момент автоматической публикации созданного тендера.
+// Передается в формате RFC3339.
+type TenderPublishAt = time.Time
+
+// TenderQuestion Вопрос по тендеру и ответ на него.
+type TenderQuestion struct {
+	// Amended Ответ внесен в тендер отдельной версией.
+	Amended *bool `json:"amended,omitempty"`
+
+	// Answer Текст вопроса или ответа
+	Answer *QuestionText `json:"answer,omitempty"`
+
+	// AnsweredAt Серверная дата и время публикации ответа.
+	// Передается в формате RFC3339.
+	AnsweredAt *string `json:"answeredAt,omitempty"`
+
+	// AuthorUsername Уникальный slug пользователя.
+	AuthorUsername Username `json:"authorUsername"`
+
+	// CreatedAt Серверная дата и время создания вопроса.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// Id Уникальный идентификатор вопроса, присвоенный сервером.
+	Id QuestionId `json:"id"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+
+	// Question Текст вопроса или ответа
+	Question QuestionText `json:"question"`
+
+	// Status Статус вопроса
+	Status QuestionStatus `json:"status"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+
+	// TenderVersion Версия тендера, в которой опубликован ответ.
+	TenderVersion *int32 `json:"tenderVersion,omitempty"`
+}
+
+// TenderRevision Версия тендера и уточнения, опубликованные в ней.
+type TenderRevision struct {
+	Clarifications []TenderQuestion `json:"clarifications"`
+
+	// Tender Информация о тендере
+	Tender Tender `json:"tender"`
+}
+
+// TenderSealed Закрытый тендер: предложения хранятся зашифрованными и скрыты от всех, включая ответственных за тендер,
+// пока не наступит срок подачи предложений или тендер не будет закрыт. После этого все предложения раскрываются одновременно.
+// Решения по раскрытым предложениям принимаются и после закрытия тендера, пока одно из них не будет одобрено.
+type TenderSealed = bool
+
+// TenderSearchHit Тендер, найденный полнотекстовым поиском.
+type TenderSearchHit struct {
+	// Rank Релевантность найденного объекта запросу, чем больше, тем выше.
+	Rank SearchRank `json:"rank"`
+
+	// Snippet Фрагмент названия и описания с совпадениями, выделенными тегами `<b>`.
+	Snippet SearchSnippet `json:"snippet"`
+
+	// Tender Информация о тендере
+	Tender Tender `json:"tender"`
+}
+
+// TenderServiceType Вид услуги, к которой относиться тендер
+type TenderServiceType string
+
+// TenderSortBy Поле, по которому сортируется список тендеров.
+type TenderSortBy string
+
+// TenderStatus Статус тендер
+type TenderStatus string
+
+// TenderSubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+// Передается в формате RFC3339.
+type TenderSubmissionDeadline = time.Time
+
+// TenderVersion Номер версии посел правок
+type TenderVersion = int32
+
+// TenderVisibility Видимость тендера. Открытый тендер видят все. Тендер по приглашениям видят только ответственные
+// за организацию-владельца и приглашенные, а предложения подают только принявшие приглашение.
+type TenderVisibility string
+
+// Username Уникальный slug пользователя.
+type Username = string
+
+// Webhook Подписка организации на события.
+type Webhook struct {
+	// CreatedAt Серверная дата и время создания подписки.
+	// Передается в формате RFC3339.
+	CreatedAt string         `json:"createdAt"`
+	Events    []WebhookEvent `json:"events"`
+
+	// Id Уникальный идентификатор подписки, присвоенный сервером.
+	Id WebhookId `json:"id"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// Secret Секрет для проверки подписи доставок. Возвращается только при создании подписки.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url Адрес http или https, на который отправляются события.
+	Url WebhookUrl `json:"url"`
+}
+
+// WebhookDelivery Доставка события по подписке.
+type WebhookDelivery struct {
+	// Attempts Сколько попыток сделано.
+	Attempts int32 `json:"attempts"`
+
+	// CreatedAt Серверная дата и время события.
+	// Передается в формате RFC3339.
+	CreatedAt string `json:"createdAt"`
+
+	// DeliveredAt Дата и время успешной попытки.
+	// Передается в формате RFC3339.
+	DeliveredAt *string `json:"deliveredAt,omitempty"`
+
+	// Event Событие:
+	//
+	// * `tender.published` — тендер опубликован, `data` — тендер.
+	// * `tender.closed` — тендер закрыт, `data` — тендер.
+	// * `bid.created` — на тендер подано предложение, `data` — предложение.
+	//   Содержимое предложения на закрытый тендер до вскрытия не передается.
+	// * `bid.decision` — по предложению принято решение, `data` — предложение и решение.
+	// * `feedback.created` — на предложение оставлен отзыв, `data` — предложение и отзыв.
+	// * `webhook.ping` — проверка подписки, `data` — подписка.
+	//
+	// События тендера получает организация-владелец, события предложения — также организация,
+	// от имени которой оно подано.
+	Event WebhookEvent `json:"event"`
+
+	// Id Уникальный идентификатор доставки, присвоенный сервером.
+	Id WebhookDeliveryId `json:"id"`
+
+	// LastError Причина неудачи последней попытки.
+	LastError *string `json:"lastError,omitempty"`
+
+	// NextAttemptAt Дата и время следующей попытки, пока доставка ожидает.
+	// Передается в формате RFC3339.
+	NextAttemptAt *string `json:"nextAttemptAt,omitempty"`
+
+	// Payload Тело запроса, которым доставляется событие.
+	Payload WebhookPayload `json:"payload"`
+
+	// ResponseStatus HTTP-статус ответа на последнюю попытку.
+	ResponseStatus *int32 `json:"responseStatus,omitempty"`
+
+	// Status Статус доставки:
+	//
+	// * `Pending` — доставка ожидает очередной попытки.
+	// * `Delivered` — получатель ответил 2xx.
+	// * `Dead` — попытки исчерпаны, доставка в очереди недоставленных.
+	Status WebhookDeliveryStatus `json:"status"`
+
+	// WebhookId Уникальный идентификатор подписки, присвоенный сервером.
+	WebhookId WebhookId `json:"webhookId"`
+}
+
+// WebhookDeliveryId Уникальный идентификатор доставки, присвоенный сервером.
+type WebhookDeliveryId = string
+
+// WebhookDeliveryStatus Статус доставки:
+//
+// * `Pending` — доставка ожидает очередной попытки.
+// * `Delivered` — получатель ответил 2xx.
+// * `Dead` — попытки исчерпаны, доставка в очереди недоставленных.
+type WebhookDeliveryStatus string
+
+// WebhookEvent Событие:
+//
+//   - `tender.published` — тендер опубликован, `data` — тендер.
+//   - `tender.closed` — тендер закрыт, `data` — тендер.
+//   - `bid.created` — на тендер подано предложение, `data` — предложение.
+//     Содержимое предложения на закрытый тендер до вскрытия не передается.
+//   - `bid.decision` — по предложению принято решение, `data` — предложение и решение.
+//   - `feedback.created` — на предложение оставлен отзыв, `data` — предложение и отзыв.
+//   - `webhook.ping` — проверка подписки, `data` — подписка.
+//
+// События тендера получает организация-владелец, события предложения — также организация,
+// от имени которой оно подано.
+type WebhookEvent string
+
+// WebhookId Уникальный идентификатор подписки, присвоенный сервером.
+type WebhookId = string
+
+// WebhookPayload Тело запроса, которым доставляется событие.
+type WebhookPayload struct {
+	// Data Объект события, см. `webhookEvent`.
+	Data map[string]interface{} `json:"data"`
+
+	// Event Событие:
+	//
+	// * `tender.published` — тендер опубликован, `data` — тендер.
+	// * `tender.closed` — тендер закрыт, `data` — тендер.
+	// * `bid.created` — на тендер подано предложение, `data` — предложение.
+	//   Содержимое предложения на закрытый тендер до вскрытия не передается.
+	// * `bid.decision` — по предложению принято решение, `data` — предложение и решение.
+	// * `feedback.created` — на предложение оставлен отзыв, `data` — предложение и отзыв.
+	// * `webhook.ping` — проверка подписки, `data` — подписка.
+	//
+	// События тендера получает организация-владелец, события предложения — также организация,
+	// от имени которой оно подано.
+	Event WebhookEvent `json:"event"`
+
+	// EventId Идентификатор события. Событие может быть доставлено повторно, в том числе
+	// в разных доставках, но всегда с тем же идентификатором: по нему получатель
+	// отбрасывает дубли. У проверки подписки (`webhook.ping`) его нет.
+	EventId *int64 `json:"eventId,omitempty"`
+
+	// OccurredAt Серверная дата и время события.
+	// Передается в формате RFC3339.
+	OccurredAt string `json:"occurredAt"`
+}
+
+// WebhookUrl Адрес http или https, на который отправляются события.
+type WebhookUrl = string
+
+// CurrencyFilter Код валюты по ISO 4217.
+type CurrencyFilter = Currency
+
+// ImportDryRun defines model for importDryRun.
+type ImportDryRun = bool
+
+// MaxBudget Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MaxBudget = Money
+
+// MaxDeliveryDays Срок выполнения предложения в днях.
+type MaxDeliveryDays = BidDeliveryDays
+
+// MaxPrice Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MaxPrice = Money
+
+// MinBudget Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MinBudget = Money
+
+// MinPrice Денежная сумма в виде десятичной строки с точностью до копеек.
+// Передается строкой, чтобы значение не искажалось при округлении.
+type MinPrice = Money
+
+// PaginationLimit defines model for paginationLimit.
+type PaginationLimit = int32
+
+// PaginationOffset defines model for paginationOffset.
+type PaginationOffset = int32
+
+// DownloadAttachmentParams defines parameters for DownloadAttachment.
+type DownloadAttachmentParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// ImportBidsParams defines parameters for ImportBids.
+type ImportBidsParams struct {
+	// Mode Режим импорта, по умолчанию `atomic`.
+	Mode *ImportMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Только проверить строки, ничего не создавая.
+	DryRun *ImportDryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// Report Формат ответа, по умолчанию JSON-отчет.
+	Report *ImportReportFormat `form:"report,omitempty" json:"report,omitempty"`
+}
+
+// GetUserBidsParams defines parameters for GetUserBids.
+type GetUserBidsParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinPrice Возвращаются только предложения с ценой не меньше указанной.
+	MinPrice *MinPrice `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Возвращаются только предложения с ценой не больше указанной.
+	MaxPrice *MaxPrice `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// MaxDeliveryDays Возвращаются только предложения со сроком выполнения не больше указанного.
+	MaxDeliveryDays *MaxDeliveryDays `form:"maxDeliveryDays,omitempty" json:"maxDeliveryDays,omitempty"`
+
+	// SortBy Поле сортировки. Предложения без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *BidSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// CreateBidJSONBody defines parameters for CreateBid.
+type CreateBidJSONBody struct {
+	// AuthorId Уникальный идентификатор автора предложения, присвоенный сервером.
+	AuthorId BidAuthorId `json:"authorId"`
+
+	// AuthorType Тип автора
+	AuthorType BidAuthorType `json:"authorType"`
+
+	// Currency Код валюты по ISO 4217.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// DeliveryDays Срок выполнения предложения в днях.
+	DeliveryDays *BidDeliveryDays `json:"deliveryDays,omitempty"`
+
+	// Description Описание предложения
+	Description BidDescription `json:"description"`
+
+	// LotIds Лоты тендера, на которые подано предложение. Обязательны для тендеров с лотами.
+	LotIds *BidLotIds `json:"lotIds,omitempty"`
+
+	// Name Полное название предложения
+	Name BidName `json:"name"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price *Money `json:"price,omitempty"`
+
+	// TenderId Уникальный идентификатор тендера, присвоенный сервером.
+	TenderId TenderId `json:"tenderId"`
+}
+
+// SearchBidsParams defines parameters for SearchBids.
+type SearchBidsParams struct {
+	// Q Поисковый запрос в синтаксисе веб-поиска: слова, фразы в кавычках, `or` и исключение через `-`.
+	// Слова приводятся к основе по правилам русского и английского языков.
+	Q string `form:"q" json:"q"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username Username          `form:"username" json:"username"`
+
+	// TenderId Искать только предложения на указанный тендер.
+	TenderId *TenderId `form:"tenderId,omitempty" json:"tenderId,omitempty"`
+}
+
+// GetBidAttachmentsParams defines parameters for GetBidAttachments.
+type GetBidAttachmentsParams struct {
+	Username Username `form:"username" json:"username"`
+	Version  *int32   `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// UploadBidAttachmentMultipartBody defines parameters for UploadBidAttachment.
+type UploadBidAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadBidAttachmentParams defines parameters for UploadBidAttachment.
+type UploadBidAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditBidJSONBody defines parameters for EditBid.
+type EditBidJSONBody struct {
+	// Currency Код валюты по ISO 4217.
+	Currency *Currency `json:"currency,omitempty"`
+
+	// DeliveryDays Срок выполнения предложения в днях.
+	DeliveryDays *BidDeliveryDays `json:"deliveryDays,omitempty"`
+
+	// Description Описание предложения
+	Description *BidDescription `json:"description,omitempty"`
+
+	// Name Полное название предложения
+	Name *BidName `json:"name,omitempty"`
+
+	// Price Денежная сумма в виде десятичной строки с точностью до копеек.
+	// Передается строкой, чтобы значение не искажалось при округлении.
+	Price *Money `json:"price,omitempty"`
+}
+
+// EditBidParams defines parameters for EditBid.
+type EditBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitBidFeedbackParams defines parameters for SubmitBidFeedback.
+type SubmitBidFeedbackParams struct {
+	BidFeedback BidFeedback `form:"bidFeedback" json:"bidFeedback"`
+	Rating      *BidRating  `form:"rating,omitempty" json:"rating,omitempty"`
+	OnTime      *BidOnTime  `form:"onTime,omitempty" json:"onTime,omitempty"`
+	Username    Username    `form:"username" json:"username"`
+}
+
+// PlaceAuctionBidParams defines parameters for PlaceAuctionBid.
+type PlaceAuctionBidParams struct {
+	Price    Money    `form:"price" json:"price"`
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackBidParams defines parameters for RollbackBid.
+type RollbackBidParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidScoresParams defines parameters for GetBidScores.
+type GetBidScoresParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SubmitBidScoresJSONBody defines parameters for SubmitBidScores.
+type SubmitBidScoresJSONBody struct {
+	Scores []CriterionScore `json:"scores"`
+}
+
+// SubmitBidScoresParams defines parameters for SubmitBidScores.
+type SubmitBidScoresParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetBidStatusParams defines parameters for GetBidStatus.
+type GetBidStatusParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// UpdateBidStatusParams defines parameters for UpdateBidStatus.
+type UpdateBidStatusParams struct {
+	Status   BidStatus `form:"status" json:"status"`
+	Username Username  `form:"username" json:"username"`
+}
+
+// SubmitBidDecisionParams defines parameters for SubmitBidDecision.
+type SubmitBidDecisionParams struct {
+	Decision BidDecision `form:"decision" json:"decision"`
+
+	// LotId Лот, по которому принимается решение. Обязателен для тендеров с лотами: решения и присуждение
+	// принимаются по каждому лоту отдельно.
+	LotId    *LotId   `form:"lotId,omitempty" json:"lotId,omitempty"`
+	Username Username `form:"username" json:"username"`
+}
+
+// ExportBidsForTenderParams defines parameters for ExportBidsForTender.
+type ExportBidsForTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Format Формат файла выгрузки, по умолчанию CSV. Если выгрузка сорвалась после начала передачи файла,
+	// сервер разрывает соединение, не завершив ответ, так что неполный файл не принимается за целый.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinPrice Возвращаются только предложения с ценой не меньше указанной.
+	MinPrice *MinPrice `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Возвращаются только предложения с ценой не больше указанной.
+	MaxPrice *MaxPrice `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// MaxDeliveryDays Возвращаются только предложения со сроком выполнения не больше указанного.
+	MaxDeliveryDays *MaxDeliveryDays `form:"maxDeliveryDays,omitempty" json:"maxDeliveryDays,omitempty"`
+
+	// SortBy Поле сортировки. Предложения без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *BidSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// GetBidsForTenderParams defines parameters for GetBidsForTender.
+type GetBidsForTenderParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinPrice Возвращаются только предложения с ценой не меньше указанной.
+	MinPrice *MinPrice `form:"minPrice,omitempty" json:"minPrice,omitempty"`
+
+	// MaxPrice Возвращаются только предложения с ценой не больше указанной.
+	MaxPrice *MaxPrice `form:"maxPrice,omitempty" json:"maxPrice,omitempty"`
+
+	// MaxDeliveryDays Возвращаются только предложения со сроком выполнения не больше указанного.
+	MaxDeliveryDays *MaxDeliveryDays `form:"maxDeliveryDays,omitempty" json:"maxDeliveryDays,omitempty"`
+
+	// SortBy Поле сортировки. Предложения без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *BidSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// GetBidReviewsParams defines parameters for GetBidReviews.
+type GetBidReviewsParams struct {
+	// AuthorUsername Имя пользователя автора предложений, отзывы на которые нужно просмотреть.
+	AuthorUsername Username `form:"authorUsername" json:"authorUsername"`
+
+	// RequesterUsername Имя пользователя, который запрашивает отзывы.
+	RequesterUsername Username `form:"requesterUsername" json:"requesterUsername"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RedeliverWebhookDeliveryParams defines parameters for RedeliverWebhookDelivery.
+type RedeliverWebhookDeliveryParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// StreamEventsParams defines parameters for StreamEvents.
+type StreamEventsParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// TenderId Только события указанного тендера и предложений на него.
+	TenderId *TenderId `form:"tenderId,omitempty" json:"tenderId,omitempty"`
+
+	// OrganizationId Только события тендеров указанной организации и предложений на них.
+	OrganizationId *OrganizationId `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+
+	// Type Только события указанных типов.
+	//
+	// Если список пустой, фильтр не применяется.
+	Type *[]EventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// LastEventID Номер последнего полученного события, после которого продолжить поток.
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// GetUserInvitationsParams defines parameters for GetUserInvitations.
+type GetUserInvitationsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// RespondTenderInvitationParams defines parameters for RespondTenderInvitation.
+type RespondTenderInvitationParams struct {
+	Response InvitationResponse `form:"response" json:"response"`
+	Username Username           `form:"username" json:"username"`
+}
+
+// GetNotificationPreferencesParams defines parameters for GetNotificationPreferences.
+type GetNotificationPreferencesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// SetNotificationPreferencesParams defines parameters for SetNotificationPreferences.
+type SetNotificationPreferencesParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// EntityType Только записи об объектах указанного типа.
+	EntityType *AuditEntityType `form:"entityType,omitempty" json:"entityType,omitempty"`
+
+	// EntityId Только записи об указанном объекте.
+	EntityId *string `form:"entityId,omitempty" json:"entityId,omitempty"`
+
+	// Actor Только изменения, сделанные указанным пользователем.
+	Actor *Username `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Только записи, сделанные не раньше указанного времени.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Только записи, сделанные раньше указанного времени.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// VerifyAuditLogParams defines parameters for VerifyAuditLog.
+type VerifyAuditLogParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetOrganizationReputationParams defines parameters for GetOrganizationReputation.
+type GetOrganizationReputationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetOrganizationWebhooksParams defines parameters for GetOrganizationWebhooks.
+type GetOrganizationWebhooksParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	Events []WebhookEvent `json:"events"`
+
+	// Url Адрес http или https, на который отправляются события.
+	Url WebhookUrl `json:"url"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// AnswerTenderQuestionJSONBody defines parameters for AnswerTenderQuestion.
+type AnswerTenderQuestionJSONBody struct {
+	// AmendTender Создать новую версию тендера с этим уточнением.
+	AmendTender *bool `json:"amendTender,omitempty"`
+
+	// Answer Текст вопроса или ответа
+	Answer QuestionText `json:"answer"`
+}
+
+// AnswerTenderQuestionParams defines parameters for AnswerTenderQuestion.
+type AnswerTenderQuestionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTendersParams defines parameters for GetTenders.
+type GetTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Username Пользователь, от имени которого запрашивается список.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// ServiceType Возвращенные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinBudget Возвращаются только тендеры, бюджет которых допускает сумму не меньше указанной.
+	MinBudget *MinBudget `form:"minBudget,omitempty" json:"minBudget,omitempty"`
+
+	// MaxBudget Возвращаются только тендеры, бюджет которых допускает сумму не больше указанной.
+	MaxBudget *MaxBudget `form:"maxBudget,omitempty" json:"maxBudget,omitempty"`
+
+	// SortBy Поле сортировки. Тендеры без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *TenderSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder    `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ExportTendersParams defines parameters for ExportTenders.
+type ExportTendersParams struct {
+	// Format Формат файла выгрузки, по умолчанию CSV. Если выгрузка сорвалась после начала передачи файла,
+	// сервер разрывает соединение, не завершив ответ, так что неполный файл не принимается за целый.
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Username Пользователь, от имени которого запрашивается выгрузка.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// ServiceType Выгруженные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinBudget Возвращаются только тендеры, бюджет которых допускает сумму не меньше указанной.
+	MinBudget *MinBudget `form:"minBudget,omitempty" json:"minBudget,omitempty"`
+
+	// MaxBudget Возвращаются только тендеры, бюджет которых допускает сумму не больше указанной.
+	MaxBudget *MaxBudget `form:"maxBudget,omitempty" json:"maxBudget,omitempty"`
+
+	// SortBy Поле сортировки. Тендеры без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *TenderSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder    `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// ImportTendersParams defines parameters for ImportTenders.
+type ImportTendersParams struct {
+	// Mode Режим импорта, по умолчанию `atomic`.
+	Mode *ImportMode `form:"mode,omitempty" json:"mode,omitempty"`
+
+	// DryRun Только проверить строки, ничего не создавая.
+	DryRun *ImportDryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+
+	// Report Формат ответа, по умолчанию JSON-отчет.
+	Report *ImportReportFormat `form:"report,omitempty" json:"report,omitempty"`
+}
+
+// GetUserTendersParams defines parameters for GetUserTenders.
+type GetUserTendersParams struct {
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset   *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+	Username *Username         `form:"username,omitempty" json:"username,omitempty"`
+
+	// Currency Возвращаются только объекты в указанной валюте.
+	Currency *CurrencyFilter `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// MinBudget Возвращаются только тендеры, бюджет которых допускает сумму не меньше указанной.
+	MinBudget *MinBudget `form:"minBudget,omitempty" json:"minBudget,omitempty"`
+
+	// MaxBudget Возвращаются только тендеры, бюджет которых допускает сумму не больше указанной.
+	MaxBudget *MaxBudget `form:"maxBudget,omitempty" json:"maxBudget,omitempty"`
+
+	// SortBy Поле сортировки. Тендеры без заданного значения идут в конце, при равенстве сортируются по названию.
+	SortBy    *TenderSortBy `form:"sortBy,omitempty" json:"sortBy,omitempty"`
+	SortOrder *SortOrder    `form:"sortOrder,omitempty" json:"sortOrder,omitempty"`
+}
+
+// CreateTenderJSONBody defines parameters for CreateTender.
+type CreateTenderJSONBody struct {
+	// Auction Параметры аукциона на понижение. Участники снижают цены своих предложений, пока аукцион идет.
+	// Тендер в формате аукциона должен иметь бюджет, ставки делаются в его валюте.
+	Auction *TenderAuction `json:"auction,omitempty"`
+
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// CreatorUsername Уникальный slug пользователя.
+	CreatorUsername Username `json:"creatorUsername"`
+
+	// Criteria Взвешенные критерии, по которым ответственные оценивают предложения. Задаются при создании тендера.
+	Criteria *TenderCriteria `json:"criteria,omitempty"`
+
+	// Description Описание тендера
+	Description TenderDescription `json:"description"`
+
+	// Lots Лоты тендера. Лоты присуждаются независимо друг от друга, тендер закрывается, когда каждый лот
+	// присужден или отменен.
+	Lots *[]TenderLotInput `json:"lots,omitempty"`
+
+	// Name Полное название тендера
+	Name TenderName `json:"name"`
+
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId OrganizationId `json:"organizationId"`
+
+	// PublishAt Момент автоматической публикации созданного тендера.
+	// Передается в формате RFC3339.
+	PublishAt *TenderPublishAt `json:"publishAt,omitempty"`
+
+	// Sealed Закрытый тендер: предложения хранятся зашифрованными и скрыты от всех, включая ответственных за тендер,
+	// пока не наступит срок подачи предложений или тендер не будет закрыт. После этого все предложения раскрываются одновременно.
+	// Решения по раскрытым предложениям принимаются и после закрытия тендера, пока одно из них не будет одобрено.
+	Sealed *TenderSealed `json:"sealed,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType TenderServiceType `json:"serviceType"`
+
+	// SubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+	// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+	// Передается в формате RFC3339.
+	SubmissionDeadline *TenderSubmissionDeadline `json:"submissionDeadline,omitempty"`
+
+	// Visibility Видимость тендера. Открытый тендер видят все. Тендер по приглашениям видят только ответственные
+	// за организацию-владельца и приглашенные, а предложения подают только принявшие приглашение.
+	Visibility *TenderVisibility `json:"visibility,omitempty"`
+}
+
+// SearchTendersParams defines parameters for SearchTenders.
+type SearchTendersParams struct {
+	// Q Поисковый запрос в синтаксисе веб-поиска: слова, фразы в кавычках, `or` и исключение через `-`.
+	// Слова приводятся к основе по правилам русского и английского языков.
+	Q string `form:"q" json:"q"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Username Пользователь, от имени которого выполняется поиск.
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+
+	// Status Искать только тендеры в указанном статусе.
+	Status *TenderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// ServiceType Найденные тендеры должны соответствовать указанным видам услуг.
+	//
+	// Если список пустой, фильтры не применяются.
+	ServiceType *[]TenderServiceType `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// OrganizationId Искать только тендеры указанной организации.
+	OrganizationId *OrganizationId `form:"organizationId,omitempty" json:"organizationId,omitempty"`
+}
+
+// GetTenderAttachmentsParams defines parameters for GetTenderAttachments.
+type GetTenderAttachmentsParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+	Version  *int32    `form:"version,omitempty" json:"version,omitempty"`
+}
+
+// UploadTenderAttachmentMultipartBody defines parameters for UploadTenderAttachment.
+type UploadTenderAttachmentMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// UploadTenderAttachmentParams defines parameters for UploadTenderAttachment.
+type UploadTenderAttachmentParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetAuctionLeaderboardParams defines parameters for GetAuctionLeaderboard.
+type GetAuctionLeaderboardParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// EditTenderJSONBody defines parameters for EditTender.
+type EditTenderJSONBody struct {
+	// Budget Бюджет тендера. Минимальная и максимальная суммы необязательны, но минимум не может превышать максимум.
+	// Предложения по тендеру с бюджетом должны быть в той же валюте. Когда по тендеру уже есть предложения,
+	// валюту бюджета нельзя сменить ни правкой, ни откатом.
+	Budget *TenderBudget `json:"budget,omitempty"`
+
+	// Description Описание тендера
+	Description *TenderDescription `json:"description,omitempty"`
+
+	// Name Полное название тендера
+	Name *TenderName `json:"name,omitempty"`
+
+	// PublishAt Момент автоматической публикации созданного тендера.
+	// Передается в формате RFC3339.
+	PublishAt *TenderPublishAt `json:"publishAt,omitempty"`
+
+	// ServiceType Вид услуги, к которой относиться тендер
+	ServiceType *TenderServiceType `json:"serviceType,omitempty"`
+
+	// SubmissionDeadline Момент, после которого предложения по тендеру не принимаются, а опубликованный тендер автоматически закрывается.
+	// После него предложения нельзя изменять, откатывать, публиковать или отзывать, к ним нельзя прикладывать файлы.
+	// Передается в формате RFC3339.
+	SubmissionDeadline *TenderSubmissionDeadline `json:"submissionDeadline,omitempty"`
+}
+
+// EditTenderParams defines parameters for EditTender.
+type EditTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderHistoryParams defines parameters for GetTenderHistory.
+type GetTenderHistoryParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetTenderInvitationsParams defines parameters for GetTenderInvitations.
+type GetTenderInvitationsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// CreateTenderInvitationJSONBody defines parameters for CreateTenderInvitation.
+type CreateTenderInvitationJSONBody struct {
+	// OrganizationId Уникальный идентификатор организации, присвоенный сервером.
+	OrganizationId *OrganizationId `json:"organizationId,omitempty"`
+
+	// Username Уникальный slug пользователя.
+	Username *Username `json:"username,omitempty"`
+}
+
+// CreateTenderInvitationParams defines parameters for CreateTenderInvitation.
+type CreateTenderInvitationParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CancelTenderLotParams defines parameters for CancelTenderLot.
+type CancelTenderLotParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderQuestionsParams defines parameters for GetTenderQuestions.
+type GetTenderQuestionsParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// AskTenderQuestionJSONBody defines parameters for AskTenderQuestion.
+type AskTenderQuestionJSONBody struct {
+	// Question Текст вопроса или ответа
+	Question QuestionText `json:"question"`
+}
+
+// AskTenderQuestionParams defines parameters for AskTenderQuestion.
+type AskTenderQuestionParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderRankingParams defines parameters for GetTenderRanking.
+type GetTenderRankingParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetTenderReportParams defines parameters for GetTenderReport.
+type GetTenderReportParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// RollbackTenderParams defines parameters for RollbackTender.
+type RollbackTenderParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetTenderStatusParams defines parameters for GetTenderStatus.
+type GetTenderStatusParams struct {
+	Username *Username `form:"username,omitempty" json:"username,omitempty"`
+}
+
+// UpdateTenderStatusParams defines parameters for UpdateTenderStatus.
+type UpdateTenderStatusParams struct {
+	Status   TenderStatus `form:"status" json:"status"`
+	Username Username     `form:"username" json:"username"`
+}
+
+// DeleteWebhookParams defines parameters for DeleteWebhook.
+type DeleteWebhookParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// GetWebhookDeliveriesParams defines parameters for GetWebhookDeliveries.
+type GetWebhookDeliveriesParams struct {
+	Username Username `form:"username" json:"username"`
+
+	// Status Показать только доставки в указанном статусе.
+	Status *WebhookDeliveryStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Максимальное число возвращаемых объектов. Используется для запросов с пагинацией.
+	//
+	// Сервер должен возвращать максимальное допустимое число объектов.
+	Limit *PaginationLimit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Какое количество объектов должно быть пропущено с начала. Используется для запросов с пагинацией.
+	Offset *PaginationOffset `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PingWebhookParams defines parameters for PingWebhook.
+type PingWebhookParams struct {
+	Username Username `form:"username" json:"username"`
+}
+
+// CreateBidJSONRequestBody defines body for CreateBid for application/json ContentType.
+type CreateBidJSONRequestBody CreateBidJSONBody
+
+// UploadBidAttachmentMultipartRequestBody defines body for UploadBidAttachment for multipart/form-data ContentType.
+type UploadBidAttachmentMultipartRequestBody UploadBidAttachmentMultipartBody
+
+// EditBidJSONRequestBody defines body for EditBid for application/json ContentType.
+type EditBidJSONRequestBody EditBidJSONBody
+
+// SubmitBidScoresJSONRequestBody defines body for SubmitBidScores for application/json ContentType.
+type SubmitBidScoresJSONRequestBody SubmitBidScoresJSONBody
+
+// QueryGraphQLJSONRequestBody defines body for QueryGraphQL for application/json ContentType.
+type QueryGraphQLJSONRequestBody = GraphqlRequest
+
+// SetNotificationPreferencesJSONRequestBody defines body for SetNotificationPreferences for application/json ContentType.
+type SetNotificationPreferencesJSONRequestBody = NotificationPreferences
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
+
+// AnswerTenderQuestionJSONRequestBody defines body for AnswerTenderQuestion for application/json ContentType.
+type AnswerTenderQuestionJSONRequestBody AnswerTenderQuestionJSONBody
+
+// CreateTenderJSONRequestBody defines body for CreateTender for application/json ContentType.
+type CreateTenderJSONRequestBody CreateTenderJSONBody
+
+// UploadTenderAttachmentMultipartRequestBody defines body for UploadTenderAttachment for multipart/form-data ContentType.
+type UploadTenderAttachmentMultipartRequestBody UploadTenderAttachmentMultipartBody
+
+// EditTenderJSONRequestBody defines body for EditTender for application/json ContentType.
+type EditTenderJSONRequestBody EditTenderJSONBody
+
+// CreateTenderInvitationJSONRequestBody defines body for CreateTenderInvitation for application/json ContentType.
+type CreateTenderInvitationJSONRequestBody CreateTenderInvitationJSONBody
+
+// AskTenderQuestionJSONRequestBody defines body for AskTenderQuestion for application/json ContentType.
+type AskTenderQuestionJSONRequestBody AskTenderQuestionJSONBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// DownloadAttachment request
+	DownloadAttachment(ctx context.Context, attachmentId AttachmentId, params *DownloadAttachmentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportBidsWithBody request with any body
+	ImportBidsWithBody(ctx context.Context, params *ImportBidsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserBids request
+	GetUserBids(ctx context.Context, params *GetUserBidsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateBidWithBody request with any body
+	CreateBidWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateBid(ctx context.Context, body CreateBidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchBids request
+	SearchBids(ctx context.Context, params *SearchBidsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBidAttachments request
+	GetBidAttachments(ctx context.Context, bidId BidId, params *GetBidAttachmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadBidAttachmentWithBody request with any body
+	UploadBidAttachmentWithBody(ctx context.Context, bidId BidId, params *UploadBidAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditBidWithBody request with any body
+	EditBidWithBody(ctx context.Context, bidId BidId, params *EditBidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditBid(ctx context.Context, bidId BidId, params *EditBidParams, body EditBidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitBidFeedback request
+	SubmitBidFeedback(ctx context.Context, bidId BidId, params *SubmitBidFeedbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PlaceAuctionBid request
+	PlaceAuctionBid(ctx context.Context, bidId BidId, params *PlaceAuctionBidParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackBid request
+	RollbackBid(ctx context.Context, bidId BidId, version int32, params *RollbackBidParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBidScores request
+	GetBidScores(ctx context.Context, bidId BidId, params *GetBidScoresParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitBidScoresWithBody request with any body
+	SubmitBidScoresWithBody(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SubmitBidScores(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, body SubmitBidScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBidStatus request
+	GetBidStatus(ctx context.Context, bidId BidId, params *GetBidStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateBidStatus request
+	UpdateBidStatus(ctx context.Context, bidId BidId, params *UpdateBidStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SubmitBidDecision request
+	SubmitBidDecision(ctx context.Context, bidId BidId, params *SubmitBidDecisionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportBidsForTender request
+	ExportBidsForTender(ctx context.Context, tenderId TenderId, params *ExportBidsForTenderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBidsForTender request
+	GetBidsForTender(ctx context.Context, tenderId TenderId, params *GetBidsForTenderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetBidReviews request
+	GetBidReviews(ctx context.Context, tenderId TenderId, params *GetBidReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RedeliverWebhookDelivery request
+	RedeliverWebhookDelivery(ctx context.Context, deliveryId WebhookDeliveryId, params *RedeliverWebhookDeliveryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamEvents request
+	StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// QueryGraphQLWithBody request with any body
+	QueryGraphQLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	QueryGraphQL(ctx context.Context, body QueryGraphQLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserInvitations request
+	GetUserInvitations(ctx context.Context, params *GetUserInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RespondTenderInvitation request
+	RespondTenderInvitation(ctx context.Context, invitationId InvitationId, params *RespondTenderInvitationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationPreferences request
+	GetNotificationPreferences(ctx context.Context, params *GetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SetNotificationPreferencesWithBody request with any body
+	SetNotificationPreferencesWithBody(ctx context.Context, params *SetNotificationPreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	SetNotificationPreferences(ctx context.Context, params *SetNotificationPreferencesParams, body SetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditLog request
+	GetAuditLog(ctx context.Context, organizationId OrganizationId, params *GetAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VerifyAuditLog request
+	VerifyAuditLog(ctx context.Context, organizationId OrganizationId, params *VerifyAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationReputation request
+	GetOrganizationReputation(ctx context.Context, organizationId OrganizationId, params *GetOrganizationReputationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetOrganizationWebhooks request
+	GetOrganizationWebhooks(ctx context.Context, organizationId OrganizationId, params *GetOrganizationWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateWebhookWithBody request with any body
+	CreateWebhookWithBody(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateWebhook(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CheckServer request
+	CheckServer(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AnswerTenderQuestionWithBody request with any body
+	AnswerTenderQuestionWithBody(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnswerTenderQuestion(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, body AnswerTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenders request
+	GetTenders(ctx context.Context, params *GetTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportTenders request
+	ExportTenders(ctx context.Context, params *ExportTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportTendersWithBody request with any body
+	ImportTendersWithBody(ctx context.Context, params *ImportTendersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserTenders request
+	GetUserTenders(ctx context.Context, params *GetUserTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTenderWithBody request with any body
+	CreateTenderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTender(ctx context.Context, body CreateTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchTenders request
+	SearchTenders(ctx context.Context, params *SearchTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderAttachments request
+	GetTenderAttachments(ctx context.Context, tenderId TenderId, params *GetTenderAttachmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadTenderAttachmentWithBody request with any body
+	UploadTenderAttachmentWithBody(ctx context.Context, tenderId TenderId, params *UploadTenderAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuctionLeaderboard request
+	GetAuctionLeaderboard(ctx context.Context, tenderId TenderId, params *GetAuctionLeaderboardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EditTenderWithBody request with any body
+	EditTenderWithBody(ctx context.Context, tenderId TenderId, params *EditTenderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EditTender(ctx context.Context, tenderId TenderId, params *EditTenderParams, body EditTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderHistory request
+	GetTenderHistory(ctx context.Context, tenderId TenderId, params *GetTenderHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderInvitations request
+	GetTenderInvitations(ctx context.Context, tenderId TenderId, params *GetTenderInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateTenderInvitationWithBody request with any body
+	CreateTenderInvitationWithBody(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateTenderInvitation(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, body CreateTenderInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelTenderLot request
+	CancelTenderLot(ctx context.Context, tenderId TenderId, lotId LotId, params *CancelTenderLotParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderQuestions request
+	GetTenderQuestions(ctx context.Context, tenderId TenderId, params *GetTenderQuestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AskTenderQuestionWithBody request with any body
+	AskTenderQuestionWithBody(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AskTenderQuestion(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, body AskTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderRanking request
+	GetTenderRanking(ctx context.Context, tenderId TenderId, params *GetTenderRankingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderReport request
+	GetTenderReport(ctx context.Context, tenderId TenderId, params *GetTenderReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RollbackTender request
+	RollbackTender(ctx context.Context, tenderId TenderId, version int32, params *RollbackTenderParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTenderStatus request
+	GetTenderStatus(ctx context.Context, tenderId TenderId, params *GetTenderStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateTenderStatus request
+	UpdateTenderStatus(ctx context.Context, tenderId TenderId, params *UpdateTenderStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhook request
+	DeleteWebhook(ctx context.Context, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhookDeliveries request
+	GetWebhookDeliveries(ctx context.Context, webhookId WebhookId, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PingWebhook request
+	PingWebhook(ctx context.Context, webhookId WebhookId, params *PingWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) DownloadAttachment(ctx context.Context, attachmentId AttachmentId, params *DownloadAttachmentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadAttachmentRequest(c.Server, attachmentId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportBidsWithBody(ctx context.Context, params *ImportBidsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportBidsRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserBids(ctx context.Context, params *GetUserBidsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserBidsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBidWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBidRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateBid(ctx context.Context, body CreateBidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateBidRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchBids(ctx context.Context, params *SearchBidsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchBidsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBidAttachments(ctx context.Context, bidId BidId, params *GetBidAttachmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBidAttachmentsRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UploadBidAttachmentWithBody(ctx context.Context, bidId BidId, params *UploadBidAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadBidAttachmentRequestWithBody(c.Server, bidId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditBidWithBody(ctx context.Context, bidId BidId, params *EditBidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditBidRequestWithBody(c.Server, bidId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditBid(ctx context.Context, bidId BidId, params *EditBidParams, body EditBidJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditBidRequest(c.Server, bidId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitBidFeedback(ctx context.Context, bidId BidId, params *SubmitBidFeedbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitBidFeedbackRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PlaceAuctionBid(ctx context.Context, bidId BidId, params *PlaceAuctionBidParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPlaceAuctionBidRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RollbackBid(ctx context.Context, bidId BidId, version int32, params *RollbackBidParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackBidRequest(c.Server, bidId, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBidScores(ctx context.Context, bidId BidId, params *GetBidScoresParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBidScoresRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitBidScoresWithBody(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitBidScoresRequestWithBody(c.Server, bidId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitBidScores(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, body SubmitBidScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitBidScoresRequest(c.Server, bidId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBidStatus(ctx context.Context, bidId BidId, params *GetBidStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBidStatusRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateBidStatus(ctx context.Context, bidId BidId, params *UpdateBidStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateBidStatusRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SubmitBidDecision(ctx context.Context, bidId BidId, params *SubmitBidDecisionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSubmitBidDecisionRequest(c.Server, bidId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportBidsForTender(ctx context.Context, tenderId TenderId, params *ExportBidsForTenderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportBidsForTenderRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBidsForTender(ctx context.Context, tenderId TenderId, params *GetBidsForTenderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBidsForTenderRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetBidReviews(ctx context.Context, tenderId TenderId, params *GetBidReviewsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetBidReviewsRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RedeliverWebhookDelivery(ctx context.Context, deliveryId WebhookDeliveryId, params *RedeliverWebhookDeliveryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRedeliverWebhookDeliveryRequest(c.Server, deliveryId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StreamEvents(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamEventsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QueryGraphQLWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryGraphQLRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) QueryGraphQL(ctx context.Context, body QueryGraphQLJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryGraphQLRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserInvitations(ctx context.Context, params *GetUserInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserInvitationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RespondTenderInvitation(ctx context.Context, invitationId InvitationId, params *RespondTenderInvitationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRespondTenderInvitationRequest(c.Server, invitationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNotificationPreferences(ctx context.Context, params *GetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationPreferencesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetNotificationPreferencesWithBody(ctx context.Context, params *SetNotificationPreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetNotificationPreferencesRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SetNotificationPreferences(ctx context.Context, params *SetNotificationPreferencesParams, body SetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSetNotificationPreferencesRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuditLog(ctx context.Context, organizationId OrganizationId, params *GetAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditLogRequest(c.Server, organizationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VerifyAuditLog(ctx context.Context, organizationId OrganizationId, params *VerifyAuditLogParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVerifyAuditLogRequest(c.Server, organizationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrganizationReputation(ctx context.Context, organizationId OrganizationId, params *GetOrganizationReputationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationReputationRequest(c.Server, organizationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetOrganizationWebhooks(ctx context.Context, organizationId OrganizationId, params *GetOrganizationWebhooksParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrganizationWebhooksRequest(c.Server, organizationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhookWithBody(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequestWithBody(c.Server, organizationId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateWebhook(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateWebhookRequest(c.Server, organizationId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CheckServer(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCheckServerRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AnswerTenderQuestionWithBody(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerTenderQuestionRequestWithBody(c.Server, questionId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AnswerTenderQuestion(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, body AnswerTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnswerTenderQuestionRequest(c.Server, questionId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenders(ctx context.Context, params *GetTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTendersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportTenders(ctx context.Context, params *ExportTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportTendersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportTendersWithBody(ctx context.Context, params *ImportTendersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportTendersRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserTenders(ctx context.Context, params *GetUserTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserTendersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTenderWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTenderRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTender(ctx context.Context, body CreateTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTenderRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SearchTenders(ctx context.Context, params *SearchTendersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchTendersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderAttachments(ctx context.Context, tenderId TenderId, params *GetTenderAttachmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderAttachmentsRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UploadTenderAttachmentWithBody(ctx context.Context, tenderId TenderId, params *UploadTenderAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadTenderAttachmentRequestWithBody(c.Server, tenderId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuctionLeaderboard(ctx context.Context, tenderId TenderId, params *GetAuctionLeaderboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuctionLeaderboardRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTenderWithBody(ctx context.Context, tenderId TenderId, params *EditTenderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTenderRequestWithBody(c.Server, tenderId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EditTender(ctx context.Context, tenderId TenderId, params *EditTenderParams, body EditTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEditTenderRequest(c.Server, tenderId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderHistory(ctx context.Context, tenderId TenderId, params *GetTenderHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderHistoryRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderInvitations(ctx context.Context, tenderId TenderId, params *GetTenderInvitationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderInvitationsRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTenderInvitationWithBody(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTenderInvitationRequestWithBody(c.Server, tenderId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateTenderInvitation(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, body CreateTenderInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateTenderInvitationRequest(c.Server, tenderId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CancelTenderLot(ctx context.Context, tenderId TenderId, lotId LotId, params *CancelTenderLotParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelTenderLotRequest(c.Server, tenderId, lotId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderQuestions(ctx context.Context, tenderId TenderId, params *GetTenderQuestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderQuestionsRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AskTenderQuestionWithBody(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAskTenderQuestionRequestWithBody(c.Server, tenderId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AskTenderQuestion(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, body AskTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAskTenderQuestionRequest(c.Server, tenderId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderRanking(ctx context.Context, tenderId TenderId, params *GetTenderRankingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderRankingRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderReport(ctx context.Context, tenderId TenderId, params *GetTenderReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderReportRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RollbackTender(ctx context.Context, tenderId TenderId, version int32, params *RollbackTenderParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRollbackTenderRequest(c.Server, tenderId, version, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTenderStatus(ctx context.Context, tenderId TenderId, params *GetTenderStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTenderStatusRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateTenderStatus(ctx context.Context, tenderId TenderId, params *UpdateTenderStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateTenderStatusRequest(c.Server, tenderId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhookRequest(c.Server, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWebhookDeliveries(ctx context.Context, webhookId WebhookId, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhookDeliveriesRequest(c.Server, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PingWebhook(ctx context.Context, webhookId WebhookId, params *PingWebhookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPingWebhookRequest(c.Server, webhookId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewDownloadAttachmentRequest generates requests for DownloadAttachment
+func NewDownloadAttachmentRequest(server string, attachmentId AttachmentId, params *DownloadAttachmentParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "attachmentId", runtime.ParamLocationPath, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/attachments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportBidsRequestWithBody generates requests for ImportBids with any type of body
+func NewImportBidsRequestWithBody(server string, params *ImportBidsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Report != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "report", runtime.ParamLocationQuery, *params.Report); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserBidsRequest generates requests for GetUserBids
+func NewGetUserBidsRequest(server string, params *GetUserBidsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/my")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxDeliveryDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxDeliveryDays", runtime.ParamLocationQuery, *params.MaxDeliveryDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateBidRequest calls the generic CreateBid builder with application/json body
+func NewCreateBidRequest(server string, body CreateBidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateBidRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateBidRequestWithBody generates requests for CreateBid with any type of body
+func NewCreateBidRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/new")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchBidsRequest generates requests for SearchBids
+func NewSearchBidsRequest(server string, params *SearchBidsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.TenderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenderId", runtime.ParamLocationQuery, *params.TenderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBidAttachmentsRequest generates requests for GetBidAttachments
+func NewGetBidAttachmentsRequest(server string, bidId BidId, params *GetBidAttachmentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadBidAttachmentRequestWithBody generates requests for UploadBidAttachment with any type of body
+func NewUploadBidAttachmentRequestWithBody(server string, bidId BidId, params *UploadBidAttachmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewEditBidRequest calls the generic EditBid builder with application/json body
+func NewEditBidRequest(server string, bidId BidId, params *EditBidParams, body EditBidJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditBidRequestWithBody(server, bidId, params, "application/json", bodyReader)
+}
+
+// NewEditBidRequestWithBody generates requests for EditBid with any type of body
+func NewEditBidRequestWithBody(server string, bidId BidId, params *EditBidParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/edit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSubmitBidFeedbackRequest generates requests for SubmitBidFeedback
+func NewSubmitBidFeedbackRequest(server string, bidId BidId, params *SubmitBidFeedbackParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/feedback", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bidFeedback", runtime.ParamLocationQuery, params.BidFeedback); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Rating != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "rating", runtime.ParamLocationQuery, *params.Rating); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OnTime != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "onTime", runtime.ParamLocationQuery, *params.OnTime); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPlaceAuctionBidRequest generates requests for PlaceAuctionBid
+func NewPlaceAuctionBidRequest(server string, bidId BidId, params *PlaceAuctionBidParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/price", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "price", runtime.ParamLocationQuery, params.Price); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRollbackBidRequest generates requests for RollbackBid
+func NewRollbackBidRequest(server string, bidId BidId, version int32, params *RollbackBidParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/rollback/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBidScoresRequest generates requests for GetBidScores
+func NewGetBidScoresRequest(server string, bidId BidId, params *GetBidScoresParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/scores", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitBidScoresRequest calls the generic SubmitBidScores builder with application/json body
+func NewSubmitBidScoresRequest(server string, bidId BidId, params *SubmitBidScoresParams, body SubmitBidScoresJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSubmitBidScoresRequestWithBody(server, bidId, params, "application/json", bodyReader)
+}
+
+// NewSubmitBidScoresRequestWithBody generates requests for SubmitBidScores with any type of body
+func NewSubmitBidScoresRequestWithBody(server string, bidId BidId, params *SubmitBidScoresParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/scores", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetBidStatusRequest generates requests for GetBidStatus
+func NewGetBidStatusRequest(server string, bidId BidId, params *GetBidStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateBidStatusRequest generates requests for UpdateBidStatus
+func NewUpdateBidStatusRequest(server string, bidId BidId, params *UpdateBidStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSubmitBidDecisionRequest generates requests for SubmitBidDecision
+func NewSubmitBidDecisionRequest(server string, bidId BidId, params *SubmitBidDecisionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "bidId", runtime.ParamLocationPath, bidId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/submit_decision", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "decision", runtime.ParamLocationQuery, params.Decision); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.LotId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "lotId", runtime.ParamLocationQuery, *params.LotId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportBidsForTenderRequest generates requests for ExportBidsForTender
+func NewExportBidsForTenderRequest(server string, tenderId TenderId, params *ExportBidsForTenderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxDeliveryDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxDeliveryDays", runtime.ParamLocationQuery, *params.MaxDeliveryDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBidsForTenderRequest generates requests for GetBidsForTender
+func NewGetBidsForTenderRequest(server string, tenderId TenderId, params *GetBidsForTenderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/list", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minPrice", runtime.ParamLocationQuery, *params.MinPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPrice != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPrice", runtime.ParamLocationQuery, *params.MaxPrice); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxDeliveryDays != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxDeliveryDays", runtime.ParamLocationQuery, *params.MaxDeliveryDays); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetBidReviewsRequest generates requests for GetBidReviews
+func NewGetBidReviewsRequest(server string, tenderId TenderId, params *GetBidReviewsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/bids/%s/reviews", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "authorUsername", runtime.ParamLocationQuery, params.AuthorUsername); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "requesterUsername", runtime.ParamLocationQuery, params.RequesterUsername); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRedeliverWebhookDeliveryRequest generates requests for RedeliverWebhookDelivery
+func NewRedeliverWebhookDeliveryRequest(server string, deliveryId WebhookDeliveryId, params *RedeliverWebhookDeliveryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "deliveryId", runtime.ParamLocationPath, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deliveries/%s/redeliver", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamEventsRequest generates requests for StreamEvents
+func NewStreamEventsRequest(server string, params *StreamEventsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TenderId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tenderId", runtime.ParamLocationQuery, *params.TenderId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizationId", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewQueryGraphQLRequest calls the generic QueryGraphQL builder with application/json body
+func NewQueryGraphQLRequest(server string, body QueryGraphQLJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryGraphQLRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewQueryGraphQLRequestWithBody generates requests for QueryGraphQL with any type of body
+func NewQueryGraphQLRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/graphql")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserInvitationsRequest generates requests for GetUserInvitations
+func NewGetUserInvitationsRequest(server string, params *GetUserInvitationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations/my")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRespondTenderInvitationRequest generates requests for RespondTenderInvitation
+func NewRespondTenderInvitationRequest(server string, invitationId InvitationId, params *RespondTenderInvitationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "invitationId", runtime.ParamLocationPath, invitationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/invitations/%s/respond", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "response", runtime.ParamLocationQuery, params.Response); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNotificationPreferencesRequest generates requests for GetNotificationPreferences
+func NewGetNotificationPreferencesRequest(server string, params *GetNotificationPreferencesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSetNotificationPreferencesRequest calls the generic SetNotificationPreferences builder with application/json body
+func NewSetNotificationPreferencesRequest(server string, params *SetNotificationPreferencesParams, body SetNotificationPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewSetNotificationPreferencesRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewSetNotificationPreferencesRequestWithBody generates requests for SetNotificationPreferences with any type of body
+func NewSetNotificationPreferencesRequestWithBody(server string, params *SetNotificationPreferencesParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAuditLogRequest generates requests for GetAuditLog
+func NewGetAuditLogRequest(server string, organizationId OrganizationId, params *GetAuditLogParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/audit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.EntityType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entityType", runtime.ParamLocationQuery, *params.EntityType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EntityId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "entityId", runtime.ParamLocationQuery, *params.EntityId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Actor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor", runtime.ParamLocationQuery, *params.Actor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVerifyAuditLogRequest generates requests for VerifyAuditLog
+func NewVerifyAuditLogRequest(server string, organizationId OrganizationId, params *VerifyAuditLogParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/audit/verify", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationReputationRequest generates requests for GetOrganizationReputation
+func NewGetOrganizationReputationRequest(server string, organizationId OrganizationId, params *GetOrganizationReputationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/reputation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrganizationWebhooksRequest generates requests for GetOrganizationWebhooks
+func NewGetOrganizationWebhooksRequest(server string, organizationId OrganizationId, params *GetOrganizationWebhooksParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateWebhookRequest calls the generic CreateWebhook builder with application/json body
+func NewCreateWebhookRequest(server string, organizationId OrganizationId, params *CreateWebhookParams, body CreateWebhookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateWebhookRequestWithBody(server, organizationId, params, "application/json", bodyReader)
+}
+
+// NewCreateWebhookRequestWithBody generates requests for CreateWebhook with any type of body
+func NewCreateWebhookRequestWithBody(server string, organizationId OrganizationId, params *CreateWebhookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "organizationId", runtime.ParamLocationPath, organizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/organizations/%s/webhooks", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCheckServerRequest generates requests for CheckServer
+func NewCheckServerRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/ping")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAnswerTenderQuestionRequest calls the generic AnswerTenderQuestion builder with application/json body
+func NewAnswerTenderQuestionRequest(server string, questionId QuestionId, params *AnswerTenderQuestionParams, body AnswerTenderQuestionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAnswerTenderQuestionRequestWithBody(server, questionId, params, "application/json", bodyReader)
+}
+
+// NewAnswerTenderQuestionRequestWithBody generates requests for AnswerTenderQuestion with any type of body
+func NewAnswerTenderQuestionRequestWithBody(server string, questionId QuestionId, params *AnswerTenderQuestionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "questionId", runtime.ParamLocationPath, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/questions/%s/answer", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTendersRequest generates requests for GetTenders
+func NewGetTendersRequest(server string, params *GetTendersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServiceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service_type", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinBudget != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minBudget", runtime.ParamLocationQuery, *params.MinBudget); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxBudget != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxBudget", runtime.ParamLocationQuery, *params.MaxBudget); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportTendersRequest generates requests for ExportTenders
+func NewExportTendersRequest(server string, params *ExportTendersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServiceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service_type", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinBudget != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minBudget", runtime.ParamLocationQuery, *params.MinBudget); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxBudget != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxBudget", runtime.ParamLocationQuery, *params.MaxBudget); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportTendersRequestWithBody generates requests for ImportTenders with any type of body
+func NewImportTendersRequestWithBody(server string, params *ImportTendersParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Mode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "mode", runtime.ParamLocationQuery, *params.Mode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.DryRun != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "dryRun", runtime.ParamLocationQuery, *params.DryRun); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Report != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "report", runtime.ParamLocationQuery, *params.Report); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserTendersRequest generates requests for GetUserTenders
+func NewGetUserTendersRequest(server string, params *GetUserTendersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/my")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Currency != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "currency", runtime.ParamLocationQuery, *params.Currency); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MinBudget != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "minBudget", runtime.ParamLocationQuery, *params.MinBudget); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxBudget != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxBudget", runtime.ParamLocationQuery, *params.MaxBudget); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortBy", runtime.ParamLocationQuery, *params.SortBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortOrder != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sortOrder", runtime.ParamLocationQuery, *params.SortOrder); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTenderRequest calls the generic CreateTender builder with application/json body
+func NewCreateTenderRequest(server string, body CreateTenderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTenderRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewCreateTenderRequestWithBody generates requests for CreateTender with any type of body
+func NewCreateTenderRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/new")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchTendersRequest generates requests for SearchTenders
+func NewSearchTendersRequest(server string, params *SearchTendersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ServiceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service_type", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrganizationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "organizationId", runtime.ParamLocationQuery, *params.OrganizationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenderAttachmentsRequest generates requests for GetTenderAttachments
+func NewGetTenderAttachmentsRequest(server string, tenderId TenderId, params *GetTenderAttachmentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Version != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "version", runtime.ParamLocationQuery, *params.Version); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadTenderAttachmentRequestWithBody generates requests for UploadTenderAttachment with any type of body
+func NewUploadTenderAttachmentRequestWithBody(server string, tenderId TenderId, params *UploadTenderAttachmentParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/attachments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAuctionLeaderboardRequest generates requests for GetAuctionLeaderboard
+func NewGetAuctionLeaderboardRequest(server string, tenderId TenderId, params *GetAuctionLeaderboardParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/auction/leaderboard", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewEditTenderRequest calls the generic EditTender builder with application/json body
+func NewEditTenderRequest(server string, tenderId TenderId, params *EditTenderParams, body EditTenderJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewEditTenderRequestWithBody(server, tenderId, params, "application/json", bodyReader)
+}
+
+// NewEditTenderRequestWithBody generates requests for EditTender with any type of body
+func NewEditTenderRequestWithBody(server string, tenderId TenderId, params *EditTenderParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/edit", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTenderHistoryRequest generates requests for GetTenderHistory
+func NewGetTenderHistoryRequest(server string, tenderId TenderId, params *GetTenderHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenderInvitationsRequest generates requests for GetTenderInvitations
+func NewGetTenderInvitationsRequest(server string, tenderId TenderId, params *GetTenderInvitationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/invitations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateTenderInvitationRequest calls the generic CreateTenderInvitation builder with application/json body
+func NewCreateTenderInvitationRequest(server string, tenderId TenderId, params *CreateTenderInvitationParams, body CreateTenderInvitationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateTenderInvitationRequestWithBody(server, tenderId, params, "application/json", bodyReader)
+}
+
+// NewCreateTenderInvitationRequestWithBody generates requests for CreateTenderInvitation with any type of body
+func NewCreateTenderInvitationRequestWithBody(server string, tenderId TenderId, params *CreateTenderInvitationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/invitations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCancelTenderLotRequest generates requests for CancelTenderLot
+func NewCancelTenderLotRequest(server string, tenderId TenderId, lotId LotId, params *CancelTenderLotParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "lotId", runtime.ParamLocationPath, lotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/lots/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenderQuestionsRequest generates requests for GetTenderQuestions
+func NewGetTenderQuestionsRequest(server string, tenderId TenderId, params *GetTenderQuestionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/questions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAskTenderQuestionRequest calls the generic AskTenderQuestion builder with application/json body
+func NewAskTenderQuestionRequest(server string, tenderId TenderId, params *AskTenderQuestionParams, body AskTenderQuestionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAskTenderQuestionRequestWithBody(server, tenderId, params, "application/json", bodyReader)
+}
+
+// NewAskTenderQuestionRequestWithBody generates requests for AskTenderQuestion with any type of body
+func NewAskTenderQuestionRequestWithBody(server string, tenderId TenderId, params *AskTenderQuestionParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/questions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTenderRankingRequest generates requests for GetTenderRanking
+func NewGetTenderRankingRequest(server string, tenderId TenderId, params *GetTenderRankingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/ranking", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenderReportRequest generates requests for GetTenderReport
+func NewGetTenderReportRequest(server string, tenderId TenderId, params *GetTenderReportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/report.pdf", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRollbackTenderRequest generates requests for RollbackTender
+func NewRollbackTenderRequest(server string, tenderId TenderId, version int32, params *RollbackTenderParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/rollback/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTenderStatusRequest generates requests for GetTenderStatus
+func NewGetTenderStatusRequest(server string, tenderId TenderId, params *GetTenderStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Username != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, *params.Username); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateTenderStatusRequest generates requests for UpdateTenderStatus
+func NewUpdateTenderStatusRequest(server string, tenderId TenderId, params *UpdateTenderStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "tenderId", runtime.ParamLocationPath, tenderId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tenders/%s/status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteWebhookRequest generates requests for DeleteWebhook
+func NewDeleteWebhookRequest(server string, webhookId WebhookId, params *DeleteWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhookDeliveriesRequest generates requests for GetWebhookDeliveries
+func NewGetWebhookDeliveriesRequest(server string, webhookId WebhookId, params *GetWebhookDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Status != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPingWebhookRequest generates requests for PingWebhook
+func NewPingWebhookRequest(server string, webhookId WebhookId, params *PingWebhookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/ping", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "username", runtime.ParamLocationQuery, params.Username); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DownloadAttachmentWithResponse request
+	DownloadAttachmentWithResponse(ctx context.Context, attachmentId AttachmentId, params *DownloadAttachmentParams, reqEditors ...RequestEditorFn) (*DownloadAttachmentResponse, error)
+
+	// ImportBidsWithBodyWithResponse request with any body
+	ImportBidsWithBodyWithResponse(ctx context.Context, params *ImportBidsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBidsResponse, error)
+
+	// GetUserBidsWithResponse request
+	GetUserBidsWithResponse(ctx context.Context, params *GetUserBidsParams, reqEditors ...RequestEditorFn) (*GetUserBidsResponse, error)
+
+	// CreateBidWithBodyWithResponse request with any body
+	CreateBidWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBidResponse, error)
+
+	CreateBidWithResponse(ctx context.Context, body CreateBidJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBidResponse, error)
+
+	// SearchBidsWithResponse request
+	SearchBidsWithResponse(ctx context.Context, params *SearchBidsParams, reqEditors ...RequestEditorFn) (*SearchBidsResponse, error)
+
+	// GetBidAttachmentsWithResponse request
+	GetBidAttachmentsWithResponse(ctx context.Context, bidId BidId, params *GetBidAttachmentsParams, reqEditors ...RequestEditorFn) (*GetBidAttachmentsResponse, error)
+
+	// UploadBidAttachmentWithBodyWithResponse request with any body
+	UploadBidAttachmentWithBodyWithResponse(ctx context.Context, bidId BidId, params *UploadBidAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadBidAttachmentResponse, error)
+
+	// EditBidWithBodyWithResponse request with any body
+	EditBidWithBodyWithResponse(ctx context.Context, bidId BidId, params *EditBidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditBidResponse, error)
+
+	EditBidWithResponse(ctx context.Context, bidId BidId, params *EditBidParams, body EditBidJSONRequestBody, reqEditors ...RequestEditorFn) (*EditBidResponse, error)
+
+	// SubmitBidFeedbackWithResponse request
+	SubmitBidFeedbackWithResponse(ctx context.Context, bidId BidId, params *SubmitBidFeedbackParams, reqEditors ...RequestEditorFn) (*SubmitBidFeedbackResponse, error)
+
+	// PlaceAuctionBidWithResponse request
+	PlaceAuctionBidWithResponse(ctx context.Context, bidId BidId, params *PlaceAuctionBidParams, reqEditors ...RequestEditorFn) (*PlaceAuctionBidResponse, error)
+
+	// RollbackBidWithResponse request
+	RollbackBidWithResponse(ctx context.Context, bidId BidId, version int32, params *RollbackBidParams, reqEditors ...RequestEditorFn) (*RollbackBidResponse, error)
+
+	// GetBidScoresWithResponse request
+	GetBidScoresWithResponse(ctx context.Context, bidId BidId, params *GetBidScoresParams, reqEditors ...RequestEditorFn) (*GetBidScoresResponse, error)
+
+	// SubmitBidScoresWithBodyWithResponse request with any body
+	SubmitBidScoresWithBodyWithResponse(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitBidScoresResponse, error)
+
+	SubmitBidScoresWithResponse(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, body SubmitBidScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitBidScoresResponse, error)
+
+	// GetBidStatusWithResponse request
+	GetBidStatusWithResponse(ctx context.Context, bidId BidId, params *GetBidStatusParams, reqEditors ...RequestEditorFn) (*GetBidStatusResponse, error)
+
+	// UpdateBidStatusWithResponse request
+	UpdateBidStatusWithResponse(ctx context.Context, bidId BidId, params *UpdateBidStatusParams, reqEditors ...RequestEditorFn) (*UpdateBidStatusResponse, error)
+
+	// SubmitBidDecisionWithResponse request
+	SubmitBidDecisionWithResponse(ctx context.Context, bidId BidId, params *SubmitBidDecisionParams, reqEditors ...RequestEditorFn) (*SubmitBidDecisionResponse, error)
+
+	// ExportBidsForTenderWithResponse request
+	ExportBidsForTenderWithResponse(ctx context.Context, tenderId TenderId, params *ExportBidsForTenderParams, reqEditors ...RequestEditorFn) (*ExportBidsForTenderResponse, error)
+
+	// GetBidsForTenderWithResponse request
+	GetBidsForTenderWithResponse(ctx context.Context, tenderId TenderId, params *GetBidsForTenderParams, reqEditors ...RequestEditorFn) (*GetBidsForTenderResponse, error)
+
+	// GetBidReviewsWithResponse request
+	GetBidReviewsWithResponse(ctx context.Context, tenderId TenderId, params *GetBidReviewsParams, reqEditors ...RequestEditorFn) (*GetBidReviewsResponse, error)
+
+	// RedeliverWebhookDeliveryWithResponse request
+	RedeliverWebhookDeliveryWithResponse(ctx context.Context, deliveryId WebhookDeliveryId, params *RedeliverWebhookDeliveryParams, reqEditors ...RequestEditorFn) (*RedeliverWebhookDeliveryResponse, error)
+
+	// StreamEventsWithResponse request
+	StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error)
+
+	// QueryGraphQLWithBodyWithResponse request with any body
+	QueryGraphQLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryGraphQLResponse, error)
+
+	QueryGraphQLWithResponse(ctx context.Context, body QueryGraphQLJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryGraphQLResponse, error)
+
+	// GetUserInvitationsWithResponse request
+	GetUserInvitationsWithResponse(ctx context.Context, params *GetUserInvitationsParams, reqEditors ...RequestEditorFn) (*GetUserInvitationsResponse, error)
+
+	// RespondTenderInvitationWithResponse request
+	RespondTenderInvitationWithResponse(ctx context.Context, invitationId InvitationId, params *RespondTenderInvitationParams, reqEditors ...RequestEditorFn) (*RespondTenderInvitationResponse, error)
+
+	// GetNotificationPreferencesWithResponse request
+	GetNotificationPreferencesWithResponse(ctx context.Context, params *GetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*GetNotificationPreferencesResponse, error)
+
+	// SetNotificationPreferencesWithBodyWithResponse request with any body
+	SetNotificationPreferencesWithBodyWithResponse(ctx context.Context, params *SetNotificationPreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetNotificationPreferencesResponse, error)
+
+	SetNotificationPreferencesWithResponse(ctx context.Context, params *SetNotificationPreferencesParams, body SetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetNotificationPreferencesResponse, error)
+
+	// GetAuditLogWithResponse request
+	GetAuditLogWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAuditLogParams, reqEditors ...RequestEditorFn) (*GetAuditLogResponse, error)
+
+	// VerifyAuditLogWithResponse request
+	VerifyAuditLogWithResponse(ctx context.Context, organizationId OrganizationId, params *VerifyAuditLogParams, reqEditors ...RequestEditorFn) (*VerifyAuditLogResponse, error)
+
+	// GetOrganizationReputationWithResponse request
+	GetOrganizationReputationWithResponse(ctx context.Context, organizationId OrganizationId, params *GetOrganizationReputationParams, reqEditors ...RequestEditorFn) (*GetOrganizationReputationResponse, error)
+
+	// GetOrganizationWebhooksWithResponse request
+	GetOrganizationWebhooksWithResponse(ctx context.Context, organizationId OrganizationId, params *GetOrganizationWebhooksParams, reqEditors ...RequestEditorFn) (*GetOrganizationWebhooksResponse, error)
+
+	// CreateWebhookWithBodyWithResponse request with any body
+	CreateWebhookWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	CreateWebhookWithResponse(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error)
+
+	// CheckServerWithResponse request
+	CheckServerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CheckServerResponse, error)
+
+	// AnswerTenderQuestionWithBodyWithResponse request with any body
+	AnswerTenderQuestionWithBodyWithResponse(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerTenderQuestionResponse, error)
+
+	AnswerTenderQuestionWithResponse(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, body AnswerTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*AnswerTenderQuestionResponse, error)
+
+	// GetTendersWithResponse request
+	GetTendersWithResponse(ctx context.Context, params *GetTendersParams, reqEditors ...RequestEditorFn) (*GetTendersResponse, error)
+
+	// ExportTendersWithResponse request
+	ExportTendersWithResponse(ctx context.Context, params *ExportTendersParams, reqEditors ...RequestEditorFn) (*ExportTendersResponse, error)
+
+	// ImportTendersWithBodyWithResponse request with any body
+	ImportTendersWithBodyWithResponse(ctx context.Context, params *ImportTendersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTendersResponse, error)
+
+	// GetUserTendersWithResponse request
+	GetUserTendersWithResponse(ctx context.Context, params *GetUserTendersParams, reqEditors ...RequestEditorFn) (*GetUserTendersResponse, error)
+
+	// CreateTenderWithBodyWithResponse request with any body
+	CreateTenderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTenderResponse, error)
+
+	CreateTenderWithResponse(ctx context.Context, body CreateTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTenderResponse, error)
+
+	// SearchTendersWithResponse request
+	SearchTendersWithResponse(ctx context.Context, params *SearchTendersParams, reqEditors ...RequestEditorFn) (*SearchTendersResponse, error)
+
+	// GetTenderAttachmentsWithResponse request
+	GetTenderAttachmentsWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderAttachmentsParams, reqEditors ...RequestEditorFn) (*GetTenderAttachmentsResponse, error)
+
+	// UploadTenderAttachmentWithBodyWithResponse request with any body
+	UploadTenderAttachmentWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *UploadTenderAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTenderAttachmentResponse, error)
+
+	// GetAuctionLeaderboardWithResponse request
+	GetAuctionLeaderboardWithResponse(ctx context.Context, tenderId TenderId, params *GetAuctionLeaderboardParams, reqEditors ...RequestEditorFn) (*GetAuctionLeaderboardResponse, error)
+
+	// EditTenderWithBodyWithResponse request with any body
+	EditTenderWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *EditTenderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTenderResponse, error)
+
+	EditTenderWithResponse(ctx context.Context, tenderId TenderId, params *EditTenderParams, body EditTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTenderResponse, error)
+
+	// GetTenderHistoryWithResponse request
+	GetTenderHistoryWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderHistoryParams, reqEditors ...RequestEditorFn) (*GetTenderHistoryResponse, error)
+
+	// GetTenderInvitationsWithResponse request
+	GetTenderInvitationsWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderInvitationsParams, reqEditors ...RequestEditorFn) (*GetTenderInvitationsResponse, error)
+
+	// CreateTenderInvitationWithBodyWithResponse request with any body
+	CreateTenderInvitationWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTenderInvitationResponse, error)
+
+	CreateTenderInvitationWithResponse(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, body CreateTenderInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTenderInvitationResponse, error)
+
+	// CancelTenderLotWithResponse request
+	CancelTenderLotWithResponse(ctx context.Context, tenderId TenderId, lotId LotId, params *CancelTenderLotParams, reqEditors ...RequestEditorFn) (*CancelTenderLotResponse, error)
+
+	// GetTenderQuestionsWithResponse request
+	GetTenderQuestionsWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderQuestionsParams, reqEditors ...RequestEditorFn) (*GetTenderQuestionsResponse, error)
+
+	// AskTenderQuestionWithBodyWithResponse request with any body
+	AskTenderQuestionWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AskTenderQuestionResponse, error)
+
+	AskTenderQuestionWithResponse(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, body AskTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*AskTenderQuestionResponse, error)
+
+	// GetTenderRankingWithResponse request
+	GetTenderRankingWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderRankingParams, reqEditors ...RequestEditorFn) (*GetTenderRankingResponse, error)
+
+	// GetTenderReportWithResponse request
+	GetTenderReportWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderReportParams, reqEditors ...RequestEditorFn) (*GetTenderReportResponse, error)
+
+	// RollbackTenderWithResponse request
+	RollbackTenderWithResponse(ctx context.Context, tenderId TenderId, version int32, params *RollbackTenderParams, reqEditors ...RequestEditorFn) (*RollbackTenderResponse, error)
+
+	// GetTenderStatusWithResponse request
+	GetTenderStatusWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderStatusParams, reqEditors ...RequestEditorFn) (*GetTenderStatusResponse, error)
+
+	// UpdateTenderStatusWithResponse request
+	UpdateTenderStatusWithResponse(ctx context.Context, tenderId TenderId, params *UpdateTenderStatusParams, reqEditors ...RequestEditorFn) (*UpdateTenderStatusResponse, error)
+
+	// DeleteWebhookWithResponse request
+	DeleteWebhookWithResponse(ctx context.Context, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error)
+
+	// GetWebhookDeliveriesWithResponse request
+	GetWebhookDeliveriesWithResponse(ctx context.Context, webhookId WebhookId, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResponse, error)
+
+	// PingWebhookWithResponse request
+	PingWebhookWithResponse(ctx context.Context, webhookId WebhookId, params *PingWebhookParams, reqEditors ...RequestEditorFn) (*PingWebhookResponse, error)
+}
+
+type DownloadAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportBidsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportBidsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportBidsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserBidsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Bid
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserBidsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserBidsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateBidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateBidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateBidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchBidsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BidSearchHit
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchBidsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchBidsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBidAttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Attachment
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBidAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBidAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadBidAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Attachment
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadBidAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadBidAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditBidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditBidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditBidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitBidFeedbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitBidFeedbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitBidFeedbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PlaceAuctionBidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PlaceAuctionBidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PlaceAuctionBidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RollbackBidResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackBidResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackBidResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBidScoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BidScorecard
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBidScoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBidScoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitBidScoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BidScorecard
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitBidScoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitBidScoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBidStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BidStatus
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBidStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBidStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateBidStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateBidStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateBidStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SubmitBidDecisionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SubmitBidDecisionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SubmitBidDecisionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportBidsForTenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportBidsForTenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportBidsForTenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBidsForTenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Bid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBidsForTenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBidsForTenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetBidReviewsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]BidReview
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetBidReviewsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetBidReviewsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RedeliverWebhookDeliveryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RedeliverWebhookDeliveryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RedeliverWebhookDeliveryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryGraphQLResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GraphqlResponse
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryGraphQLResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryGraphQLResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserInvitationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TenderInvitation
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RespondTenderInvitationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenderInvitation
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RespondTenderInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RespondTenderInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationPreferences
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SetNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationPreferences
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SetNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SetNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AuditEntry
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VerifyAuditLogResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditVerification
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r VerifyAuditLogResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VerifyAuditLogResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationReputationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrganizationReputation
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationReputationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationReputationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrganizationWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrganizationWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetOrganizationWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CheckServerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CheckServerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CheckServerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnswerTenderQuestionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenderQuestion
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AnswerTenderQuestionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnswerTenderQuestionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTendersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tender
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTendersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTendersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportTendersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportTendersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportTendersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportTendersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+	JSON400      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportTendersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportTendersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserTendersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Tender
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserTendersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserTendersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tender
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchTendersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TenderSearchHit
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchTendersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchTendersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderAttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Attachment
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderAttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderAttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadTenderAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Attachment
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadTenderAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadTenderAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuctionLeaderboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuctionLeaderboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuctionLeaderboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EditTenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tender
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r EditTenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EditTenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TenderRevision
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderInvitationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TenderInvitation
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderInvitationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderInvitationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateTenderInvitationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenderInvitation
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateTenderInvitationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateTenderInvitationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelTenderLotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tender
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelTenderLotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelTenderLotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderQuestionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TenderQuestion
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderQuestionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderQuestionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AskTenderQuestionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenderQuestion
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r AskTenderQuestionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AskTenderQuestionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderRankingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RankedBid
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderRankingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderRankingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RollbackTenderResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tender
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r RollbackTenderResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RollbackTenderResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTenderStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TenderStatus
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTenderStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTenderStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateTenderStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Tender
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateTenderStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateTenderStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Webhook
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhookDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]WebhookDelivery
+	JSON400      *ErrorResponse
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhookDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhookDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PingWebhookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDelivery
+	JSON401      *ErrorResponse
+	JSON403      *ErrorResponse
+	JSON404      *ErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PingWebhookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PingWebhookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// DownloadAttachmentWithResponse request returning *DownloadAttachmentResponse
+func (c *ClientWithResponses) DownloadAttachmentWithResponse(ctx context.Context, attachmentId AttachmentId, params *DownloadAttachmentParams, reqEditors ...RequestEditorFn) (*DownloadAttachmentResponse, error) {
+	rsp, err := c.DownloadAttachment(ctx, attachmentId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadAttachmentResponse(rsp)
+}
+
+// ImportBidsWithBodyWithResponse request with arbitrary body returning *ImportBidsResponse
+func (c *ClientWithResponses) ImportBidsWithBodyWithResponse(ctx context.Context, params *ImportBidsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportBidsResponse, error) {
+	rsp, err := c.ImportBidsWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportBidsResponse(rsp)
+}
+
+// GetUserBidsWithResponse request returning *GetUserBidsResponse
+func (c *ClientWithResponses) GetUserBidsWithResponse(ctx context.Context, params *GetUserBidsParams, reqEditors ...RequestEditorFn) (*GetUserBidsResponse, error) {
+	rsp, err := c.GetUserBids(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserBidsResponse(rsp)
+}
+
+// CreateBidWithBodyWithResponse request with arbitrary body returning *CreateBidResponse
+func (c *ClientWithResponses) CreateBidWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateBidResponse, error) {
+	rsp, err := c.CreateBidWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBidResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateBidWithResponse(ctx context.Context, body CreateBidJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateBidResponse, error) {
+	rsp, err := c.CreateBid(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateBidResponse(rsp)
+}
+
+// SearchBidsWithResponse request returning *SearchBidsResponse
+func (c *ClientWithResponses) SearchBidsWithResponse(ctx context.Context, params *SearchBidsParams, reqEditors ...RequestEditorFn) (*SearchBidsResponse, error) {
+	rsp, err := c.SearchBids(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchBidsResponse(rsp)
+}
+
+// GetBidAttachmentsWithResponse request returning *GetBidAttachmentsResponse
+func (c *ClientWithResponses) GetBidAttachmentsWithResponse(ctx context.Context, bidId BidId, params *GetBidAttachmentsParams, reqEditors ...RequestEditorFn) (*GetBidAttachmentsResponse, error) {
+	rsp, err := c.GetBidAttachments(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBidAttachmentsResponse(rsp)
+}
+
+// UploadBidAttachmentWithBodyWithResponse request with arbitrary body returning *UploadBidAttachmentResponse
+func (c *ClientWithResponses) UploadBidAttachmentWithBodyWithResponse(ctx context.Context, bidId BidId, params *UploadBidAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadBidAttachmentResponse, error) {
+	rsp, err := c.UploadBidAttachmentWithBody(ctx, bidId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadBidAttachmentResponse(rsp)
+}
+
+// EditBidWithBodyWithResponse request with arbitrary body returning *EditBidResponse
+func (c *ClientWithResponses) EditBidWithBodyWithResponse(ctx context.Context, bidId BidId, params *EditBidParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditBidResponse, error) {
+	rsp, err := c.EditBidWithBody(ctx, bidId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditBidResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditBidWithResponse(ctx context.Context, bidId BidId, params *EditBidParams, body EditBidJSONRequestBody, reqEditors ...RequestEditorFn) (*EditBidResponse, error) {
+	rsp, err := c.EditBid(ctx, bidId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditBidResponse(rsp)
+}
+
+// SubmitBidFeedbackWithResponse request returning *SubmitBidFeedbackResponse
+func (c *ClientWithResponses) SubmitBidFeedbackWithResponse(ctx context.Context, bidId BidId, params *SubmitBidFeedbackParams, reqEditors ...RequestEditorFn) (*SubmitBidFeedbackResponse, error) {
+	rsp, err := c.SubmitBidFeedback(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitBidFeedbackResponse(rsp)
+}
+
+// PlaceAuctionBidWithResponse request returning *PlaceAuctionBidResponse
+func (c *ClientWithResponses) PlaceAuctionBidWithResponse(ctx context.Context, bidId BidId, params *PlaceAuctionBidParams, reqEditors ...RequestEditorFn) (*PlaceAuctionBidResponse, error) {
+	rsp, err := c.PlaceAuctionBid(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePlaceAuctionBidResponse(rsp)
+}
+
+// RollbackBidWithResponse request returning *RollbackBidResponse
+func (c *ClientWithResponses) RollbackBidWithResponse(ctx context.Context, bidId BidId, version int32, params *RollbackBidParams, reqEditors ...RequestEditorFn) (*RollbackBidResponse, error) {
+	rsp, err := c.RollbackBid(ctx, bidId, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackBidResponse(rsp)
+}
+
+// GetBidScoresWithResponse request returning *GetBidScoresResponse
+func (c *ClientWithResponses) GetBidScoresWithResponse(ctx context.Context, bidId BidId, params *GetBidScoresParams, reqEditors ...RequestEditorFn) (*GetBidScoresResponse, error) {
+	rsp, err := c.GetBidScores(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBidScoresResponse(rsp)
+}
+
+// SubmitBidScoresWithBodyWithResponse request with arbitrary body returning *SubmitBidScoresResponse
+func (c *ClientWithResponses) SubmitBidScoresWithBodyWithResponse(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SubmitBidScoresResponse, error) {
+	rsp, err := c.SubmitBidScoresWithBody(ctx, bidId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitBidScoresResponse(rsp)
+}
+
+func (c *ClientWithResponses) SubmitBidScoresWithResponse(ctx context.Context, bidId BidId, params *SubmitBidScoresParams, body SubmitBidScoresJSONRequestBody, reqEditors ...RequestEditorFn) (*SubmitBidScoresResponse, error) {
+	rsp, err := c.SubmitBidScores(ctx, bidId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitBidScoresResponse(rsp)
+}
+
+// GetBidStatusWithResponse request returning *GetBidStatusResponse
+func (c *ClientWithResponses) GetBidStatusWithResponse(ctx context.Context, bidId BidId, params *GetBidStatusParams, reqEditors ...RequestEditorFn) (*GetBidStatusResponse, error) {
+	rsp, err := c.GetBidStatus(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBidStatusResponse(rsp)
+}
+
+// UpdateBidStatusWithResponse request returning *UpdateBidStatusResponse
+func (c *ClientWithResponses) UpdateBidStatusWithResponse(ctx context.Context, bidId BidId, params *UpdateBidStatusParams, reqEditors ...RequestEditorFn) (*UpdateBidStatusResponse, error) {
+	rsp, err := c.UpdateBidStatus(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateBidStatusResponse(rsp)
+}
+
+// SubmitBidDecisionWithResponse request returning *SubmitBidDecisionResponse
+func (c *ClientWithResponses) SubmitBidDecisionWithResponse(ctx context.Context, bidId BidId, params *SubmitBidDecisionParams, reqEditors ...RequestEditorFn) (*SubmitBidDecisionResponse, error) {
+	rsp, err := c.SubmitBidDecision(ctx, bidId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSubmitBidDecisionResponse(rsp)
+}
+
+// ExportBidsForTenderWithResponse request returning *ExportBidsForTenderResponse
+func (c *ClientWithResponses) ExportBidsForTenderWithResponse(ctx context.Context, tenderId TenderId, params *ExportBidsForTenderParams, reqEditors ...RequestEditorFn) (*ExportBidsForTenderResponse, error) {
+	rsp, err := c.ExportBidsForTender(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportBidsForTenderResponse(rsp)
+}
+
+// GetBidsForTenderWithResponse request returning *GetBidsForTenderResponse
+func (c *ClientWithResponses) GetBidsForTenderWithResponse(ctx context.Context, tenderId TenderId, params *GetBidsForTenderParams, reqEditors ...RequestEditorFn) (*GetBidsForTenderResponse, error) {
+	rsp, err := c.GetBidsForTender(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBidsForTenderResponse(rsp)
+}
+
+// GetBidReviewsWithResponse request returning *GetBidReviewsResponse
+func (c *ClientWithResponses) GetBidReviewsWithResponse(ctx context.Context, tenderId TenderId, params *GetBidReviewsParams, reqEditors ...RequestEditorFn) (*GetBidReviewsResponse, error) {
+	rsp, err := c.GetBidReviews(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetBidReviewsResponse(rsp)
+}
+
+// RedeliverWebhookDeliveryWithResponse request returning *RedeliverWebhookDeliveryResponse
+func (c *ClientWithResponses) RedeliverWebhookDeliveryWithResponse(ctx context.Context, deliveryId WebhookDeliveryId, params *RedeliverWebhookDeliveryParams, reqEditors ...RequestEditorFn) (*RedeliverWebhookDeliveryResponse, error) {
+	rsp, err := c.RedeliverWebhookDelivery(ctx, deliveryId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRedeliverWebhookDeliveryResponse(rsp)
+}
+
+// StreamEventsWithResponse request returning *StreamEventsResponse
+func (c *ClientWithResponses) StreamEventsWithResponse(ctx context.Context, params *StreamEventsParams, reqEditors ...RequestEditorFn) (*StreamEventsResponse, error) {
+	rsp, err := c.StreamEvents(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamEventsResponse(rsp)
+}
+
+// QueryGraphQLWithBodyWithResponse request with arbitrary body returning *QueryGraphQLResponse
+func (c *ClientWithResponses) QueryGraphQLWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryGraphQLResponse, error) {
+	rsp, err := c.QueryGraphQLWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryGraphQLResponse(rsp)
+}
+
+func (c *ClientWithResponses) QueryGraphQLWithResponse(ctx context.Context, body QueryGraphQLJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryGraphQLResponse, error) {
+	rsp, err := c.QueryGraphQL(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryGraphQLResponse(rsp)
+}
+
+// GetUserInvitationsWithResponse request returning *GetUserInvitationsResponse
+func (c *ClientWithResponses) GetUserInvitationsWithResponse(ctx context.Context, params *GetUserInvitationsParams, reqEditors ...RequestEditorFn) (*GetUserInvitationsResponse, error) {
+	rsp, err := c.GetUserInvitations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserInvitationsResponse(rsp)
+}
+
+// RespondTenderInvitationWithResponse request returning *RespondTenderInvitationResponse
+func (c *ClientWithResponses) RespondTenderInvitationWithResponse(ctx context.Context, invitationId InvitationId, params *RespondTenderInvitationParams, reqEditors ...RequestEditorFn) (*RespondTenderInvitationResponse, error) {
+	rsp, err := c.RespondTenderInvitation(ctx, invitationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRespondTenderInvitationResponse(rsp)
+}
+
+// GetNotificationPreferencesWithResponse request returning *GetNotificationPreferencesResponse
+func (c *ClientWithResponses) GetNotificationPreferencesWithResponse(ctx context.Context, params *GetNotificationPreferencesParams, reqEditors ...RequestEditorFn) (*GetNotificationPreferencesResponse, error) {
+	rsp, err := c.GetNotificationPreferences(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationPreferencesResponse(rsp)
+}
+
+// SetNotificationPreferencesWithBodyWithResponse request with arbitrary body returning *SetNotificationPreferencesResponse
+func (c *ClientWithResponses) SetNotificationPreferencesWithBodyWithResponse(ctx context.Context, params *SetNotificationPreferencesParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*SetNotificationPreferencesResponse, error) {
+	rsp, err := c.SetNotificationPreferencesWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetNotificationPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) SetNotificationPreferencesWithResponse(ctx context.Context, params *SetNotificationPreferencesParams, body SetNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*SetNotificationPreferencesResponse, error) {
+	rsp, err := c.SetNotificationPreferences(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSetNotificationPreferencesResponse(rsp)
+}
+
+// GetAuditLogWithResponse request returning *GetAuditLogResponse
+func (c *ClientWithResponses) GetAuditLogWithResponse(ctx context.Context, organizationId OrganizationId, params *GetAuditLogParams, reqEditors ...RequestEditorFn) (*GetAuditLogResponse, error) {
+	rsp, err := c.GetAuditLog(ctx, organizationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditLogResponse(rsp)
+}
+
+// VerifyAuditLogWithResponse request returning *VerifyAuditLogResponse
+func (c *ClientWithResponses) VerifyAuditLogWithResponse(ctx context.Context, organizationId OrganizationId, params *VerifyAuditLogParams, reqEditors ...RequestEditorFn) (*VerifyAuditLogResponse, error) {
+	rsp, err := c.VerifyAuditLog(ctx, organizationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVerifyAuditLogResponse(rsp)
+}
+
+// GetOrganizationReputationWithResponse request returning *GetOrganizationReputationResponse
+func (c *ClientWithResponses) GetOrganizationReputationWithResponse(ctx context.Context, organizationId OrganizationId, params *GetOrganizationReputationParams, reqEditors ...RequestEditorFn) (*GetOrganizationReputationResponse, error) {
+	rsp, err := c.GetOrganizationReputation(ctx, organizationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationReputationResponse(rsp)
+}
+
+// GetOrganizationWebhooksWithResponse request returning *GetOrganizationWebhooksResponse
+func (c *ClientWithResponses) GetOrganizationWebhooksWithResponse(ctx context.Context, organizationId OrganizationId, params *GetOrganizationWebhooksParams, reqEditors ...RequestEditorFn) (*GetOrganizationWebhooksResponse, error) {
+	rsp, err := c.GetOrganizationWebhooks(ctx, organizationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrganizationWebhooksResponse(rsp)
+}
+
+// CreateWebhookWithBodyWithResponse request with arbitrary body returning *CreateWebhookResponse
+func (c *ClientWithResponses) CreateWebhookWithBodyWithResponse(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhookWithBody(ctx, organizationId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateWebhookWithResponse(ctx context.Context, organizationId OrganizationId, params *CreateWebhookParams, body CreateWebhookJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateWebhookResponse, error) {
+	rsp, err := c.CreateWebhook(ctx, organizationId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateWebhookResponse(rsp)
+}
+
+// CheckServerWithResponse request returning *CheckServerResponse
+func (c *ClientWithResponses) CheckServerWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CheckServerResponse, error) {
+	rsp, err := c.CheckServer(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCheckServerResponse(rsp)
+}
+
+// AnswerTenderQuestionWithBodyWithResponse request with arbitrary body returning *AnswerTenderQuestionResponse
+func (c *ClientWithResponses) AnswerTenderQuestionWithBodyWithResponse(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnswerTenderQuestionResponse, error) {
+	rsp, err := c.AnswerTenderQuestionWithBody(ctx, questionId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerTenderQuestionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AnswerTenderQuestionWithResponse(ctx context.Context, questionId QuestionId, params *AnswerTenderQuestionParams, body AnswerTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*AnswerTenderQuestionResponse, error) {
+	rsp, err := c.AnswerTenderQuestion(ctx, questionId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnswerTenderQuestionResponse(rsp)
+}
+
+// GetTendersWithResponse request returning *GetTendersResponse
+func (c *ClientWithResponses) GetTendersWithResponse(ctx context.Context, params *GetTendersParams, reqEditors ...RequestEditorFn) (*GetTendersResponse, error) {
+	rsp, err := c.GetTenders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTendersResponse(rsp)
+}
+
+// ExportTendersWithResponse request returning *ExportTendersResponse
+func (c *ClientWithResponses) ExportTendersWithResponse(ctx context.Context, params *ExportTendersParams, reqEditors ...RequestEditorFn) (*ExportTendersResponse, error) {
+	rsp, err := c.ExportTenders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportTendersResponse(rsp)
+}
+
+// ImportTendersWithBodyWithResponse request with arbitrary body returning *ImportTendersResponse
+func (c *ClientWithResponses) ImportTendersWithBodyWithResponse(ctx context.Context, params *ImportTendersParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportTendersResponse, error) {
+	rsp, err := c.ImportTendersWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportTendersResponse(rsp)
+}
+
+// GetUserTendersWithResponse request returning *GetUserTendersResponse
+func (c *ClientWithResponses) GetUserTendersWithResponse(ctx context.Context, params *GetUserTendersParams, reqEditors ...RequestEditorFn) (*GetUserTendersResponse, error) {
+	rsp, err := c.GetUserTenders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserTendersResponse(rsp)
+}
+
+// CreateTenderWithBodyWithResponse request with arbitrary body returning *CreateTenderResponse
+func (c *ClientWithResponses) CreateTenderWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTenderResponse, error) {
+	rsp, err := c.CreateTenderWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTenderResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTenderWithResponse(ctx context.Context, body CreateTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTenderResponse, error) {
+	rsp, err := c.CreateTender(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTenderResponse(rsp)
+}
+
+// SearchTendersWithResponse request returning *SearchTendersResponse
+func (c *ClientWithResponses) SearchTendersWithResponse(ctx context.Context, params *SearchTendersParams, reqEditors ...RequestEditorFn) (*SearchTendersResponse, error) {
+	rsp, err := c.SearchTenders(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchTendersResponse(rsp)
+}
+
+// GetTenderAttachmentsWithResponse request returning *GetTenderAttachmentsResponse
+func (c *ClientWithResponses) GetTenderAttachmentsWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderAttachmentsParams, reqEditors ...RequestEditorFn) (*GetTenderAttachmentsResponse, error) {
+	rsp, err := c.GetTenderAttachments(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderAttachmentsResponse(rsp)
+}
+
+// UploadTenderAttachmentWithBodyWithResponse request with arbitrary body returning *UploadTenderAttachmentResponse
+func (c *ClientWithResponses) UploadTenderAttachmentWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *UploadTenderAttachmentParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadTenderAttachmentResponse, error) {
+	rsp, err := c.UploadTenderAttachmentWithBody(ctx, tenderId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadTenderAttachmentResponse(rsp)
+}
+
+// GetAuctionLeaderboardWithResponse request returning *GetAuctionLeaderboardResponse
+func (c *ClientWithResponses) GetAuctionLeaderboardWithResponse(ctx context.Context, tenderId TenderId, params *GetAuctionLeaderboardParams, reqEditors ...RequestEditorFn) (*GetAuctionLeaderboardResponse, error) {
+	rsp, err := c.GetAuctionLeaderboard(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuctionLeaderboardResponse(rsp)
+}
+
+// EditTenderWithBodyWithResponse request with arbitrary body returning *EditTenderResponse
+func (c *ClientWithResponses) EditTenderWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *EditTenderParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EditTenderResponse, error) {
+	rsp, err := c.EditTenderWithBody(ctx, tenderId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTenderResponse(rsp)
+}
+
+func (c *ClientWithResponses) EditTenderWithResponse(ctx context.Context, tenderId TenderId, params *EditTenderParams, body EditTenderJSONRequestBody, reqEditors ...RequestEditorFn) (*EditTenderResponse, error) {
+	rsp, err := c.EditTender(ctx, tenderId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEditTenderResponse(rsp)
+}
+
+// GetTenderHistoryWithResponse request returning *GetTenderHistoryResponse
+func (c *ClientWithResponses) GetTenderHistoryWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderHistoryParams, reqEditors ...RequestEditorFn) (*GetTenderHistoryResponse, error) {
+	rsp, err := c.GetTenderHistory(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderHistoryResponse(rsp)
+}
+
+// GetTenderInvitationsWithResponse request returning *GetTenderInvitationsResponse
+func (c *ClientWithResponses) GetTenderInvitationsWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderInvitationsParams, reqEditors ...RequestEditorFn) (*GetTenderInvitationsResponse, error) {
+	rsp, err := c.GetTenderInvitations(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderInvitationsResponse(rsp)
+}
+
+// CreateTenderInvitationWithBodyWithResponse request with arbitrary body returning *CreateTenderInvitationResponse
+func (c *ClientWithResponses) CreateTenderInvitationWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateTenderInvitationResponse, error) {
+	rsp, err := c.CreateTenderInvitationWithBody(ctx, tenderId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTenderInvitationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateTenderInvitationWithResponse(ctx context.Context, tenderId TenderId, params *CreateTenderInvitationParams, body CreateTenderInvitationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateTenderInvitationResponse, error) {
+	rsp, err := c.CreateTenderInvitation(ctx, tenderId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateTenderInvitationResponse(rsp)
+}
+
+// CancelTenderLotWithResponse request returning *CancelTenderLotResponse
+func (c *ClientWithResponses) CancelTenderLotWithResponse(ctx context.Context, tenderId TenderId, lotId LotId, params *CancelTenderLotParams, reqEditors ...RequestEditorFn) (*CancelTenderLotResponse, error) {
+	rsp, err := c.CancelTenderLot(ctx, tenderId, lotId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelTenderLotResponse(rsp)
+}
+
+// GetTenderQuestionsWithResponse request returning *GetTenderQuestionsResponse
+func (c *ClientWithResponses) GetTenderQuestionsWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderQuestionsParams, reqEditors ...RequestEditorFn) (*GetTenderQuestionsResponse, error) {
+	rsp, err := c.GetTenderQuestions(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderQuestionsResponse(rsp)
+}
+
+// AskTenderQuestionWithBodyWithResponse request with arbitrary body returning *AskTenderQuestionResponse
+func (c *ClientWithResponses) AskTenderQuestionWithBodyWithResponse(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AskTenderQuestionResponse, error) {
+	rsp, err := c.AskTenderQuestionWithBody(ctx, tenderId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAskTenderQuestionResponse(rsp)
+}
+
+func (c *ClientWithResponses) AskTenderQuestionWithResponse(ctx context.Context, tenderId TenderId, params *AskTenderQuestionParams, body AskTenderQuestionJSONRequestBody, reqEditors ...RequestEditorFn) (*AskTenderQuestionResponse, error) {
+	rsp, err := c.AskTenderQuestion(ctx, tenderId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAskTenderQuestionResponse(rsp)
+}
+
+// GetTenderRankingWithResponse request returning *GetTenderRankingResponse
+func (c *ClientWithResponses) GetTenderRankingWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderRankingParams, reqEditors ...RequestEditorFn) (*GetTenderRankingResponse, error) {
+	rsp, err := c.GetTenderRanking(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderRankingResponse(rsp)
+}
+
+// GetTenderReportWithResponse request returning *GetTenderReportResponse
+func (c *ClientWithResponses) GetTenderReportWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderReportParams, reqEditors ...RequestEditorFn) (*GetTenderReportResponse, error) {
+	rsp, err := c.GetTenderReport(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderReportResponse(rsp)
+}
+
+// RollbackTenderWithResponse request returning *RollbackTenderResponse
+func (c *ClientWithResponses) RollbackTenderWithResponse(ctx context.Context, tenderId TenderId, version int32, params *RollbackTenderParams, reqEditors ...RequestEditorFn) (*RollbackTenderResponse, error) {
+	rsp, err := c.RollbackTender(ctx, tenderId, version, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRollbackTenderResponse(rsp)
+}
+
+// GetTenderStatusWithResponse request returning *GetTenderStatusResponse
+func (c *ClientWithResponses) GetTenderStatusWithResponse(ctx context.Context, tenderId TenderId, params *GetTenderStatusParams, reqEditors ...RequestEditorFn) (*GetTenderStatusResponse, error) {
+	rsp, err := c.GetTenderStatus(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTenderStatusResponse(rsp)
+}
+
+// UpdateTenderStatusWithResponse request returning *UpdateTenderStatusResponse
+func (c *ClientWithResponses) UpdateTenderStatusWithResponse(ctx context.Context, tenderId TenderId, params *UpdateTenderStatusParams, reqEditors ...RequestEditorFn) (*UpdateTenderStatusResponse, error) {
+	rsp, err := c.UpdateTenderStatus(ctx, tenderId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateTenderStatusResponse(rsp)
+}
+
+// DeleteWebhookWithResponse request returning *DeleteWebhookResponse
+func (c *ClientWithResponses) DeleteWebhookWithResponse(ctx context.Context, webhookId WebhookId, params *DeleteWebhookParams, reqEditors ...RequestEditorFn) (*DeleteWebhookResponse, error) {
+	rsp, err := c.DeleteWebhook(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhookResponse(rsp)
+}
+
+// GetWebhookDeliveriesWithResponse request returning *GetWebhookDeliveriesResponse
+func (c *ClientWithResponses) GetWebhookDeliveriesWithResponse(ctx context.Context, webhookId WebhookId, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhookDeliveriesResponse, error) {
+	rsp, err := c.GetWebhookDeliveries(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhookDeliveriesResponse(rsp)
+}
+
+// PingWebhookWithResponse request returning *PingWebhookResponse
+func (c *ClientWithResponses) PingWebhookWithResponse(ctx context.Context, webhookId WebhookId, params *PingWebhookParams, reqEditors ...RequestEditorFn) (*PingWebhookResponse, error) {
+	rsp, err := c.PingWebhook(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePingWebhookResponse(rsp)
+}
+
+// ParseDownloadAttachmentResponse parses an HTTP response from a DownloadAttachmentWithResponse call
+func ParseDownloadAttachmentResponse(rsp *http.Response) (*DownloadAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportBidsResponse parses an HTTP response from a ImportBidsWithResponse call
+func ParseImportBidsResponse(rsp *http.Response) (*ImportBidsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportBidsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserBidsResponse parses an HTTP response from a GetUserBidsWithResponse call
+func ParseGetUserBidsResponse(rsp *http.Response) (*GetUserBidsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserBidsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateBidResponse parses an HTTP response from a CreateBidWithResponse call
+func ParseCreateBidResponse(rsp *http.Response) (*CreateBidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateBidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchBidsResponse parses an HTTP response from a SearchBidsWithResponse call
+func ParseSearchBidsResponse(rsp *http.Response) (*SearchBidsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchBidsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BidSearchHit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBidAttachmentsResponse parses an HTTP response from a GetBidAttachmentsWithResponse call
+func ParseGetBidAttachmentsResponse(rsp *http.Response) (*GetBidAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBidAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadBidAttachmentResponse parses an HTTP response from a UploadBidAttachmentWithResponse call
+func ParseUploadBidAttachmentResponse(rsp *http.Response) (*UploadBidAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadBidAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditBidResponse parses an HTTP response from a EditBidWithResponse call
+func ParseEditBidResponse(rsp *http.Response) (*EditBidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditBidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitBidFeedbackResponse parses an HTTP response from a SubmitBidFeedbackWithResponse call
+func ParseSubmitBidFeedbackResponse(rsp *http.Response) (*SubmitBidFeedbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitBidFeedbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePlaceAuctionBidResponse parses an HTTP response from a PlaceAuctionBidWithResponse call
+func ParsePlaceAuctionBidResponse(rsp *http.Response) (*PlaceAuctionBidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PlaceAuctionBidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRollbackBidResponse parses an HTTP response from a RollbackBidWithResponse call
+func ParseRollbackBidResponse(rsp *http.Response) (*RollbackBidResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RollbackBidResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBidScoresResponse parses an HTTP response from a GetBidScoresWithResponse call
+func ParseGetBidScoresResponse(rsp *http.Response) (*GetBidScoresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBidScoresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BidScorecard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitBidScoresResponse parses an HTTP response from a SubmitBidScoresWithResponse call
+func ParseSubmitBidScoresResponse(rsp *http.Response) (*SubmitBidScoresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitBidScoresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BidScorecard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBidStatusResponse parses an HTTP response from a GetBidStatusWithResponse call
+func ParseGetBidStatusResponse(rsp *http.Response) (*GetBidStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBidStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BidStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateBidStatusResponse parses an HTTP response from a UpdateBidStatusWithResponse call
+func ParseUpdateBidStatusResponse(rsp *http.Response) (*UpdateBidStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateBidStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSubmitBidDecisionResponse parses an HTTP response from a SubmitBidDecisionWithResponse call
+func ParseSubmitBidDecisionResponse(rsp *http.Response) (*SubmitBidDecisionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SubmitBidDecisionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportBidsForTenderResponse parses an HTTP response from a ExportBidsForTenderWithResponse call
+func ParseExportBidsForTenderResponse(rsp *http.Response) (*ExportBidsForTenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportBidsForTenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBidsForTenderResponse parses an HTTP response from a GetBidsForTenderWithResponse call
+func ParseGetBidsForTenderResponse(rsp *http.Response) (*GetBidsForTenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBidsForTenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Bid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetBidReviewsResponse parses an HTTP response from a GetBidReviewsWithResponse call
+func ParseGetBidReviewsResponse(rsp *http.Response) (*GetBidReviewsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetBidReviewsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []BidReview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRedeliverWebhookDeliveryResponse parses an HTTP response from a RedeliverWebhookDeliveryWithResponse call
+func ParseRedeliverWebhookDeliveryResponse(rsp *http.Response) (*RedeliverWebhookDeliveryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RedeliverWebhookDeliveryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamEventsResponse parses an HTTP response from a StreamEventsWithResponse call
+func ParseStreamEventsResponse(rsp *http.Response) (*StreamEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseQueryGraphQLResponse parses an HTTP response from a QueryGraphQLWithResponse call
+func ParseQueryGraphQLResponse(rsp *http.Response) (*QueryGraphQLResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &QueryGraphQLResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GraphqlResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserInvitationsResponse parses an HTTP response from a GetUserInvitationsWithResponse call
+func ParseGetUserInvitationsResponse(rsp *http.Response) (*GetUserInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TenderInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRespondTenderInvitationResponse parses an HTTP response from a RespondTenderInvitationWithResponse call
+func ParseRespondTenderInvitationResponse(rsp *http.Response) (*RespondTenderInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RespondTenderInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenderInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationPreferencesResponse parses an HTTP response from a GetNotificationPreferencesWithResponse call
+func ParseGetNotificationPreferencesResponse(rsp *http.Response) (*GetNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSetNotificationPreferencesResponse parses an HTTP response from a SetNotificationPreferencesWithResponse call
+func ParseSetNotificationPreferencesResponse(rsp *http.Response) (*SetNotificationPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SetNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditLogResponse parses an HTTP response from a GetAuditLogWithResponse call
+func ParseGetAuditLogResponse(rsp *http.Response) (*GetAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AuditEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseVerifyAuditLogResponse parses an HTTP response from a VerifyAuditLogWithResponse call
+func ParseVerifyAuditLogResponse(rsp *http.Response) (*VerifyAuditLogResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &VerifyAuditLogResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditVerification
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationReputationResponse parses an HTTP response from a GetOrganizationReputationWithResponse call
+func ParseGetOrganizationReputationResponse(rsp *http.Response) (*GetOrganizationReputationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationReputationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrganizationReputation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetOrganizationWebhooksResponse parses an HTTP response from a GetOrganizationWebhooksWithResponse call
+func ParseGetOrganizationWebhooksResponse(rsp *http.Response) (*GetOrganizationWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetOrganizationWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateWebhookResponse parses an HTTP response from a CreateWebhookWithResponse call
+func ParseCreateWebhookResponse(rsp *http.Response) (*CreateWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCheckServerResponse parses an HTTP response from a CheckServerWithResponse call
+func ParseCheckServerResponse(rsp *http.Response) (*CheckServerResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CheckServerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseAnswerTenderQuestionResponse parses an HTTP response from a AnswerTenderQuestionWithResponse call
+func ParseAnswerTenderQuestionResponse(rsp *http.Response) (*AnswerTenderQuestionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AnswerTenderQuestionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenderQuestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTendersResponse parses an HTTP response from a GetTendersWithResponse call
+func ParseGetTendersResponse(rsp *http.Response) (*GetTendersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTendersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseExportTendersResponse parses an HTTP response from a ExportTendersWithResponse call
+func ParseExportTendersResponse(rsp *http.Response) (*ExportTendersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ExportTendersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportTendersResponse parses an HTTP response from a ImportTendersWithResponse call
+func ParseImportTendersResponse(rsp *http.Response) (*ImportTendersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportTendersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserTendersResponse parses an HTTP response from a GetUserTendersWithResponse call
+func ParseGetUserTendersResponse(rsp *http.Response) (*GetUserTendersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserTendersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTenderResponse parses an HTTP response from a CreateTenderWithResponse call
+func ParseCreateTenderResponse(rsp *http.Response) (*CreateTenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchTendersResponse parses an HTTP response from a SearchTendersWithResponse call
+func ParseSearchTendersResponse(rsp *http.Response) (*SearchTendersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchTendersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TenderSearchHit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderAttachmentsResponse parses an HTTP response from a GetTenderAttachmentsWithResponse call
+func ParseGetTenderAttachmentsResponse(rsp *http.Response) (*GetTenderAttachmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderAttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUploadTenderAttachmentResponse parses an HTTP response from a UploadTenderAttachmentWithResponse call
+func ParseUploadTenderAttachmentResponse(rsp *http.Response) (*UploadTenderAttachmentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UploadTenderAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuctionLeaderboardResponse parses an HTTP response from a GetAuctionLeaderboardWithResponse call
+func ParseGetAuctionLeaderboardResponse(rsp *http.Response) (*GetAuctionLeaderboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuctionLeaderboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseEditTenderResponse parses an HTTP response from a EditTenderWithResponse call
+func ParseEditTenderResponse(rsp *http.Response) (*EditTenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &EditTenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderHistoryResponse parses an HTTP response from a GetTenderHistoryWithResponse call
+func ParseGetTenderHistoryResponse(rsp *http.Response) (*GetTenderHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TenderRevision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderInvitationsResponse parses an HTTP response from a GetTenderInvitationsWithResponse call
+func ParseGetTenderInvitationsResponse(rsp *http.Response) (*GetTenderInvitationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderInvitationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TenderInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateTenderInvitationResponse parses an HTTP response from a CreateTenderInvitationWithResponse call
+func ParseCreateTenderInvitationResponse(rsp *http.Response) (*CreateTenderInvitationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateTenderInvitationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenderInvitation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelTenderLotResponse parses an HTTP response from a CancelTenderLotWithResponse call
+func ParseCancelTenderLotResponse(rsp *http.Response) (*CancelTenderLotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelTenderLotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderQuestionsResponse parses an HTTP response from a GetTenderQuestionsWithResponse call
+func ParseGetTenderQuestionsResponse(rsp *http.Response) (*GetTenderQuestionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderQuestionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TenderQuestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseAskTenderQuestionResponse parses an HTTP response from a AskTenderQuestionWithResponse call
+func ParseAskTenderQuestionResponse(rsp *http.Response) (*AskTenderQuestionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &AskTenderQuestionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenderQuestion
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderRankingResponse parses an HTTP response from a GetTenderRankingWithResponse call
+func ParseGetTenderRankingResponse(rsp *http.Response) (*GetTenderRankingResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderRankingResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RankedBid
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderReportResponse parses an HTTP response from a GetTenderReportWithResponse call
+func ParseGetTenderReportResponse(rsp *http.Response) (*GetTenderReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRollbackTenderResponse parses an HTTP response from a RollbackTenderWithResponse call
+func ParseRollbackTenderResponse(rsp *http.Response) (*RollbackTenderResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RollbackTenderResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTenderStatusResponse parses an HTTP response from a GetTenderStatusWithResponse call
+func ParseGetTenderStatusResponse(rsp *http.Response) (*GetTenderStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTenderStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TenderStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateTenderStatusResponse parses an HTTP response from a UpdateTenderStatusWithResponse call
+func ParseUpdateTenderStatusResponse(rsp *http.Response) (*UpdateTenderStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateTenderStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Tender
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhookResponse parses an HTTP response from a DeleteWebhookWithResponse call
+func ParseDeleteWebhookResponse(rsp *http.Response) (*DeleteWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhookDeliveriesResponse parses an HTTP response from a GetWebhookDeliveriesWithResponse call
+func ParseGetWebhookDeliveriesResponse(rsp *http.Response) (*GetWebhookDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhookDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePingWebhookResponse parses an HTTP response from a PingWebhookWithResponse call
+func ParsePingWebhookResponse(rsp *http.Response) (*PingWebhookResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PingWebhookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,230 @@
+// Package client is a Go client of the Tender API. The requests, responses
+// and models are generated from задание/openapi.yml into client.gen.go, so
+// every endpoint of the spec has a typed method on Client and
+// ClientWithResponses; this file and pages.go add what the generator
+// doesn't: retries, idempotency keys, errors and pagination.
+//
+//	c, err := client.New("http://localhost:8080/api")
+//	tenders, err := c.GetTendersWithResponse(ctx, &client.GetTendersParams{})
+//	for bid, err := range c.GetUserBidsIter(ctx, &client.GetUserBidsParams{Username: "user"}) {
+//		...
+//	}
+package client
+
+//go:generate go run github.com/oapi-codegen/oapi-codegen/v2/cmd/oapi-codegen -config oapi-codegen.yaml ../задание/openapi.yml
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is how many times New retries a failed call.
+	DefaultMaxRetries = 3
+	// DefaultBackoff is the wait before the first retry of New, doubled
+	// before every next one.
+	DefaultBackoff = 200 * time.Millisecond
+)
+
+// maxBackoff caps the wait between retries, whatever Retry-After says.
+const maxBackoff = 30 * time.Second
+
+// IdempotencyKeyHeader is set on every call that changes something.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// New returns a client of the API at server, e.g.
+// "http://localhost:8080/api", sending its calls through a Transport with
+// the default retries. An http.Client of your own goes in as its Doer:
+//
+//	client.New(server, client.WithHTTPClient(&client.Transport{Doer: hc, MaxRetries: 1}))
+func New(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	transport := &Transport{MaxRetries: DefaultMaxRetries, Backoff: DefaultBackoff}
+	return NewClientWithResponses(server, append([]ClientOption{WithHTTPClient(transport)}, opts...)...)
+}
+
+// Transport sends the calls of a client, retrying those that failed in
+// passing and turning error responses into *Error.
+//
+// Reads (GET, HEAD and OPTIONS) are retried on network errors and on 429,
+// 502, 503 and 504. Any other call is retried only when it couldn't be
+// sent at all, as the server may have acted on it otherwise. Such calls
+// carry an Idempotency-Key, the same on every attempt, unless the request
+// already has one.
+type Transport struct {
+	// Doer sends the requests; http.DefaultClient if nil.
+	Doer HttpRequestDoer
+	// MaxRetries is how many times a call is retried; none if zero.
+	MaxRetries int
+	// Backoff is the wait before the first retry, doubled before every next
+	// one. Retry-After of the response overrides it.
+	Backoff time.Duration
+}
+
+// Do sends req. It returns an *Error for a response with a status of 400
+// or more, having read and closed its body.
+func (t *Transport) Do(req *http.Request) (*http.Response, error) {
+	doer := t.Doer
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	read := isRead(req.Method)
+	if !read && req.Header.Get(IdempotencyKeyHeader) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(IdempotencyKeyHeader, uuid.NewString())
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			if req, err = rewind(req); err != nil {
+				return nil, err
+			}
+		}
+		resp, err = doer.Do(req)
+		if attempt == t.MaxRetries || !retryable(read, resp, err) || (req.Body != nil && req.GetBody == nil) {
+			break
+		}
+
+		wait := t.Backoff << attempt
+		if resp != nil {
+			if after, ok := retryAfter(resp); ok {
+				wait = after
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		timer := time.NewTimer(min(wait, maxBackoff))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		return nil, newError(req, resp)
+	}
+	return resp, nil
+}
+
+func isRead(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+// retryable tells if a call that got resp or err may be sent again.
+func retryable(read bool, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		var op *net.OpError
+		return read || (errors.As(err, &op) && op.Op == "dial")
+	}
+	if !read {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// rewind returns req with its body ready to be sent again.
+func rewind(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Body = body
+	return req, nil
+}
+
+// retryAfter reads the Retry-After of resp, given in seconds or as a date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// Error is a response of the API with a status of 400 or more. Its reason
+// comes from the ErrorResponse the server sends with every such status.
+//
+// It matches the sentinel of its status with errors.Is:
+//
+//	if errors.Is(err, client.ErrNotFound) { ... }
+type Error struct {
+	StatusCode int
+	Reason     string
+	Method     string
+	Path       string
+	// RequestID is the X-Request-ID of the call, under which the server
+	// audited it.
+	RequestID string
+}
+
+// The sentinels of the statuses the API answers with.
+var (
+	ErrBadRequest   = &Error{StatusCode: http.StatusBadRequest}
+	ErrUnauthorized = &Error{StatusCode: http.StatusUnauthorized}
+	ErrForbidden    = &Error{StatusCode: http.StatusForbidden}
+	ErrNotFound     = &Error{StatusCode: http.StatusNotFound}
+	ErrServer       = &Error{StatusCode: http.StatusInternalServerError}
+	ErrUnavailable  = &Error{StatusCode: http.StatusServiceUnavailable}
+)
+
+func (e *Error) Error() string {
+	if e.Method == "" {
+		return fmt.Sprintf("status %d: %s", e.StatusCode, e.Reason)
+	}
+	return fmt.Sprintf("%s %s: status %d: %s", e.Method, e.Path, e.StatusCode, e.Reason)
+}
+
+// Is matches a sentinel of the same status.
+func (e *Error) Is(target error) bool {
+	sentinel, ok := target.(*Error)
+	return ok && sentinel.Reason == "" && sentinel.StatusCode == e.StatusCode
+}
+
+func newError(req *http.Request, resp *http.Response) *Error {
+	defer resp.Body.Close()
+
+	e := &Error{
+		StatusCode: resp.StatusCode,
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		RequestID:  resp.Header.Get("X-Request-ID"),
+	}
+	var body ErrorResponse
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err == nil && json.Unmarshal(data, &body) == nil && body.Reason != "" {
+		e.Reason = body.Reason
+	} else {
+		e.Reason = http.StatusText(resp.StatusCode)
+	}
+	return e
+}
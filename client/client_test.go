@@ -0,0 +1,245 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"my_zad/api"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// flaky answers 503 to the next failures requests and passes the others on
+// to the API, noting every request it gets.
+type flaky struct {
+	next http.Handler
+
+	mu       sync.Mutex
+	failures int
+	requests []*http.Request
+}
+
+func (f *flaky) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requests = append(f.requests, r)
+	fail := f.failures > 0
+	if fail {
+		f.failures--
+	}
+	f.mu.Unlock()
+
+	if fail {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"reason":"try later"}`))
+		return
+	}
+	f.next.ServeHTTP(w, r)
+}
+
+func (f *flaky) fail(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.failures = n
+	f.requests = nil
+}
+
+type testAPI struct {
+	url    string
+	client *ClientWithResponses
+	flaky  *flaky
+	org    string
+	owner  string
+}
+
+// newTestAPI serves the real handlers over the in-process storage, with
+// an organization of one responsible.
+func newTestAPI(t *testing.T) *testAPI {
+	t.Helper()
+
+	store := api.NewMemoryStorage()
+	blobs, err := api.NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := api.NewAPIServer("", store, blobs, nil).Handler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &flaky{next: handler}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+
+	transport := &Transport{Doer: srv.Client(), MaxRetries: 3, Backoff: time.Millisecond}
+	c, err := New(srv.URL+"/api", WithHTTPClient(transport))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	org := store.AddOrganization("Avito")
+	owner := store.AddEmployee("owner", "Own", "Er")
+	store.AddResponsible(org, owner.Id)
+	return &testAPI{url: srv.URL + "/api", client: c, flaky: f, org: org, owner: owner.Username}
+}
+
+func (a *testAPI) createTender(t *testing.T, name string) Tender {
+	t.Helper()
+	resp, err := a.client.CreateTenderWithResponse(context.Background(), CreateTenderJSONRequestBody{
+		Name:            name,
+		Description:     "Доставка",
+		ServiceType:     TenderServiceTypeDelivery,
+		OrganizationId:  a.org,
+		CreatorUsername: a.owner,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *resp.JSON200
+}
+
+func TestErrors(t *testing.T) {
+	a := newTestAPI(t)
+	ctx := context.Background()
+	tender := a.createTender(t, "Тендер")
+
+	stranger := "stranger"
+	_, err := a.client.GetTenderStatusWithResponse(ctx, tender.Id, &GetTenderStatusParams{Username: &stranger})
+	if !errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrForbidden) {
+		t.Fatalf("status for a stranger: %v", err)
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.Reason == "" || apiErr.RequestID == "" ||
+		apiErr.Path != "/api/tenders/"+tender.Id+"/status" {
+		t.Errorf("error: %#v", apiErr)
+	}
+
+	_, err = a.client.GetTenderStatusWithResponse(ctx, "3fa85f64-5717-4562-b3fc-2c963f66afa6", &GetTenderStatusParams{Username: &a.owner})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("status of a missing tender: %v", err)
+	}
+	_, err = a.client.UpdateTenderStatusWithResponse(ctx, tender.Id, &UpdateTenderStatusParams{Status: "Open", Username: a.owner})
+	if !errors.Is(err, ErrBadRequest) {
+		t.Errorf("unknown status: %v", err)
+	}
+}
+
+func TestPages(t *testing.T) {
+	a := newTestAPI(t)
+	for i := range 7 {
+		a.createTender(t, "Тендер "+strconv.Itoa(i))
+	}
+
+	limit := int32(3)
+	a.flaky.fail(0)
+	var names []string
+	for tender, err := range a.client.GetUserTendersIter(context.Background(), &GetUserTendersParams{Username: &a.owner, Limit: &limit}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, tender.Name)
+	}
+	if len(names) != 7 || len(a.flaky.requests) != 3 {
+		t.Errorf("got %d tenders in %d requests", len(names), len(a.flaky.requests))
+	}
+
+	offset := int32(5)
+	a.flaky.fail(0)
+	var rest int
+	for _, err := range a.client.GetUserTendersIter(context.Background(), &GetUserTendersParams{Username: &a.owner, Offset: &offset}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		rest++
+	}
+	if rest != 2 || len(a.flaky.requests) != 1 {
+		t.Errorf("got %d tenders from offset 5 in %d requests", rest, len(a.flaky.requests))
+	}
+
+	stranger := "stranger"
+	var errs []error
+	for _, err := range a.client.GetUserTendersIter(context.Background(), &GetUserTendersParams{Username: &stranger}) {
+		errs = append(errs, err)
+	}
+	if len(errs) != 1 || !errors.Is(errs[0], ErrUnauthorized) {
+		t.Errorf("stranger's tenders: %v", errs)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	a := newTestAPI(t)
+	ctx := context.Background()
+
+	a.flaky.fail(0)
+	tender := a.createTender(t, "Тендер")
+	if len(a.flaky.requests) != 1 {
+		t.Fatalf("create was sent %d times", len(a.flaky.requests))
+	}
+
+	a.flaky.fail(2)
+	_, err := a.client.CreateTenderWithResponse(ctx, CreateTenderJSONRequestBody{
+		Name: "Тендер", Description: "Доставка", ServiceType: TenderServiceTypeDelivery,
+		OrganizationId: a.org, CreatorUsername: a.owner,
+	})
+	if !errors.Is(err, ErrUnavailable) || len(a.flaky.requests) != 1 {
+		t.Errorf("failed create: %v after %d requests", err, len(a.flaky.requests))
+	}
+	if a.flaky.requests[0].Header.Get(IdempotencyKeyHeader) == "" {
+		t.Error("create without an idempotency key")
+	}
+
+	a.flaky.fail(2)
+	resp, err := a.client.GetTenderStatusWithResponse(ctx, tender.Id, &GetTenderStatusParams{Username: &a.owner})
+	if err != nil || *resp.JSON200 != TenderStatusCreated || len(a.flaky.requests) != 3 {
+		t.Errorf("status: %v after %d requests", err, len(a.flaky.requests))
+	}
+	if a.flaky.requests[0].Header.Get(IdempotencyKeyHeader) != "" {
+		t.Error("read with an idempotency key")
+	}
+
+	a.flaky.fail(10)
+	_, err = a.client.GetTenderStatusWithResponse(ctx, tender.Id, &GetTenderStatusParams{Username: &a.owner})
+	if !errors.Is(err, ErrUnavailable) || len(a.flaky.requests) != 4 {
+		t.Errorf("status: %v after %d requests", err, len(a.flaky.requests))
+	}
+}
+
+func TestIdempotencyKeyKept(t *testing.T) {
+	a := newTestAPI(t)
+
+	a.flaky.fail(0)
+	a.createTender(t, "Тендер")
+	first := a.flaky.requests[0].Header.Get(IdempotencyKeyHeader)
+	a.flaky.fail(0)
+	_, err := a.client.CreateTenderWithResponse(context.Background(), CreateTenderJSONRequestBody{
+		Name: "Тендер", Description: "Доставка", ServiceType: TenderServiceTypeDelivery,
+		OrganizationId: a.org, CreatorUsername: a.owner,
+	}, func(ctx context.Context, req *http.Request) error {
+		req.Header.Set(IdempotencyKeyHeader, "order-42")
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key := a.flaky.requests[0].Header.Get(IdempotencyKeyHeader); key != "order-42" || first == "" || first == key {
+		t.Errorf("keys %q and %q", first, key)
+	}
+}
+
+func TestRetryCanceled(t *testing.T) {
+	a := newTestAPI(t)
+	c, err := New(a.url, WithHTTPClient(&Transport{MaxRetries: 5, Backoff: time.Hour}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	a.flaky.fail(10)
+	_, err = c.GetTendersWithResponse(ctx, &GetTendersParams{})
+	if !errors.Is(err, context.DeadlineExceeded) || len(a.flaky.requests) != 1 {
+		t.Errorf("canceled retry: %v after %d requests", err, len(a.flaky.requests))
+	}
+}
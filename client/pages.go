@@ -0,0 +1,235 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// maxPageSize is the most objects the API returns on a page.
+const maxPageSize = 50
+
+// All iterates over the objects of a paginated list, fetching its pages
+// with page as they are needed. It starts at offset, or at the first
+// object if nil, and asks for pages of limit objects, or of as many as the
+// API allows if nil. The iteration stops at the first short page or at the
+// first error, which it yields.
+func All[T any](ctx context.Context, limit, offset *int32, page func(ctx context.Context, limit, offset int32) ([]T, error)) iter.Seq2[T, error] {
+	size := int32(maxPageSize)
+	if limit != nil && *limit > 0 {
+		size = *limit
+	}
+	var start int32
+	if offset != nil {
+		start = *offset
+	}
+
+	return func(yield func(T, error) bool) {
+		for at := start; ; at += size {
+			items, err := page(ctx, size, at)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if int32(len(items)) < size {
+				return
+			}
+		}
+	}
+}
+
+// deref returns what p points to, or the zero value if p is nil.
+func deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// GetTendersIter iterates over all the pages of GetTendersWithResponse.
+func (c *ClientWithResponses) GetTendersIter(ctx context.Context, params *GetTendersParams, reqEditors ...RequestEditorFn) iter.Seq2[Tender, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]Tender, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetTendersWithResponse(ctx, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// SearchTendersIter iterates over all the pages of SearchTendersWithResponse.
+func (c *ClientWithResponses) SearchTendersIter(ctx context.Context, params *SearchTendersParams, reqEditors ...RequestEditorFn) iter.Seq2[TenderSearchHit, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]TenderSearchHit, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.SearchTendersWithResponse(ctx, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetUserTendersIter iterates over all the pages of GetUserTendersWithResponse.
+func (c *ClientWithResponses) GetUserTendersIter(ctx context.Context, params *GetUserTendersParams, reqEditors ...RequestEditorFn) iter.Seq2[Tender, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]Tender, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetUserTendersWithResponse(ctx, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetTenderRankingIter iterates over all the pages of GetTenderRankingWithResponse.
+func (c *ClientWithResponses) GetTenderRankingIter(ctx context.Context, tenderId TenderId, params *GetTenderRankingParams, reqEditors ...RequestEditorFn) iter.Seq2[RankedBid, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]RankedBid, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetTenderRankingWithResponse(ctx, tenderId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetTenderInvitationsIter iterates over all the pages of GetTenderInvitationsWithResponse.
+func (c *ClientWithResponses) GetTenderInvitationsIter(ctx context.Context, tenderId TenderId, params *GetTenderInvitationsParams, reqEditors ...RequestEditorFn) iter.Seq2[TenderInvitation, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]TenderInvitation, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetTenderInvitationsWithResponse(ctx, tenderId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetUserInvitationsIter iterates over all the pages of GetUserInvitationsWithResponse.
+func (c *ClientWithResponses) GetUserInvitationsIter(ctx context.Context, params *GetUserInvitationsParams, reqEditors ...RequestEditorFn) iter.Seq2[TenderInvitation, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]TenderInvitation, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetUserInvitationsWithResponse(ctx, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetTenderQuestionsIter iterates over all the pages of GetTenderQuestionsWithResponse.
+func (c *ClientWithResponses) GetTenderQuestionsIter(ctx context.Context, tenderId TenderId, params *GetTenderQuestionsParams, reqEditors ...RequestEditorFn) iter.Seq2[TenderQuestion, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]TenderQuestion, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetTenderQuestionsWithResponse(ctx, tenderId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetTenderHistoryIter iterates over all the pages of GetTenderHistoryWithResponse.
+func (c *ClientWithResponses) GetTenderHistoryIter(ctx context.Context, tenderId TenderId, params *GetTenderHistoryParams, reqEditors ...RequestEditorFn) iter.Seq2[TenderRevision, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]TenderRevision, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetTenderHistoryWithResponse(ctx, tenderId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetUserBidsIter iterates over all the pages of GetUserBidsWithResponse.
+func (c *ClientWithResponses) GetUserBidsIter(ctx context.Context, params *GetUserBidsParams, reqEditors ...RequestEditorFn) iter.Seq2[Bid, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]Bid, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetUserBidsWithResponse(ctx, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// SearchBidsIter iterates over all the pages of SearchBidsWithResponse.
+func (c *ClientWithResponses) SearchBidsIter(ctx context.Context, params *SearchBidsParams, reqEditors ...RequestEditorFn) iter.Seq2[BidSearchHit, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]BidSearchHit, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.SearchBidsWithResponse(ctx, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetBidsForTenderIter iterates over all the pages of GetBidsForTenderWithResponse.
+func (c *ClientWithResponses) GetBidsForTenderIter(ctx context.Context, tenderId TenderId, params *GetBidsForTenderParams, reqEditors ...RequestEditorFn) iter.Seq2[Bid, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]Bid, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetBidsForTenderWithResponse(ctx, tenderId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetBidReviewsIter iterates over all the pages of GetBidReviewsWithResponse.
+func (c *ClientWithResponses) GetBidReviewsIter(ctx context.Context, tenderId TenderId, params *GetBidReviewsParams, reqEditors ...RequestEditorFn) iter.Seq2[BidReview, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]BidReview, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetBidReviewsWithResponse(ctx, tenderId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetWebhookDeliveriesIter iterates over all the pages of GetWebhookDeliveriesWithResponse.
+func (c *ClientWithResponses) GetWebhookDeliveriesIter(ctx context.Context, webhookId WebhookId, params *GetWebhookDeliveriesParams, reqEditors ...RequestEditorFn) iter.Seq2[WebhookDelivery, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]WebhookDelivery, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetWebhookDeliveriesWithResponse(ctx, webhookId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
+
+// GetAuditLogIter iterates over all the pages of GetAuditLogWithResponse.
+func (c *ClientWithResponses) GetAuditLogIter(ctx context.Context, organizationId OrganizationId, params *GetAuditLogParams, reqEditors ...RequestEditorFn) iter.Seq2[AuditEntry, error] {
+	p := deref(params)
+	return All(ctx, p.Limit, p.Offset, func(ctx context.Context, limit, offset int32) ([]AuditEntry, error) {
+		p.Limit, p.Offset = &limit, &offset
+		resp, err := c.GetAuditLogWithResponse(ctx, organizationId, &p, reqEditors...)
+		if err != nil {
+			return nil, err
+		}
+		return deref(resp.JSON200), nil
+	})
+}
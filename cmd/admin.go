@@ -0,0 +1,254 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"my_zad/api"
+	"net/url"
+	"os"
+)
+
+// seedOrganizations are the demo organizations seed adds: one with three
+// responsibles, enough for the full decision quorum, and one bidding alone.
+var seedOrganizations = []struct {
+	name         string
+	responsibles [][3]string
+}{
+	{"Demo Buyer LLC", [][3]string{
+		{"demo_buyer1", "Ivan", "Petrov"},
+		{"demo_buyer2", "Anna", "Smirnova"},
+		{"demo_buyer3", "Oleg", "Ivanov"},
+	}},
+	{"Demo Supplier JSC", [][3]string{
+		{"demo_supplier", "Maria", "Kuznetsova"},
+	}},
+}
+
+// seedFreelancers are demo employees of no organization, who bid as users.
+var seedFreelancers = [][3]string{
+	{"demo_freelancer", "Pavel", "Sokolov"},
+}
+
+// errUsage is returned by a command given the wrong arguments.
+var errUsage = errors.New("wrong arguments")
+
+// newFlags returns the flag set of a command, failing on bad flags instead
+// of exiting.
+func newFlags(name string) *flag.FlagSet {
+	return flag.NewFlagSet(name, flag.ContinueOnError)
+}
+
+// runSeed adds the demo data. Running it again adds nothing: employees are
+// found by username and organizations by their first responsible.
+func runSeed(store api.Storage, out io.Writer, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	for _, org := range seedOrganizations {
+		var orgId string
+		for _, name := range org.responsibles {
+			user, err := seedUser(store, out, name)
+			if err != nil {
+				return err
+			}
+			current, err := store.GetUserOrganization(user.Id)
+			if err != nil {
+				return err
+			}
+			switch {
+			case current != "" && orgId == "":
+				orgId = current
+				continue
+			case current != "":
+				continue
+			case orgId == "":
+				if orgId, err = store.CreateOrganization(org.name); err != nil {
+					return err
+				}
+				fmt.Fprintf(out, "organization %q %s\n", org.name, orgId)
+			}
+			if err := store.AddOrganizationResponsible(orgId, user.Id); err != nil {
+				return err
+			}
+			fmt.Fprintf(out, "responsible %s of %s\n", user.Username, orgId)
+		}
+	}
+	for _, name := range seedFreelancers {
+		if _, err := seedUser(store, out, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func seedUser(store api.Storage, out io.Writer, name [3]string) (*api.User, error) {
+	user, err := store.CreateUser(&api.User{Username: name[0], FirstName: name[1], LastName: name[2]})
+	if errors.Is(err, api.ErrUserExists) {
+		return store.GetUserByUsername(name[0])
+	}
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(out, "user %s %s\n", user.Username, user.Id)
+	return user, nil
+}
+
+func runUserAdd(store api.Storage, out io.Writer, args []string) error {
+	flags := newFlags("user add")
+	first := flags.String("first", "", "first name")
+	last := flags.String("last", "", "last name")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 || flags.Arg(0) == "" {
+		return errUsage
+	}
+
+	user, err := store.CreateUser(&api.User{Username: flags.Arg(0), FirstName: *first, LastName: *last})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "user %s %s\n", user.Username, user.Id)
+	return nil
+}
+
+func runOrgAdd(store api.Storage, out io.Writer, args []string) error {
+	if len(args) != 1 || args[0] == "" {
+		return errUsage
+	}
+
+	id, err := store.CreateOrganization(args[0])
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "organization %q %s\n", args[0], id)
+	return nil
+}
+
+func runOrgAddResponsible(store api.Storage, out io.Writer, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+
+	user, err := store.GetUserByUsername(args[1])
+	if err != nil {
+		return err
+	}
+	if err := store.AddOrganizationResponsible(args[0], user.Id); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "responsible %s of %s\n", user.Username, args[0])
+	return nil
+}
+
+// runTenderClose closes a tender through the API, so the rules of closing
+// apply and the change is audited as made by the responsible.
+func runTenderClose(store api.Storage, out io.Writer, args []string) error {
+	flags := newFlags("tender close")
+	username := flags.String("username", "", "responsible of the tender closing it")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 || *username == "" {
+		return errUsage
+	}
+
+	query := url.Values{"status": {string(api.TenderStatusClosed)}, "username": {*username}}
+	if err := callAPI(store, "PUT", "/tenders/"+flags.Arg(0)+"/status", query, "", nil, io.Discard); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "tender %s closed\n", flags.Arg(0))
+	return nil
+}
+
+// runExport writes an export of the API to a file or to out, with the
+// visibility of the given user.
+func runExport(store api.Storage, out io.Writer, args []string) error {
+	flags := newFlags("export")
+	format := flags.String("format", string(api.ExportFormatCsv), "csv or xlsx")
+	username := flags.String("username", "", "user the export is made for")
+	path := flags.String("o", "", "file to write the export to instead of the standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	query := url.Values{"format": {*format}}
+	if *username != "" {
+		query.Set("username", *username)
+	}
+	var route string
+	switch {
+	case flags.NArg() == 1 && flags.Arg(0) == "tenders":
+		route = "/tenders/export"
+	case flags.NArg() == 2 && flags.Arg(0) == "bids":
+		route = "/bids/" + flags.Arg(1) + "/export"
+	default:
+		return errUsage
+	}
+
+	if *path == "" {
+		return callAPI(store, "GET", route, query, "", nil, out)
+	}
+	file, err := os.Create(*path)
+	if err != nil {
+		return err
+	}
+	if err := callAPI(store, "GET", route, query, "", nil, file); err != nil {
+		file.Close()
+		os.Remove(*path)
+		return err
+	}
+	return file.Close()
+}
+
+func runVerifyAudit(store api.Storage, out io.Writer, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+
+	result, err := api.VerifyAuditLog(store)
+	if err != nil {
+		return err
+	}
+	if !result.Valid {
+		return fmt.Errorf("audit log is broken at entry %d, after %d intact entries", *result.BrokenAt, result.Checked)
+	}
+	fmt.Fprintf(out, "audit log is intact, %d entries checked\n", result.Checked)
+	return nil
+}
+
+// reseal encrypts the sealed bids and their attachments again with the
+// current sealing key, after the key was rotated. Run it while the old key
+// is still listed in BID_SEALING_PREVIOUS_KEYS; once it succeeds, the old
+// key can be dropped.
+func reseal(store *api.PostgresStorage, sealer *api.Sealer, out io.Writer) error {
+	if sealer == nil {
+		return errors.New("BID_SEALING_KEY is not set")
+	}
+
+	bids, err := store.ResealBids()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%d sealed bid versions resealed\n", bids)
+
+	attachments, err := store.GetSealedAttachments()
+	if err != nil {
+		return err
+	}
+	blobs, err := api.NewBlobStoreFromEnv()
+	if err != nil {
+		return err
+	}
+	for _, attachment := range attachments {
+		if err := sealer.ResealBlob(context.Background(), blobs, attachment); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(out, "%d sealed attachments resealed\n", len(attachments))
+	return nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"my_zad/api"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// run runs the command args name on store and returns what it printed.
+func run(t *testing.T, store api.Storage, args ...string) (string, error) {
+	t.Helper()
+	cmd, rest := findCommand(args)
+	if cmd == nil || cmd.run == nil {
+		t.Fatalf("no command %q", args)
+	}
+	var out bytes.Buffer
+	err := cmd.run(store, &out, rest)
+	return out.String(), err
+}
+
+func TestAdminCommands(t *testing.T) {
+	store := api.NewMemoryStorage()
+
+	out, err := run(t, store, "seed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Count(out, "user ") != 5 || strings.Count(out, "organization ") != 2 || strings.Count(out, "responsible ") != 4 {
+		t.Errorf("seed printed:\n%s", out)
+	}
+	if out, err := run(t, store, "seed"); err != nil || out != "" {
+		t.Errorf("seed again: %q, %v", out, err)
+	}
+	buyer, err := store.GetUserByUsername("demo_buyer1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	org, _ := store.GetUserOrganization(buyer.Id)
+	if responsibles, _ := store.GetOrganizationResponsibles(org); len(responsibles) != 3 {
+		t.Errorf("demo buyer has %d responsibles", len(responsibles))
+	}
+
+	if _, err := run(t, store, "user", "add", "-first", "Nina", "nina"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run(t, store, "user", "add", "nina"); !errors.Is(err, api.ErrUserExists) {
+		t.Errorf("adding nina again: %v", err)
+	}
+	if _, err := run(t, store, "user", "add"); !errors.Is(err, errUsage) {
+		t.Errorf("user add without a name: %v", err)
+	}
+	if _, err := run(t, store, "org", "add-responsible", org, "nina"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := run(t, store, "org", "add-responsible", org, "nina"); !errors.Is(err, api.ErrAlreadyResponsible) {
+		t.Errorf("adding nina twice: %v", err)
+	}
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "tenders.jsonl")
+	row := `{"name":"Ремонт","description":"Дороги","serviceType":"Construction","organizationId":"` + org +
+		`","creatorUsername":"nina"}`
+	if err := os.WriteFile(file, []byte(row+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if out, err := run(t, store, "import", "-dry-run", "tenders", file); err != nil ||
+		out != "1 rows: 0 created, 0 failed, nothing committed\n" {
+		t.Fatalf("dry run: %q, %v", out, err)
+	}
+	if _, err := run(t, store, "import", "tenders", file); err != nil {
+		t.Fatal(err)
+	}
+	tenders, _ := store.GetTendersByUsername("nina", api.TenderFilter{}, 10, 0)
+	if len(tenders) != 1 {
+		t.Fatalf("nina has %d tenders", len(tenders))
+	}
+
+	if _, err := run(t, store, "tender", "close", "-username", "demo_supplier", tenders[0].Id); err == nil ||
+		!strings.Contains(err.Error(), "PUT /tenders/"+tenders[0].Id+"/status") {
+		t.Errorf("closing as an outsider: %v", err)
+	}
+	if _, err := run(t, store, "tender", "close", "-username", "demo_buyer2", tenders[0].Id); err != nil {
+		t.Fatal(err)
+	}
+
+	export := filepath.Join(dir, "bids.csv")
+	if _, err := run(t, store, "export", "-username", "nina", "-o", export, "bids", tenders[0].Id); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(export)
+	if !strings.HasPrefix(string(data), "\ufeffid,tenderId,name") {
+		t.Errorf("export:\n%s", data)
+	}
+	if _, err := run(t, store, "export", "-format", "pdf", "-o", export, "tenders"); err == nil {
+		t.Error("exported as pdf")
+	}
+	if _, err := os.Stat(export); !os.IsNotExist(err) {
+		t.Errorf("failed export left its file: %v", err)
+	}
+
+	if out, err := run(t, store, "verify-audit"); err != nil || out != "audit log is intact, 2 entries checked\n" {
+		t.Errorf("verify-audit: %q, %v", out, err)
+	}
+}
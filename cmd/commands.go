@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"my_zad/api"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type command struct {
+	name  string
+	args  string
+	about string
+	// run is nil for serve, migrate and reseal, which need the Postgres
+	// storage itself and are run by main.
+	run func(store api.Storage, out io.Writer, args []string) error
+}
+
+var commands = []command{
+	{"serve", "", "start the API server, the scheduler and the event relays (default)", nil},
+	{"migrate", "", "create the database schema or bring it up to date", nil},
+	{"reseal", "", "encrypt sealed bids and attachments again with BID_SEALING_KEY after a key rotation", nil},
+	{"seed", "", "add demo organizations, employees and responsibles", runSeed},
+	{"user add", "[-first NAME] [-last NAME] USERNAME", "add an employee", runUserAdd},
+	{"org add", "NAME", "add an organization", runOrgAdd},
+	{"org add-responsible", "ORGANIZATION_ID USERNAME", "make an employee responsible for an organization", runOrgAddResponsible},
+	{"tender close", "-username USERNAME TENDER_ID", "close a tender on behalf of one of its responsibles", runTenderClose},
+	{"export", "[-format csv|xlsx] [-username USERNAME] [-o FILE] tenders | bids TENDER_ID", "export tenders or the bids of a tender", runExport},
+	{"import", "[-mode atomic|perRow] [-dry-run] [-errors FILE] tenders|bids FILE", "import tenders or bids from CSV or JSONL", runImport},
+	{"verify-audit", "", "check the hash chain of the audit log", runVerifyAudit},
+}
+
+// findCommand returns the command args start with, named by one word or by
+// two, and the arguments that follow its name.
+func findCommand(args []string) (*command, []string) {
+	for i := range commands {
+		name := strings.Fields(commands[i].name)
+		if len(args) >= len(name) && strings.Join(args[:len(name)], " ") == commands[i].name {
+			return &commands[i], args[len(name):]
+		}
+	}
+	return nil, nil
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: main [COMMAND [ARGS]]")
+	fmt.Fprintln(w)
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %s %s\n        %s\n", c.name, c.args, c.about)
+	}
+}
+
+// callAPI serves a request to the API in process, so that a command goes
+// through the same checks, policy and audit as a request over HTTP. The
+// body of a successful response is written to out.
+func callAPI(store api.Storage, method, path string, query url.Values, contentType string, body io.Reader, out io.Writer) error {
+	handler, err := api.NewAPIServer("", store, nil, nil).Handler()
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, "/api"+path+"?"+query.Encode(), body)
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp := &cliResponse{header: http.Header{}, out: out}
+	if !serveAPI(handler, resp, req) {
+		return fmt.Errorf("%s %s: response aborted", method, path)
+	}
+	if resp.err != nil {
+		return resp.err
+	}
+	if resp.status != http.StatusOK {
+		var reason api.ErrorResponse
+		if err := json.Unmarshal(resp.failure.Bytes(), &reason); err != nil {
+			return fmt.Errorf("%s %s: status %d", method, path, resp.status)
+		}
+		return fmt.Errorf("%s %s: %s", method, path, reason.Reason)
+	}
+	return nil
+}
+
+// serveAPI serves the request, reporting false if the handler aborted the
+// response half way, as a failed export does.
+func serveAPI(handler http.Handler, resp http.ResponseWriter, req *http.Request) (completed bool) {
+	defer func() {
+		if v := recover(); v != nil {
+			if v != http.ErrAbortHandler {
+				panic(v)
+			}
+			completed = false
+		}
+	}()
+	handler.ServeHTTP(resp, req)
+	return true
+}
+
+// cliResponse passes the body of a successful response on to out as it is
+// written, and keeps that of a failed one.
+type cliResponse struct {
+	header  http.Header
+	status  int
+	out     io.Writer
+	failure bytes.Buffer
+	err     error
+}
+
+func (r *cliResponse) Header() http.Header {
+	return r.header
+}
+
+func (r *cliResponse) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+}
+
+func (r *cliResponse) Write(p []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	if r.status != http.StatusOK {
+		return r.failure.Write(p)
+	}
+	n, err := r.out.Write(p)
+	if err != nil && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
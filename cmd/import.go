@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"my_zad/api"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// runImport imports a CSV or JSONL file of tenders or bids through the API,
+// so its rows are checked and audited the same way as over HTTP. It fails
+// when any row does.
+func runImport(store api.Storage, out io.Writer, args []string) error {
+	flags := newFlags("import")
+	mode := flags.String("mode", string(api.ImportModeAtomic), "atomic: all rows or none; perRow: every valid row")
+	dryRun := flags.Bool("dry-run", false, "only check the rows")
+	errorsPath := flags.String("errors", "", "write the rows that weren't accepted to this CSV file")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 2 || (flags.Arg(0) != "tenders" && flags.Arg(0) != "bids") {
+		return errUsage
+	}
+	kind, path := flags.Arg(0), flags.Arg(1)
+
+	contentType := "application/x-ndjson"
+	if filepath.Ext(path) == ".csv" {
+		contentType = "text/csv"
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var body bytes.Buffer
+	query := url.Values{"mode": {*mode}, "dryRun": {strconv.FormatBool(*dryRun)}}
+	if err := callAPI(store, "POST", "/"+kind+"/import", query, contentType, file, &body); err != nil {
+		return err
+	}
+	var report api.ImportReport
+	if err := json.Unmarshal(body.Bytes(), &report); err != nil {
+		return fmt.Errorf("failed to decode import report: %w", err)
+	}
+
+	for _, row := range report.Rows {
+		if row.Status == api.ImportRowStatusFailed {
+			fmt.Fprintf(out, "row %d: %s\n", row.Row, *row.Reason)
+		}
+	}
+	fmt.Fprintf(out, "%d rows: %d created, %d failed", report.Total, report.Created, report.Failed)
+	if !report.Committed {
+		fmt.Fprint(out, ", nothing committed")
+	}
+	fmt.Fprintln(out)
+
+	if *errorsPath != "" {
+		f, err := os.Create(*errorsPath)
+		if err != nil {
+			return err
+		}
+		if err := api.WriteImportErrors(f, &report); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	if report.Failed > 0 {
+		return fmt.Errorf("%d of %d rows failed", report.Failed, report.Total)
+	}
+	return nil
+}
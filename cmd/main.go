@@ -1,13 +1,26 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
 	"github.com/joho/godotenv"
 	"log"
 	"log/slog"
 	"my_zad/api"
 	"os"
+	"time"
 )
 
+// schedulerLockKey identifies the advisory lock the replicas compete for to
+// run the tender scheduler.
+const schedulerLockKey = 0x54454e44 // "TEND"
+
+// outboxLockKey identifies the advisory lock held by the replica relaying
+// the outbox.
+const outboxLockKey = 0x4f555442 // "OUTB"
+
 const (
 	envLocal = "local"
 	envDev   = "dev"
@@ -27,21 +40,118 @@ func main() {
 	//	log.Info("starting", slog.String("env", cfg.Env))
 	//	log.Debug("Debug enabled")
 
+	// Without a command the service is started, as it always was.
+	args := os.Args[1:]
+	if len(args) == 0 {
+		args = []string{"serve"}
+	}
+	if args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(os.Stdout)
+		return
+	}
+	cmd, args := findCommand(args)
+	if cmd == nil {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
 	//TODO: init storage:
-	store, err := api.NewPostgresStorage()
+	sealer, err := api.NewSealerFromEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	store, err := api.NewPostgresStorage(sealer)
 
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	if err := store.Init(); err != nil {
+	switch cmd.name {
+	case "serve":
+		if err := store.Init(); err != nil {
+			log.Fatal(err)
+		}
+		serve(store, sealer)
+	case "migrate":
+		if err := store.Init(); err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println("schema is up to date")
+	case "reseal":
+		if err := reseal(store, sealer, os.Stdout); err != nil {
+			log.Fatal(err)
+		}
+	default:
+		err := cmd.run(store, os.Stdout, args)
+		if errors.Is(err, errUsage) || errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "usage: main %s %s\n", cmd.name, cmd.args)
+			os.Exit(2)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// serve runs the API server, and the gRPC one if GRPC_ADDRESS is set,
+// along with the scheduler, the webhook and notification dispatchers and
+// the outbox relay.
+func serve(store *api.PostgresStorage, sealer *api.Sealer) {
+	interval, err := time.ParseDuration(os.Getenv("SCHEDULER_INTERVAL"))
+	if err != nil {
+		interval = time.Minute
+	}
+	scheduler := api.NewScheduler(store, store.NewAdvisoryLock(schedulerLockKey), interval)
+	go scheduler.Run(context.Background())
+
+	webhookInterval, err := time.ParseDuration(os.Getenv("WEBHOOK_INTERVAL"))
+	if err != nil {
+		webhookInterval = 10 * time.Second
+	}
+	go api.NewWebhookDispatcher(store, webhookInterval).Run(context.Background())
+
+	outboxInterval, err := time.ParseDuration(os.Getenv("OUTBOX_INTERVAL"))
+	if err != nil {
+		outboxInterval = 5 * time.Second
+	}
+	sinks := []api.EventSink{api.LogSink{}, api.NewWebhookSink(store), api.NewEventLogSink(store)}
+	if natsURL := os.Getenv("OUTBOX_NATS_URL"); natsURL != "" {
+		subject := os.Getenv("OUTBOX_NATS_SUBJECT")
+		if subject == "" {
+			subject = "tenders"
+		}
+		nats, err := api.NewNATSSink(natsURL, subject)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sinks = append(sinks, nats)
+	}
+	if smtpAddr := os.Getenv("SMTP_ADDR"); smtpAddr != "" {
+		mailer, err := api.NewSMTPMailer(smtpAddr, os.Getenv("SMTP_FROM"), os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		notifyInterval, err := time.ParseDuration(os.Getenv("NOTIFY_INTERVAL"))
+		if err != nil {
+			notifyInterval = 10 * time.Second
+		}
+		sinks = append(sinks, api.NewNotificationSink(store))
+		go api.NewNotificationDispatcher(store, mailer, notifyInterval).Run(context.Background())
+	}
+	relay := api.NewOutboxRelay(store, store.NewAdvisoryLock(outboxLockKey), outboxInterval, sinks...)
+	go relay.Run(context.Background())
+
+	blobs, err := api.NewBlobStoreFromEnv()
+	if err != nil {
 		log.Fatal(err)
 	}
 
 	//TODO: run server
-	server := api.NewAPIServer(os.Getenv("SERVER_ADDRESS"), store) //8082
+	server := api.NewAPIServer(os.Getenv("SERVER_ADDRESS"), store, blobs, sealer) //8082
+	if grpcAddr := os.Getenv("GRPC_ADDRESS"); grpcAddr != "" {
+		go server.RunGRPC(grpcAddr)
+	}
 	server.Run()
-
 }
 
 func setupLogger(env string) *slog.Logger {
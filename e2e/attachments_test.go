@@ -0,0 +1,138 @@
+package e2e
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"mime/multipart"
+	"my_zad/api"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"testing"
+)
+
+var pdf = []byte("%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\n%%EOF\n")
+
+func (f *fixture) upload(user *api.User, path, filename, contentType string, data []byte, status int) api.Attachment {
+	f.t.Helper()
+
+	var attachment api.Attachment
+	f.expect(f.sendFile(user, path, filename, contentType, data), status, &attachment)
+	return attachment
+}
+
+// sendFile posts data as the file part of a multipart upload.
+func (f *fixture) sendFile(user *api.User, path, filename, contentType string, data []byte) response {
+	f.t.Helper()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="file"; filename="`+filename+`"`)
+	header.Set("Content-Type", contentType)
+	part, err := mw.CreatePart(header)
+	if err != nil {
+		f.t.Fatalf("create part: %v", err)
+	}
+	part.Write(data)
+	mw.Close()
+
+	return f.send("POST", query(path, "username", user.Username), mw.FormDataContentType(), body.Bytes())
+}
+
+func TestRequestSize(t *testing.T) {
+	f := newFixture(t)
+	tender := f.createTender(f.owners[0], "С документацией", "Construction")
+
+	// Bodies are capped before the validator reads them, by the limit of
+	// the operation.
+	var reason api.ErrorResponse
+	f.expect(f.sendFile(f.owners[0], "/api/tenders/"+tender.Id+"/attachments", "big.pdf", "application/pdf",
+		bytes.Repeat([]byte("x"), 22<<20)), http.StatusBadRequest, &reason)
+	if reason.Reason != "request body is larger than 22020096 bytes" {
+		t.Errorf("oversized upload: %s", reason.Reason)
+	}
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{"name": strings.Repeat("x", 1<<20)}),
+		http.StatusBadRequest, &reason)
+	if reason.Reason != "request body is larger than 1048576 bytes" {
+		t.Errorf("oversized tender: %s", reason.Reason)
+	}
+}
+
+func TestAttachments(t *testing.T) {
+	f := newFixture(t)
+
+	tender := f.createTender(f.owners[0], "С документацией", "Construction")
+	attachments := "/api/tenders/" + tender.Id + "/attachments"
+
+	f.upload(f.bidder, attachments, "spec.pdf", "application/pdf", pdf, http.StatusForbidden)
+	f.upload(f.owners[0], attachments, "run.exe", "application/x-msdownload", []byte("MZ"), http.StatusBadRequest)
+	// The declared type has to agree with the contents, where the sniffer
+	// can tell: a docx is a zip archive to it, a dwg drawing nothing at all.
+	docx := "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	f.upload(f.owners[0], attachments, "spec.pdf", "application/pdf", []byte("MZ\x90\x00\x03"), http.StatusBadRequest)
+	f.upload(f.owners[0], attachments, "notes.txt", "text/plain", []byte("<html><script>alert(1)</script>"), http.StatusBadRequest)
+	f.upload(f.owners[0], attachments, "spec.docx", docx, pdf, http.StatusBadRequest)
+	drafts := "/api/tenders/" + f.createTender(f.owners[0], "Черновики", "Construction").Id + "/attachments"
+	f.upload(f.owners[0], drafts, "spec.docx", docx, []byte("PK\x03\x04\x14\x00"), http.StatusOK)
+	f.upload(f.owners[0], drafts, "plan.dwg", "image/vnd.dwg", []byte("AC1032\x00\x00"), http.StatusOK)
+
+	spec := f.upload(f.owners[0], attachments, "spec.pdf", "application/pdf", pdf, http.StatusOK)
+	sum := sha256.Sum256(pdf)
+	if spec.Version != 1 || spec.Size != int64(len(pdf)) || spec.Sha256 != hex.EncodeToString(sum[:]) ||
+		spec.ContentType != "application/pdf" || spec.TenderId == nil || *spec.TenderId != tender.Id {
+		t.Errorf("attachment = %+v", spec)
+	}
+
+	download := "/api/attachments/" + spec.Id
+	// The tender isn't published yet, so only its responsibles see the file.
+	f.expect(f.do("GET", download, nil), http.StatusUnauthorized, nil)
+	f.expect(f.do("GET", query(download, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+
+	f.expect(f.do("PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.owners[0].Username),
+		map[string]any{"description": "Уточненное описание"}), http.StatusOK, nil)
+	f.upload(f.owners[1], attachments, "notes.txt", "text/plain", []byte("Сроки сдвинуты"), http.StatusOK)
+	f.publishTender(f.owners[0], tender.Id)
+
+	var listed []api.Attachment
+	f.expect(f.do("GET", query(attachments, "version", "1"), nil), http.StatusOK, &listed)
+	if len(listed) != 1 || listed[0].Id != spec.Id {
+		t.Errorf("version 1 attachments = %+v", listed)
+	}
+	f.expect(f.do("GET", attachments, nil), http.StatusOK, &listed)
+	if len(listed) != 2 || listed[1].Version != 2 || listed[1].Name != "notes.txt" {
+		t.Errorf("latest attachments = %+v", listed)
+	}
+
+	resp := f.do("GET", download, nil)
+	if resp.status != http.StatusOK || !bytes.Equal(resp.body, pdf) {
+		t.Errorf("download = %d %q", resp.status, resp.body)
+	}
+
+	// Attachments aren't versioned: rolling back to version 1 keeps the
+	// file attached to version 2.
+	f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/rollback/1", "username", f.owners[0].Username), nil),
+		http.StatusOK, nil)
+	f.expect(f.do("GET", attachments, nil), http.StatusOK, &listed)
+	if len(listed) != 2 || listed[1].Name != "notes.txt" {
+		t.Errorf("attachments after the rollback = %+v", listed)
+	}
+
+	t.Run("bid", func(t *testing.T) {
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "С сертификатом")
+		bidAttachments := "/api/bids/" + bid.Id + "/attachments"
+
+		f.upload(f.freelancer, bidAttachments, "cert.png", "image/png", []byte("\x89PNG\r\n\x1a\n"), http.StatusForbidden)
+		cert := f.upload(f.bidder, bidAttachments, "cert.png", "image/png", []byte("\x89PNG\r\n\x1a\n"), http.StatusOK)
+
+		download := "/api/attachments/" + cert.Id
+		f.expect(f.do("GET", download, nil), http.StatusUnauthorized, nil)
+		f.expect(f.do("GET", query(download, "username", f.freelancer.Username), nil), http.StatusForbidden, nil)
+		f.expect(f.do("GET", query(download, "username", f.owners[2].Username), nil), http.StatusOK, nil)
+		f.expect(f.do("GET", query(bidAttachments, "username", f.owners[2].Username), nil), http.StatusOK, &listed)
+		if len(listed) != 1 || listed[0].BidId == nil || *listed[0].BidId != bid.Id {
+			t.Errorf("bid attachments = %+v", listed)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package e2e
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func (f *fixture) createAuction(name string, startAt, endAt time.Time, extension int) api.Tender {
+	f.t.Helper()
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            name,
+		"description":     "Описание " + name,
+		"serviceType":     "Delivery",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"budget":          map[string]any{"max": "1000", "currency": "RUB"},
+		"auction": map[string]any{
+			"startAt":          startAt,
+			"endAt":            endAt,
+			"minDecrement":     "10",
+			"extensionSeconds": extension,
+		},
+	}), http.StatusOK, &tender)
+	f.publishTender(f.owners[0], tender.Id)
+	return tender
+}
+
+func (f *fixture) placePrice(author *api.User, bidId, price string, status int) api.Bid {
+	f.t.Helper()
+
+	var bid api.Bid
+	f.expect(f.do("PUT", query("/api/bids/"+bidId+"/price",
+		"price", price, "username", author.Username), nil), status, &bid)
+	return bid
+}
+
+// leaderboardStream reads the server-sent events of an auction leaderboard.
+type leaderboardStream struct {
+	t *testing.T
+	r *bufio.Reader
+}
+
+func (f *fixture) streamLeaderboard(user *api.User, tenderId string) *leaderboardStream {
+	f.t.Helper()
+
+	resp, err := f.srv.Client().Get(f.srv.URL + query("/api/tenders/"+tenderId+"/auction/leaderboard", "username", user.Username))
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.t.Cleanup(func() { resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		f.t.Fatalf("stream status = %d, content type = %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return &leaderboardStream{t: f.t, r: bufio.NewReader(resp.Body)}
+}
+
+func (s *leaderboardStream) next() (string, api.AuctionLeaderboard) {
+	s.t.Helper()
+
+	var event string
+	var board api.AuctionLeaderboard
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			s.t.Fatalf("read event: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "":
+			return event, board
+		case strings.HasPrefix(line, "event: "):
+			event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &board); err != nil {
+				s.t.Fatalf("decode %s: %v", line, err)
+			}
+		}
+	}
+}
+
+func TestAuction(t *testing.T) {
+	f := newFixture(t)
+	now := time.Now()
+
+	tender := f.createAuction("Аукцион", now.Add(-time.Minute), now.Add(time.Hour), 60)
+	if tender.Auction == nil || tender.Auction.MinDecrement != "10.00" {
+		t.Fatalf("auction = %+v", tender.Auction)
+	}
+
+	f.expect(f.do("POST", "/api/bids/new", map[string]any{
+		"name":        "С ценой",
+		"description": "Описание",
+		"tenderId":    tender.Id,
+		"authorType":  "User",
+		"authorId":    f.bidder.Id,
+		"price":       "900",
+		"currency":    "RUB",
+	}), http.StatusBadRequest, nil)
+
+	first := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Первое")
+	f.publishBid(f.bidder, first.Id)
+	second := f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "Второе")
+	f.publishBid(f.freelancer, second.Id)
+
+	stream := f.streamLeaderboard(f.freelancer, tender.Id)
+	if event, board := stream.next(); event != "leaderboard" || len(board.Entries) != 0 {
+		t.Fatalf("initial %s = %+v", event, board)
+	}
+
+	f.placePrice(f.bidder, first.Id, "1000.01", http.StatusBadRequest)
+	bid := f.placePrice(f.bidder, first.Id, "900", http.StatusOK)
+	if *bid.Price != "900.00" || *bid.Currency != "RUB" || bid.Version != first.Version+1 {
+		t.Errorf("placed bid = %+v", bid)
+	}
+
+	event, board := stream.next()
+	if event != "leaderboard" || len(board.Entries) != 1 || board.Entries[0].BidId != first.Id || board.Entries[0].Own {
+		t.Fatalf("update %s = %+v", event, board)
+	}
+
+	f.placePrice(f.freelancer, second.Id, "895", http.StatusBadRequest)
+	f.placePrice(f.freelancer, second.Id, "890", http.StatusOK)
+	_, board = stream.next()
+	if len(board.Entries) != 2 || board.Entries[0].BidId != second.Id || !board.Entries[0].Own || board.Entries[1].Rank != 2 {
+		t.Errorf("leaderboard = %+v", board)
+	}
+
+	// Only the organization and the bidders watch the auction.
+	outsider := f.addUser("outsider", "Out", "Sider")
+	f.expect(f.do("GET", query("/api/tenders/"+tender.Id+"/auction/leaderboard", "username", outsider.Username), nil),
+		http.StatusForbidden, nil)
+
+	t.Run("schedule", func(t *testing.T) {
+		early := f.createAuction("Рано", now.Add(time.Hour), now.Add(2*time.Hour), 60)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, early.Id, "Раньше времени")
+		f.publishBid(f.bidder, bid.Id)
+		f.placePrice(f.bidder, bid.Id, "900", http.StatusForbidden)
+
+		if _, err := f.store.PlaceAuctionBid(first.Id, "500.00", now.Add(2*time.Hour)); !errors.Is(err, api.ErrAuctionClosed) {
+			t.Errorf("price after the end: %v", err)
+		}
+	})
+
+	t.Run("anti-sniping", func(t *testing.T) {
+		late := f.createAuction("Под занавес", now.Add(-time.Minute), time.Now().Add(5*time.Second), 60)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, late.Id, "В последний момент")
+		f.publishBid(f.bidder, bid.Id)
+		f.placePrice(f.bidder, bid.Id, "900", http.StatusOK)
+
+		got, err := f.store.GetTenderById(late.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if left := time.Until(got.Auction.EndAt); left < 50*time.Second {
+			t.Errorf("auction ends in %v, want extended to a minute", left)
+		}
+	})
+
+	t.Run("closing", func(t *testing.T) {
+		if err := api.NewScheduler(f.store, nil, time.Minute).Tick(now.Add(3 * time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		if got := f.tenderStatus(tender.Id); got != api.TenderStatusClosed {
+			t.Errorf("status after the auction = %s", got)
+		}
+	})
+}
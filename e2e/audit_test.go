@@ -0,0 +1,105 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestAuditLog(t *testing.T) {
+	f := newFixture(t)
+	audit := "/api/organizations/" + f.org + "/audit"
+	entries := func(kv ...string) []api.AuditEntry {
+		t.Helper()
+		var got []api.AuditEntry
+		f.expect(f.do("GET", query(audit, append([]string{"username", f.owners[0].Username}, kv...)...), nil),
+			http.StatusOK, &got)
+		return got
+	}
+
+	start := time.Now().Add(-time.Second)
+	tender := f.createTender(f.owners[0], "Ремонт дорог", "Delivery")
+	f.publishTender(f.owners[1], tender.Id)
+	f.expect(f.do("PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.owners[0].Username),
+		map[string]any{"name": "Ремонт мостов"}), http.StatusOK, nil)
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Быстрая доставка")
+
+	// A request keeps the id its client sent.
+	req, err := http.NewRequest("PUT", f.srv.URL+query("/api/bids/"+bid.Id+"/status",
+		"status", "Published", "username", f.bidder.Username), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Request-ID", "publish-bid-1")
+	resp, err := f.srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("X-Request-ID") != "publish-bid-1" {
+		t.Fatalf("publish bid: %d, request id %q", resp.StatusCode, resp.Header.Get("X-Request-ID"))
+	}
+
+	got := entries()
+	want := []struct {
+		actor  string
+		action api.AuditAction
+	}{
+		{"bidder", api.AuditActionUpdateBidStatus},
+		{"bidder", api.AuditActionCreateBid},
+		{"owner1", api.AuditActionEditTender},
+		{"owner2", api.AuditActionUpdateTenderStatus},
+		{"owner1", api.AuditActionCreateTender},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("audit log = %+v", got)
+	}
+	for i, w := range want {
+		if got[i].Actor != w.actor || got[i].Action != w.action || got[i].RequestId == "" || got[i].Ip == "" {
+			t.Errorf("entry %d = %+v, want %s by %s", i, got[i], w.action, w.actor)
+		}
+	}
+	if got[0].RequestId != "publish-bid-1" {
+		t.Errorf("request id = %q", got[0].RequestId)
+	}
+	if got[4].Before != nil || (*got[4].After)["name"] != "Ремонт дорог" {
+		t.Errorf("creation recorded %v -> %v", got[4].Before, got[4].After)
+	}
+	if (*got[2].Before)["name"] != "Ремонт дорог" || (*got[2].After)["name"] != "Ремонт мостов" {
+		t.Errorf("edit recorded %v -> %v", got[2].Before, got[2].After)
+	}
+	if got[1].PrevHash != got[2].Hash {
+		t.Errorf("entry %d is not chained to %d", got[1].Id, got[2].Id)
+	}
+
+	if got := entries("entityType", "bid", "entityId", bid.Id); len(got) != 2 {
+		t.Errorf("bid entries = %+v", got)
+	}
+	if got := entries("actor", "owner2"); len(got) != 1 || got[0].Action != api.AuditActionUpdateTenderStatus {
+		t.Errorf("owner2 entries = %+v", got)
+	}
+	if got := entries("from", start.Format(time.RFC3339), "limit", "2"); len(got) != 2 {
+		t.Errorf("first page = %+v", got)
+	}
+	if got := entries("to", start.Format(time.RFC3339)); len(got) != 0 {
+		t.Errorf("entries before the test = %+v", got)
+	}
+
+	// The rival organization sees the bid made on its behalf, not the tender.
+	var rival []api.AuditEntry
+	f.expect(f.do("GET", query("/api/organizations/"+f.rival+"/audit", "username", f.bidder.Username), nil),
+		http.StatusOK, &rival)
+	if len(rival) != 2 {
+		t.Errorf("rival entries = %+v", rival)
+	}
+
+	f.expect(f.do("GET", query(audit, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+	f.expect(f.do("GET", query(audit+"/verify", "username", f.freelancer.Username), nil), http.StatusForbidden, nil)
+
+	var verification api.AuditVerification
+	f.expect(f.do("GET", query(audit+"/verify", "username", f.owners[2].Username), nil), http.StatusOK, &verification)
+	if !verification.Valid || verification.Checked != 5 || verification.BrokenAt != nil {
+		t.Errorf("verification = %+v", verification)
+	}
+}
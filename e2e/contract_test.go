@@ -0,0 +1,376 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestContract(t *testing.T) {
+	t.Run("01/ping", func(t *testing.T) {
+		f := newFixture(t)
+
+		resp := f.do("GET", "/api/ping", nil)
+		f.expect(resp, http.StatusOK, nil)
+		if string(resp.body) != "ok" {
+			t.Errorf("body = %q, want ok", resp.body)
+		}
+	})
+
+	t.Run("02/tenders/new", func(t *testing.T) {
+		f := newFixture(t)
+
+		tender := f.createTender(f.owners[0], "Тендер 1", api.TenderServiceTypeConstruction)
+		if tender.Status != api.TenderStatusCreated || tender.Version != 1 || tender.OrganizationId != f.org {
+			t.Errorf("created tender = %+v", tender)
+		}
+
+		body := map[string]any{
+			"name":            "Тендер 2",
+			"description":     "Описание",
+			"serviceType":     "Delivery",
+			"organizationId":  f.org,
+			"creatorUsername": "ghost",
+		}
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusUnauthorized, nil)
+
+		body["creatorUsername"] = f.bidder.Username
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusForbidden, nil)
+
+		body["creatorUsername"] = f.owners[0].Username
+		body["serviceType"] = "Gardening"
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+
+		body["serviceType"] = "Delivery"
+		body["name"] = strings.Repeat("x", 101)
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+	})
+
+	t.Run("03/tenders/list", func(t *testing.T) {
+		f := newFixture(t)
+
+		b := f.createTender(f.owners[0], "B", api.TenderServiceTypeDelivery)
+		a := f.createTender(f.owners[0], "A", api.TenderServiceTypeConstruction)
+		f.createTender(f.owners[1], "C", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], a.Id)
+		f.publishTender(f.owners[0], b.Id)
+
+		var tenders []api.Tender
+		f.expect(f.do("GET", "/api/tenders", nil), http.StatusOK, &tenders)
+		if names := tenderNames(tenders); names != "A,B" {
+			t.Errorf("published tenders = %s, want A,B", names)
+		}
+
+		f.expect(f.do("GET", query("/api/tenders", "service_type", "Delivery"), nil), http.StatusOK, &tenders)
+		if names := tenderNames(tenders); names != "B" {
+			t.Errorf("delivery tenders = %s, want B", names)
+		}
+
+		f.expect(f.do("GET", query("/api/tenders", "limit", "1", "offset", "1"), nil), http.StatusOK, &tenders)
+		if names := tenderNames(tenders); names != "B" {
+			t.Errorf("second page = %s, want B", names)
+		}
+
+		f.expect(f.do("GET", query("/api/tenders/my", "username", f.owners[0].Username), nil), http.StatusOK, &tenders)
+		if names := tenderNames(tenders); names != "A,B" {
+			t.Errorf("owner1 tenders = %s, want A,B", names)
+		}
+
+		f.expect(f.do("GET", query("/api/tenders/my", "username", "ghost"), nil), http.StatusUnauthorized, nil)
+		f.expect(f.do("GET", query("/api/tenders", "limit", "51"), nil), http.StatusBadRequest, nil)
+	})
+
+	t.Run("04/tenders/status", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeManufacture)
+		path := "/api/tenders/" + tender.Id + "/status"
+
+		var status api.TenderStatus
+		f.expect(f.do("GET", query(path, "username", f.owners[1].Username), nil), http.StatusOK, &status)
+		if status != api.TenderStatusCreated {
+			t.Errorf("status = %s, want Created", status)
+		}
+
+		f.expect(f.do("GET", query(path, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+		f.expect(f.do("GET", query(path, "username", "ghost"), nil), http.StatusUnauthorized, nil)
+		f.expect(f.do("PUT", query(path, "status", "Published", "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+		f.expect(f.do("PUT", query(path, "status", "Open", "username", f.owners[0].Username), nil), http.StatusBadRequest, nil)
+
+		var updated api.Tender
+		f.expect(f.do("PUT", query(path, "status", "Published", "username", f.owners[0].Username), nil), http.StatusOK, &updated)
+		if updated.Status != api.TenderStatusPublished {
+			t.Errorf("updated status = %s, want Published", updated.Status)
+		}
+
+		f.expect(f.do("GET", query(path, "username", f.bidder.Username), nil), http.StatusOK, &status)
+		if status != api.TenderStatusPublished {
+			t.Errorf("status = %s, want Published", status)
+		}
+
+		f.expect(f.do("GET", query("/api/tenders/00000000-0000-0000-0000-000000000000/status",
+			"username", f.owners[0].Username), nil), http.StatusNotFound, nil)
+	})
+
+	t.Run("05/tenders/version", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер 1", api.TenderServiceTypeConstruction)
+		edit := query("/api/tenders/"+tender.Id+"/edit", "username", f.owners[1].Username)
+
+		var edited api.Tender
+		f.expect(f.do("PATCH", edit, map[string]any{
+			"name":        "Обновленный Тендер 1",
+			"serviceType": "Delivery",
+		}), http.StatusOK, &edited)
+		if edited.Version != 2 || edited.Name != "Обновленный Тендер 1" ||
+			edited.Description != tender.Description || edited.ServiceType != api.TenderServiceTypeDelivery {
+			t.Errorf("edited tender = %+v", edited)
+		}
+
+		f.expect(f.do("PATCH", edit, map[string]any{"status": "Closed"}), http.StatusBadRequest, nil)
+		f.expect(f.do("PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.bidder.Username),
+			map[string]any{"name": "Чужой"}), http.StatusForbidden, nil)
+
+		var rolledBack api.Tender
+		f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/rollback/1", "username", f.owners[0].Username), nil),
+			http.StatusOK, &rolledBack)
+		if rolledBack.Version != 3 || rolledBack.Name != tender.Name || rolledBack.ServiceType != tender.ServiceType {
+			t.Errorf("rolled back tender = %+v", rolledBack)
+		}
+
+		f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/rollback/9", "username", f.owners[0].Username), nil),
+			http.StatusNotFound, nil)
+		f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/rollback/0", "username", f.owners[0].Username), nil),
+			http.StatusBadRequest, nil)
+	})
+
+	t.Run("06/bids/new", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+
+		body := map[string]any{
+			"name":        "Предложение 1",
+			"description": "Описание предложения",
+			"tenderId":    tender.Id,
+			"authorType":  "User",
+			"authorId":    f.bidder.Id,
+		}
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusForbidden, nil)
+
+		f.publishTender(f.owners[0], tender.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Предложение 1")
+		if bid.Status != api.BidStatusCreated || bid.Version != 1 || bid.TenderId != tender.Id || bid.AuthorId != f.bidder.Id {
+			t.Errorf("created bid = %+v", bid)
+		}
+		f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "Предложение 2")
+
+		body["authorType"] = "Organization"
+		body["authorId"] = f.freelancer.Id
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusForbidden, nil)
+
+		body["authorId"] = f.owners[0].Id
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusForbidden, nil)
+
+		body["authorId"] = "00000000-0000-0000-0000-000000000000"
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusUnauthorized, nil)
+
+		body["authorId"] = f.bidder.Id
+		body["tenderId"] = "00000000-0000-0000-0000-000000000000"
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusNotFound, nil)
+
+		body["authorType"] = "Robot"
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusBadRequest, nil)
+	})
+
+	t.Run("07/bids/decision", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], tender.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Предложение")
+		decide := func(user *api.User, bidId, decision string) response {
+			return f.do("PUT", query("/api/bids/"+bidId+"/submit_decision",
+				"decision", decision, "username", user.Username), nil)
+		}
+
+		f.expect(decide(f.owners[0], bid.Id, "Approved"), http.StatusBadRequest, nil)
+		f.publishBid(f.bidder, bid.Id)
+
+		f.expect(decide(f.bidder, bid.Id, "Approved"), http.StatusForbidden, nil)
+		f.expect(decide(f.owners[0], bid.Id, "Maybe"), http.StatusBadRequest, nil)
+
+		f.expect(decide(f.owners[0], bid.Id, "Approved"), http.StatusOK, nil)
+		f.expect(decide(f.owners[0], bid.Id, "Approved"), http.StatusBadRequest, nil)
+		f.expect(decide(f.owners[1], bid.Id, "Approved"), http.StatusOK, nil)
+		if status := f.tenderStatus(tender.Id); status != api.TenderStatusPublished {
+			t.Fatalf("tender closed before quorum: %s", status)
+		}
+
+		var decided api.Bid
+		f.expect(decide(f.owners[2], bid.Id, "Approved"), http.StatusOK, &decided)
+		if decided.Id != bid.Id {
+			t.Errorf("decision returned bid %s, want %s", decided.Id, bid.Id)
+		}
+		if status := f.tenderStatus(tender.Id); status != api.TenderStatusClosed {
+			t.Errorf("tender status after quorum = %s, want Closed", status)
+		}
+
+		other := f.createTender(f.owners[0], "Другой", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], other.Id)
+		rejected := f.createBid(f.bidder, api.BidAuthorTypeUser, other.Id, "Отклоняемое")
+		f.publishBid(f.bidder, rejected.Id)
+		f.expect(decide(f.owners[0], rejected.Id, "Rejected"), http.StatusOK, nil)
+		f.expect(decide(f.owners[1], rejected.Id, "Approved"), http.StatusOK, nil)
+		f.expect(decide(f.owners[2], rejected.Id, "Approved"), http.StatusOK, nil)
+		if status := f.tenderStatus(other.Id); status != api.TenderStatusPublished {
+			t.Errorf("rejected bid closed the tender: %s", status)
+		}
+	})
+
+	t.Run("08/bids/list", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], tender.Id)
+		b := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "B")
+		a := f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "A")
+		f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "C")
+		f.publishBid(f.bidder, b.Id)
+		f.publishBid(f.freelancer, a.Id)
+
+		list := "/api/bids/" + tender.Id + "/list"
+		var bids []api.Bid
+		f.expect(f.do("GET", query(list, "username", f.owners[2].Username), nil), http.StatusOK, &bids)
+		if names := bidNames(bids); names != "A,B" {
+			t.Errorf("published bids = %s, want A,B", names)
+		}
+
+		f.expect(f.do("GET", query(list, "username", f.owners[2].Username, "limit", "1"), nil), http.StatusOK, &bids)
+		if names := bidNames(bids); names != "A" {
+			t.Errorf("first page = %s, want A", names)
+		}
+
+		f.expect(f.do("GET", query(list, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+		f.expect(f.do("GET", query(list, "username", "ghost"), nil), http.StatusUnauthorized, nil)
+		f.expect(f.do("GET", query("/api/bids/00000000-0000-0000-0000-000000000000/list",
+			"username", f.owners[0].Username), nil), http.StatusNotFound, nil)
+
+		f.expect(f.do("GET", query("/api/bids/my", "username", f.bidder.Username), nil), http.StatusOK, &bids)
+		if names := bidNames(bids); names != "B,C" {
+			t.Errorf("bidder's bids = %s, want B,C", names)
+		}
+		f.expect(f.do("GET", query("/api/bids/my", "username", "ghost"), nil), http.StatusUnauthorized, nil)
+	})
+
+	t.Run("09/bids/status", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], tender.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Предложение")
+		path := "/api/bids/" + bid.Id + "/status"
+
+		var status api.BidStatus
+		f.expect(f.do("GET", query(path, "username", f.bidder.Username), nil), http.StatusOK, &status)
+		if status != api.BidStatusCreated {
+			t.Errorf("status = %s, want Created", status)
+		}
+		f.expect(f.do("GET", query(path, "username", f.freelancer.Username), nil), http.StatusForbidden, nil)
+
+		var updated api.Bid
+		f.expect(f.do("PUT", query(path, "status", "Canceled", "username", f.bidder.Username), nil), http.StatusOK, &updated)
+		if updated.Status != api.BidStatusCanceled {
+			t.Errorf("updated status = %s, want Canceled", updated.Status)
+		}
+
+		f.expect(f.do("PUT", query(path, "status", "Approved", "username", f.bidder.Username), nil), http.StatusBadRequest, nil)
+		f.expect(f.do("PUT", query(path, "status", "Published", "username", f.owners[0].Username), nil), http.StatusForbidden, nil)
+		f.expect(f.do("GET", query("/api/bids/00000000-0000-0000-0000-000000000000/status",
+			"username", f.bidder.Username), nil), http.StatusNotFound, nil)
+	})
+
+	t.Run("10/bids/version", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], tender.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Предложение 1")
+		edit := query("/api/bids/"+bid.Id+"/edit", "username", f.bidder.Username)
+
+		var edited api.Bid
+		f.expect(f.do("PATCH", edit, map[string]any{"description": "Обновленное описание"}), http.StatusOK, &edited)
+		if edited.Version != 2 || edited.Name != bid.Name || edited.Description != "Обновленное описание" {
+			t.Errorf("edited bid = %+v", edited)
+		}
+
+		f.expect(f.do("PATCH", edit, map[string]any{"tenderId": tender.Id}), http.StatusBadRequest, nil)
+		f.expect(f.do("PATCH", query("/api/bids/"+bid.Id+"/edit", "username", f.freelancer.Username),
+			map[string]any{"name": "Чужое"}), http.StatusForbidden, nil)
+
+		var rolledBack api.Bid
+		f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/rollback/1", "username", f.bidder.Username), nil),
+			http.StatusOK, &rolledBack)
+		if rolledBack.Version != 3 || rolledBack.Description != bid.Description {
+			t.Errorf("rolled back bid = %+v", rolledBack)
+		}
+
+		f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/rollback/7", "username", f.bidder.Username), nil),
+			http.StatusNotFound, nil)
+	})
+
+	t.Run("11/bids/feedback", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], tender.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Предложение")
+		f.publishBid(f.bidder, bid.Id)
+		feedback := "/api/bids/" + bid.Id + "/feedback"
+
+		var reviewed api.Bid
+		f.expect(f.do("PUT", query(feedback, "bidFeedback", "All gooood!!!!", "username", f.owners[1].Username), nil),
+			http.StatusOK, &reviewed)
+		if reviewed.Id != bid.Id {
+			t.Errorf("feedback returned bid %s, want %s", reviewed.Id, bid.Id)
+		}
+
+		f.expect(f.do("PUT", query(feedback, "bidFeedback", "Мне нравится", "username", f.bidder.Username), nil),
+			http.StatusForbidden, nil)
+		f.expect(f.do("PUT", query(feedback, "bidFeedback", strings.Repeat("x", 1001), "username", f.owners[1].Username), nil),
+			http.StatusBadRequest, nil)
+
+		reviews := "/api/bids/" + tender.Id + "/reviews"
+		var got []api.BidReview
+		f.expect(f.do("GET", query(reviews, "authorUsername", f.bidder.Username, "requesterUsername", f.owners[2].Username), nil),
+			http.StatusOK, &got)
+		if len(got) != 1 || got[0].Description != "All gooood!!!!" {
+			t.Errorf("reviews = %+v", got)
+		}
+
+		f.expect(f.do("GET", query(reviews, "authorUsername", f.bidder.Username, "requesterUsername", f.freelancer.Username), nil),
+			http.StatusForbidden, nil)
+		f.expect(f.do("GET", query(reviews, "authorUsername", "ghost", "requesterUsername", f.owners[2].Username), nil),
+			http.StatusNotFound, nil)
+		f.expect(f.do("GET", query(reviews, "authorUsername", f.bidder.Username), nil), http.StatusBadRequest, nil)
+	})
+}
+
+func (f *fixture) tenderStatus(tenderId string) api.TenderStatus {
+	f.t.Helper()
+
+	var status api.TenderStatus
+	f.expect(f.do("GET", query("/api/tenders/"+tenderId+"/status", "username", f.owners[0].Username), nil),
+		http.StatusOK, &status)
+	return status
+}
+
+func tenderNames(tenders []api.Tender) string {
+	names := make([]string, 0, len(tenders))
+	for _, t := range tenders {
+		names = append(names, t.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func bidNames(bids []api.Bid) string {
+	names := make([]string, 0, len(bids))
+	for _, b := range bids {
+		names = append(names, b.Name)
+	}
+	return strings.Join(names, ",")
+}
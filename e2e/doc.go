@@ -0,0 +1,8 @@
+// Package e2e holds the contract test suite: it runs the scenarios of
+// задание/README.md against an APIServer backed by the in-process storage
+// and checks every response against задание/openapi.yml.
+//
+// The grading groups map onto subtests, e.g.
+//
+//	go test ./e2e -run 'TestContract/07'
+package e2e
@@ -0,0 +1,117 @@
+package e2e
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// eventStream reads the server-sent events of the event stream.
+type eventStream struct {
+	t *testing.T
+	r *bufio.Reader
+}
+
+func (f *fixture) streamEvents(lastEventId string, kv ...string) *eventStream {
+	f.t.Helper()
+
+	req, err := http.NewRequest("GET", f.srv.URL+query("/api/events/stream", kv...), nil)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	if lastEventId != "" {
+		req.Header.Set("Last-Event-ID", lastEventId)
+	}
+	resp, err := f.srv.Client().Do(req)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.t.Cleanup(func() { resp.Body.Close() })
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" {
+		f.t.Fatalf("stream status = %d, content type = %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return &eventStream{t: f.t, r: bufio.NewReader(resp.Body)}
+}
+
+// next returns the id of the next event and the event, skipping comments.
+func (s *eventStream) next() (string, api.StreamEvent) {
+	s.t.Helper()
+
+	var id string
+	var event api.StreamEvent
+	for {
+		line, err := s.r.ReadString('\n')
+		if err != nil {
+			s.t.Fatalf("read event: %v", err)
+		}
+		line = strings.TrimRight(line, "\n")
+		switch {
+		case line == "" && id != "":
+			return id, event
+		case strings.HasPrefix(line, "id: "):
+			id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &event); err != nil {
+				s.t.Fatalf("decode %s: %v", line, err)
+			}
+		}
+	}
+}
+
+// expect reads the next events and fails unless they are the given ones, as
+// "id type" pairs.
+func (s *eventStream) expect(want ...string) {
+	s.t.Helper()
+
+	for _, w := range want {
+		id, event := s.next()
+		if got := id + " " + string(event.Type); got != w {
+			s.t.Fatalf("event = %s, want %s", got, w)
+		}
+	}
+}
+
+func TestEventStream(t *testing.T) {
+	f := newFixture(t)
+	relay := api.NewOutboxRelay(f.store, nil, time.Second, api.NewEventLogSink(f.store))
+
+	f.expect(f.do("GET", query("/api/events/stream", "username", "nobody"), nil), http.StatusUnauthorized, nil)
+
+	owner := f.streamEvents("", "username", f.owners[0].Username)
+	bidder := f.streamEvents("", "username", f.bidder.Username, "organizationId", f.org)
+	anonymous := f.streamEvents("", "type", "tender.published", "type", "tender.updated")
+
+	tender := f.createTender(f.owners[0], "Со стримом", "Delivery")
+	// An unpublished tender cannot be followed by those who cannot see it.
+	f.expect(f.do("GET", query("/api/events/stream", "username", f.freelancer.Username, "tenderId", tender.Id), nil),
+		http.StatusForbidden, nil)
+	f.publishTender(f.owners[0], tender.Id)
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Быстрая доставка")
+	f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "Черновик")
+	f.publishBid(f.bidder, bid.Id)
+	f.expect(f.do("PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.owners[0].Username),
+		map[string]any{"name": "Со стримом и правками"}), http.StatusOK, nil)
+
+	// Events are streamed once the outbox is relayed into the event log.
+	if err := relay.Tick(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The freelancer's draft, event 4, is seen by its author only.
+	owner.expect("1 tender.created", "2 tender.published", "3 bid.created", "5 bid.published", "6 tender.updated")
+	bidder.expect("1 tender.created", "2 tender.published", "3 bid.created", "5 bid.published", "6 tender.updated")
+	anonymous.expect("2 tender.published", "6 tender.updated")
+
+	// A client resuming after an event gets the later ones it may see.
+	f.streamEvents("3", "username", f.freelancer.Username, "tenderId", tender.Id).
+		expect("4 bid.created", "6 tender.updated")
+	_, event := f.streamEvents("2", "username", f.owners[1].Username, "type", "bid.created").next()
+	if event.AggregateType != api.EventAggregateTypeBid || event.AggregateId != bid.Id {
+		t.Errorf("resumed event = %+v", event)
+	}
+}
@@ -0,0 +1,121 @@
+package e2e
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// exportCSV reads a CSV export into its header and rows.
+func exportCSV(t *testing.T, resp response) ([]string, [][]string) {
+	t.Helper()
+	if resp.status != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", resp.status, resp.body)
+	}
+	body, ok := bytes.CutPrefix(resp.body, []byte("\ufeff"))
+	if !ok {
+		t.Errorf("export has no byte order mark")
+	}
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	return records[0], records[1:]
+}
+
+// column returns the values of the named column.
+func column(header []string, rows [][]string, name string) []string {
+	i := -1
+	for j, h := range header {
+		if h == name {
+			i = j
+		}
+	}
+	var values []string
+	for _, row := range rows {
+		values = append(values, row[i])
+	}
+	return values
+}
+
+func TestExport(t *testing.T) {
+	f := newFixture(t)
+	roads := f.createTender(f.owners[0], "Ремонт дорог", "Construction")
+	f.publishTender(f.owners[0], roads.Id)
+	formula := f.createTender(f.owners[0], "=HYPERLINK(\"http://evil\")", "Delivery")
+	f.publishTender(f.owners[0], formula.Id)
+	f.createTender(f.owners[0], "Черновик", "Delivery")
+
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, roads.Id, "Быстрый ремонт")
+	f.publishBid(f.bidder, bid.Id)
+	other := f.createBid(f.freelancer, api.BidAuthorTypeUser, roads.Id, "Дешевый ремонт")
+	f.publishBid(f.freelancer, other.Id)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback", "bidFeedback", "Уточните сроки",
+		"username", f.owners[0].Username), nil), http.StatusOK, nil)
+	for _, owner := range f.owners[:2] {
+		f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision", "decision", "Approved",
+			"username", owner.Username), nil), http.StatusOK, nil)
+	}
+	f.expect(f.do("PUT", query("/api/bids/"+other.Id+"/submit_decision", "decision", "Rejected",
+		"username", f.owners[0].Username), nil), http.StatusOK, nil)
+
+	header, rows := exportCSV(t, f.do("GET", "/api/tenders/export", nil))
+	if got := strings.Join(column(header, rows, "name"), "|"); got != "'=HYPERLINK(\"http://evil\")|Ремонт дорог" {
+		t.Errorf("exported tenders = %s", got)
+	}
+	if got := strings.Join(rows[1][len(rows[1])-3:], ","); got != "2,1,1" {
+		t.Errorf("tender activity = %s", got)
+	}
+	if got := column(header, rows, "version"); got[1] != "1" {
+		t.Errorf("versions = %v", got)
+	}
+
+	_, rows = exportCSV(t, f.do("GET", query("/api/tenders/export", "service_type", "Construction", "format", "csv"), nil))
+	if len(rows) != 1 || rows[0][0] != roads.Id {
+		t.Errorf("filtered tenders = %v", rows)
+	}
+	f.expect(f.do("GET", query("/api/tenders/export", "format", "pdf"), nil), http.StatusBadRequest, nil)
+	f.expect(f.do("GET", query("/api/tenders/export", "username", "nobody"), nil), http.StatusUnauthorized, nil)
+
+	bids := "/api/bids/" + roads.Id + "/export"
+	header, rows = exportCSV(t, f.do("GET", query(bids, "username", f.owners[2].Username), nil))
+	if got := strings.Join(column(header, rows, "name"), "|"); got != "Быстрый ремонт|Дешевый ремонт" {
+		t.Errorf("exported bids = %s", got)
+	}
+	for i, want := range []string{"2,0,1", "0,1,0"} {
+		c := column(header, rows, "approvals")[i] + "," + column(header, rows, "rejections")[i] + "," +
+			column(header, rows, "reviews")[i]
+		if c != want {
+			t.Errorf("activity of bid %d = %s, want %s", i, c, want)
+		}
+	}
+	f.expect(f.do("GET", query(bids, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+	f.expect(f.do("GET", query("/api/bids/00000000-0000-0000-0000-000000000000/export", "username", f.owners[0].Username), nil),
+		http.StatusNotFound, nil)
+
+	resp := f.do("GET", query(bids, "username", f.owners[0].Username, "format", "xlsx", "sortBy", "name"), nil)
+	if resp.status != http.StatusOK {
+		t.Fatalf("xlsx status = %d; body: %s", resp.status, resp.body)
+	}
+	book, err := zip.NewReader(bytes.NewReader(resp.body), int64(len(resp.body)))
+	if err != nil {
+		t.Fatalf("open xlsx: %v", err)
+	}
+	sheet, err := book.Open("xl/worksheets/sheet1.xml")
+	if err != nil {
+		t.Fatalf("open sheet: %v", err)
+	}
+	data, _ := io.ReadAll(sheet)
+	if rows := strings.Count(string(data), "<row>"); rows != 3 {
+		t.Errorf("sheet has %d rows", rows)
+	}
+	if !strings.Contains(string(data), "<t xml:space=\"preserve\">Быстрый ремонт</t>") ||
+		!strings.Contains(string(data), "<c><v>2</v></c>") {
+		t.Errorf("sheet = %s", data)
+	}
+}
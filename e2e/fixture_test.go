@@ -0,0 +1,302 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"io"
+	"my_zad/api"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+)
+
+const specPath = "../задание/openapi.yml"
+
+var specRouter = loadSpec()
+
+func loadSpec() routers.Router {
+	doc, err := openapi3.NewLoader().LoadFromFile(specPath)
+	if err != nil {
+		panic(fmt.Sprintf("load %s: %v", specPath, err))
+	}
+	doc.Servers = openapi3.Servers{{URL: "/api"}}
+
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		panic(fmt.Sprintf("build router for %s: %v", specPath, err))
+	}
+	return router
+}
+
+// fixture is a running server seeded with two organizations: org has three
+// responsibles (so the decision quorum is 3), rival has one bidder. freelancer
+// belongs to no organization.
+type fixture struct {
+	t      *testing.T
+	srv    *httptest.Server
+	store  api.Storage
+	blobs  api.BlobStore
+	org    string
+	rival  string
+	owners []*api.User
+	bidder *api.User
+
+	freelancer *api.User
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+
+	sealer, err := api.NewSealer(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32)))
+	if err != nil {
+		t.Fatalf("sealer: %v", err)
+	}
+	store := newStore(t, sealer)
+	blobs, err := api.NewLocalBlobStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("blob store: %v", err)
+	}
+	handler, err := api.NewAPIServer("", store, blobs, sealer).Handler()
+	if err != nil {
+		t.Fatalf("build handler: %v", err)
+	}
+
+	f := &fixture{t: t, srv: httptest.NewServer(handler), store: store, blobs: blobs}
+	t.Cleanup(f.srv.Close)
+
+	for _, name := range []string{"owner1", "owner2", "owner3"} {
+		f.owners = append(f.owners, f.addUser(name, "Owner", name))
+	}
+	f.org = f.addOrganization("Avito", f.owners...)
+	f.bidder = f.addUser("bidder", "Bid", "Der")
+	f.rival = f.addOrganization("Rival", f.bidder)
+	f.freelancer = f.addUser("freelancer", "Free", "Lancer")
+	return f
+}
+
+// newStore returns the storage a fixture runs against: a schema of its own
+// in the Postgres database at POSTGRES_CONN when that is set, memory
+// otherwise. So POSTGRES_CONN=postgres://... go test ./e2e runs every suite
+// against PostgresStorage.
+func newStore(t *testing.T, sealer *api.Sealer) api.Storage {
+	t.Helper()
+
+	connStr := os.Getenv("POSTGRES_CONN")
+	if connStr == "" {
+		return api.NewMemoryStorage()
+	}
+	if strings.HasPrefix(connStr, "postgres://") || strings.HasPrefix(connStr, "postgresql://") {
+		var err error
+		if connStr, err = pq.ParseURL(connStr); err != nil {
+			t.Fatalf("POSTGRES_CONN: %v", err)
+		}
+	}
+
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	schema := "e2e_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := db.Exec(`CREATE SCHEMA ` + schema); err != nil {
+		db.Close()
+		t.Fatalf("create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := db.Exec(`DROP SCHEMA ` + schema + ` CASCADE`); err != nil {
+			t.Errorf("drop schema %s: %v", schema, err)
+		}
+		db.Close()
+	})
+
+	// Extensions already installed stay visible through public.
+	store, err := api.OpenPostgresStorage(connStr+" search_path="+schema+",public", sealer)
+	if err != nil {
+		t.Fatalf("connect to database: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	if err := store.Init(); err != nil {
+		t.Fatalf("create tables: %v", err)
+	}
+	return store
+}
+
+func (f *fixture) addUser(username, firstName, lastName string) *api.User {
+	f.t.Helper()
+
+	u, err := f.store.CreateUser(&api.User{Username: username, FirstName: firstName, LastName: lastName})
+	if err != nil {
+		f.t.Fatalf("add user %s: %v", username, err)
+	}
+	return u
+}
+
+func (f *fixture) addOrganization(name string, responsibles ...*api.User) string {
+	f.t.Helper()
+
+	id, err := f.store.CreateOrganization(name)
+	if err != nil {
+		f.t.Fatalf("add organization %s: %v", name, err)
+	}
+	for _, u := range responsibles {
+		if err := f.store.AddOrganizationResponsible(id, u.Id); err != nil {
+			f.t.Fatalf("add responsible %s: %v", u.Username, err)
+		}
+	}
+	return id
+}
+
+type response struct {
+	status int
+	body   []byte
+}
+
+// do sends a request and fails the test if the response doesn't match the
+// schema the spec declares for its status code. 400 is accepted on every
+// operation, as the task requires it for any malformed request.
+func (f *fixture) do(method, path string, body any) response {
+	f.t.Helper()
+
+	if body == nil {
+		return f.send(method, path, "", nil)
+	}
+	raw, err := json.Marshal(body)
+	if err != nil {
+		f.t.Fatalf("marshal body: %v", err)
+	}
+	return f.send(method, path, "application/json", raw)
+}
+
+// send is do for a body already encoded as contentType.
+func (f *fixture) send(method, path, contentType string, raw []byte) response {
+	f.t.Helper()
+
+	var reader io.Reader
+	if raw != nil {
+		reader = bytes.NewReader(raw)
+	}
+
+	req, err := http.NewRequest(method, f.srv.URL+path, reader)
+	if err != nil {
+		f.t.Fatalf("new request: %v", err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := f.srv.Client().Do(req)
+	if err != nil {
+		f.t.Fatalf("%s %s: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		f.t.Fatalf("read body: %v", err)
+	}
+
+	f.validate(method, path, raw, resp, data)
+	return response{status: resp.StatusCode, body: data}
+}
+
+func (f *fixture) validate(method, path string, reqBody []byte, resp *http.Response, body []byte) {
+	f.t.Helper()
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(reqBody))
+	route, pathParams, err := specRouter.FindRoute(req)
+	if err != nil {
+		f.t.Fatalf("%s %s is not in the spec: %v", method, path, err)
+	}
+
+	input := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: pathParams,
+			Route:      route,
+		},
+		Status: resp.StatusCode,
+		Header: resp.Header,
+		Body:   io.NopCloser(bytes.NewReader(body)),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: resp.StatusCode != http.StatusBadRequest,
+		},
+	}
+	if err := openapi3filter.ValidateResponse(context.Background(), input); err != nil {
+		f.t.Errorf("%s %s -> %d %s violates the spec: %v", method, path, resp.StatusCode, body, err)
+	}
+}
+
+func (f *fixture) expect(resp response, status int, out any) {
+	f.t.Helper()
+
+	if resp.status != status {
+		f.t.Fatalf("status = %d, want %d; body: %s", resp.status, status, resp.body)
+	}
+	if out != nil {
+		if err := json.Unmarshal(resp.body, out); err != nil {
+			f.t.Fatalf("decode %s: %v", resp.body, err)
+		}
+	}
+}
+
+func query(path string, kv ...string) string {
+	q := url.Values{}
+	for i := 0; i+1 < len(kv); i += 2 {
+		q.Add(kv[i], kv[i+1])
+	}
+	return path + "?" + q.Encode()
+}
+
+func (f *fixture) createTender(owner *api.User, name string, serviceType api.TenderServiceType) api.Tender {
+	f.t.Helper()
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            name,
+		"description":     "Описание " + name,
+		"serviceType":     serviceType,
+		"organizationId":  f.org,
+		"creatorUsername": owner.Username,
+	}), http.StatusOK, &tender)
+	return tender
+}
+
+func (f *fixture) publishTender(owner *api.User, tenderId string) {
+	f.t.Helper()
+
+	f.expect(f.do("PUT", query("/api/tenders/"+tenderId+"/status",
+		"status", "Published", "username", owner.Username), nil), http.StatusOK, nil)
+}
+
+func (f *fixture) createBid(author *api.User, authorType api.BidAuthorType, tenderId, name string) api.Bid {
+	f.t.Helper()
+
+	var bid api.Bid
+	f.expect(f.do("POST", "/api/bids/new", map[string]any{
+		"name":        name,
+		"description": "Описание " + name,
+		"tenderId":    tenderId,
+		"authorType":  authorType,
+		"authorId":    author.Id,
+	}), http.StatusOK, &bid)
+	return bid
+}
+
+func (f *fixture) publishBid(author *api.User, bidId string) {
+	f.t.Helper()
+
+	f.expect(f.do("PUT", query("/api/bids/"+bidId+"/status",
+		"status", "Published", "username", author.Username), nil), http.StatusOK, nil)
+}
@@ -0,0 +1,306 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"my_zad/api"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type graphqlError struct {
+	Message    string `json:"message"`
+	Path       []any  `json:"path"`
+	Extensions struct {
+		Status int `json:"status"`
+	} `json:"extensions"`
+}
+
+// graphql runs a query, decodes what it selected into data and returns the
+// errors it reported.
+func (f *fixture) graphql(q string, variables map[string]any, data any) []graphqlError {
+	f.t.Helper()
+
+	body := map[string]any{"query": q}
+	if variables != nil {
+		body["variables"] = variables
+	}
+	var resp struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []graphqlError  `json:"errors"`
+	}
+	f.expect(f.do("POST", "/api/graphql", body), http.StatusOK, &resp)
+	if data != nil && len(resp.Data) > 0 {
+		if err := json.Unmarshal(resp.Data, data); err != nil {
+			f.t.Fatalf("decode %s: %v", resp.Data, err)
+		}
+	}
+	return resp.Errors
+}
+
+// expectGraphQLError checks that the query failed at path alone, with the
+// status REST answers with.
+func expectGraphQLError(t *testing.T, errs []graphqlError, path string, status int) {
+	t.Helper()
+
+	if len(errs) != 1 || fmt.Sprint(errs[0].Path...) != path || errs[0].Extensions.Status != status {
+		t.Errorf("errors = %+v, want one at %s with status %d", errs, path, status)
+	}
+}
+
+type graphqlEmployee struct {
+	Username     string `json:"username"`
+	Organization *struct {
+		Name string `json:"name"`
+	} `json:"organization"`
+}
+
+type graphqlBid struct {
+	Name    string          `json:"name"`
+	Author  graphqlEmployee `json:"author"`
+	Reviews []struct {
+		Description string          `json:"description"`
+		Author      graphqlEmployee `json:"author"`
+	} `json:"reviews"`
+	Decisions []struct {
+		Decision string          `json:"decision"`
+		Employee graphqlEmployee `json:"employee"`
+	} `json:"decisions"`
+	Tender *struct {
+		Id string `json:"id"`
+	} `json:"tender"`
+}
+
+const tenderGraphQL = `query($id: ID!, $username: String) {
+  tender(id: $id, username: $username) {
+    name
+    organization { name }
+    versions { version name }
+    bids {
+      name
+      author { username organization { name } }
+      reviews { description author { username } }
+      decisions { decision employee { username } }
+      tender { id }
+    }
+  }
+}`
+
+func TestGraphQL(t *testing.T) {
+	f := newFixture(t)
+
+	tender := f.createTender(f.owners[0], "Тендер", api.TenderServiceTypeDelivery)
+	f.publishTender(f.owners[0], tender.Id)
+	f.expect(f.do("PATCH", query("/api/tenders/"+tender.Id+"/edit", "username", f.owners[0].Username),
+		map[string]any{"name": "Тендер 2"}), http.StatusOK, nil)
+
+	first := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Первое")
+	second := f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "Второе")
+	for _, b := range []struct {
+		author *api.User
+		id     string
+	}{{f.bidder, first.Id}, {f.freelancer, second.Id}} {
+		f.publishBid(b.author, b.id)
+	}
+	f.expect(f.do("PUT", query("/api/bids/"+first.Id+"/feedback",
+		"bidFeedback", "Уточните сроки", "username", f.owners[1].Username), nil), http.StatusOK, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+first.Id+"/submit_decision",
+		"decision", "Approved", "username", f.owners[2].Username), nil), http.StatusOK, nil)
+
+	var got struct {
+		Tender *struct {
+			Name         string `json:"name"`
+			Organization struct {
+				Name string `json:"name"`
+			} `json:"organization"`
+			Versions []struct {
+				Version int    `json:"version"`
+				Name    string `json:"name"`
+			} `json:"versions"`
+			Bids []graphqlBid `json:"bids"`
+		} `json:"tender"`
+	}
+	if errs := f.graphql(tenderGraphQL, map[string]any{"id": tender.Id, "username": f.owners[0].Username}, &got); errs != nil {
+		t.Fatalf("errors = %+v", errs)
+	}
+	if got.Tender.Name != "Тендер 2" || got.Tender.Organization.Name != "Avito" {
+		t.Errorf("tender = %+v", got.Tender)
+	}
+	if len(got.Tender.Versions) != 2 || got.Tender.Versions[0].Version != 2 || got.Tender.Versions[1].Name != "Тендер" {
+		t.Errorf("versions = %+v", got.Tender.Versions)
+	}
+	if len(got.Tender.Bids) != 2 {
+		t.Fatalf("bids = %+v", got.Tender.Bids)
+	}
+	for _, b := range got.Tender.Bids {
+		if b.Tender == nil || b.Tender.Id != tender.Id {
+			t.Errorf("bid %s tender = %+v", b.Name, b.Tender)
+		}
+		switch b.Name {
+		case "Первое":
+			if b.Author.Username != "bidder" || b.Author.Organization == nil || b.Author.Organization.Name != "Rival" {
+				t.Errorf("author = %+v", b.Author)
+			}
+			if len(b.Reviews) != 1 || b.Reviews[0].Description != "Уточните сроки" || b.Reviews[0].Author.Username != "owner2" {
+				t.Errorf("reviews = %+v", b.Reviews)
+			}
+			if len(b.Decisions) != 1 || b.Decisions[0].Decision != "Approved" || b.Decisions[0].Employee.Username != "owner3" {
+				t.Errorf("decisions = %+v", b.Decisions)
+			}
+		case "Второе":
+			if b.Author.Username != "freelancer" || b.Author.Organization != nil || len(b.Reviews) != 0 || len(b.Decisions) != 0 {
+				t.Errorf("bid = %+v", b)
+			}
+		}
+	}
+
+	t.Run("visibility", func(t *testing.T) {
+		// Nested fields fail as the REST operation reading the same data.
+		list := "/api/bids/" + tender.Id + "/list"
+		f.expect(f.do("GET", query(list, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+		var partial struct {
+			Tender *struct {
+				Name string        `json:"name"`
+				Bids *[]graphqlBid `json:"bids"`
+			} `json:"tender"`
+		}
+		errs := f.graphql(`query($id: ID!) { tender(id: $id, username: "bidder") { name bids { name } } }`,
+			map[string]any{"id": tender.Id}, &partial)
+		expectGraphQLError(t, errs, "tenderbids", http.StatusForbidden)
+		if partial.Tender == nil || partial.Tender.Name != "Тендер 2" || partial.Tender.Bids != nil {
+			t.Errorf("tender = %+v", partial.Tender)
+		}
+
+		hidden := f.createTender(f.owners[0], "Черновик", api.TenderServiceTypeDelivery)
+		f.expect(f.do("GET", "/api/tenders/"+hidden.Id+"/status", nil), http.StatusUnauthorized, nil)
+		errs = f.graphql(`query($id: ID!) { tender(id: $id) { name } }`, map[string]any{"id": hidden.Id}, nil)
+		expectGraphQLError(t, errs, "tender", http.StatusUnauthorized)
+
+		f.expect(f.do("GET", query("/api/bids/"+first.Id+"/status", "username", f.freelancer.Username), nil),
+			http.StatusForbidden, nil)
+		errs = f.graphql(`query($id: ID!) { bid(id: $id, username: "freelancer") { name } }`,
+			map[string]any{"id": first.Id}, nil)
+		expectGraphQLError(t, errs, "bid", http.StatusForbidden)
+
+		errs = f.graphql(`{ tender(id: "missing", username: "ghost") { name } }`, nil, nil)
+		expectGraphQLError(t, errs, "tender", http.StatusUnauthorized)
+
+		// The author sees the bid but not what responsibles did with it.
+		var own struct {
+			MyBids []graphqlBid `json:"myBids"`
+		}
+		errs = f.graphql(`{ myBids(username: "freelancer") { name tender { id } reviews { description } } }`, nil, &own)
+		expectGraphQLError(t, errs, "myBids0reviews", http.StatusForbidden)
+		if len(own.MyBids) != 1 || own.MyBids[0].Tender == nil || own.MyBids[0].Tender.Id != tender.Id {
+			t.Errorf("myBids = %+v", own.MyBids)
+		}
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		errs := f.graphql(`{ tenders(limit: 50) { bids(limit: 50) { name } } }`, nil, nil)
+		if len(errs) != 1 || errs[0].Extensions.Status != http.StatusBadRequest {
+			t.Errorf("errors = %+v, want the query rejected as too complex", errs)
+		}
+
+		// 1 + 10 * (1 + 5 * 1) is within the limit.
+		errs = f.graphql(`{ tenders { bids(limit: 5) { name } } }`, nil, nil)
+		for _, e := range errs {
+			if e.Extensions.Status == http.StatusBadRequest {
+				t.Errorf("errors = %+v", errs)
+			}
+		}
+
+		errs = f.graphql(`{ tenders(limit: 51) { name } }`, nil, nil)
+		expectGraphQLError(t, errs, "tenders", http.StatusBadRequest)
+
+		f.expect(f.do("POST", "/api/graphql", map[string]any{"query": ""}), http.StatusBadRequest, nil)
+	})
+}
+
+// countingStore counts the calls of the batch reads nested fields go
+// through.
+type countingStore struct {
+	api.Storage
+
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (s *countingStore) count(method string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+}
+
+func (s *countingStore) GetBidsByTenderIds(ids []string) (map[string][]*api.Bid, error) {
+	s.count("GetBidsByTenderIds")
+	return s.Storage.GetBidsByTenderIds(ids)
+}
+
+func (s *countingStore) GetReviewsByBidIds(ids []string) (map[string][]api.ReviewRecord, error) {
+	s.count("GetReviewsByBidIds")
+	return s.Storage.GetReviewsByBidIds(ids)
+}
+
+func (s *countingStore) GetUsersByUsernames(usernames []string) (map[string]*api.User, error) {
+	s.count("GetUsersByUsernames")
+	return s.Storage.GetUsersByUsernames(usernames)
+}
+
+func (s *countingStore) GetUsersByIds(ids []string) (map[string]*api.User, error) {
+	s.count("GetUsersByIds")
+	return s.Storage.GetUsersByIds(ids)
+}
+
+func TestGraphQLBatching(t *testing.T) {
+	f := newFixture(t)
+	for i := range 3 {
+		tender := f.createTender(f.owners[0], fmt.Sprint("Тендер ", i), api.TenderServiceTypeDelivery)
+		f.publishTender(f.owners[0], tender.Id)
+		for _, author := range []*api.User{f.bidder, f.freelancer} {
+			bid := f.createBid(author, api.BidAuthorTypeUser, tender.Id, "Предложение "+author.Username)
+			f.publishBid(author, bid.Id)
+			f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback",
+				"bidFeedback", "Отзыв", "username", f.owners[i].Username), nil), http.StatusOK, nil)
+		}
+	}
+
+	// Serve the same data through a store counting the reads.
+	store := &countingStore{Storage: f.store, calls: map[string]int{}}
+	handler, err := api.NewAPIServer("", store, nil, nil).Handler()
+	if err != nil {
+		t.Fatalf("build handler: %v", err)
+	}
+	f.srv = httptest.NewServer(handler)
+	defer f.srv.Close()
+
+	var got struct {
+		Tenders []struct {
+			Bids []graphqlBid `json:"bids"`
+		} `json:"tenders"`
+	}
+	errs := f.graphql(`{ tenders(username: "owner1", limit: 3) {
+	  bids(limit: 2) { name author { username } reviews { author { username } } }
+	} }`, nil, &got)
+	if errs != nil {
+		t.Fatalf("errors = %+v", errs)
+	}
+
+	reviews := 0
+	for _, tender := range got.Tenders {
+		for _, b := range tender.Bids {
+			reviews += len(b.Reviews)
+		}
+	}
+	if len(got.Tenders) != 3 || reviews != 6 {
+		t.Fatalf("tenders = %+v", got.Tenders)
+	}
+
+	want := map[string]int{"GetBidsByTenderIds": 1, "GetReviewsByBidIds": 1, "GetUsersByIds": 1, "GetUsersByUsernames": 1}
+	if !reflect.DeepEqual(store.calls, want) {
+		t.Errorf("calls = %v, want %v", store.calls, want)
+	}
+}
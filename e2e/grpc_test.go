@@ -0,0 +1,468 @@
+package e2e
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"my_zad/api"
+	"my_zad/api/tenderpb"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// grpcAPI calls the gRPC API of a server sharing the storage of a fixture.
+type grpcAPI struct {
+	client tenderpb.TenderServiceClient
+}
+
+func newGRPCAPI(f *fixture) *grpcAPI {
+	f.t.Helper()
+
+	srv, err := api.NewAPIServer("", f.store, nil, nil).GRPCServer()
+	if err != nil {
+		f.t.Fatalf("build grpc server: %v", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	go srv.Serve(lis)
+	f.t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	f.t.Cleanup(func() { conn.Close() })
+	return &grpcAPI{client: tenderpb.NewTenderServiceClient(conn)}
+}
+
+// httpStatuses are the HTTP statuses of the codes the gRPC API returns.
+var httpStatuses = map[codes.Code]int{
+	codes.InvalidArgument:  http.StatusBadRequest,
+	codes.Unauthenticated:  http.StatusUnauthorized,
+	codes.PermissionDenied: http.StatusForbidden,
+	codes.NotFound:         http.StatusNotFound,
+	codes.Unimplemented:    http.StatusMethodNotAllowed,
+	codes.Unavailable:      http.StatusServiceUnavailable,
+	codes.Internal:         http.StatusInternalServerError,
+}
+
+// restError is the error the REST API would have returned for the status
+// err.
+func restError(err error) error {
+	s, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+	code, known := httpStatuses[s.Code()]
+	if !known {
+		return err
+	}
+	return &api.HTTPError{Status: code, Reason: s.Message()}
+}
+
+// grpcResult converts the result of a call with convert, or its status
+// into the error the REST API would have returned.
+func grpcResult[P, T any](convert func(P) T) func(P, error) (T, error) {
+	return func(p P, err error) (T, error) {
+		if err != nil {
+			var zero T
+			return zero, restError(err)
+		}
+		return convert(p), nil
+	}
+}
+
+// pbEnum is the proto enum value named like v: TENDER_STATUS_PUBLISHED for
+// Published. A value matching none is unspecified.
+func pbEnum[P ~int32, E ~string](names map[string]int32, v E) P {
+	want := strings.ToUpper(strings.NewReplacer("_", "", " ", "").Replace(string(v)))
+	best := ""
+	for name := range names {
+		if strings.HasSuffix(strings.ReplaceAll(name, "_", ""), want) && len(name) > len(best) {
+			best = name
+		}
+	}
+	return P(names[best])
+}
+
+// restEnum is the value of values the proto enum value p is named like.
+func restEnum[E ~string, P interface{ String() string }](p P, values ...E) E {
+	name := strings.ReplaceAll(p.String(), "_", "")
+	for _, v := range values {
+		if strings.HasSuffix(name, strings.ToUpper(strings.ReplaceAll(string(v), "_", ""))) {
+			return v
+		}
+	}
+	return E(p.String())
+}
+
+func restTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime()
+	return &v
+}
+
+func restCreatedAt(t *timestamppb.Timestamp) string {
+	return t.AsTime().Format(time.RFC3339)
+}
+
+func restBudget(b *tenderpb.Budget) *api.TenderBudget {
+	if b == nil {
+		return nil
+	}
+	return &api.TenderBudget{Currency: b.Currency, Min: b.Min, Max: b.Max}
+}
+
+func pbBudget(b *api.TenderBudget) *tenderpb.Budget {
+	if b == nil {
+		return nil
+	}
+	return &tenderpb.Budget{Currency: b.Currency, Min: b.Min, Max: b.Max}
+}
+
+func restTender(t *tenderpb.Tender) *api.Tender {
+	tender := &api.Tender{
+		Id:          t.Id,
+		Name:        t.Name,
+		Description: t.Description,
+		ServiceType: restEnum(t.ServiceType, api.TenderServiceTypeConstruction,
+			api.TenderServiceTypeDelivery, api.TenderServiceTypeManufacture),
+		Status:             restEnum(t.Status, api.TenderStatusCreated, api.TenderStatusPublished, api.TenderStatusClosed),
+		OrganizationId:     t.OrganizationId,
+		Version:            t.Version,
+		CreatedAt:          restCreatedAt(t.CreatedAt),
+		Budget:             restBudget(t.Budget),
+		PublishAt:          restTime(t.PublishAt),
+		SubmissionDeadline: restTime(t.SubmissionDeadline),
+		RevealedAt:         restTime(t.RevealedAt),
+		Sealed:             t.Sealed,
+		Visibility:         restEnum(t.Visibility, api.TenderVisibilityPublic, api.TenderVisibilityPrivate),
+	}
+	if t.Auction != nil {
+		tender.Auction = &api.TenderAuction{
+			StartAt:          t.Auction.StartAt.AsTime(),
+			EndAt:            t.Auction.EndAt.AsTime(),
+			MinDecrement:     t.Auction.MinDecrement,
+			ExtensionSeconds: t.Auction.ExtensionSeconds,
+		}
+	}
+	if len(t.Criteria) > 0 {
+		criteria := api.TenderCriteria{}
+		for _, c := range t.Criteria {
+			criteria = append(criteria, api.EvaluationCriterion{
+				Kind: restEnum(c.Kind, api.EvaluationCriterionKindPrice, api.EvaluationCriterionKindDeliveryTime,
+					api.EvaluationCriterionKindWarranty, api.EvaluationCriterionKindCustom),
+				Name:   c.Name,
+				Weight: c.Weight,
+			})
+		}
+		tender.Criteria = &criteria
+	}
+	if len(t.Lots) > 0 {
+		lots := []api.TenderLot{}
+		for _, lot := range t.Lots {
+			lots = append(lots, api.TenderLot{
+				Id:          lot.Id,
+				Name:        lot.Name,
+				Description: lot.Description,
+				Budget:      restBudget(lot.Budget),
+				Status: restEnum(lot.Status, api.TenderLotStatusOpen,
+					api.TenderLotStatusAwarded, api.TenderLotStatusCanceled),
+				AwardedBidId: lot.AwardedBidId,
+			})
+		}
+		tender.Lots = &lots
+	}
+	return tender
+}
+
+func restTenders(resp *tenderpb.ListTendersResponse) []api.Tender {
+	tenders := []api.Tender{}
+	for _, t := range resp.Tenders {
+		tenders = append(tenders, *restTender(t))
+	}
+	return tenders
+}
+
+func restBid(b *tenderpb.Bid) *api.Bid {
+	bid := &api.Bid{
+		Id:           b.Id,
+		Name:         b.Name,
+		Description:  b.Description,
+		Status:       restEnum(b.Status, api.BidStatusCreated, api.BidStatusPublished, api.BidStatusCanceled),
+		TenderId:     b.TenderId,
+		AuthorType:   restEnum(b.AuthorType, api.BidAuthorTypeOrganization, api.BidAuthorTypeUser),
+		AuthorId:     b.AuthorId,
+		Version:      b.Version,
+		CreatedAt:    restCreatedAt(b.CreatedAt),
+		Price:        b.Price,
+		Currency:     b.Currency,
+		DeliveryDays: b.DeliveryDays,
+	}
+	if len(b.LotIds) > 0 {
+		bid.LotIds = &b.LotIds
+	}
+	if r := b.AuthorReputation; r != nil {
+		bid.AuthorReputation = &api.Reputation{
+			AverageRating: r.AverageRating, Ratings: r.Ratings, Bids: r.Bids, Won: r.Won, Lost: r.Lost,
+			Canceled: r.Canceled, CancellationRate: r.CancellationRate, OnTime: r.OnTime, Late: r.Late,
+		}
+	}
+	return bid
+}
+
+func restBids(resp *tenderpb.ListBidsResponse) []api.Bid {
+	bids := []api.Bid{}
+	for _, b := range resp.Bids {
+		bids = append(bids, *restBid(b))
+	}
+	return bids
+}
+
+func restTenderStatus(resp *tenderpb.GetTenderStatusResponse) api.TenderStatus {
+	return restEnum(resp.Status, api.TenderStatusCreated, api.TenderStatusPublished, api.TenderStatusClosed)
+}
+
+func restBidStatus(resp *tenderpb.GetBidStatusResponse) api.BidStatus {
+	return restEnum(resp.Status, api.BidStatusCreated, api.BidStatusPublished, api.BidStatusCanceled)
+}
+
+func restReviews(resp *tenderpb.ListBidReviewsResponse) []api.BidReview {
+	reviews := []api.BidReview{}
+	for _, r := range resp.Reviews {
+		reviews = append(reviews, api.BidReview{
+			Id: r.Id, Description: r.Description, CreatedAt: restCreatedAt(r.CreatedAt), Rating: r.Rating, OnTime: r.OnTime,
+		})
+	}
+	return reviews
+}
+
+func (g *grpcAPI) createTender(body api.CreateTenderJSONRequestBody) (*api.Tender, error) {
+	req := &tenderpb.CreateTenderRequest{
+		Name:            body.Name,
+		Description:     body.Description,
+		ServiceType:     pbEnum[tenderpb.ServiceType](tenderpb.ServiceType_value, body.ServiceType),
+		OrganizationId:  body.OrganizationId,
+		CreatorUsername: body.CreatorUsername,
+		Budget:          pbBudget(body.Budget),
+		Sealed:          body.Sealed,
+	}
+	if body.ServiceType != "" && req.ServiceType == 0 {
+		// A service type the proto enum doesn't have is sent as a number
+		// no value has, as a client of an older proto would.
+		req.ServiceType = 42
+	}
+	return grpcResult(restTender)(g.client.CreateTender(context.Background(), req))
+}
+
+func (g *grpcAPI) listTenders(username *string) ([]api.Tender, error) {
+	return grpcResult(restTenders)(g.client.ListTenders(context.Background(), &tenderpb.ListTendersRequest{Username: username}))
+}
+
+func (g *grpcAPI) userTenders(username string) ([]api.Tender, error) {
+	return grpcResult(restTenders)(g.client.ListUserTenders(context.Background(), &tenderpb.ListUserTendersRequest{Username: username}))
+}
+
+func (g *grpcAPI) tenderStatus(tenderId string, username *string) (api.TenderStatus, error) {
+	return grpcResult(restTenderStatus)(g.client.GetTenderStatus(context.Background(), &tenderpb.GetTenderStatusRequest{TenderId: tenderId, Username: username}))
+}
+
+func (g *grpcAPI) setTenderStatus(tenderId string, s api.TenderStatus, username string) (*api.Tender, error) {
+	return grpcResult(restTender)(g.client.UpdateTenderStatus(context.Background(), &tenderpb.UpdateTenderStatusRequest{
+		TenderId: tenderId, Status: pbEnum[tenderpb.TenderStatus](tenderpb.TenderStatus_value, s), Username: username,
+	}))
+}
+
+func (g *grpcAPI) editTender(tenderId, username string, body api.EditTenderJSONRequestBody) (*api.Tender, error) {
+	req := &tenderpb.EditTenderRequest{
+		TenderId: tenderId, Username: username, Name: body.Name, Description: body.Description, Budget: pbBudget(body.Budget),
+	}
+	if body.ServiceType != nil {
+		req.ServiceType = pbEnum[tenderpb.ServiceType](tenderpb.ServiceType_value, *body.ServiceType)
+	}
+	return grpcResult(restTender)(g.client.EditTender(context.Background(), req))
+}
+
+func (g *grpcAPI) rollbackTender(tenderId string, version int32, username string) (*api.Tender, error) {
+	return grpcResult(restTender)(g.client.RollbackTender(context.Background(), &tenderpb.RollbackTenderRequest{
+		TenderId: tenderId, Version: version, Username: username,
+	}))
+}
+
+func (g *grpcAPI) createBid(body api.CreateBidJSONRequestBody) (*api.Bid, error) {
+	return grpcResult(restBid)(g.client.CreateBid(context.Background(), &tenderpb.CreateBidRequest{
+		Name:         body.Name,
+		Description:  body.Description,
+		TenderId:     body.TenderId,
+		AuthorType:   pbEnum[tenderpb.AuthorType](tenderpb.AuthorType_value, body.AuthorType),
+		AuthorId:     body.AuthorId,
+		Price:        body.Price,
+		Currency:     body.Currency,
+		DeliveryDays: body.DeliveryDays,
+	}))
+}
+
+func (g *grpcAPI) userBids(username string) ([]api.Bid, error) {
+	return grpcResult(restBids)(g.client.ListUserBids(context.Background(), &tenderpb.ListUserBidsRequest{Username: username}))
+}
+
+func (g *grpcAPI) tenderBids(tenderId, username string) ([]api.Bid, error) {
+	return grpcResult(restBids)(g.client.ListTenderBids(context.Background(), &tenderpb.ListTenderBidsRequest{
+		TenderId: tenderId, Username: username,
+	}))
+}
+
+func (g *grpcAPI) bidStatus(bidId, username string) (api.BidStatus, error) {
+	return grpcResult(restBidStatus)(g.client.GetBidStatus(context.Background(), &tenderpb.GetBidStatusRequest{BidId: bidId, Username: username}))
+}
+
+func (g *grpcAPI) setBidStatus(bidId string, s api.BidStatus, username string) (*api.Bid, error) {
+	return grpcResult(restBid)(g.client.UpdateBidStatus(context.Background(), &tenderpb.UpdateBidStatusRequest{
+		BidId: bidId, Status: pbEnum[tenderpb.BidStatus](tenderpb.BidStatus_value, s), Username: username,
+	}))
+}
+
+func (g *grpcAPI) editBid(bidId, username string, body api.EditBidJSONRequestBody) (*api.Bid, error) {
+	return grpcResult(restBid)(g.client.EditBid(context.Background(), &tenderpb.EditBidRequest{
+		BidId:        bidId,
+		Username:     username,
+		Name:         body.Name,
+		Description:  body.Description,
+		Price:        body.Price,
+		Currency:     body.Currency,
+		DeliveryDays: body.DeliveryDays,
+	}))
+}
+
+func (g *grpcAPI) rollbackBid(bidId string, version int32, username string) (*api.Bid, error) {
+	return grpcResult(restBid)(g.client.RollbackBid(context.Background(), &tenderpb.RollbackBidRequest{
+		BidId: bidId, Version: version, Username: username,
+	}))
+}
+
+func (g *grpcAPI) decide(bidId string, decision api.BidDecision, username string) (*api.Bid, error) {
+	return grpcResult(restBid)(g.client.SubmitBidDecision(context.Background(), &tenderpb.SubmitBidDecisionRequest{
+		BidId: bidId, Decision: pbEnum[tenderpb.Decision](tenderpb.Decision_value, decision), Username: username,
+	}))
+}
+
+func (g *grpcAPI) feedback(bidId, feedback, username string) (*api.Bid, error) {
+	return grpcResult(restBid)(g.client.SubmitBidFeedback(context.Background(), &tenderpb.SubmitBidFeedbackRequest{
+		BidId: bidId, Feedback: feedback, Username: username,
+	}))
+}
+
+func (g *grpcAPI) reviews(tenderId, authorUsername, requesterUsername string) ([]api.BidReview, error) {
+	return grpcResult(restReviews)(g.client.ListBidReviews(context.Background(), &tenderpb.ListBidReviewsRequest{
+		TenderId: tenderId, AuthorUsername: authorUsername, RequesterUsername: requesterUsername,
+	}))
+}
+
+var bidEventTypes = map[tenderpb.BidEventType]api.EventType{
+	tenderpb.BidEventType_BID_EVENT_TYPE_CREATED:   api.EventTypeBidCreated,
+	tenderpb.BidEventType_BID_EVENT_TYPE_PUBLISHED: api.EventTypeBidPublished,
+	tenderpb.BidEventType_BID_EVENT_TYPE_UPDATED:   api.EventTypeBidUpdated,
+	tenderpb.BidEventType_BID_EVENT_TYPE_CANCELED:  api.EventTypeBidCanceled,
+	tenderpb.BidEventType_BID_EVENT_TYPE_DECISION:  api.EventTypeBidDecision,
+	tenderpb.BidEventType_BID_EVENT_TYPE_FEEDBACK:  api.EventTypeFeedbackCreated,
+}
+
+// TestWatchTenderBids follows the bids of a tender over gRPC and over the
+// event stream of the REST API, which have to tell the same.
+func TestWatchTenderBids(t *testing.T) {
+	f := newFixture(t)
+	g := newGRPCAPI(f)
+	relay := api.NewOutboxRelay(f.store, nil, time.Second, api.NewEventLogSink(f.store))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tender := f.createTender(f.owners[0], "Со стримом", "Delivery")
+	// A stream is refused as the event stream of the REST API is.
+	nobody, long := "nobody", strings.Repeat("x", 101)
+	for _, req := range []*tenderpb.WatchTenderBidsRequest{
+		{TenderId: tender.Id, Username: &f.freelancer.Username},
+		{TenderId: tender.Id, Username: &nobody},
+		{TenderId: "3fa85f64-5717-4562-b3fc-2c963f66afa6"},
+		{TenderId: long},
+	} {
+		kv := []string{"tenderId", req.TenderId}
+		if req.Username != nil {
+			kv = append(kv, "username", *req.Username)
+		}
+		resp := f.do("GET", query("/api/events/stream", kv...), nil)
+		_, err := firstUpdate(g.client.WatchTenderBids(ctx, req))
+		var httpErr *api.HTTPError
+		if !errors.As(restError(err), &httpErr) || httpErr.Status != resp.status {
+			t.Errorf("stream of %v: grpc %v, rest %d %s", req, err, resp.status, resp.body)
+		}
+	}
+
+	owner := f.owners[0].Username
+	stream, err := g.client.WatchTenderBids(ctx, &tenderpb.WatchTenderBidsRequest{TenderId: tender.Id, Username: &owner})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sse := f.streamEvents("", "username", owner, "tenderId", tender.Id, "type", "bid.created", "type", "bid.published",
+		"type", "bid.updated", "type", "bid.canceled", "type", "bid.decision", "type", "feedback.created")
+
+	f.publishTender(f.owners[0], tender.Id)
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Быстрая доставка")
+	f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "Черновик")
+	f.publishBid(f.bidder, bid.Id)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback", "bidFeedback", "Спасибо", "username", owner), nil),
+		http.StatusOK, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision", "decision", "Rejected", "username", owner), nil),
+		http.StatusOK, nil)
+	if err := relay.Tick(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// The freelancer's draft is not the owner's to see, on either stream.
+	var updates []*tenderpb.BidUpdate
+	for _, want := range []string{"bid.created", "bid.published", "feedback.created", "bid.decision"} {
+		id, event := sse.next()
+		update, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		updates = append(updates, update)
+		if string(event.Type) != want || strconv.FormatInt(update.Seq, 10) != id ||
+			bidEventTypes[update.Type] != event.Type {
+			t.Errorf("grpc sent %d %v, event stream %s %s; want %s", update.Seq, update.Type, id, event.Type, want)
+		}
+		if update.Bid.GetId() != bid.Id || update.Bid.GetTenderId() != tender.Id {
+			t.Errorf("update of %s: %v", want, update.Bid)
+		}
+	}
+	if updates[3].Bid.Status != tenderpb.BidStatus_BID_STATUS_PUBLISHED || updates[3].Bid.Version != 1 {
+		t.Errorf("decided bid = %v", updates[3].Bid)
+	}
+
+	// A client resuming after an event gets the later ones it may see.
+	resumed, err := firstUpdate(g.client.WatchTenderBids(ctx, &tenderpb.WatchTenderBidsRequest{
+		TenderId: tender.Id, Username: &owner, After: &updates[1].Seq,
+	}))
+	if err != nil || resumed.Seq != updates[2].Seq {
+		t.Errorf("resumed after %d: %v, %v", updates[1].Seq, resumed, err)
+	}
+}
+
+// firstUpdate is the first update of a stream, or the error it fails with.
+func firstUpdate(stream grpc.ServerStreamingClient[tenderpb.BidUpdate], err error) (*tenderpb.BidUpdate, error) {
+	if err != nil {
+		return nil, err
+	}
+	return stream.Recv()
+}
@@ -0,0 +1,155 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// importCSV encodes rows, the first being the header, as a CSV file.
+func importCSV(t *testing.T, rows ...[]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func (f *fixture) importReport(path, contentType string, body []byte) api.ImportReport {
+	f.t.Helper()
+	var report api.ImportReport
+	f.expect(f.send("POST", path, contentType, body), http.StatusOK, &report)
+	return report
+}
+
+func statuses(report api.ImportReport) string {
+	var s []string
+	for _, row := range report.Rows {
+		s = append(s, string(row.Status))
+	}
+	return strings.Join(s, ",")
+}
+
+func (f *fixture) myTenders(owner *api.User) []api.Tender {
+	f.t.Helper()
+	var tenders []api.Tender
+	f.expect(f.do("GET", query("/api/tenders/my", "username", owner.Username), nil), http.StatusOK, &tenders)
+	return tenders
+}
+
+func TestImportTenders(t *testing.T) {
+	f := newFixture(t)
+	header := []string{"name", "description", "serviceType", "organizationId", "creatorUsername", "budget", "lots"}
+	file := importCSV(t, header,
+		[]string{"Ремонт дорог", "Асфальт", "Construction", f.org, f.owners[0].Username,
+			`{"currency":"RUB","max":"1000.00"}`, `[{"name":"Лот 1","description":"Первый"}]`},
+		[]string{"Чужой тендер", "Описание", "Delivery", f.org, f.bidder.Username, "", ""},
+		[]string{"Не та услуга", "Описание", "Cleaning", f.org, f.owners[1].Username, "", ""},
+		[]string{"Без бюджета", "Описание", "Delivery", f.org, f.owners[0].Username, "", ""},
+	)
+
+	report := f.importReport(query("/api/tenders/import", "dryRun", "true"), "text/csv", file)
+	if got := statuses(report); got != "valid,failed,failed,valid" || report.Committed || report.Failed != 2 {
+		t.Fatalf("dry run = %s, committed %v", got, report.Committed)
+	}
+	if reason := *report.Rows[1].Reason; reason != "Invalid creator username for the given organization" {
+		t.Errorf("reason of row 2 = %q", reason)
+	}
+	if reason := *report.Rows[2].Reason; !strings.HasPrefix(reason, "invalid row: serviceType:") {
+		t.Errorf("reason of row 3 = %q", reason)
+	}
+
+	report = f.importReport("/api/tenders/import", "text/csv", file)
+	if report.Committed || report.Created != 0 || len(f.myTenders(f.owners[0])) != 0 {
+		t.Fatalf("atomic import with errors created %d tenders", report.Created)
+	}
+
+	resp := f.send("POST", query("/api/tenders/import", "mode", "perRow", "report", "csv"), "text/csv", file)
+	header, rows := exportCSV(t, resp)
+	if strings.Join(header, ",") != "row,reason" || len(rows) != 2 || rows[0][0] != "2" || rows[1][0] != "3" {
+		t.Fatalf("error file = %v %v", header, rows)
+	}
+	created := f.myTenders(f.owners[0])
+	if len(created) != 2 {
+		t.Fatalf("perRow import created %d tenders", len(created))
+	}
+	for _, tender := range created {
+		if tender.Name == "Ремонт дорог" && (len(*tender.Lots) != 1 || *tender.Budget.Max != "1000.00") {
+			t.Errorf("imported tender = %+v", tender)
+		}
+	}
+
+	lines := []string{
+		`{"name":"Первый","description":"Описание","serviceType":"Delivery","organizationId":"` + f.org + `","creatorUsername":"owner3"}`,
+		``,
+		`{"name":"Второй","description":"Описание","serviceType":"Delivery","organizationId":"` + f.org + `","creatorUsername":"owner3","sealed":true}`,
+	}
+	report = f.importReport("/api/tenders/import", "application/x-ndjson", []byte(strings.Join(lines, "\n")))
+	if got := statuses(report); got != "created,created" || !report.Committed || report.Rows[1].Row != 3 {
+		t.Fatalf("atomic import = %+v", report)
+	}
+	var entries []api.AuditEntry
+	f.expect(f.do("GET", query("/api/organizations/"+f.org+"/audit", "username", f.owners[0].Username,
+		"action", "createTender"), nil), http.StatusOK, &entries)
+	if len(entries) != 4 {
+		t.Errorf("audit has %d tender creations, want 4", len(entries))
+	}
+
+	for _, bad := range []struct{ contentType, body string }{
+		{"text/csv", "name,owner\nРемонт,owner1\n"},
+		{"text/csv", "name\n"},
+		{"text/csv", "name,\"description\n"},
+		{"application/json", `{"name":"Ремонт"}`},
+	} {
+		f.expect(f.send("POST", "/api/tenders/import", bad.contentType, []byte(bad.body)), http.StatusBadRequest, nil)
+	}
+}
+
+func TestImportBids(t *testing.T) {
+	f := newFixture(t)
+	tender := f.createTender(f.owners[0], "Ремонт дорог", "Construction")
+	f.publishTender(f.owners[0], tender.Id)
+	draft := f.createTender(f.owners[0], "Черновик", "Construction")
+
+	var lines []string
+	for _, row := range []map[string]any{
+		{"name": "От организации", "description": "Быстро", "tenderId": tender.Id, "authorType": "Organization",
+			"authorId": f.bidder.Id, "deliveryDays": 10},
+		{"name": "Свой тендер", "description": "Нельзя", "tenderId": tender.Id, "authorType": "User",
+			"authorId": f.owners[1].Id},
+		{"name": "Черновик", "description": "Нельзя", "tenderId": draft.Id, "authorType": "User",
+			"authorId": f.freelancer.Id},
+		{"name": "От себя", "description": "Дешево", "tenderId": tender.Id, "authorType": "User",
+			"authorId": f.freelancer.Id},
+	} {
+		line, err := json.Marshal(row)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, string(line))
+	}
+	lines = append(lines, `{"name": "Обрыв`)
+	file := []byte(strings.Join(lines, "\n") + "\n")
+
+	report := f.importReport(query("/api/bids/import", "mode", "perRow"), "application/x-ndjson", file)
+	if got := statuses(report); got != "created,failed,failed,created,failed" || report.Created != 2 {
+		t.Fatalf("perRow import = %s", got)
+	}
+	if !strings.HasPrefix(*report.Rows[4].Reason, "invalid row:") {
+		t.Errorf("reason of broken row = %q", *report.Rows[4].Reason)
+	}
+
+	for i, author := range map[int]*api.User{0: f.bidder, 3: f.freelancer} {
+		var bids []api.Bid
+		f.expect(f.do("GET", query("/api/bids/my", "username", author.Username), nil), http.StatusOK, &bids)
+		if len(bids) != 1 || bids[0].Id != *report.Rows[i].Id || bids[0].TenderId != tender.Id {
+			t.Errorf("bids of %s = %+v", author.Username, bids)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestInvitations(t *testing.T) {
+	f := newFixture(t)
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            "Закрытый",
+		"description":     "Только по приглашениям",
+		"serviceType":     "Delivery",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"visibility":      "private",
+	}), http.StatusOK, &tender)
+	if tender.Visibility != api.TenderVisibilityPrivate {
+		t.Fatalf("visibility = %s", tender.Visibility)
+	}
+	f.publishTender(f.owners[0], tender.Id)
+	public := f.createTender(f.owners[0], "Открытый", "Delivery")
+	f.publishTender(f.owners[0], public.Id)
+
+	listed := func(user *api.User) string {
+		t.Helper()
+		path := "/api/tenders"
+		if user != nil {
+			path = query(path, "username", user.Username)
+		}
+		var tenders []api.Tender
+		f.expect(f.do("GET", path, nil), http.StatusOK, &tenders)
+		return tenderNames(tenders)
+	}
+	if got := listed(nil); got != "Открытый" {
+		t.Errorf("anonymous list = %s", got)
+	}
+	if got := listed(f.freelancer); got != "Открытый" {
+		t.Errorf("uninvited list = %s", got)
+	}
+	if got := listed(f.owners[1]); got != "Закрытый,Открытый" {
+		t.Errorf("owner list = %s", got)
+	}
+	status := "/api/tenders/" + tender.Id + "/status"
+	f.expect(f.do("GET", status, nil), http.StatusUnauthorized, nil)
+	f.expect(f.do("GET", query(status, "username", f.freelancer.Username), nil), http.StatusForbidden, nil)
+
+	invite := func(body map[string]any, code int) api.TenderInvitation {
+		t.Helper()
+		var inv api.TenderInvitation
+		f.expect(f.do("POST", query("/api/tenders/"+tender.Id+"/invitations", "username", f.owners[0].Username), body),
+			code, &inv)
+		return inv
+	}
+	f.expect(f.do("POST", query("/api/tenders/"+public.Id+"/invitations", "username", f.owners[0].Username),
+		map[string]any{"username": f.freelancer.Username}), http.StatusBadRequest, nil)
+	f.expect(f.do("POST", query("/api/tenders/"+tender.Id+"/invitations", "username", f.bidder.Username),
+		map[string]any{"username": f.freelancer.Username}), http.StatusForbidden, nil)
+	invite(map[string]any{"username": f.freelancer.Username, "organizationId": f.rival}, http.StatusBadRequest)
+	invite(map[string]any{"username": "nobody"}, http.StatusNotFound)
+	invite(map[string]any{"organizationId": f.org}, http.StatusBadRequest)
+
+	personal := invite(map[string]any{"username": f.freelancer.Username}, http.StatusOK)
+	if personal.Status != api.InvitationStatusPending {
+		t.Errorf("new invitation = %+v", personal)
+	}
+	invite(map[string]any{"username": f.freelancer.Username}, http.StatusBadRequest)
+
+	if got := listed(f.freelancer); got != "Закрытый,Открытый" {
+		t.Errorf("invitee list = %s", got)
+	}
+	f.expect(f.do("GET", query(status, "username", f.freelancer.Username), nil), http.StatusOK, nil)
+
+	bid := map[string]any{
+		"name":        "Предложение",
+		"description": "Описание",
+		"tenderId":    tender.Id,
+		"authorType":  "User",
+		"authorId":    f.freelancer.Id,
+	}
+	respond := func(user *api.User, id, response string, code int) {
+		t.Helper()
+		f.expect(f.do("PUT", query("/api/invitations/"+id+"/respond", "response", response, "username", user.Username), nil),
+			code, nil)
+	}
+	f.expect(f.do("POST", "/api/bids/new", bid), http.StatusForbidden, nil)
+	respond(f.bidder, personal.Id, "Accepted", http.StatusForbidden)
+	respond(f.freelancer, personal.Id, "Accepted", http.StatusOK)
+	f.expect(f.do("POST", "/api/bids/new", bid), http.StatusOK, nil)
+
+	// An organization invitation is answered by its responsibles.
+	org := invite(map[string]any{"organizationId": f.rival}, http.StatusOK)
+	var mine []api.TenderInvitation
+	f.expect(f.do("GET", query("/api/invitations/my", "username", f.bidder.Username), nil), http.StatusOK, &mine)
+	if len(mine) != 1 || mine[0].Id != org.Id {
+		t.Errorf("bidder invitations = %+v", mine)
+	}
+	if got := listed(f.bidder); !strings.Contains(got, "Закрытый") {
+		t.Errorf("invited organization list = %s", got)
+	}
+	respond(f.freelancer, org.Id, "Accepted", http.StatusForbidden)
+	respond(f.bidder, org.Id, "Declined", http.StatusOK)
+	if got := listed(f.bidder); got != "Открытый" {
+		t.Errorf("list after declining = %s", got)
+	}
+	bid["authorId"] = f.bidder.Id
+	f.expect(f.do("POST", "/api/bids/new", bid), http.StatusForbidden, nil)
+
+	var all []api.TenderInvitation
+	f.expect(f.do("GET", query("/api/tenders/"+tender.Id+"/invitations", "username", f.owners[2].Username), nil),
+		http.StatusOK, &all)
+	if len(all) != 2 || all[0].Id != org.Id || all[0].Status != api.InvitationStatusDeclined ||
+		all[1].Status != api.InvitationStatusAccepted || all[1].RespondedAt == nil {
+		t.Errorf("tender invitations = %+v", all)
+	}
+}
@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"testing"
+)
+
+func TestLots(t *testing.T) {
+	f := newFixture(t)
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            "Стройка",
+		"description":     "Склад под ключ",
+		"serviceType":     "Construction",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"lots": []map[string]any{
+			{"name": "Материалы", "description": "Кирпич и цемент", "budget": map[string]any{"max": "500000", "currency": "RUB"}},
+			{"name": "Работы", "description": "Кладка"},
+		},
+	}), http.StatusOK, &tender)
+	if tender.Lots == nil || len(*tender.Lots) != 2 {
+		t.Fatalf("lots = %+v", tender.Lots)
+	}
+	materials, labor := (*tender.Lots)[0], (*tender.Lots)[1]
+	if materials.Status != api.TenderLotStatusOpen || *materials.Budget.Max != "500000.00" {
+		t.Fatalf("materials = %+v", materials)
+	}
+	f.publishTender(f.owners[0], tender.Id)
+
+	newBid := func(lotIds ...string) map[string]any {
+		return map[string]any{
+			"name":        "Предложение",
+			"description": "Описание",
+			"tenderId":    tender.Id,
+			"authorType":  "User",
+			"authorId":    f.bidder.Id,
+			"lotIds":      lotIds,
+		}
+	}
+	f.expect(f.do("POST", "/api/bids/new", newBid()), http.StatusBadRequest, nil)
+	f.expect(f.do("POST", "/api/bids/new", newBid(f.org)), http.StatusBadRequest, nil)
+
+	var bid api.Bid
+	f.expect(f.do("POST", "/api/bids/new", newBid(materials.Id, labor.Id)), http.StatusOK, &bid)
+	if bid.LotIds == nil || len(*bid.LotIds) != 2 {
+		t.Fatalf("bid lots = %+v", bid.LotIds)
+	}
+	f.publishBid(f.bidder, bid.Id)
+
+	f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/status",
+		"status", "Closed", "username", f.owners[0].Username), nil), http.StatusBadRequest, nil)
+
+	decide := func(owner *api.User, lotId string, status int) {
+		t.Helper()
+		kv := []string{"decision", "Approved", "username", owner.Username}
+		if lotId != "" {
+			kv = append(kv, "lotId", lotId)
+		}
+		f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision", kv...), nil), status, nil)
+	}
+	decide(f.owners[0], "", http.StatusBadRequest)
+	for _, owner := range f.owners {
+		decide(owner, materials.Id, http.StatusOK)
+	}
+	decide(f.owners[0], materials.Id, http.StatusBadRequest)
+
+	got, err := f.store.GetTenderById(tender.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lot := (*got.Lots)[0]; lot.Status != api.TenderLotStatusAwarded || lot.AwardedBidId == nil || *lot.AwardedBidId != bid.Id {
+		t.Errorf("awarded lot = %+v", lot)
+	}
+	if got.Status != api.TenderStatusPublished {
+		t.Errorf("status with an open lot = %s", got.Status)
+	}
+
+	f.expect(f.do("POST", "/api/bids/new", newBid(materials.Id)), http.StatusForbidden, nil)
+
+	cancel := query("/api/tenders/"+tender.Id+"/lots/"+labor.Id+"/cancel", "username", f.owners[1].Username)
+	f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/lots/"+labor.Id+"/cancel", "username", f.bidder.Username), nil),
+		http.StatusForbidden, nil)
+	f.expect(f.do("PUT", cancel, nil), http.StatusOK, got)
+	if (*got.Lots)[1].Status != api.TenderLotStatusCanceled || got.Status != api.TenderStatusClosed {
+		t.Errorf("after canceling the last open lot: status %s, lots %+v", got.Status, *got.Lots)
+	}
+	f.expect(f.do("PUT", cancel, nil), http.StatusBadRequest, nil)
+
+	t.Run("without lots", func(t *testing.T) {
+		plain := f.createTender(f.owners[0], "Без лотов", "Delivery")
+		f.publishTender(f.owners[0], plain.Id)
+		f.expect(f.do("POST", "/api/bids/new", map[string]any{
+			"name":        "Предложение",
+			"description": "Описание",
+			"tenderId":    plain.Id,
+			"authorType":  "User",
+			"authorId":    f.bidder.Id,
+			"lotIds":      []string{materials.Id},
+		}), http.StatusBadRequest, nil)
+	})
+}
@@ -0,0 +1,108 @@
+package e2e
+
+import (
+	"context"
+	"my_zad/api"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// outbox is a mailer keeping the messages it is given.
+type outbox struct {
+	messages []api.MailMessage
+}
+
+func (o *outbox) Send(ctx context.Context, msg api.MailMessage) error {
+	o.messages = append(o.messages, msg)
+	return nil
+}
+
+// take returns the messages sent since the last call as "to: subject"
+// lines, sorted.
+func (o *outbox) take() string {
+	var got []string
+	for _, msg := range o.messages {
+		got = append(got, msg.To+": "+msg.Subject)
+	}
+	o.messages = nil
+	sort.Strings(got)
+	return strings.Join(got, "\n")
+}
+
+func TestNotifications(t *testing.T) {
+	f := newFixture(t)
+	mail := &outbox{}
+	relay := api.NewOutboxRelay(f.store, nil, time.Second, api.NewNotificationSink(f.store))
+	dispatcher := api.NewNotificationDispatcher(f.store, mail, time.Second)
+	deliver := func() string {
+		t.Helper()
+		if err := relay.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if err := dispatcher.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		return mail.take()
+	}
+
+	preferences := func(user *api.User) string { return query("/api/notifications/preferences", "username", user.Username) }
+	var got api.NotificationPreferences
+	f.expect(f.do("GET", preferences(f.owners[0]), nil), http.StatusOK, &got)
+	if got.Email != nil || got.Language != api.NotificationLanguageRu || len(got.Events) != 0 {
+		t.Errorf("default preferences = %+v", got)
+	}
+	f.expect(f.do("GET", query("/api/notifications/preferences", "username", "nobody"), nil), http.StatusUnauthorized, nil)
+	f.expect(f.do("PUT", preferences(f.owners[0]), map[string]any{"language": "ru", "events": []string{"bid.submitted"}}),
+		http.StatusBadRequest, nil)
+	f.expect(f.do("PUT", preferences(f.owners[0]), map[string]any{"email": "Owner <owner1@example.com>", "language": "ru",
+		"events": []string{"bid.submitted"}}), http.StatusBadRequest, nil)
+
+	f.expect(f.do("PUT", preferences(f.owners[0]), map[string]any{"email": "owner1@example.com", "language": "ru",
+		"events": []string{"bid.submitted", "decision.required"}}), http.StatusOK, &got)
+	if *got.Email != "owner1@example.com" || len(got.Events) != 2 {
+		t.Errorf("saved preferences = %+v", got)
+	}
+	f.expect(f.do("PUT", preferences(f.owners[1]), map[string]any{"email": "owner2@example.com", "language": "en",
+		"events": []string{"decision.required"}}), http.StatusOK, nil)
+	f.expect(f.do("PUT", preferences(f.bidder), map[string]any{"email": "bidder@example.com", "language": "en",
+		"events": []string{"bid.approved", "bid.rejected", "feedback.created"}}), http.StatusOK, nil)
+
+	tender := f.createTender(f.owners[0], "Ремонт дорог", "Delivery")
+	f.publishTender(f.owners[0], tender.Id)
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Быстрая доставка")
+	if got := deliver(); got != "" {
+		t.Errorf("notified of a draft: %s", got)
+	}
+
+	f.publishBid(f.bidder, bid.Id)
+	if got := deliver(); got != "owner1@example.com: Новое предложение на тендер «Ремонт дорог»" {
+		t.Errorf("published bid notified:\n%s", got)
+	}
+
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback", "bidFeedback", "Уточните сроки", "username", f.owners[0].Username),
+		nil), http.StatusOK, nil)
+	decide := func(user *api.User) {
+		t.Helper()
+		f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision", "decision", "Approved", "username", user.Username),
+			nil), http.StatusOK, nil)
+	}
+	decide(f.owners[0])
+	if got := deliver(); got != "bidder@example.com: Feedback on bid \"Быстрая доставка\"\n"+
+		"owner2@example.com: Your decision is required on bid \"Быстрая доставка\"" {
+		t.Errorf("feedback and decision notified:\n%s", got)
+	}
+
+	// owner3 is yet to decide but has not opted in; owner2 has decided.
+	decide(f.owners[1])
+	if got := deliver(); got != "" {
+		t.Errorf("second decision notified:\n%s", got)
+	}
+
+	decide(f.owners[2])
+	if got := deliver(); got != "bidder@example.com: Bid \"Быстрая доставка\" approved" {
+		t.Errorf("approval notified:\n%s", got)
+	}
+}
@@ -0,0 +1,161 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"testing"
+)
+
+func (f *fixture) createBudgetTender(name, min, max, currency string) api.Tender {
+	f.t.Helper()
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            name,
+		"description":     "Описание " + name,
+		"serviceType":     "Delivery",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"budget":          map[string]any{"min": min, "max": max, "currency": currency},
+	}), http.StatusOK, &tender)
+	f.publishTender(f.owners[0], tender.Id)
+	return tender
+}
+
+func (f *fixture) createPricedBid(tenderId, name, price, currency string, days int) api.Bid {
+	f.t.Helper()
+
+	var bid api.Bid
+	f.expect(f.do("POST", "/api/bids/new", map[string]any{
+		"name":         name,
+		"description":  "Описание " + name,
+		"tenderId":     tenderId,
+		"authorType":   "User",
+		"authorId":     f.bidder.Id,
+		"price":        price,
+		"currency":     currency,
+		"deliveryDays": days,
+	}), http.StatusOK, &bid)
+	f.publishBid(f.bidder, bid.Id)
+	return bid
+}
+
+func TestPricing(t *testing.T) {
+	t.Run("tenders", func(t *testing.T) {
+		f := newFixture(t)
+
+		body := map[string]any{
+			"name":            "Тендер",
+			"description":     "Описание",
+			"serviceType":     "Delivery",
+			"organizationId":  f.org,
+			"creatorUsername": f.owners[0].Username,
+			"budget":          map[string]any{"min": "200", "max": "100", "currency": "RUB"},
+		}
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+		body["budget"] = map[string]any{"min": "1e3", "currency": "RUB"}
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+		body["budget"] = map[string]any{"min": "100", "currency": "rub"}
+		f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+
+		small := f.createBudgetTender("Малый", "100", "1000.5", "RUB")
+		if small.Budget == nil || *small.Budget.Min != "100.00" || *small.Budget.Max != "1000.50" {
+			t.Fatalf("budget = %+v, want amounts normalized to two decimals", small.Budget)
+		}
+		f.createBudgetTender("Большой", "5000", "90000", "RUB")
+		f.createBudgetTender("Валютный", "10", "20", "USD")
+		f.createTender(f.owners[0], "Без бюджета", api.TenderServiceTypeDelivery)
+
+		var tenders []api.Tender
+		f.expect(f.do("GET", query("/api/tenders", "currency", "RUB", "minBudget", "2000"), nil), http.StatusOK, &tenders)
+		if got := tenderNames(tenders); got != "Большой" {
+			t.Errorf("filtered tenders = %s, want Большой", got)
+		}
+
+		f.expect(f.do("GET", query("/api/tenders/my", "username", f.owners[0].Username,
+			"sortBy", "budgetMax", "sortOrder", "desc"), nil), http.StatusOK, &tenders)
+		if got := tenderNames(tenders); got != "Большой,Малый,Валютный,Без бюджета" {
+			t.Errorf("tenders by budget = %s", got)
+		}
+
+		var edited api.Tender
+		f.expect(f.do("PATCH", query("/api/tenders/"+small.Id+"/edit", "username", f.owners[0].Username),
+			map[string]any{"budget": map[string]any{"max": "0.10", "currency": "RUB"}}), http.StatusOK, &edited)
+		if edited.Version != 2 || edited.Budget.Min != nil || *edited.Budget.Max != "0.10" {
+			t.Errorf("edited budget = %+v (version %d)", edited.Budget, edited.Version)
+		}
+
+		var rolledBack api.Tender
+		f.expect(f.do("PUT", query("/api/tenders/"+small.Id+"/rollback/1", "username", f.owners[0].Username), nil),
+			http.StatusOK, &rolledBack)
+		if *rolledBack.Budget.Max != "1000.50" {
+			t.Errorf("rolled back budget = %+v", rolledBack.Budget)
+		}
+	})
+
+	t.Run("bids", func(t *testing.T) {
+		f := newFixture(t)
+		tender := f.createBudgetTender("Тендер", "100", "1000", "RUB")
+
+		body := map[string]any{
+			"name":        "Предложение",
+			"description": "Описание",
+			"tenderId":    tender.Id,
+			"authorType":  "User",
+			"authorId":    f.bidder.Id,
+			"price":       "500",
+			"currency":    "USD",
+		}
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusBadRequest, nil)
+		delete(body, "currency")
+		f.expect(f.do("POST", "/api/bids/new", body), http.StatusBadRequest, nil)
+
+		cheap := f.createPricedBid(tender.Id, "Дешево", "300", "RUB", 30)
+		f.createPricedBid(tender.Id, "Дорого", "900.99", "RUB", 5)
+		f.createPricedBid(tender.Id, "Средне", "500", "RUB", 10)
+
+		list := "/api/bids/" + tender.Id + "/list"
+		var bids []api.Bid
+		f.expect(f.do("GET", query(list, "username", f.owners[0].Username, "sortBy", "price", "sortOrder", "desc"), nil),
+			http.StatusOK, &bids)
+		if got := bidNames(bids); got != "Дорого,Средне,Дешево" {
+			t.Errorf("bids by price = %s", got)
+		}
+
+		f.expect(f.do("GET", query(list, "username", f.owners[0].Username, "maxPrice", "500.00", "sortBy", "deliveryDays"), nil),
+			http.StatusOK, &bids)
+		if got := bidNames(bids); got != "Средне,Дешево" {
+			t.Errorf("bids under 500 by delivery = %s", got)
+		}
+
+		f.expect(f.do("GET", query("/api/bids/my", "username", f.bidder.Username, "maxDeliveryDays", "10"), nil),
+			http.StatusOK, &bids)
+		if got := bidNames(bids); got != "Дорого,Средне" {
+			t.Errorf("bids within 10 days = %s", got)
+		}
+
+		edit := query("/api/bids/"+cheap.Id+"/edit", "username", f.bidder.Username)
+		f.expect(f.do("PATCH", edit, map[string]any{"currency": "EUR"}), http.StatusBadRequest, nil)
+
+		var edited api.Bid
+		f.expect(f.do("PATCH", edit, map[string]any{"price": "250.5"}), http.StatusOK, &edited)
+		if edited.Version != 2 || *edited.Price != "250.50" || *edited.Currency != "RUB" || *edited.DeliveryDays != 30 {
+			t.Errorf("edited bid = %+v", edited)
+		}
+
+		// The bids are in roubles, so the budget may change, but not its
+		// currency, by an edit or by a rollback.
+		editTender := query("/api/tenders/"+tender.Id+"/edit", "username", f.owners[0].Username)
+		f.expect(f.do("PATCH", editTender, map[string]any{"budget": map[string]any{"max": "2000", "currency": "USD"}}),
+			http.StatusBadRequest, nil)
+		f.expect(f.do("PATCH", editTender, map[string]any{"budget": map[string]any{"max": "2000", "currency": "RUB"}}),
+			http.StatusOK, nil)
+
+		switched := f.createBudgetTender("Валюта сменилась", "100", "1000", "RUB")
+		f.expect(f.do("PATCH", query("/api/tenders/"+switched.Id+"/edit", "username", f.owners[0].Username),
+			map[string]any{"budget": map[string]any{"max": "20", "currency": "USD"}}), http.StatusOK, nil)
+		f.createPricedBid(switched.Id, "В долларах", "15", "USD", 5)
+		f.expect(f.do("PUT", query("/api/tenders/"+switched.Id+"/rollback/1", "username", f.owners[0].Username), nil),
+			http.StatusBadRequest, nil)
+	})
+}
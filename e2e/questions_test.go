@@ -0,0 +1,75 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"testing"
+)
+
+func TestQuestions(t *testing.T) {
+	f := newFixture(t)
+
+	tender := f.createTender(f.owners[0], "С вопросами", "Delivery")
+	questions := "/api/tenders/" + tender.Id + "/questions"
+	ask := func(user *api.User, text string, status int) api.TenderQuestion {
+		t.Helper()
+		var q api.TenderQuestion
+		f.expect(f.do("POST", query(questions, "username", user.Username), map[string]any{"question": text}), status, &q)
+		return q
+	}
+	list := func(user *api.User) []api.TenderQuestion {
+		t.Helper()
+		var qs []api.TenderQuestion
+		f.expect(f.do("GET", query(questions, "username", user.Username), nil), http.StatusOK, &qs)
+		return qs
+	}
+	answer := func(user *api.User, id, text string, amend bool, status int) api.TenderQuestion {
+		t.Helper()
+		var q api.TenderQuestion
+		f.expect(f.do("PUT", query("/api/questions/"+id+"/answer", "username", user.Username),
+			map[string]any{"answer": text, "amendTender": amend}), status, &q)
+		return q
+	}
+
+	ask(f.bidder, "Рано?", http.StatusForbidden)
+	f.publishTender(f.owners[0], tender.Id)
+	ask(f.owners[1], "Свой вопрос", http.StatusForbidden)
+	ask(f.bidder, "", http.StatusBadRequest)
+
+	weight := ask(f.bidder, "Какой вес груза?", http.StatusOK)
+	if weight.Status != api.QuestionStatusOpen || weight.OrganizationId == nil || *weight.OrganizationId != f.rival {
+		t.Errorf("new question = %+v", weight)
+	}
+	dates := ask(f.freelancer, "Какие сроки?", http.StatusOK)
+
+	// Until answered, a question is seen by the responsibles and its author.
+	if qs := list(f.owners[2]); len(qs) != 2 || qs[0].Id != dates.Id {
+		t.Errorf("responsible questions = %+v", qs)
+	}
+	if qs := list(f.freelancer); len(qs) != 1 || qs[0].Id != dates.Id {
+		t.Errorf("author questions = %+v", qs)
+	}
+
+	answer(f.bidder, weight.Id, "Тонна", false, http.StatusForbidden)
+	got := answer(f.owners[1], weight.Id, "Тонна", false, http.StatusOK)
+	if got.Status != api.QuestionStatusAnswered || *got.Answer != "Тонна" || *got.TenderVersion != 1 || *got.Amended {
+		t.Errorf("answered question = %+v", got)
+	}
+	answer(f.owners[1], weight.Id, "Две", false, http.StatusBadRequest)
+	if qs := list(f.freelancer); len(qs) != 2 {
+		t.Errorf("questions after answer = %+v", qs)
+	}
+
+	got = answer(f.owners[0], dates.Id, "Месяц", true, http.StatusOK)
+	if *got.TenderVersion != 2 || !*got.Amended {
+		t.Errorf("amending answer = %+v", got)
+	}
+
+	var history []api.TenderRevision
+	f.expect(f.do("GET", query("/api/tenders/"+tender.Id+"/history", "username", f.bidder.Username), nil),
+		http.StatusOK, &history)
+	if len(history) != 2 || history[0].Tender.Version != 2 || len(history[0].Clarifications) != 1 ||
+		history[0].Clarifications[0].Id != dates.Id || history[1].Clarifications[0].Id != weight.Id {
+		t.Errorf("history = %+v", history)
+	}
+}
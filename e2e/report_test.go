@@ -0,0 +1,40 @@
+package e2e
+
+import (
+	"bytes"
+	"my_zad/api"
+	"net/http"
+	"testing"
+)
+
+func TestTenderReport(t *testing.T) {
+	f := newFixture(t)
+	tender := f.createTender(f.owners[0], "Ремонт дорог", "Construction")
+	f.publishTender(f.owners[0], tender.Id)
+	report := "/api/tenders/" + tender.Id + "/report.pdf"
+	f.expect(f.do("GET", query(report, "username", f.owners[0].Username), nil), http.StatusBadRequest, nil)
+
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Быстрый ремонт")
+	f.publishBid(f.bidder, bid.Id)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback", "bidFeedback", "Уточните сроки",
+		"username", f.owners[1].Username), nil), http.StatusOK, nil)
+	for _, owner := range f.owners {
+		f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision", "decision", "Approved",
+			"username", owner.Username), nil), http.StatusOK, nil)
+	}
+
+	// The approval awards the tender, which closes it.
+	f.expect(f.do("GET", query(report, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+	f.expect(f.do("GET", query(report, "username", "nobody"), nil), http.StatusUnauthorized, nil)
+	f.expect(f.do("GET", query("/api/tenders/00000000-0000-0000-0000-000000000000/report.pdf",
+		"username", f.owners[0].Username), nil), http.StatusNotFound, nil)
+
+	resp := f.do("GET", query(report, "username", f.owners[2].Username), nil)
+	if resp.status != http.StatusOK {
+		t.Fatalf("status = %d; body: %s", resp.status, resp.body)
+	}
+	if !bytes.HasPrefix(resp.body, []byte("%PDF-1.7\n")) || !bytes.HasSuffix(resp.body, []byte("%%EOF\n")) ||
+		bytes.Count(resp.body, []byte("/Type /Page ")) != 1 {
+		t.Errorf("report is not a one page pdf: %d bytes", len(resp.body))
+	}
+}
@@ -0,0 +1,92 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"testing"
+)
+
+func TestReputation(t *testing.T) {
+	f := newFixture(t)
+	feedback := func(bidId string, owner *api.User, kv ...string) {
+		t.Helper()
+		kv = append([]string{"bidFeedback", "Отзыв", "username", owner.Username}, kv...)
+		f.expect(f.do("PUT", query("/api/bids/"+bidId+"/feedback", kv...), nil), http.StatusOK, nil)
+	}
+
+	won := f.createTender(f.owners[0], "Ремонт дорог", "Construction")
+	f.publishTender(f.owners[0], won.Id)
+	wonBid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, won.Id, "Быстрый ремонт")
+	f.publishBid(f.bidder, wonBid.Id)
+	feedback(wonBid.Id, f.owners[0], "rating", "5", "onTime", "true")
+	feedback(wonBid.Id, f.owners[1], "rating", "4", "onTime", "false")
+	for _, owner := range f.owners {
+		f.expect(f.do("PUT", query("/api/bids/"+wonBid.Id+"/submit_decision", "decision", "Approved",
+			"username", owner.Username), nil), http.StatusOK, nil)
+	}
+
+	canceled := f.createTender(f.owners[0], "Доставка", "Delivery")
+	f.publishTender(f.owners[0], canceled.Id)
+	canceledBid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, canceled.Id, "Доставка за день")
+	f.publishBid(f.bidder, canceledBid.Id)
+	f.expect(f.do("PUT", query("/api/bids/"+canceledBid.Id+"/status", "status", "Canceled",
+		"username", f.bidder.Username), nil), http.StatusOK, nil)
+	f.createBid(f.bidder, api.BidAuthorTypeOrganization, canceled.Id, "Черновик")
+
+	lost := f.createTender(f.owners[0], "Покраска", "Construction")
+	f.publishTender(f.owners[0], lost.Id)
+	lostBid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, lost.Id, "Дорогая покраска")
+	f.publishBid(f.bidder, lostBid.Id)
+	other := f.createBid(f.freelancer, api.BidAuthorTypeUser, lost.Id, "Дешевая покраска")
+	f.publishBid(f.freelancer, other.Id)
+	feedback(lostBid.Id, f.owners[2], "rating", "2")
+	f.expect(f.do("PUT", query("/api/bids/"+lostBid.Id+"/submit_decision", "decision", "Rejected",
+		"username", f.owners[0].Username), nil), http.StatusOK, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+other.Id+"/feedback", "bidFeedback", "Отзыв", "rating", "6",
+		"username", f.owners[0].Username), nil), http.StatusBadRequest, nil)
+
+	want := api.Reputation{Ratings: 3, Bids: 3, Won: 1, Lost: 1, Canceled: 1, CancellationRate: 0.33, OnTime: 1, Late: 1}
+	check := func(name string, got *api.Reputation, want api.Reputation, average float64) {
+		t.Helper()
+		if got == nil {
+			t.Fatalf("%s: no reputation", name)
+		}
+		if got.AverageRating == nil || *got.AverageRating != average {
+			t.Errorf("%s: average rating = %v, want %v", name, got.AverageRating, average)
+		}
+		got.AverageRating = nil
+		if *got != want {
+			t.Errorf("%s: reputation = %+v, want %+v", name, *got, want)
+		}
+	}
+
+	path := "/api/organizations/" + f.rival + "/reputation"
+	var rep api.OrganizationReputation
+	f.expect(f.do("GET", query(path, "username", f.owners[0].Username), nil), http.StatusOK, &rep)
+	check("organization", &rep.Reputation, want, 3.67)
+	if len(rep.Authors) != 1 || rep.Authors[0].Username != f.bidder.Username {
+		t.Fatalf("authors = %+v", rep.Authors)
+	}
+	check("author", &rep.Authors[0].Reputation, want, 3.67)
+
+	f.expect(f.do("GET", query(path, "username", "nobody"), nil), http.StatusUnauthorized, nil)
+	f.expect(f.do("GET", query(path, "username", f.freelancer.Username), nil), http.StatusForbidden, nil)
+	f.expect(f.do("GET", query("/api/organizations/00000000-0000-0000-0000-000000000000/reputation",
+		"username", f.bidder.Username), nil), http.StatusNotFound, nil)
+
+	var bids []api.Bid
+	f.expect(f.do("GET", query("/api/bids/"+lost.Id+"/list", "username", f.owners[1].Username), nil), http.StatusOK, &bids)
+	if len(bids) != 2 {
+		t.Fatalf("listed %d bids, want 2", len(bids))
+	}
+	for _, b := range bids {
+		switch b.Id {
+		case lostBid.Id:
+			check("organization bid", b.AuthorReputation, want, 3.67)
+		case other.Id:
+			if r := b.AuthorReputation; r == nil || r.AverageRating != nil || *r != (api.Reputation{Bids: 1}) {
+				t.Errorf("freelancer reputation = %+v", r)
+			}
+		}
+	}
+}
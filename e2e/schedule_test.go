@@ -0,0 +1,96 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSchedule(t *testing.T) {
+	f := newFixture(t)
+	scheduler := api.NewScheduler(f.store, nil, time.Minute)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	publishAt, deadline := now.Add(time.Hour), now.Add(2*time.Hour)
+
+	body := map[string]any{
+		"name":               "Тендер",
+		"description":        "Описание",
+		"serviceType":        "Delivery",
+		"organizationId":     f.org,
+		"creatorUsername":    f.owners[0].Username,
+		"publishAt":          publishAt,
+		"submissionDeadline": now.Add(-time.Hour),
+	}
+	f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+
+	body["submissionDeadline"] = publishAt
+	f.expect(f.do("POST", "/api/tenders/new", body), http.StatusBadRequest, nil)
+
+	body["submissionDeadline"] = deadline
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", body), http.StatusOK, &tender)
+	if tender.PublishAt == nil || !tender.PublishAt.Equal(publishAt) ||
+		tender.SubmissionDeadline == nil || !tender.SubmissionDeadline.Equal(deadline) {
+		t.Fatalf("created tender schedule = %v/%v", tender.PublishAt, tender.SubmissionDeadline)
+	}
+
+	if err := scheduler.Tick(now); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.tenderStatus(tender.Id); got != api.TenderStatusCreated {
+		t.Fatalf("status before publishAt = %s, want Created", got)
+	}
+
+	if err := scheduler.Tick(publishAt); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.tenderStatus(tender.Id); got != api.TenderStatusPublished {
+		t.Fatalf("status at publishAt = %s, want Published", got)
+	}
+	bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "В срок")
+	f.publishBid(f.bidder, bid.Id)
+	edit := query("/api/bids/"+bid.Id+"/edit", "username", f.bidder.Username)
+	f.expect(f.do("PATCH", edit, map[string]any{"description": "Уточнено"}), http.StatusOK, nil)
+
+	// Move the deadline into the past behind the handler's back: bids are
+	// rejected even before the scheduler closes the tender.
+	past := now.Add(-time.Minute)
+	if _, err := f.store.UpdateTenderById(tender.Id, api.EditTenderJSONRequestBody{SubmissionDeadline: &past}); err != nil {
+		t.Fatal(err)
+	}
+	f.expect(f.do("POST", "/api/bids/new", map[string]any{
+		"name":        "Опоздал",
+		"description": "Описание",
+		"tenderId":    tender.Id,
+		"authorType":  "User",
+		"authorId":    f.bidder.Id,
+	}), http.StatusForbidden, nil)
+
+	// Nor can the bids made in time change any more.
+	f.expect(f.do("PATCH", edit, map[string]any{"description": "Опоздал"}), http.StatusBadRequest, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/rollback/1", "username", f.bidder.Username), nil),
+		http.StatusBadRequest, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/status", "status", "Published", "username", f.bidder.Username), nil),
+		http.StatusBadRequest, nil)
+	f.upload(f.bidder, "/api/bids/"+bid.Id+"/attachments", "late.pdf", "application/pdf", pdf, http.StatusBadRequest)
+
+	if err := scheduler.Tick(now); err != nil {
+		t.Fatal(err)
+	}
+	if got := f.tenderStatus(tender.Id); got != api.TenderStatusClosed {
+		t.Fatalf("status after deadline = %s, want Closed", got)
+	}
+
+	// The responsibles decide on the bids once the deadline has closed the
+	// tender, until one of them wins it.
+	decide := func(owner *api.User) response {
+		return f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision",
+			"decision", "Approved", "username", owner.Username), nil)
+	}
+	for _, owner := range f.owners {
+		f.expect(decide(owner), http.StatusOK, nil)
+	}
+	f.expect(decide(f.owners[0]), http.StatusBadRequest, nil)
+}
@@ -0,0 +1,163 @@
+package e2e
+
+import (
+	"maps"
+	"my_zad/api"
+	"net/http"
+	"testing"
+)
+
+func (f *fixture) scoreBid(reviewer *api.User, bidId string, status int, scores map[string]int) api.BidScorecard {
+	f.t.Helper()
+
+	body := []map[string]any{}
+	for criterion, score := range scores {
+		body = append(body, map[string]any{"criterion": criterion, "score": score})
+	}
+	var card api.BidScorecard
+	f.expect(f.do("PUT", query("/api/bids/"+bidId+"/scores", "username", reviewer.Username),
+		map[string]any{"scores": body}), status, &card)
+	return card
+}
+
+// createScoredBid publishes a bid of author on the tender with the fields
+// criteria are measured on.
+func (f *fixture) createScoredBid(author *api.User, tenderId, name string, fields map[string]any) api.Bid {
+	f.t.Helper()
+
+	body := map[string]any{
+		"name":        name,
+		"description": "Описание " + name,
+		"tenderId":    tenderId,
+		"authorType":  "User",
+		"authorId":    author.Id,
+	}
+	maps.Copy(body, fields)
+	var bid api.Bid
+	f.expect(f.do("POST", "/api/bids/new", body), http.StatusOK, &bid)
+	f.publishBid(author, bid.Id)
+	return bid
+}
+
+func TestScoring(t *testing.T) {
+	f := newFixture(t)
+
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            "Дубли",
+		"description":     "Описание",
+		"serviceType":     "Delivery",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"budget":          map[string]any{"max": "1000", "currency": "RUB"},
+		"criteria": []map[string]any{
+			{"name": "Цена", "kind": "price", "weight": 1},
+			{"name": "Цена", "kind": "custom", "weight": 1},
+		},
+	}), http.StatusBadRequest, nil)
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            "Без бюджета",
+		"description":     "Описание",
+		"serviceType":     "Delivery",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"criteria":        []map[string]any{{"name": "Цена", "kind": "price", "weight": 1}},
+	}), http.StatusBadRequest, nil)
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":            "С оценкой",
+		"description":     "Описание",
+		"serviceType":     "Delivery",
+		"organizationId":  f.org,
+		"creatorUsername": f.owners[0].Username,
+		"budget":          map[string]any{"max": "1000", "currency": "RUB"},
+		"criteria": []map[string]any{
+			{"name": "Цена", "kind": "price", "weight": 3},
+			{"name": "Гарантия", "kind": "warranty", "weight": 1},
+		},
+	}), http.StatusOK, &tender)
+	if tender.Criteria == nil || len(*tender.Criteria) != 2 {
+		t.Fatalf("criteria = %+v", tender.Criteria)
+	}
+	f.publishTender(f.owners[0], tender.Id)
+
+	cheap := f.createScoredBid(f.bidder, tender.Id, "Дешево", map[string]any{"price": "800", "currency": "RUB"})
+	solid := f.createScoredBid(f.freelancer, tender.Id, "Надежно", map[string]any{"price": "1000", "currency": "RUB"})
+	f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Черновик")
+
+	f.scoreBid(f.owners[0], cheap.Id, http.StatusBadRequest, map[string]int{})
+	f.scoreBid(f.owners[0], cheap.Id, http.StatusBadRequest, map[string]int{"Цена": 9, "Гарантия": 2})
+	f.scoreBid(f.owners[0], cheap.Id, http.StatusBadRequest, map[string]int{"Гарантия": 2, "Сроки": 5})
+	f.scoreBid(f.bidder, cheap.Id, http.StatusForbidden, map[string]int{"Гарантия": 10})
+
+	f.scoreBid(f.owners[0], cheap.Id, http.StatusOK, map[string]int{"Гарантия": 1})
+	card := f.scoreBid(f.owners[0], cheap.Id, http.StatusOK, map[string]int{"Гарантия": 2})
+	if card.Version != 2 || card.Locked {
+		t.Errorf("rescored card = %+v", card)
+	}
+	f.scoreBid(f.owners[1], cheap.Id, http.StatusOK, map[string]int{"Гарантия": 4})
+	f.scoreBid(f.owners[0], solid.Id, http.StatusOK, map[string]int{"Гарантия": 10})
+
+	var ranking []api.RankedBid
+	f.expect(f.do("GET", query("/api/tenders/"+tender.Id+"/ranking", "username", f.owners[2].Username), nil),
+		http.StatusOK, &ranking)
+	// solid is priced 8 (800 / 1000 * 10) and scored 10: (8*3 + 10*1) / 4 / 10 * 100 = 85;
+	// cheap is priced 10 and averages 3: (10*3 + 3*1) / 4 / 10 * 100 = 82.5. The draft isn't ranked.
+	if len(ranking) != 2 || ranking[0].BidId != solid.Id || ranking[0].Total != 85 || ranking[0].Criteria[0].Score != 8 ||
+		ranking[1].BidId != cheap.Id || ranking[1].Total != 82.5 || ranking[1].Reviewers != 2 || ranking[1].Rank != 2 {
+		t.Errorf("ranking = %+v", ranking)
+	}
+	f.expect(f.do("GET", query("/api/tenders/"+tender.Id+"/ranking", "username", f.bidder.Username), nil),
+		http.StatusForbidden, nil)
+
+	// A decision locks the scores of the responsible who submitted it.
+	f.expect(f.do("PUT", query("/api/bids/"+cheap.Id+"/submit_decision",
+		"decision", "Approved", "username", f.owners[0].Username), nil), http.StatusOK, nil)
+	f.scoreBid(f.owners[0], cheap.Id, http.StatusForbidden, map[string]int{"Гарантия": 1})
+	f.scoreBid(f.owners[1], cheap.Id, http.StatusOK, map[string]int{"Гарантия": 5})
+
+	var cards []api.BidScorecard
+	f.expect(f.do("GET", query("/api/bids/"+cheap.Id+"/scores", "username", f.owners[1].Username), nil),
+		http.StatusOK, &cards)
+	if len(cards) != 2 || cards[0].ReviewerUsername != f.owners[0].Username || !cards[0].Locked || cards[0].Version != 2 ||
+		cards[1].Locked || cards[1].Version != 2 {
+		t.Errorf("scorecards = %+v", cards)
+	}
+
+	t.Run("measured", func(t *testing.T) {
+		var tender api.Tender
+		f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+			"name":            "По срокам",
+			"description":     "Описание",
+			"serviceType":     "Delivery",
+			"organizationId":  f.org,
+			"creatorUsername": f.owners[0].Username,
+			"criteria":        []map[string]any{{"name": "Сроки", "kind": "deliveryTime", "weight": 1}},
+		}), http.StatusOK, &tender)
+		f.publishTender(f.owners[0], tender.Id)
+		fast := f.createScoredBid(f.bidder, tender.Id, "Быстро", map[string]any{"deliveryDays": 5})
+		slow := f.createScoredBid(f.freelancer, tender.Id, "Медленно", map[string]any{"deliveryDays": 20})
+
+		// Nothing is left for the responsibles to score.
+		f.scoreBid(f.owners[0], fast.Id, http.StatusBadRequest, map[string]int{})
+
+		var ranking []api.RankedBid
+		f.expect(f.do("GET", query("/api/tenders/"+tender.Id+"/ranking", "username", f.owners[0].Username), nil),
+			http.StatusOK, &ranking)
+		if len(ranking) != 2 || ranking[0].BidId != fast.Id || ranking[0].Total != 100 || ranking[0].Reviewers != 0 ||
+			ranking[1].BidId != slow.Id || ranking[1].Total != 25 {
+			t.Errorf("ranking = %+v", ranking)
+		}
+	})
+
+	t.Run("no criteria", func(t *testing.T) {
+		plain := f.createTender(f.owners[0], "Без критериев", "Delivery")
+		f.publishTender(f.owners[0], plain.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, plain.Id, "Обычное")
+		f.publishBid(f.bidder, bid.Id)
+
+		f.scoreBid(f.owners[0], bid.Id, http.StatusBadRequest, map[string]int{"Цена": 5})
+		f.expect(f.do("GET", query("/api/tenders/"+plain.Id+"/ranking", "username", f.owners[0].Username), nil),
+			http.StatusBadRequest, nil)
+	})
+}
@@ -0,0 +1,190 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"my_zad/api"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSealedBids(t *testing.T) {
+	f := newFixture(t)
+
+	var tender api.Tender
+	f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+		"name":               "Закрытый",
+		"description":        "Описание",
+		"serviceType":        "Delivery",
+		"organizationId":     f.org,
+		"creatorUsername":    f.owners[0].Username,
+		"submissionDeadline": time.Now().Add(time.Hour),
+		"sealed":             true,
+	}), http.StatusOK, &tender)
+	if !tender.Sealed || tender.RevealedAt != nil {
+		t.Fatalf("created tender sealed = %v, revealedAt = %v", tender.Sealed, tender.RevealedAt)
+	}
+	f.publishTender(f.owners[0], tender.Id)
+
+	bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Секрет")
+	f.publishBid(f.bidder, bid.Id)
+
+	list := query("/api/bids/"+tender.Id+"/list", "username", f.owners[0].Username)
+	f.expect(f.do("GET", list, nil), http.StatusForbidden, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/submit_decision",
+		"decision", "Approved", "username", f.owners[0].Username), nil), http.StatusForbidden, nil)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback",
+		"bidFeedback", "Подглядел", "username", f.owners[0].Username), nil), http.StatusForbidden, nil)
+
+	// The author still sees their own bid.
+	var own []api.Bid
+	f.expect(f.do("GET", query("/api/bids/my", "username", f.bidder.Username), nil), http.StatusOK, &own)
+	if bidNames(own) != "Секрет" {
+		t.Errorf("author bids = %s", bidNames(own))
+	}
+
+	var closed api.Tender
+	f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/status",
+		"status", "Closed", "username", f.owners[0].Username), nil), http.StatusOK, &closed)
+	if closed.RevealedAt == nil {
+		t.Fatal("closing the tender didn't reveal its bids")
+	}
+
+	var bids []api.Bid
+	f.expect(f.do("GET", list, nil), http.StatusOK, &bids)
+	if bidNames(bids) != "Секрет" {
+		t.Errorf("revealed bids = %s", bidNames(bids))
+	}
+	f.expect(f.do("PATCH", query("/api/bids/"+bid.Id+"/edit", "username", f.bidder.Username),
+		map[string]any{"name": "Подмена"}), http.StatusBadRequest, nil)
+
+	t.Run("deadline", func(t *testing.T) {
+		var tender api.Tender
+		f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+			"name":               "По сроку",
+			"description":        "Описание",
+			"serviceType":        "Delivery",
+			"organizationId":     f.org,
+			"creatorUsername":    f.owners[0].Username,
+			"submissionDeadline": time.Now().Add(time.Hour),
+			"sealed":             true,
+		}), http.StatusOK, &tender)
+		f.publishTender(f.owners[0], tender.Id)
+		f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Вовремя")
+
+		// Move the deadline behind the handlers' back: the list reveals
+		// the bids without waiting for the scheduler.
+		past := time.Now().Add(-time.Minute)
+		if _, err := f.store.UpdateTenderById(tender.Id, api.EditTenderJSONRequestBody{SubmissionDeadline: &past}); err != nil {
+			t.Fatal(err)
+		}
+
+		f.expect(f.do("GET", query("/api/bids/"+tender.Id+"/list", "username", f.owners[1].Username), nil), http.StatusOK, nil)
+		if got := f.tenderStatus(tender.Id); got != api.TenderStatusPublished {
+			t.Errorf("status = %s, want Published until the scheduler closes it", got)
+		}
+		got, err := f.store.GetTenderById(tender.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.RevealedAt == nil {
+			t.Error("bids past the deadline are not revealed")
+		}
+
+		// The reveal is audited, newest entry first.
+		var entries []api.AuditEntry
+		f.expect(f.do("GET", query("/api/organizations/"+f.org+"/audit", "username", f.owners[0].Username,
+			"entityType", "tender", "entityId", tender.Id), nil), http.StatusOK, &entries)
+		if len(entries) == 0 || entries[0].Action != api.AuditActionRevealTenderBids || entries[0].Actor != "system" ||
+			entries[0].After == nil || (*entries[0].After)["revealTrigger"] != string(api.RevealTriggerDeadline) {
+			t.Errorf("audit entries = %+v", entries)
+		}
+	})
+
+	t.Run("award", func(t *testing.T) {
+		var tender api.Tender
+		f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+			"name":               "С победителем",
+			"description":        "Описание",
+			"serviceType":        "Delivery",
+			"organizationId":     f.org,
+			"creatorUsername":    f.owners[0].Username,
+			"submissionDeadline": time.Now().Add(time.Hour),
+			"sealed":             true,
+		}), http.StatusOK, &tender)
+		f.publishTender(f.owners[0], tender.Id)
+		winner := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Лучшая")
+		f.publishBid(f.bidder, winner.Id)
+		loser := f.createBid(f.freelancer, api.BidAuthorTypeUser, tender.Id, "Худшая")
+		f.publishBid(f.freelancer, loser.Id)
+
+		// The scheduler closes the tender at its deadline and reveals the
+		// bids; the responsibles decide on them afterwards.
+		if err := api.NewScheduler(f.store, nil, time.Minute).Tick(time.Now().Add(2 * time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+		if got := f.tenderStatus(tender.Id); got != api.TenderStatusClosed {
+			t.Fatalf("status = %s, want Closed past the deadline", got)
+		}
+
+		decide := func(bidId, decision string, owner *api.User) response {
+			return f.do("PUT", query("/api/bids/"+bidId+"/submit_decision",
+				"decision", decision, "username", owner.Username), nil)
+		}
+		f.expect(decide(loser.Id, "Rejected", f.owners[0]), http.StatusOK, nil)
+		for _, owner := range f.owners {
+			f.expect(decide(winner.Id, "Approved", owner), http.StatusOK, nil)
+		}
+		f.expect(decide(loser.Id, "Approved", f.owners[1]), http.StatusBadRequest, nil)
+	})
+
+	t.Run("attachments", func(t *testing.T) {
+		var tender api.Tender
+		f.expect(f.do("POST", "/api/tenders/new", map[string]any{
+			"name":               "С вложениями",
+			"description":        "Описание",
+			"serviceType":        "Delivery",
+			"organizationId":     f.org,
+			"creatorUsername":    f.owners[0].Username,
+			"submissionDeadline": time.Now().Add(time.Hour),
+			"sealed":             true,
+		}), http.StatusOK, &tender)
+		f.publishTender(f.owners[0], tender.Id)
+		bid := f.createBid(f.bidder, api.BidAuthorTypeUser, tender.Id, "Со сметой")
+		contents := []byte("Смета: 1000 рублей")
+		attachment := f.upload(f.bidder, "/api/bids/"+bid.Id+"/attachments", "смета.txt", "text/plain", contents, http.StatusOK)
+
+		// The blob is encrypted, and the audit log doesn't tell what the
+		// file is.
+		blob, err := f.blobs.Get(context.Background(), attachment.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stored, _ := io.ReadAll(blob)
+		blob.Close()
+		if bytes.Contains(stored, []byte("Смета")) {
+			t.Error("attachment is stored in plaintext")
+		}
+		var entries []api.AuditEntry
+		f.expect(f.do("GET", query("/api/organizations/"+f.org+"/audit", "username", f.owners[0].Username,
+			"entityType", "attachment", "entityId", attachment.Id), nil), http.StatusOK, &entries)
+		if len(entries) != 1 || entries[0].After == nil {
+			t.Fatalf("audit entries = %+v", entries)
+		}
+		for _, key := range []string{"name", "contentType", "size", "sha256"} {
+			if _, ok := (*entries[0].After)[key]; ok {
+				t.Errorf("audit entry tells the %s of the attachment", key)
+			}
+		}
+
+		download := query("/api/attachments/"+attachment.Id, "username", f.owners[0].Username)
+		f.expect(f.do("GET", download, nil), http.StatusForbidden, nil)
+		f.expect(f.do("PUT", query("/api/tenders/"+tender.Id+"/status",
+			"status", "Closed", "username", f.owners[0].Username), nil), http.StatusOK, nil)
+		if resp := f.do("GET", download, nil); resp.status != http.StatusOK || !bytes.Equal(resp.body, contents) {
+			t.Errorf("download = %d %q", resp.status, resp.body)
+		}
+	})
+}
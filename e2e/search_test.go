@@ -0,0 +1,91 @@
+package e2e
+
+import (
+	"my_zad/api"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	f := newFixture(t)
+
+	roads := f.createTender(f.owners[0], "Ремонт дорог", "Construction")
+	f.publishTender(f.owners[0], roads.Id)
+	supplies := f.createTender(f.owners[0], "Доставка ремонтных материалов", "Delivery")
+	f.publishTender(f.owners[0], supplies.Id)
+	roof := f.createTender(f.owners[0], "Ремонт кровли", "Construction")
+
+	search := func(user *api.User, kv ...string) []api.TenderSearchHit {
+		t.Helper()
+		if user != nil {
+			kv = append(kv, "username", user.Username)
+		}
+		var hits []api.TenderSearchHit
+		f.expect(f.do("GET", query("/api/tenders/search", kv...), nil), http.StatusOK, &hits)
+		return hits
+	}
+	names := func(hits []api.TenderSearchHit) []string {
+		names := []string{}
+		for _, h := range hits {
+			names = append(names, h.Tender.Name)
+		}
+		return names
+	}
+
+	hits := search(nil, "q", "ремонты")
+	if got := names(hits); len(got) != 2 || got[0] != "Доставка ремонтных материалов" || got[1] != "Ремонт дорог" {
+		t.Errorf("anonymous search = %v", got)
+	}
+	if !strings.Contains(hits[1].Snippet, "<b>Ремонт</b> дорог") || hits[1].Rank <= 0 {
+		t.Errorf("hit = %+v", hits[1])
+	}
+
+	// Responsibles find their tenders in any status.
+	if got := names(search(f.owners[1], "q", "ремонт", "service_type", "Construction")); len(got) != 2 {
+		t.Errorf("responsible search = %v", got)
+	}
+	if got := names(search(f.owners[1], "q", "ремонт", "status", "Created")); len(got) != 1 || got[0] != roof.Name {
+		t.Errorf("created search = %v", got)
+	}
+	if got := names(search(f.freelancer, "q", "ремонт", "service_type", "Construction")); len(got) != 1 || got[0] != roads.Name {
+		t.Errorf("bidder search = %v", got)
+	}
+	if got := names(search(f.owners[1], "q", "ремонт -кровли -доставка")); len(got) != 1 || got[0] != roads.Name {
+		t.Errorf("excluding search = %v", got)
+	}
+	if got := names(search(nil, "q", "ремонт", "organizationId", f.rival)); len(got) != 0 {
+		t.Errorf("rival search = %v", got)
+	}
+	f.expect(f.do("GET", query("/api/tenders/search", "q", " "), nil), http.StatusBadRequest, nil)
+
+	t.Run("bids", func(t *testing.T) {
+		hot := f.createBid(f.bidder, api.BidAuthorTypeUser, roads.Id, "Горячий асфальт")
+		f.createBid(f.freelancer, api.BidAuthorTypeUser, roads.Id, "Холодный асфальт")
+
+		search := func(user *api.User, kv ...string) []api.BidSearchHit {
+			t.Helper()
+			var hits []api.BidSearchHit
+			f.expect(f.do("GET", query("/api/bids/search", append(kv, "username", user.Username)...), nil),
+				http.StatusOK, &hits)
+			return hits
+		}
+
+		if hits := search(f.bidder, "q", "асфальт"); len(hits) != 1 || hits[0].Bid.Id != hot.Id {
+			t.Errorf("author search = %+v", hits)
+		}
+		// Reviewers only find submitted bids.
+		if hits := search(f.owners[2], "q", "асфальт"); len(hits) != 0 {
+			t.Errorf("reviewer search before publishing = %+v", hits)
+		}
+		f.publishBid(f.bidder, hot.Id)
+		if hits := search(f.owners[2], "q", "асфальт", "tenderId", roads.Id); len(hits) != 1 || hits[0].Bid.Id != hot.Id {
+			t.Errorf("reviewer search = %+v", hits)
+		}
+		if hits := search(f.owners[2], "q", "асфальт", "tenderId", supplies.Id); len(hits) != 0 {
+			t.Errorf("search on another tender = %+v", hits)
+		}
+		f.expect(f.do("GET", query("/api/bids/search", "q", "асфальт", "username", "nobody"), nil),
+			http.StatusUnauthorized, nil)
+	})
+}
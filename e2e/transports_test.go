@@ -0,0 +1,327 @@
+package e2e
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"my_zad/api"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// tenderAPI is what the transport suite does with tenders and bids, over
+// REST or over gRPC. A failed call returns an *api.HTTPError, which the
+// gRPC transport makes of the status of the call.
+type tenderAPI interface {
+	createTender(body api.CreateTenderJSONRequestBody) (*api.Tender, error)
+	listTenders(username *string) ([]api.Tender, error)
+	userTenders(username string) ([]api.Tender, error)
+	tenderStatus(tenderId string, username *string) (api.TenderStatus, error)
+	setTenderStatus(tenderId string, status api.TenderStatus, username string) (*api.Tender, error)
+	editTender(tenderId, username string, body api.EditTenderJSONRequestBody) (*api.Tender, error)
+	rollbackTender(tenderId string, version int32, username string) (*api.Tender, error)
+
+	createBid(body api.CreateBidJSONRequestBody) (*api.Bid, error)
+	userBids(username string) ([]api.Bid, error)
+	tenderBids(tenderId, username string) ([]api.Bid, error)
+	bidStatus(bidId, username string) (api.BidStatus, error)
+	setBidStatus(bidId string, status api.BidStatus, username string) (*api.Bid, error)
+	editBid(bidId, username string, body api.EditBidJSONRequestBody) (*api.Bid, error)
+	rollbackBid(bidId string, version int32, username string) (*api.Bid, error)
+	decide(bidId string, decision api.BidDecision, username string) (*api.Bid, error)
+	feedback(bidId, feedback, username string) (*api.Bid, error)
+	reviews(tenderId, authorUsername, requesterUsername string) ([]api.BidReview, error)
+}
+
+// restAPI calls the REST API of a fixture.
+type restAPI struct {
+	f *fixture
+}
+
+// call sends a request and decodes the response into out or into the
+// error it reports.
+func (r restAPI) call(method, path string, body, out any) error {
+	resp := r.f.do(method, path, body)
+	if resp.status != http.StatusOK {
+		var reason api.ErrorResponse
+		if err := json.Unmarshal(resp.body, &reason); err != nil {
+			r.f.t.Fatalf("decode %s: %v", resp.body, err)
+		}
+		return &api.HTTPError{Status: resp.status, Reason: reason.Reason}
+	}
+	if err := json.Unmarshal(resp.body, out); err != nil {
+		r.f.t.Fatalf("decode %s: %v", resp.body, err)
+	}
+	return nil
+}
+
+func restCall[T any](r restAPI, method, path string, body any) (T, error) {
+	var out T
+	err := r.call(method, path, body, &out)
+	return out, err
+}
+
+func (r restAPI) createTender(body api.CreateTenderJSONRequestBody) (*api.Tender, error) {
+	return restCall[*api.Tender](r, "POST", "/api/tenders/new", body)
+}
+
+func (r restAPI) listTenders(username *string) ([]api.Tender, error) {
+	if username == nil {
+		return restCall[[]api.Tender](r, "GET", "/api/tenders", nil)
+	}
+	return restCall[[]api.Tender](r, "GET", query("/api/tenders", "username", *username), nil)
+}
+
+func (r restAPI) userTenders(username string) ([]api.Tender, error) {
+	return restCall[[]api.Tender](r, "GET", query("/api/tenders/my", "username", username), nil)
+}
+
+func (r restAPI) tenderStatus(tenderId string, username *string) (api.TenderStatus, error) {
+	path := "/api/tenders/" + tenderId + "/status"
+	if username != nil {
+		path = query(path, "username", *username)
+	}
+	return restCall[api.TenderStatus](r, "GET", path, nil)
+}
+
+func (r restAPI) setTenderStatus(tenderId string, status api.TenderStatus, username string) (*api.Tender, error) {
+	return restCall[*api.Tender](r, "PUT", query("/api/tenders/"+tenderId+"/status",
+		"status", string(status), "username", username), nil)
+}
+
+func (r restAPI) editTender(tenderId, username string, body api.EditTenderJSONRequestBody) (*api.Tender, error) {
+	return restCall[*api.Tender](r, "PATCH", query("/api/tenders/"+tenderId+"/edit", "username", username), body)
+}
+
+func (r restAPI) rollbackTender(tenderId string, version int32, username string) (*api.Tender, error) {
+	return restCall[*api.Tender](r, "PUT", query(fmt.Sprintf("/api/tenders/%s/rollback/%d", tenderId, version),
+		"username", username), nil)
+}
+
+func (r restAPI) createBid(body api.CreateBidJSONRequestBody) (*api.Bid, error) {
+	return restCall[*api.Bid](r, "POST", "/api/bids/new", body)
+}
+
+func (r restAPI) userBids(username string) ([]api.Bid, error) {
+	return restCall[[]api.Bid](r, "GET", query("/api/bids/my", "username", username), nil)
+}
+
+func (r restAPI) tenderBids(tenderId, username string) ([]api.Bid, error) {
+	return restCall[[]api.Bid](r, "GET", query("/api/bids/"+tenderId+"/list", "username", username), nil)
+}
+
+func (r restAPI) bidStatus(bidId, username string) (api.BidStatus, error) {
+	return restCall[api.BidStatus](r, "GET", query("/api/bids/"+bidId+"/status", "username", username), nil)
+}
+
+func (r restAPI) setBidStatus(bidId string, status api.BidStatus, username string) (*api.Bid, error) {
+	return restCall[*api.Bid](r, "PUT", query("/api/bids/"+bidId+"/status",
+		"status", string(status), "username", username), nil)
+}
+
+func (r restAPI) editBid(bidId, username string, body api.EditBidJSONRequestBody) (*api.Bid, error) {
+	return restCall[*api.Bid](r, "PATCH", query("/api/bids/"+bidId+"/edit", "username", username), body)
+}
+
+func (r restAPI) rollbackBid(bidId string, version int32, username string) (*api.Bid, error) {
+	return restCall[*api.Bid](r, "PUT", query(fmt.Sprintf("/api/bids/%s/rollback/%d", bidId, version),
+		"username", username), nil)
+}
+
+func (r restAPI) decide(bidId string, decision api.BidDecision, username string) (*api.Bid, error) {
+	return restCall[*api.Bid](r, "PUT", query("/api/bids/"+bidId+"/submit_decision",
+		"decision", string(decision), "username", username), nil)
+}
+
+func (r restAPI) feedback(bidId, feedback, username string) (*api.Bid, error) {
+	return restCall[*api.Bid](r, "PUT", query("/api/bids/"+bidId+"/feedback",
+		"bidFeedback", feedback, "username", username), nil)
+}
+
+func (r restAPI) reviews(tenderId, authorUsername, requesterUsername string) ([]api.BidReview, error) {
+	return restCall[[]api.BidReview](r, "GET", query("/api/bids/"+tenderId+"/reviews",
+		"authorUsername", authorUsername, "requesterUsername", requesterUsername), nil)
+}
+
+// transcript records what every step of a scenario returned, with the ids
+// and times that differ from run to run replaced by placeholders.
+type transcript struct {
+	t     *testing.T
+	lines []string
+	ids   map[string]string
+}
+
+var (
+	uuidPattern = regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`)
+	timePattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})`)
+)
+
+// result is what a step returned.
+type result[T any] struct {
+	v   T
+	err error
+}
+
+func got[T any](v T, err error) result[T] {
+	return result[T]{v, err}
+}
+
+func (tr *transcript) record(step string, v any, err error) {
+	tr.t.Helper()
+
+	line := step + ": "
+	var httpErr *api.HTTPError
+	switch {
+	case errors.As(err, &httpErr):
+		line += fmt.Sprintf("%d %s", httpErr.Status, httpErr.Reason)
+	case err != nil:
+		tr.t.Fatalf("%s: %v", step, err)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			tr.t.Fatal(err)
+		}
+		line += string(data)
+	}
+
+	line = uuidPattern.ReplaceAllStringFunc(line, func(id string) string {
+		if _, ok := tr.ids[id]; !ok {
+			tr.ids[id] = "id" + strconv.Itoa(len(tr.ids)+1)
+		}
+		return tr.ids[id]
+	})
+	tr.lines = append(tr.lines, timePattern.ReplaceAllString(line, "<time>"))
+}
+
+// must records a step that has to succeed and returns its result.
+func must[T any](tr *transcript, step string, r result[T]) T {
+	tr.t.Helper()
+	tr.record(step, r.v, r.err)
+	if r.err != nil {
+		tr.t.Fatalf("%s: %v", step, r.err)
+	}
+	return r.v
+}
+
+// fails records a step that has to fail with status.
+func fails[T any](tr *transcript, step string, status int, r result[T]) {
+	tr.t.Helper()
+	tr.record(step, r.v, r.err)
+	var httpErr *api.HTTPError
+	if !errors.As(r.err, &httpErr) || httpErr.Status != status {
+		tr.t.Errorf("%s: got %v, want status %d", step, r.err, status)
+	}
+}
+
+// tenderScenario runs the life of a tender through c: creation, editing,
+// publication, bids, feedback and decisions, along with the requests the
+// rules turn down.
+func tenderScenario(t *testing.T, f *fixture, c tenderAPI) []string {
+	tr := &transcript{t: t, ids: map[string]string{}}
+	owner, other := f.owners[0].Username, f.owners[1].Username
+	newTender := api.CreateTenderJSONRequestBody{
+		Name: "Доставка", Description: "Доставка труб", ServiceType: api.TenderServiceTypeDelivery,
+		OrganizationId: f.org, CreatorUsername: owner,
+	}
+
+	tender := must(tr, "create tender", got(c.createTender(newTender)))
+	strange := newTender
+	strange.CreatorUsername = "nobody"
+	fails(tr, "create tender as nobody", http.StatusUnauthorized, got(c.createTender(strange)))
+	strange.CreatorUsername = f.bidder.Username
+	fails(tr, "create tender as outsider", http.StatusForbidden, got(c.createTender(strange)))
+	strange.CreatorUsername, strange.ServiceType = owner, "Repair"
+	fails(tr, "create tender of unknown type", http.StatusBadRequest, got(c.createTender(strange)))
+	strange.ServiceType, strange.Name = api.TenderServiceTypeDelivery, strings.Repeat("я", 101)
+	fails(tr, "create tender with long name", http.StatusBadRequest, got(c.createTender(strange)))
+
+	if tenders := must(tr, "list tenders", got(c.listTenders(nil))); len(tenders) != 0 {
+		t.Errorf("unpublished tender listed: %v", tenders)
+	}
+	must(tr, "list tenders of owner", got(c.listTenders(&owner)))
+	fails(tr, "tender status for freelancer", http.StatusForbidden, got(c.tenderStatus(tender.Id, &f.freelancer.Username)))
+	fails(tr, "status of missing tender", http.StatusNotFound, got(c.tenderStatus("3fa85f64-5717-4562-b3fc-2c963f66afa6", &owner)))
+
+	name := "Доставка труб и фитингов"
+	edited := must(tr, "edit tender", got(c.editTender(tender.Id, other, api.EditTenderJSONRequestBody{Name: &name})))
+	if edited.Version != 2 {
+		t.Errorf("edited tender version = %d", edited.Version)
+	}
+	fails(tr, "edit tender as outsider", http.StatusForbidden, got(c.editTender(tender.Id, f.bidder.Username, api.EditTenderJSONRequestBody{Name: &name})))
+	must(tr, "rollback tender", got(c.rollbackTender(tender.Id, 1, owner)))
+	fails(tr, "rollback tender to missing version", http.StatusNotFound, got(c.rollbackTender(tender.Id, 9, owner)))
+	must(tr, "publish tender", got(c.setTenderStatus(tender.Id, api.TenderStatusPublished, owner)))
+	must(tr, "tender status", got(c.tenderStatus(tender.Id, nil)))
+	must(tr, "list published tenders", got(c.listTenders(nil)))
+
+	price := "1500.00"
+	bid := must(tr, "create bid", got(c.createBid(api.CreateBidJSONRequestBody{
+		Name: "Трубы", Description: "Трубы за неделю", TenderId: tender.Id,
+		AuthorType: api.BidAuthorTypeOrganization, AuthorId: f.bidder.Id,
+	})))
+	draft := must(tr, "create freelancer bid", got(c.createBid(api.CreateBidJSONRequestBody{
+		Name: "Фитинги", Description: "Фитинги", TenderId: tender.Id,
+		AuthorType: api.BidAuthorTypeUser, AuthorId: f.freelancer.Id,
+	})))
+	fails(tr, "create bid on missing tender", http.StatusNotFound, got(c.createBid(api.CreateBidJSONRequestBody{
+		Name: "Трубы", Description: "Трубы", TenderId: "3fa85f64-5717-4562-b3fc-2c963f66afa6",
+		AuthorType: api.BidAuthorTypeUser, AuthorId: f.freelancer.Id,
+	})))
+
+	if bids := must(tr, "bids before publishing", got(c.tenderBids(tender.Id, owner))); len(bids) != 0 {
+		t.Errorf("unpublished bids listed: %v", bids)
+	}
+	fails(tr, "bids for outsider", http.StatusForbidden, got(c.tenderBids(tender.Id, f.bidder.Username)))
+	fails(tr, "bid status for freelancer", http.StatusForbidden, got(c.bidStatus(bid.Id, f.freelancer.Username)))
+	must(tr, "edit bid", got(c.editBid(bid.Id, f.bidder.Username, api.EditBidJSONRequestBody{Price: &price, Currency: ptr("RUB")})))
+	must(tr, "rollback bid", got(c.rollbackBid(bid.Id, 1, f.bidder.Username)))
+	must(tr, "publish bid", got(c.setBidStatus(bid.Id, api.BidStatusPublished, f.bidder.Username)))
+	must(tr, "bid status", got(c.bidStatus(bid.Id, f.bidder.Username)))
+	must(tr, "bids of tender", got(c.tenderBids(tender.Id, owner)))
+	must(tr, "bids of bidder", got(c.userBids(f.bidder.Username)))
+
+	fails(tr, "decide on draft", http.StatusBadRequest, got(c.decide(draft.Id, api.BidDecisionApproved, owner)))
+	fails(tr, "decide as bidder", http.StatusForbidden, got(c.decide(bid.Id, api.BidDecisionApproved, f.bidder.Username)))
+	must(tr, "feedback", got(c.feedback(bid.Id, "Хорошие трубы", owner)))
+	if reviews := must(tr, "reviews", got(c.reviews(tender.Id, f.bidder.Username, other))); len(reviews) != 1 {
+		t.Errorf("reviews = %v", reviews)
+	}
+	fails(tr, "reviews for bidder", http.StatusForbidden, got(c.reviews(tender.Id, f.bidder.Username, f.bidder.Username)))
+	must(tr, "approve", got(c.decide(bid.Id, api.BidDecisionApproved, owner)))
+	fails(tr, "approve again", http.StatusBadRequest, got(c.decide(bid.Id, api.BidDecisionApproved, owner)))
+	must(tr, "approve by second owner", got(c.decide(bid.Id, api.BidDecisionApproved, other)))
+	must(tr, "tender status after approval", got(c.tenderStatus(tender.Id, &owner)))
+	must(tr, "tenders of owner", got(c.userTenders(owner)))
+	return tr.lines
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// TestTransports runs the same scenario over REST and over gRPC, each on
+// a fresh server, and checks that every step returns the same.
+func TestTransports(t *testing.T) {
+	var transcripts [2][]string
+	t.Run("rest", func(t *testing.T) {
+		f := newFixture(t)
+		transcripts[0] = tenderScenario(t, f, restAPI{f})
+	})
+	t.Run("grpc", func(t *testing.T) {
+		f := newFixture(t)
+		transcripts[1] = tenderScenario(t, f, newGRPCAPI(f))
+	})
+
+	rest, grpc := transcripts[0], transcripts[1]
+	if len(rest) != len(grpc) {
+		t.Fatalf("rest ran %d steps, grpc %d", len(rest), len(grpc))
+	}
+	for i := range rest {
+		if rest[i] != grpc[i] {
+			t.Errorf("transports differ:\nrest %s\ngrpc %s", rest[i], grpc[i])
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"my_zad/api"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// receiver is a local HTTP endpoint recording the webhooks it gets.
+type receiver struct {
+	mu       sync.Mutex
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header  http.Header
+	body    []byte
+	payload api.WebhookPayload
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	got := receivedWebhook{header: r.Header, body: body}
+	json.Unmarshal(body, &got.payload)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.requests = append(rc.requests, got)
+}
+
+func (rc *receiver) received() []receivedWebhook {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]receivedWebhook(nil), rc.requests...)
+}
+
+func TestWebhooks(t *testing.T) {
+	f := newFixture(t)
+	rc := &receiver{}
+	srv := httptest.NewServer(rc)
+	defer srv.Close()
+	relay := api.NewOutboxRelay(f.store, nil, time.Second, api.NewWebhookSink(f.store))
+	dispatcher := api.NewWebhookDispatcher(f.store, time.Second)
+
+	webhooks := "/api/organizations/" + f.org + "/webhooks"
+	events := []api.WebhookEvent{api.WebhookEventTenderPublished, api.WebhookEventBidCreated, api.WebhookEventBidDecision}
+	subscribe := map[string]any{"url": srv.URL + "/hooks", "events": events}
+
+	f.expect(f.do("POST", query(webhooks, "username", f.bidder.Username), subscribe), http.StatusForbidden, nil)
+	f.expect(f.do("POST", query(webhooks, "username", f.owners[0].Username),
+		map[string]any{"url": "ftp://erp.example.com", "events": events}), http.StatusBadRequest, nil)
+	// Webhooks can't probe the network of the service.
+	for _, url := range []string{srv.URL + "/hooks", "http://169.254.169.254/latest/meta-data", "http://[::1]/hooks"} {
+		f.expect(f.do("POST", query(webhooks, "username", f.owners[0].Username),
+			map[string]any{"url": url, "events": events}), http.StatusBadRequest, nil)
+	}
+	// The receiver of the test is on the loopback, though.
+	t.Setenv("WEBHOOK_ALLOW_PRIVATE", "true")
+
+	var webhook api.Webhook
+	f.expect(f.do("POST", query(webhooks, "username", f.owners[0].Username), subscribe), http.StatusOK, &webhook)
+	if webhook.Secret == nil || webhook.OrganizationId != f.org || len(webhook.Events) != 3 {
+		t.Fatalf("webhook = %+v", webhook)
+	}
+	secret := *webhook.Secret
+
+	var listed []api.Webhook
+	f.expect(f.do("GET", query(webhooks, "username", f.owners[1].Username), nil), http.StatusOK, &listed)
+	if len(listed) != 1 || listed[0].Id != webhook.Id || listed[0].Secret != nil {
+		t.Errorf("listed webhooks = %+v", listed)
+	}
+
+	tender := f.createTender(f.owners[0], "С уведомлениями", "Delivery")
+	f.publishTender(f.owners[0], tender.Id)
+	bid := f.createBid(f.bidder, api.BidAuthorTypeOrganization, tender.Id, "Быстрая доставка")
+	f.publishBid(f.bidder, bid.Id)
+	f.expect(f.do("PUT", query("/api/bids/"+bid.Id+"/feedback", "bidFeedback", "Хорошо", "username", f.owners[0].Username),
+		nil), http.StatusOK, nil)
+
+	// Events reach the webhooks once the outbox is relayed.
+	if err := dispatcher.Tick(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(rc.received()) != 0 {
+		t.Fatal("webhooks sent before the outbox was relayed")
+	}
+	if err := relay.Tick(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := dispatcher.Tick(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// feedback.created isn't subscribed to.
+	received := rc.received()
+	if len(received) != 2 {
+		t.Fatalf("received %d webhooks", len(received))
+	}
+	for i, want := range []api.WebhookEvent{api.WebhookEventTenderPublished, api.WebhookEventBidCreated} {
+		got := received[i]
+		if got.payload.Event != want || got.header.Get("X-Webhook-Event") != string(want) {
+			t.Errorf("webhook %d = %s %+v", i, got.header.Get("X-Webhook-Event"), got.payload)
+		}
+		if err := api.VerifyWebhook(secret, got.header.Get("X-Webhook-Signature"), got.body, time.Now(), time.Minute); err != nil {
+			t.Errorf("webhook %d: %v", i, err)
+		}
+	}
+	if received[0].payload.Data["id"] != tender.Id || received[1].payload.Data["name"] != bid.Name {
+		t.Errorf("payloads = %+v, %+v", received[0].payload, received[1].payload)
+	}
+	if received[0].payload.EventId == nil || received[1].payload.EventId == nil ||
+		*received[0].payload.EventId == *received[1].payload.EventId {
+		t.Errorf("event ids = %v, %v", received[0].payload.EventId, received[1].payload.EventId)
+	}
+
+	deliveries := "/api/webhooks/" + webhook.Id + "/deliveries"
+	var log []api.WebhookDelivery
+	f.expect(f.do("GET", query(deliveries, "username", f.owners[2].Username, "status", "Delivered"), nil),
+		http.StatusOK, &log)
+	if len(log) != 2 || log[0].Event != api.WebhookEventBidCreated || log[0].Attempts != 1 ||
+		*log[0].ResponseStatus != http.StatusOK || log[0].DeliveredAt == nil {
+		t.Errorf("delivery log = %+v", log)
+	}
+	f.expect(f.do("GET", query(deliveries, "username", f.bidder.Username), nil), http.StatusForbidden, nil)
+	f.expect(f.do("POST", query("/api/deliveries/"+log[0].Id+"/redeliver", "username", f.owners[0].Username), nil),
+		http.StatusBadRequest, nil)
+
+	t.Run("ping", func(t *testing.T) {
+		var ping api.WebhookDelivery
+		f.expect(f.do("POST", query("/api/webhooks/"+webhook.Id+"/ping", "username", f.owners[0].Username), nil),
+			http.StatusOK, &ping)
+		if ping.Status != api.WebhookDeliveryStatusPending || ping.Event != api.WebhookEventWebhookPing {
+			t.Errorf("ping = %+v", ping)
+		}
+		if err := dispatcher.Tick(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		received := rc.received()
+		if last := received[len(received)-1]; last.header.Get("X-Webhook-Delivery") != ping.Id {
+			t.Errorf("last webhook = %v", last.header)
+		}
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		f.expect(f.do("DELETE", query("/api/webhooks/"+webhook.Id, "username", f.owners[0].Username), nil),
+			http.StatusOK, nil)
+		f.expect(f.do("GET", query(deliveries, "username", f.owners[0].Username), nil), http.StatusNotFound, nil)
+	})
+}